@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -29,6 +30,8 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_VISIBILITY_PRIVATE     Visibility = 1
 	Visibility_VISIBILITY_PUBLIC      Visibility = 2
+	// Visible to the members of the organization owning the template.
+	Visibility_VISIBILITY_ORG Visibility = 3
 )
 
 // Enum value maps for Visibility.
@@ -37,11 +40,13 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "VISIBILITY_PRIVATE",
 		2: "VISIBILITY_PUBLIC",
+		3: "VISIBILITY_ORG",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"VISIBILITY_PRIVATE":     1,
 		"VISIBILITY_PUBLIC":      2,
+		"VISIBILITY_ORG":         3,
 	}
 )
 
@@ -122,60 +127,10910 @@ func (TemplateType) EnumDescriptor() ([]byte, []int) {
 	return file_prompt_proto_rawDescGZIP(), []int{1}
 }
 
+// TemplateSort defines the order in which templates are listed.
+type TemplateSort int32
+
+const (
+	// Defaults to newest first.
+	TemplateSort_TEMPLATE_SORT_UNSPECIFIED TemplateSort = 0
+	TemplateSort_TEMPLATE_SORT_NEWEST      TemplateSort = 1
+	// Ordered by the time-decayed trending score.
+	TemplateSort_TEMPLATE_SORT_TRENDING TemplateSort = 2
+	// Ordered by the Bayesian rating score, best rated first.
+	TemplateSort_TEMPLATE_SORT_RATING TemplateSort = 3
+)
+
+// Enum value maps for TemplateSort.
+var (
+	TemplateSort_name = map[int32]string{
+		0: "TEMPLATE_SORT_UNSPECIFIED",
+		1: "TEMPLATE_SORT_NEWEST",
+		2: "TEMPLATE_SORT_TRENDING",
+		3: "TEMPLATE_SORT_RATING",
+	}
+	TemplateSort_value = map[string]int32{
+		"TEMPLATE_SORT_UNSPECIFIED": 0,
+		"TEMPLATE_SORT_NEWEST":      1,
+		"TEMPLATE_SORT_TRENDING":    2,
+		"TEMPLATE_SORT_RATING":      3,
+	}
+)
+
+func (x TemplateSort) Enum() *TemplateSort {
+	p := new(TemplateSort)
+	*p = x
+	return p
+}
+
+func (x TemplateSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[2].Descriptor()
+}
+
+func (TemplateSort) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[2]
+}
+
+func (x TemplateSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateSort.Descriptor instead.
+func (TemplateSort) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{2}
+}
+
+// ShareRole defines what a user a template is shared with can do.
+type ShareRole int32
+
+const (
+	ShareRole_SHARE_ROLE_UNSPECIFIED ShareRole = 0
+	// Can view the template.
+	ShareRole_SHARE_ROLE_VIEWER ShareRole = 1
+	// Can view and update the template, but not change its visibility or delete it.
+	ShareRole_SHARE_ROLE_EDITOR ShareRole = 2
+)
+
+// Enum value maps for ShareRole.
+var (
+	ShareRole_name = map[int32]string{
+		0: "SHARE_ROLE_UNSPECIFIED",
+		1: "SHARE_ROLE_VIEWER",
+		2: "SHARE_ROLE_EDITOR",
+	}
+	ShareRole_value = map[string]int32{
+		"SHARE_ROLE_UNSPECIFIED": 0,
+		"SHARE_ROLE_VIEWER":      1,
+		"SHARE_ROLE_EDITOR":      2,
+	}
+)
+
+func (x ShareRole) Enum() *ShareRole {
+	p := new(ShareRole)
+	*p = x
+	return p
+}
+
+func (x ShareRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[3].Descriptor()
+}
+
+func (ShareRole) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[3]
+}
+
+func (x ShareRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareRole.Descriptor instead.
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{3}
+}
+
+// OrgRole defines what a member can do in an organization.
+type OrgRole int32
+
+const (
+	OrgRole_ORG_ROLE_UNSPECIFIED OrgRole = 0
+	// Can read the organization's library and add templates to it.
+	OrgRole_ORG_ROLE_MEMBER OrgRole = 1
+	// Can also edit every template of the library and invite and remove members.
+	OrgRole_ORG_ROLE_ADMIN OrgRole = 2
+	// Can also change member roles.
+	OrgRole_ORG_ROLE_OWNER OrgRole = 3
+)
+
+// Enum value maps for OrgRole.
+var (
+	OrgRole_name = map[int32]string{
+		0: "ORG_ROLE_UNSPECIFIED",
+		1: "ORG_ROLE_MEMBER",
+		2: "ORG_ROLE_ADMIN",
+		3: "ORG_ROLE_OWNER",
+	}
+	OrgRole_value = map[string]int32{
+		"ORG_ROLE_UNSPECIFIED": 0,
+		"ORG_ROLE_MEMBER":      1,
+		"ORG_ROLE_ADMIN":       2,
+		"ORG_ROLE_OWNER":       3,
+	}
+)
+
+func (x OrgRole) Enum() *OrgRole {
+	p := new(OrgRole)
+	*p = x
+	return p
+}
+
+func (x OrgRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrgRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[4].Descriptor()
+}
+
+func (OrgRole) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[4]
+}
+
+func (x OrgRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrgRole.Descriptor instead.
+func (OrgRole) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{4}
+}
+
+// ModerationState defines whether moderation keeps a template out of sight.
+type ModerationState int32
+
+const (
+	ModerationState_MODERATION_STATE_UNSPECIFIED ModerationState = 0
+	ModerationState_MODERATION_STATE_VISIBLE     ModerationState = 1
+	// Only the owner and administrators can see the template.
+	ModerationState_MODERATION_STATE_HIDDEN ModerationState = 2
+	// Only administrators can see the template.
+	ModerationState_MODERATION_STATE_REMOVED ModerationState = 3
+)
+
+// Enum value maps for ModerationState.
+var (
+	ModerationState_name = map[int32]string{
+		0: "MODERATION_STATE_UNSPECIFIED",
+		1: "MODERATION_STATE_VISIBLE",
+		2: "MODERATION_STATE_HIDDEN",
+		3: "MODERATION_STATE_REMOVED",
+	}
+	ModerationState_value = map[string]int32{
+		"MODERATION_STATE_UNSPECIFIED": 0,
+		"MODERATION_STATE_VISIBLE":     1,
+		"MODERATION_STATE_HIDDEN":      2,
+		"MODERATION_STATE_REMOVED":     3,
+	}
+)
+
+func (x ModerationState) Enum() *ModerationState {
+	p := new(ModerationState)
+	*p = x
+	return p
+}
+
+func (x ModerationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[5].Descriptor()
+}
+
+func (ModerationState) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[5]
+}
+
+func (x ModerationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationState.Descriptor instead.
+func (ModerationState) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{5}
+}
+
+// ReportReason defines why a template was reported.
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED   ReportReason = 0
+	ReportReason_REPORT_REASON_SPAM          ReportReason = 1
+	ReportReason_REPORT_REASON_ABUSE         ReportReason = 2
+	ReportReason_REPORT_REASON_INAPPROPRIATE ReportReason = 3
+	ReportReason_REPORT_REASON_COPYRIGHT     ReportReason = 4
+	ReportReason_REPORT_REASON_OTHER         ReportReason = 5
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_SPAM",
+		2: "REPORT_REASON_ABUSE",
+		3: "REPORT_REASON_INAPPROPRIATE",
+		4: "REPORT_REASON_COPYRIGHT",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED":   0,
+		"REPORT_REASON_SPAM":          1,
+		"REPORT_REASON_ABUSE":         2,
+		"REPORT_REASON_INAPPROPRIATE": 3,
+		"REPORT_REASON_COPYRIGHT":     4,
+		"REPORT_REASON_OTHER":         5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[6].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[6]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{6}
+}
+
+// ReportStatus defines where a report stands in the moderation queue.
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_DISMISSED   ReportStatus = 2
+	ReportStatus_REPORT_STATUS_ACTIONED    ReportStatus = 3
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_DISMISSED",
+		3: "REPORT_STATUS_ACTIONED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_DISMISSED":   2,
+		"REPORT_STATUS_ACTIONED":    3,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[7].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[7]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{7}
+}
+
+// TemplateEvent defines the kinds of template usage counted by the statistics.
+type TemplateEvent int32
+
+const (
+	TemplateEvent_TEMPLATE_EVENT_UNSPECIFIED TemplateEvent = 0
+	// The template was viewed. Each viewer is counted once a day.
+	TemplateEvent_TEMPLATE_EVENT_VIEW TemplateEvent = 1
+	// The template was rendered with variables on the client.
+	TemplateEvent_TEMPLATE_EVENT_RENDER TemplateEvent = 2
+	// The template content was copied on the client.
+	TemplateEvent_TEMPLATE_EVENT_COPY TemplateEvent = 3
+	// A prompt was created from the template.
+	TemplateEvent_TEMPLATE_EVENT_INSTANTIATE TemplateEvent = 4
+)
+
+// Enum value maps for TemplateEvent.
+var (
+	TemplateEvent_name = map[int32]string{
+		0: "TEMPLATE_EVENT_UNSPECIFIED",
+		1: "TEMPLATE_EVENT_VIEW",
+		2: "TEMPLATE_EVENT_RENDER",
+		3: "TEMPLATE_EVENT_COPY",
+		4: "TEMPLATE_EVENT_INSTANTIATE",
+	}
+	TemplateEvent_value = map[string]int32{
+		"TEMPLATE_EVENT_UNSPECIFIED": 0,
+		"TEMPLATE_EVENT_VIEW":        1,
+		"TEMPLATE_EVENT_RENDER":      2,
+		"TEMPLATE_EVENT_COPY":        3,
+		"TEMPLATE_EVENT_INSTANTIATE": 4,
+	}
+)
+
+func (x TemplateEvent) Enum() *TemplateEvent {
+	p := new(TemplateEvent)
+	*p = x
+	return p
+}
+
+func (x TemplateEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[8].Descriptor()
+}
+
+func (TemplateEvent) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[8]
+}
+
+func (x TemplateEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateEvent.Descriptor instead.
+func (TemplateEvent) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{8}
+}
+
+// FeedItemType defines the activity a feed item is about.
+type FeedItemType int32
+
+const (
+	FeedItemType_FEED_ITEM_TYPE_UNSPECIFIED FeedItemType = 0
+	// A followed user published a public template.
+	FeedItemType_FEED_ITEM_TYPE_TEMPLATE FeedItemType = 1
+	// A followed user published a new version of a public template.
+	FeedItemType_FEED_ITEM_TYPE_VERSION FeedItemType = 2
+	// A followed user published a fork of a template.
+	FeedItemType_FEED_ITEM_TYPE_FORK FeedItemType = 3
+)
+
+// Enum value maps for FeedItemType.
+var (
+	FeedItemType_name = map[int32]string{
+		0: "FEED_ITEM_TYPE_UNSPECIFIED",
+		1: "FEED_ITEM_TYPE_TEMPLATE",
+		2: "FEED_ITEM_TYPE_VERSION",
+		3: "FEED_ITEM_TYPE_FORK",
+	}
+	FeedItemType_value = map[string]int32{
+		"FEED_ITEM_TYPE_UNSPECIFIED": 0,
+		"FEED_ITEM_TYPE_TEMPLATE":    1,
+		"FEED_ITEM_TYPE_VERSION":     2,
+		"FEED_ITEM_TYPE_FORK":        3,
+	}
+)
+
+func (x FeedItemType) Enum() *FeedItemType {
+	p := new(FeedItemType)
+	*p = x
+	return p
+}
+
+func (x FeedItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[9].Descriptor()
+}
+
+func (FeedItemType) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[9]
+}
+
+func (x FeedItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedItemType.Descriptor instead.
+func (FeedItemType) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{9}
+}
+
+// NotificationType defines the event a notification is about.
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED NotificationType = 0
+	// Someone liked one of your templates.
+	NotificationType_NOTIFICATION_TYPE_LIKE NotificationType = 1
+	// Someone favorited one of your templates.
+	NotificationType_NOTIFICATION_TYPE_FAVORITE NotificationType = 2
+	// Someone forked one of your templates.
+	NotificationType_NOTIFICATION_TYPE_FORK NotificationType = 3
+	// Someone commented on one of your templates.
+	NotificationType_NOTIFICATION_TYPE_COMMENT NotificationType = 4
+	// Someone replied to one of your comments.
+	NotificationType_NOTIFICATION_TYPE_REPLY NotificationType = 5
+	// Someone reviewed one of your templates.
+	NotificationType_NOTIFICATION_TYPE_REVIEW NotificationType = 6
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_LIKE",
+		2: "NOTIFICATION_TYPE_FAVORITE",
+		3: "NOTIFICATION_TYPE_FORK",
+		4: "NOTIFICATION_TYPE_COMMENT",
+		5: "NOTIFICATION_TYPE_REPLY",
+		6: "NOTIFICATION_TYPE_REVIEW",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED": 0,
+		"NOTIFICATION_TYPE_LIKE":        1,
+		"NOTIFICATION_TYPE_FAVORITE":    2,
+		"NOTIFICATION_TYPE_FORK":        3,
+		"NOTIFICATION_TYPE_COMMENT":     4,
+		"NOTIFICATION_TYPE_REPLY":       5,
+		"NOTIFICATION_TYPE_REVIEW":      6,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[10].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[10]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{10}
+}
+
+// ModerationAction defines how an administrator resolves the reports of a template.
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNSPECIFIED ModerationAction = 0
+	// Keep the template visible, making it visible again if it was hidden.
+	ModerationAction_MODERATION_ACTION_DISMISS ModerationAction = 1
+	// Hide the template from everyone but its owner.
+	ModerationAction_MODERATION_ACTION_HIDE ModerationAction = 2
+	// Remove the template and warn its owner by email.
+	ModerationAction_MODERATION_ACTION_REMOVE ModerationAction = 3
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNSPECIFIED",
+		1: "MODERATION_ACTION_DISMISS",
+		2: "MODERATION_ACTION_HIDE",
+		3: "MODERATION_ACTION_REMOVE",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNSPECIFIED": 0,
+		"MODERATION_ACTION_DISMISS":     1,
+		"MODERATION_ACTION_HIDE":        2,
+		"MODERATION_ACTION_REMOVE":      3,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[11].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[11]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{11}
+}
+
+// UserRole defines what a user account can do across the service.
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	UserRole_USER_ROLE_USER        UserRole = 1
+	// Can manage users, system templates and featured templates.
+	UserRole_USER_ROLE_ADMIN UserRole = 2
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_USER",
+		2: "USER_ROLE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_USER":        1,
+		"USER_ROLE_ADMIN":       2,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[12].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[12]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{12}
+}
+
+// PromptSort is the order of a prompt listing.
+type PromptSort int32
+
+const (
+	// Defaults to newest first.
+	PromptSort_PROMPT_SORT_UNSPECIFIED PromptSort = 0
+	PromptSort_PROMPT_SORT_NEWEST      PromptSort = 1
+	// Most recently used first.
+	PromptSort_PROMPT_SORT_LAST_USED PromptSort = 2
+)
+
+// Enum value maps for PromptSort.
+var (
+	PromptSort_name = map[int32]string{
+		0: "PROMPT_SORT_UNSPECIFIED",
+		1: "PROMPT_SORT_NEWEST",
+		2: "PROMPT_SORT_LAST_USED",
+	}
+	PromptSort_value = map[string]int32{
+		"PROMPT_SORT_UNSPECIFIED": 0,
+		"PROMPT_SORT_NEWEST":      1,
+		"PROMPT_SORT_LAST_USED":   2,
+	}
+)
+
+func (x PromptSort) Enum() *PromptSort {
+	p := new(PromptSort)
+	*p = x
+	return p
+}
+
+func (x PromptSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromptSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[13].Descriptor()
+}
+
+func (PromptSort) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[13]
+}
+
+func (x PromptSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromptSort.Descriptor instead.
+func (PromptSort) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{13}
+}
+
+// ExecutionStatus is the outcome of a run.
+type ExecutionStatus int32
+
+const (
+	ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED ExecutionStatus = 0
+	ExecutionStatus_EXECUTION_STATUS_SUCCEEDED   ExecutionStatus = 1
+	ExecutionStatus_EXECUTION_STATUS_FAILED      ExecutionStatus = 2
+	// The client went away before the end of the run.
+	ExecutionStatus_EXECUTION_STATUS_CANCELLED ExecutionStatus = 3
+)
+
+// Enum value maps for ExecutionStatus.
+var (
+	ExecutionStatus_name = map[int32]string{
+		0: "EXECUTION_STATUS_UNSPECIFIED",
+		1: "EXECUTION_STATUS_SUCCEEDED",
+		2: "EXECUTION_STATUS_FAILED",
+		3: "EXECUTION_STATUS_CANCELLED",
+	}
+	ExecutionStatus_value = map[string]int32{
+		"EXECUTION_STATUS_UNSPECIFIED": 0,
+		"EXECUTION_STATUS_SUCCEEDED":   1,
+		"EXECUTION_STATUS_FAILED":      2,
+		"EXECUTION_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x ExecutionStatus) Enum() *ExecutionStatus {
+	p := new(ExecutionStatus)
+	*p = x
+	return p
+}
+
+func (x ExecutionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[14].Descriptor()
+}
+
+func (ExecutionStatus) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[14]
+}
+
+func (x ExecutionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionStatus.Descriptor instead.
+func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{14}
+}
+
+// AssertionType is the kind of check of an assertion.
+type AssertionType int32
+
+const (
+	AssertionType_ASSERTION_TYPE_UNSPECIFIED AssertionType = 0
+	// The output contains value.
+	AssertionType_ASSERTION_TYPE_CONTAINS AssertionType = 1
+	// The output matches the regular expression value.
+	AssertionType_ASSERTION_TYPE_REGEX AssertionType = 2
+	// The output is a JSON document valid against the JSON schema value.
+	AssertionType_ASSERTION_TYPE_JSON_SCHEMA AssertionType = 3
+	// The output has at most max_length characters.
+	AssertionType_ASSERTION_TYPE_MAX_LENGTH AssertionType = 4
+	// A model answers PASS when asked whether the output meets the criteria value.
+	AssertionType_ASSERTION_TYPE_JUDGE AssertionType = 5
+)
+
+// Enum value maps for AssertionType.
+var (
+	AssertionType_name = map[int32]string{
+		0: "ASSERTION_TYPE_UNSPECIFIED",
+		1: "ASSERTION_TYPE_CONTAINS",
+		2: "ASSERTION_TYPE_REGEX",
+		3: "ASSERTION_TYPE_JSON_SCHEMA",
+		4: "ASSERTION_TYPE_MAX_LENGTH",
+		5: "ASSERTION_TYPE_JUDGE",
+	}
+	AssertionType_value = map[string]int32{
+		"ASSERTION_TYPE_UNSPECIFIED": 0,
+		"ASSERTION_TYPE_CONTAINS":    1,
+		"ASSERTION_TYPE_REGEX":       2,
+		"ASSERTION_TYPE_JSON_SCHEMA": 3,
+		"ASSERTION_TYPE_MAX_LENGTH":  4,
+		"ASSERTION_TYPE_JUDGE":       5,
+	}
+)
+
+func (x AssertionType) Enum() *AssertionType {
+	p := new(AssertionType)
+	*p = x
+	return p
+}
+
+func (x AssertionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssertionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[15].Descriptor()
+}
+
+func (AssertionType) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[15]
+}
+
+func (x AssertionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssertionType.Descriptor instead.
+func (AssertionType) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{15}
+}
+
+// EvaluationChange is how the outcome of a case changed from the base to the head run.
+type EvaluationChange int32
+
+const (
+	// The case is missing from one of the runs.
+	EvaluationChange_EVALUATION_CHANGE_UNSPECIFIED EvaluationChange = 0
+	EvaluationChange_EVALUATION_CHANGE_UNCHANGED   EvaluationChange = 1
+	// The case failed on the base version and passes on the head version.
+	EvaluationChange_EVALUATION_CHANGE_FIXED EvaluationChange = 2
+	// The case passed on the base version and fails on the head version.
+	EvaluationChange_EVALUATION_CHANGE_REGRESSED EvaluationChange = 3
+)
+
+// Enum value maps for EvaluationChange.
+var (
+	EvaluationChange_name = map[int32]string{
+		0: "EVALUATION_CHANGE_UNSPECIFIED",
+		1: "EVALUATION_CHANGE_UNCHANGED",
+		2: "EVALUATION_CHANGE_FIXED",
+		3: "EVALUATION_CHANGE_REGRESSED",
+	}
+	EvaluationChange_value = map[string]int32{
+		"EVALUATION_CHANGE_UNSPECIFIED": 0,
+		"EVALUATION_CHANGE_UNCHANGED":   1,
+		"EVALUATION_CHANGE_FIXED":       2,
+		"EVALUATION_CHANGE_REGRESSED":   3,
+	}
+)
+
+func (x EvaluationChange) Enum() *EvaluationChange {
+	p := new(EvaluationChange)
+	*p = x
+	return p
+}
+
+func (x EvaluationChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_prompt_proto_enumTypes[16].Descriptor()
+}
+
+func (EvaluationChange) Type() protoreflect.EnumType {
+	return &file_prompt_proto_enumTypes[16]
+}
+
+func (x EvaluationChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationChange.Descriptor instead.
+func (EvaluationChange) EnumDescriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{16}
+}
+
 // Template represents a prompt template metadata.
 type Template struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the template (UUID).
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// ID of the user who owns the template.
-	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	// Title of the template.
-	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	// Description of the template.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// Visibility status (public or private).
-	Visibility Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
-	// Type of the template (system or user).
-	Type TemplateType `protobuf:"varint,6,opt,name=type,proto3,enum=v1.TemplateType" json:"type,omitempty"`
-	// List of tags associated with the template.
-	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	// Category of the template.
-	Category string `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
-	// Number of likes.
-	LikeCount int32 `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	// Number of favorites.
-	FavoriteCount int32 `protobuf:"varint,10,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	// Timestamp when the template was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Timestamp when the template was last updated.
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// The latest version of the template.
-	LatestVersion *TemplateVersion `protobuf:"bytes,13,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Whether the current user has liked this template.
-	IsLiked bool `protobuf:"varint,14,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	// Whether the current user has favorited this template.
-	IsFavorited bool `protobuf:"varint,15,opt,name=is_favorited,json=isFavorited,proto3" json:"is_favorited,omitempty"`
-	// Language of the template (e.g. "en", "zh").
-	Language      string `protobuf:"bytes,16,opt,name=language,proto3" json:"language,omitempty"`
+	// Unique identifier for the template (UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the user who owns the template.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Title of the template.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Description of the template.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Visibility status (public or private).
+	Visibility Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	// Type of the template (system or user).
+	Type TemplateType `protobuf:"varint,6,opt,name=type,proto3,enum=v1.TemplateType" json:"type,omitempty"`
+	// List of tags associated with the template.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Category of the template.
+	Category string `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	// Number of likes.
+	LikeCount int32 `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	// Number of favorites.
+	FavoriteCount int32 `protobuf:"varint,10,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	// Timestamp when the template was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the template was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The latest version of the template.
+	LatestVersion *TemplateVersion `protobuf:"bytes,13,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Whether the current user has liked this template.
+	IsLiked bool `protobuf:"varint,14,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	// Whether the current user has favorited this template.
+	IsFavorited bool `protobuf:"varint,15,opt,name=is_favorited,json=isFavorited,proto3" json:"is_favorited,omitempty"`
+	// Language of the template (e.g. "en", "zh").
+	Language string `protobuf:"bytes,16,opt,name=language,proto3" json:"language,omitempty"`
+	// Time-decayed popularity score, recomputed periodically.
+	TrendingScore float64 `protobuf:"fixed64,17,opt,name=trending_score,json=trendingScore,proto3" json:"trending_score,omitempty"`
+	// ID of the template this one was forked from, if any.
+	ForkedFrom string `protobuf:"bytes,18,opt,name=forked_from,json=forkedFrom,proto3" json:"forked_from,omitempty"`
+	// ID of the organization owning the template, if any.
+	OrgId string `protobuf:"bytes,19,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Whether an administrator features the template.
+	Featured bool `protobuf:"varint,20,opt,name=featured,proto3" json:"featured,omitempty"`
+	// Moderation state. Hidden and removed templates are left out of listings.
+	ModerationState ModerationState `protobuf:"varint,21,opt,name=moderation_state,json=moderationState,proto3,enum=v1.ModerationState" json:"moderation_state,omitempty"`
+	// Number of comments on the template, deleted ones excluded.
+	CommentCount int32 `protobuf:"varint,22,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Number of reviews.
+	RatingCount int32 `protobuf:"varint,23,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	// Average star rating, 0 without reviews.
+	RatingAverage float64 `protobuf:"fixed64,24,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	// Average star rating pulled towards a prior while there are few reviews; used to sort by rating.
+	RatingScore   float64 `protobuf:"fixed64,25,opt,name=rating_score,json=ratingScore,proto3" json:"rating_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_prompt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Template) GetType() TemplateType {
+	if x != nil {
+		return x.Type
+	}
+	return TemplateType_TEMPLATE_TYPE_UNSPECIFIED
+}
+
+func (x *Template) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Template) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Template) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Template) GetFavoriteCount() int32 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetLatestVersion() *TemplateVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *Template) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *Template) GetIsFavorited() bool {
+	if x != nil {
+		return x.IsFavorited
+	}
+	return false
+}
+
+func (x *Template) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Template) GetTrendingScore() float64 {
+	if x != nil {
+		return x.TrendingScore
+	}
+	return 0
+}
+
+func (x *Template) GetForkedFrom() string {
+	if x != nil {
+		return x.ForkedFrom
+	}
+	return ""
+}
+
+func (x *Template) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Template) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+func (x *Template) GetModerationState() ModerationState {
+	if x != nil {
+		return x.ModerationState
+	}
+	return ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func (x *Template) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Template) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Template) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Template) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
+// TemplateVersion represents a specific version of a template's content.
+type TemplateVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the version record.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the parent template.
+	TemplateId string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Logical version number (1, 2, 3...).
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The actual prompt content with placeholders (e.g., $$).
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Timestamp when this version was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether the version is a major update. Reviews of earlier versions are flagged as stale.
+	Major bool `protobuf:"varint,6,opt,name=major,proto3" json:"major,omitempty"`
+	// Reserved {{sys.*}} variables that prompts may set explicitly instead of
+	// having them filled by the server.
+	OverridableVariables []string `protobuf:"bytes,7,rep,name=overridable_variables,json=overridableVariables,proto3" json:"overridable_variables,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TemplateVersion) Reset() {
+	*x = TemplateVersion{}
+	mi := &file_prompt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateVersion) ProtoMessage() {}
+
+func (x *TemplateVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateVersion.ProtoReflect.Descriptor instead.
+func (*TemplateVersion) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateVersion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TemplateVersion) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TemplateVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TemplateVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TemplateVersion) GetMajor() bool {
+	if x != nil {
+		return x.Major
+	}
+	return false
+}
+
+func (x *TemplateVersion) GetOverridableVariables() []string {
+	if x != nil {
+		return x.OverridableVariables
+	}
+	return nil
+}
+
+// ListTemplateVersionsRequest is the request message for ListTemplateVersions.
+type ListTemplateVersionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Whether to compute total_count.
+	IncludeTotalCount bool `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTemplateVersionsRequest) Reset() {
+	*x = ListTemplateVersionsRequest{}
+	mi := &file_prompt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateVersionsRequest) ProtoMessage() {}
+
+func (x *ListTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplateVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTemplateVersionsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListTemplateVersionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTemplateVersionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTemplateVersionsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListTemplateVersionsResponse is the response message for ListTemplateVersions.
+type ListTemplateVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*TemplateVersion     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of versions, only set when include_total_count was requested.
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateVersionsResponse) Reset() {
+	*x = ListTemplateVersionsResponse{}
+	mi := &file_prompt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateVersionsResponse) ProtoMessage() {}
+
+func (x *ListTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplateVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTemplateVersionsResponse) GetVersions() []*TemplateVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListTemplateVersionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTemplateVersionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Prompt represents an instantiated prompt saved by a user.
+type Prompt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the prompt (UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the template used.
+	TemplateId string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// ID of the specific version used.
+	VersionId int32 `protobuf:"varint,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// ID of the user who saved this prompt.
+	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// List of variable values used to replace placeholders.
+	Variables []string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Timestamp when the prompt was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Title of the template used.
+	TemplateTitle string `protobuf:"bytes,7,opt,name=template_title,json=templateTitle,proto3" json:"template_title,omitempty"`
+	// Logical version number of the version used.
+	Version int32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Name given by the owner, empty if unnamed.
+	Title string `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	// Free-form notes of the owner.
+	Notes  string   `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags   []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pinned bool     `protobuf:"varint,12,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Content of the version with the variables substituted for its placeholders.
+	RenderedText string `protobuf:"bytes,13,opt,name=rendered_text,json=renderedText,proto3" json:"rendered_text,omitempty"`
+	// Timestamp when the prompt was last created, copied or re-instantiated.
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Values of the named {{placeholders}}, by name.
+	NamedVariables map[string]string `protobuf:"bytes,15,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_prompt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prompt) ProtoMessage() {}
+
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Prompt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Prompt) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Prompt) GetVersionId() int32 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *Prompt) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Prompt) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Prompt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Prompt) GetTemplateTitle() string {
+	if x != nil {
+		return x.TemplateTitle
+	}
+	return ""
+}
+
+func (x *Prompt) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Prompt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Prompt) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Prompt) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Prompt) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Prompt) GetRenderedText() string {
+	if x != nil {
+		return x.RenderedText
+	}
+	return ""
+}
+
+func (x *Prompt) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Prompt) GetNamedVariables() map[string]string {
+	if x != nil {
+		return x.NamedVariables
+	}
+	return nil
+}
+
+// PlaceholderDrift compares the placeholders of the version a prompt was
+// instantiated from with the ones of the latest version.
+type PlaceholderDrift struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PreviousVersion      int32                  `protobuf:"varint,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	LatestVersion        int32                  `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	PreviousPlaceholders int32                  `protobuf:"varint,3,opt,name=previous_placeholders,json=previousPlaceholders,proto3" json:"previous_placeholders,omitempty"`
+	LatestPlaceholders   int32                  `protobuf:"varint,4,opt,name=latest_placeholders,json=latestPlaceholders,proto3" json:"latest_placeholders,omitempty"`
+	// Variable values left over because the latest version has fewer placeholders.
+	DroppedVariables []string `protobuf:"bytes,5,rep,name=dropped_variables,json=droppedVariables,proto3" json:"dropped_variables,omitempty"`
+	// Number of placeholders of the latest version left empty.
+	UnfilledPlaceholders int32 `protobuf:"varint,6,opt,name=unfilled_placeholders,json=unfilledPlaceholders,proto3" json:"unfilled_placeholders,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PlaceholderDrift) Reset() {
+	*x = PlaceholderDrift{}
+	mi := &file_prompt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceholderDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceholderDrift) ProtoMessage() {}
+
+func (x *PlaceholderDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceholderDrift.ProtoReflect.Descriptor instead.
+func (*PlaceholderDrift) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlaceholderDrift) GetPreviousVersion() int32 {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return 0
+}
+
+func (x *PlaceholderDrift) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *PlaceholderDrift) GetPreviousPlaceholders() int32 {
+	if x != nil {
+		return x.PreviousPlaceholders
+	}
+	return 0
+}
+
+func (x *PlaceholderDrift) GetLatestPlaceholders() int32 {
+	if x != nil {
+		return x.LatestPlaceholders
+	}
+	return 0
+}
+
+func (x *PlaceholderDrift) GetDroppedVariables() []string {
+	if x != nil {
+		return x.DroppedVariables
+	}
+	return nil
+}
+
+func (x *PlaceholderDrift) GetUnfilledPlaceholders() int32 {
+	if x != nil {
+		return x.UnfilledPlaceholders
+	}
+	return 0
+}
+
+// CreateTemplateRequest is the request message for CreateTemplate.
+type CreateTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId     string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,4,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	Type        TemplateType           `protobuf:"varint,5,opt,name=type,proto3,enum=v1.TemplateType" json:"type,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category    string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	// Initial content for the first version.
+	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	// Language of the template.
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	// Organization to add the template to. The current user must be a member.
+	OrgId string `protobuf:"bytes,10,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Reserved {{sys.*}} variables that prompts may set explicitly.
+	OverridableVariables []string `protobuf:"bytes,11,rep,name=overridable_variables,json=overridableVariables,proto3" json:"overridable_variables,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateTemplateRequest) GetType() TemplateType {
+	if x != nil {
+		return x.Type
+	}
+	return TemplateType_TEMPLATE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetOverridableVariables() []string {
+	if x != nil {
+		return x.OverridableVariables
+	}
+	return nil
+}
+
+// CreateTemplateResponse is the response message for CreateTemplate.
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Version       *TemplateVersion       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateTemplateResponse) GetVersion() *TemplateVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// UpdateTemplateRequest is the request message for UpdateTemplate.
+type UpdateTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId  string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OwnerId     string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Ignored, the caller is authorized from the token
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category    string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	// New content, which triggers a new version creation.
+	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	// Language of the template.
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	// Marks the new version, if the content changed, as a major update.
+	Major bool `protobuf:"varint,10,opt,name=major,proto3" json:"major,omitempty"`
+	// Reserved {{sys.*}} variables that prompts may set explicitly. A change
+	// creates a new version, like a change of the content. When unset, those of
+	// the latest version carry over; an empty list clears them.
+	OverridableVariables *VariableNames `protobuf:"bytes,11,opt,name=overridable_variables,json=overridableVariables,proto3" json:"overridable_variables,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetMajor() bool {
+	if x != nil {
+		return x.Major
+	}
+	return false
+}
+
+func (x *UpdateTemplateRequest) GetOverridableVariables() *VariableNames {
+	if x != nil {
+		return x.OverridableVariables
+	}
+	return nil
+}
+
+// VariableNames is a list of variable names that can be told apart from an
+// unset one.
+type VariableNames struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableNames) Reset() {
+	*x = VariableNames{}
+	mi := &file_prompt_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableNames) ProtoMessage() {}
+
+func (x *VariableNames) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableNames.ProtoReflect.Descriptor instead.
+func (*VariableNames) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VariableNames) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// UpdateTemplateResponse is the response message for UpdateTemplate.
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	NewVersion    *TemplateVersion       `protobuf:"bytes,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *UpdateTemplateResponse) GetNewVersion() *TemplateVersion {
+	if x != nil {
+		return x.NewVersion
+	}
+	return nil
+}
+
+// GetTemplateRequest is the request message for GetTemplate.
+type GetTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Token of a share link, giving read access to a template that is not otherwise visible.
+	ShareToken    string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// GetTemplateResponse is the response message for GetTemplate.
+type GetTemplateResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Template *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Optionally return the latest version or all versions.
+	// For simplicity, let's return the latest version.
+	LatestVersion *TemplateVersion `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GetTemplateResponse) GetLatestVersion() *TemplateVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+// ListTemplatesRequest is the request message for ListTemplates.
+type ListTemplatesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	// In the mixed view, send "next_page_token:private_next_page_token".
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filter by visibility (e.g., public only, or my private ones).
+	Visibility Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	// Filter by owner.
+	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Filter by category.
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// Filter by tags.
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Filter by user likes.
+	MyLikes bool `protobuf:"varint,7,opt,name=my_likes,json=myLikes,proto3" json:"my_likes,omitempty"`
+	// Filter by user favorites.
+	MyFavorites bool `protobuf:"varint,8,opt,name=my_favorites,json=myFavorites,proto3" json:"my_favorites,omitempty"`
+	// Filter by language.
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	// Sort order of the results.
+	Sort TemplateSort `protobuf:"varint,10,opt,name=sort,proto3,enum=v1.TemplateSort" json:"sort,omitempty"`
+	// Whether to compute total_count (and private_total_count in the mixed view).
+	IncludeTotalCount bool `protobuf:"varint,11,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// List the templates of a collection, in the collection's order.
+	// Other visibility and owner filters are ignored.
+	CollectionId string `protobuf:"bytes,12,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// List the templates other users have shared with the current user.
+	SharedWithMe bool `protobuf:"varint,13,opt,name=shared_with_me,json=sharedWithMe,proto3" json:"shared_with_me,omitempty"`
+	// List the library of an organization the current user is a member of.
+	// Other visibility and owner filters are ignored.
+	OrgId string `protobuf:"bytes,14,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Only list featured templates, most recently featured first.
+	FeaturedOnly bool `protobuf:"varint,15,opt,name=featured_only,json=featuredOnly,proto3" json:"featured_only,omitempty"`
+	// Only list templates with at least this average rating, from 1 to 5.
+	MinRating     float64 `protobuf:"fixed64,16,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_prompt_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTemplatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ListTemplatesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTemplatesRequest) GetMyLikes() bool {
+	if x != nil {
+		return x.MyLikes
+	}
+	return false
+}
+
+func (x *ListTemplatesRequest) GetMyFavorites() bool {
+	if x != nil {
+		return x.MyFavorites
+	}
+	return false
+}
+
+func (x *ListTemplatesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetSort() TemplateSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TemplateSort_TEMPLATE_SORT_UNSPECIFIED
+}
+
+func (x *ListTemplatesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListTemplatesRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetSharedWithMe() bool {
+	if x != nil {
+		return x.SharedWithMe
+	}
+	return false
+}
+
+func (x *ListTemplatesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetFeaturedOnly() bool {
+	if x != nil {
+		return x.FeaturedOnly
+	}
+	return false
+}
+
+func (x *ListTemplatesRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+// ListTemplatesResponse is the response message for ListTemplates.
+type ListTemplatesResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Templates            []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`                                // Used for public templates when mixed
+	NextPageToken        string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Used for public pagination when mixed
+	PrivateTemplates     []*Template            `protobuf:"bytes,3,rep,name=private_templates,json=privateTemplates,proto3" json:"private_templates,omitempty"`
+	PrivateNextPageToken string                 `protobuf:"bytes,4,opt,name=private_next_page_token,json=privateNextPageToken,proto3" json:"private_next_page_token,omitempty"`
+	// Total number of matching templates, only set when include_total_count was requested.
+	TotalCount        int32 `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PrivateTotalCount int32 `protobuf:"varint,6,opt,name=private_total_count,json=privateTotalCount,proto3" json:"private_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_prompt_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesResponse) GetPrivateTemplates() []*Template {
+	if x != nil {
+		return x.PrivateTemplates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetPrivateNextPageToken() string {
+	if x != nil {
+		return x.PrivateNextPageToken
+	}
+	return ""
+}
+
+func (x *ListTemplatesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTemplatesResponse) GetPrivateTotalCount() int32 {
+	if x != nil {
+		return x.PrivateTotalCount
+	}
+	return 0
+}
+
+// ListTrendingTemplatesRequest is the request message for ListTrendingTemplates.
+type ListTrendingTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to a single category; empty means all categories.
+	Category      string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingTemplatesRequest) Reset() {
+	*x = ListTrendingTemplatesRequest{}
+	mi := &file_prompt_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTrendingTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTrendingTemplatesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListTrendingTemplatesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListTrendingTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListTrendingTemplatesResponse is the response message for ListTrendingTemplates.
+type ListTrendingTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingTemplatesResponse) Reset() {
+	*x = ListTrendingTemplatesResponse{}
+	mi := &file_prompt_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTrendingTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTrendingTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Collection is a user-owned, ordered list of templates.
+type Collection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the collection (UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the user who owns the collection.
+	OwnerId     string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Public collections can be browsed and followed by other users.
+	Visibility Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	// Number of templates in the collection.
+	ItemCount int32 `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	// Number of users following the collection.
+	FollowerCount int32 `protobuf:"varint,7,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	// Whether the current user follows this collection.
+	IsFollowing   bool                   `protobuf:"varint,8,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_prompt_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Collection) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *Collection) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCollectionRequest is the request message for CreateCollection.
+type CreateCollectionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Defaults to private.
+	Visibility    Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+// CreateCollectionResponse is the response message for CreateCollection.
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// GetCollectionRequest is the request message for GetCollection.
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetCollectionResponse is the response message for GetCollection.
+type GetCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionResponse) Reset() {
+	*x = GetCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionResponse) ProtoMessage() {}
+
+func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// UpdateCollectionRequest is the request message for UpdateCollection.
+type UpdateCollectionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Left unchanged when unspecified.
+	Visibility    Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+// UpdateCollectionResponse is the response message for UpdateCollection.
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// DeleteCollectionRequest is the request message for DeleteCollection.
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteCollectionResponse is the response message for DeleteCollection.
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListCollectionsRequest is the request message for ListCollections.
+// With owner_id, the owner's collections are listed (private ones only to the owner).
+// With followed, the collections the current user follows are listed.
+// Otherwise all public collections are listed.
+type ListCollectionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OwnerId       string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Followed      bool   `protobuf:"varint,4,opt,name=followed,proto3" json:"followed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_prompt_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetFollowed() bool {
+	if x != nil {
+		return x.Followed
+	}
+	return false
+}
+
+// ListCollectionsResponse is the response message for ListCollections.
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_prompt_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddTemplateToCollectionRequest is the request message for AddTemplateToCollection.
+type AddTemplateToCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTemplateToCollectionRequest) Reset() {
+	*x = AddTemplateToCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTemplateToCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTemplateToCollectionRequest) ProtoMessage() {}
+
+func (x *AddTemplateToCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTemplateToCollectionRequest.ProtoReflect.Descriptor instead.
+func (*AddTemplateToCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddTemplateToCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *AddTemplateToCollectionRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// AddTemplateToCollectionResponse is the response message for AddTemplateToCollection.
+type AddTemplateToCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTemplateToCollectionResponse) Reset() {
+	*x = AddTemplateToCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTemplateToCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTemplateToCollectionResponse) ProtoMessage() {}
+
+func (x *AddTemplateToCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTemplateToCollectionResponse.ProtoReflect.Descriptor instead.
+func (*AddTemplateToCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddTemplateToCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// RemoveTemplateFromCollectionRequest is the request message for RemoveTemplateFromCollection.
+type RemoveTemplateFromCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTemplateFromCollectionRequest) Reset() {
+	*x = RemoveTemplateFromCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTemplateFromCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTemplateFromCollectionRequest) ProtoMessage() {}
+
+func (x *RemoveTemplateFromCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTemplateFromCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTemplateFromCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveTemplateFromCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *RemoveTemplateFromCollectionRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// RemoveTemplateFromCollectionResponse is the response message for RemoveTemplateFromCollection.
+type RemoveTemplateFromCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTemplateFromCollectionResponse) Reset() {
+	*x = RemoveTemplateFromCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTemplateFromCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTemplateFromCollectionResponse) ProtoMessage() {}
+
+func (x *RemoveTemplateFromCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTemplateFromCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTemplateFromCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveTemplateFromCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// ReorderCollectionRequest is the request message for ReorderCollection.
+type ReorderCollectionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// Every template of the collection, in the new order.
+	TemplateIds   []string `protobuf:"bytes,2,rep,name=template_ids,json=templateIds,proto3" json:"template_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionRequest) Reset() {
+	*x = ReorderCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReorderCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ReorderCollectionRequest) GetTemplateIds() []string {
+	if x != nil {
+		return x.TemplateIds
+	}
+	return nil
+}
+
+// ReorderCollectionResponse is the response message for ReorderCollection.
+type ReorderCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionResponse) Reset() {
+	*x = ReorderCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionResponse) ProtoMessage() {}
+
+func (x *ReorderCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReorderCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ToggleFollowCollectionRequest is the request message for ToggleFollowCollection.
+type ToggleFollowCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFollowCollectionRequest) Reset() {
+	*x = ToggleFollowCollectionRequest{}
+	mi := &file_prompt_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFollowCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFollowCollectionRequest) ProtoMessage() {}
+
+func (x *ToggleFollowCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFollowCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFollowCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ToggleFollowCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+// ToggleFollowCollectionResponse is the response message for ToggleFollowCollection.
+type ToggleFollowCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	FollowerCount int32                  `protobuf:"varint,2,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFollowCollectionResponse) Reset() {
+	*x = ToggleFollowCollectionResponse{}
+	mi := &file_prompt_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFollowCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFollowCollectionResponse) ProtoMessage() {}
+
+func (x *ToggleFollowCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFollowCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ToggleFollowCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ToggleFollowCollectionResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *ToggleFollowCollectionResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+// TemplateGrant gives a user access to a template.
+type TemplateGrant struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role       ShareRole              `protobuf:"varint,3,opt,name=role,proto3,enum=v1.ShareRole" json:"role,omitempty"`
+	// ID of the user who granted the access.
+	GrantedBy     string                 `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateGrant) Reset() {
+	*x = TemplateGrant{}
+	mi := &file_prompt_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateGrant) ProtoMessage() {}
+
+func (x *TemplateGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateGrant.ProtoReflect.Descriptor instead.
+func (*TemplateGrant) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TemplateGrant) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TemplateGrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TemplateGrant) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *TemplateGrant) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *TemplateGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ShareLink is a secret link giving read access to a template.
+type ShareLink struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateId string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// The secret token. Only returned when the link is created.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// Unset if the link never expires.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_prompt_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ShareLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareLink) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ShareLink) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GrantTemplateAccessRequest is the request message for GrantTemplateAccess.
+type GrantTemplateAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShareRole              `protobuf:"varint,3,opt,name=role,proto3,enum=v1.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantTemplateAccessRequest) Reset() {
+	*x = GrantTemplateAccessRequest{}
+	mi := &file_prompt_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantTemplateAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantTemplateAccessRequest) ProtoMessage() {}
+
+func (x *GrantTemplateAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantTemplateAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantTemplateAccessRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GrantTemplateAccessRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *GrantTemplateAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantTemplateAccessRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+// GrantTemplateAccessResponse is the response message for GrantTemplateAccess.
+type GrantTemplateAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *TemplateGrant         `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantTemplateAccessResponse) Reset() {
+	*x = GrantTemplateAccessResponse{}
+	mi := &file_prompt_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantTemplateAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantTemplateAccessResponse) ProtoMessage() {}
+
+func (x *GrantTemplateAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantTemplateAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantTemplateAccessResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GrantTemplateAccessResponse) GetGrant() *TemplateGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// RevokeTemplateAccessRequest is the request message for RevokeTemplateAccess.
+type RevokeTemplateAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTemplateAccessRequest) Reset() {
+	*x = RevokeTemplateAccessRequest{}
+	mi := &file_prompt_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTemplateAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTemplateAccessRequest) ProtoMessage() {}
+
+func (x *RevokeTemplateAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTemplateAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTemplateAccessRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RevokeTemplateAccessRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RevokeTemplateAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RevokeTemplateAccessResponse is the response message for RevokeTemplateAccess.
+type RevokeTemplateAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTemplateAccessResponse) Reset() {
+	*x = RevokeTemplateAccessResponse{}
+	mi := &file_prompt_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTemplateAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTemplateAccessResponse) ProtoMessage() {}
+
+func (x *RevokeTemplateAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTemplateAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTemplateAccessResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevokeTemplateAccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListTemplateGrantsRequest is the request message for ListTemplateGrants.
+type ListTemplateGrantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateGrantsRequest) Reset() {
+	*x = ListTemplateGrantsRequest{}
+	mi := &file_prompt_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateGrantsRequest) ProtoMessage() {}
+
+func (x *ListTemplateGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplateGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTemplateGrantsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// ListTemplateGrantsResponse is the response message for ListTemplateGrants.
+type ListTemplateGrantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*TemplateGrant       `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplateGrantsResponse) Reset() {
+	*x = ListTemplateGrantsResponse{}
+	mi := &file_prompt_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplateGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplateGrantsResponse) ProtoMessage() {}
+
+func (x *ListTemplateGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplateGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplateGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListTemplateGrantsResponse) GetGrants() []*TemplateGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// CreateShareLinkRequest is the request message for CreateShareLink.
+type CreateShareLinkRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Leave unset for a link that never expires.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_prompt_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateShareLinkRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateShareLinkResponse is the response message for CreateShareLink.
+type CreateShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *ShareLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkResponse) Reset() {
+	*x = CreateShareLinkResponse{}
+	mi := &file_prompt_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkResponse) ProtoMessage() {}
+
+func (x *CreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateShareLinkResponse) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// ListShareLinksRequest is the request message for ListShareLinks.
+type ListShareLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_prompt_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksRequest) ProtoMessage() {}
+
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListShareLinksRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// ListShareLinksResponse is the response message for ListShareLinks.
+type ListShareLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*ShareLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_prompt_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListShareLinksResponse) GetLinks() []*ShareLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// RevokeShareLinkRequest is the request message for RevokeShareLink.
+type RevokeShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkRequest) Reset() {
+	*x = RevokeShareLinkRequest{}
+	mi := &file_prompt_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkRequest) ProtoMessage() {}
+
+func (x *RevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevokeShareLinkRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RevokeShareLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+// RevokeShareLinkResponse is the response message for RevokeShareLink.
+type RevokeShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkResponse) Reset() {
+	*x = RevokeShareLinkResponse{}
+	mi := &file_prompt_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkResponse) ProtoMessage() {}
+
+func (x *RevokeShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokeShareLinkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Organization is a team sharing a library of templates.
+type Organization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the organization (UUID).
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Role of the current user in the organization.
+	Role          OrgRole                `protobuf:"varint,3,opt,name=role,proto3,enum=v1.OrgRole" json:"role,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_prompt_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func (x *Organization) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// OrganizationMember is a user's membership of an organization.
+type OrganizationMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role          OrgRole                `protobuf:"varint,4,opt,name=role,proto3,enum=v1.OrgRole" json:"role,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
+	mi := &file_prompt_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMember) ProtoMessage() {}
+
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OrganizationMember) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// OrganizationInvitation is a pending invitation to join an organization.
+type OrganizationInvitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Email string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Role given to the invitee on acceptance.
+	Role          OrgRole                `protobuf:"varint,4,opt,name=role,proto3,enum=v1.OrgRole" json:"role,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationInvitation) Reset() {
+	*x = OrganizationInvitation{}
+	mi := &file_prompt_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationInvitation) ProtoMessage() {}
+
+func (x *OrganizationInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationInvitation.ProtoReflect.Descriptor instead.
+func (*OrganizationInvitation) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OrganizationInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *OrganizationInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *OrganizationInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateOrganizationRequest is the request message for CreateOrganization.
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_prompt_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateOrganizationResponse is the response message for CreateOrganization.
+type CreateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_prompt_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// GetOrganizationRequest is the request message for GetOrganization.
+type GetOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_prompt_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetOrganizationResponse is the response message for GetOrganization.
+type GetOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationResponse) Reset() {
+	*x = GetOrganizationResponse{}
+	mi := &file_prompt_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationResponse) ProtoMessage() {}
+
+func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// ListOrganizationsRequest is the request message for ListOrganizations.
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_prompt_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{57}
+}
+
+// ListOrganizationsResponse is the response message for ListOrganizations.
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_prompt_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// ListOrganizationMembersRequest is the request message for ListOrganizationMembers.
+type ListOrganizationMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationMembersRequest) Reset() {
+	*x = ListOrganizationMembersRequest{}
+	mi := &file_prompt_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationMembersRequest) ProtoMessage() {}
+
+func (x *ListOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListOrganizationMembersRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// ListOrganizationMembersResponse is the response message for ListOrganizationMembers.
+type ListOrganizationMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*OrganizationMember  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationMembersResponse) Reset() {
+	*x = ListOrganizationMembersResponse{}
+	mi := &file_prompt_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationMembersResponse) ProtoMessage() {}
+
+func (x *ListOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListOrganizationMembersResponse) GetMembers() []*OrganizationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// InviteOrganizationMemberRequest is the request message for InviteOrganizationMember.
+type InviteOrganizationMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Defaults to member. Only owners can invite admins; nobody can invite owners.
+	Role OrgRole `protobuf:"varint,3,opt,name=role,proto3,enum=v1.OrgRole" json:"role,omitempty"`
+	// Language of the invitation email (e.g. "en", "zh").
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteOrganizationMemberRequest) Reset() {
+	*x = InviteOrganizationMemberRequest{}
+	mi := &file_prompt_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *InviteOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InviteOrganizationMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InviteOrganizationMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteOrganizationMemberRequest) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func (x *InviteOrganizationMemberRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// InviteOrganizationMemberResponse is the response message for InviteOrganizationMember.
+type InviteOrganizationMemberResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Invitation    *OrganizationInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteOrganizationMemberResponse) Reset() {
+	*x = InviteOrganizationMemberResponse{}
+	mi := &file_prompt_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *InviteOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InviteOrganizationMemberResponse) GetInvitation() *OrganizationInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// AcceptOrganizationInvitationRequest is the request message for AcceptOrganizationInvitation.
+type AcceptOrganizationInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token sent in the invitation email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationInvitationRequest) Reset() {
+	*x = AcceptOrganizationInvitationRequest{}
+	mi := &file_prompt_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptOrganizationInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AcceptOrganizationInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AcceptOrganizationInvitationResponse is the response message for AcceptOrganizationInvitation.
+type AcceptOrganizationInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationInvitationResponse) Reset() {
+	*x = AcceptOrganizationInvitationResponse{}
+	mi := &file_prompt_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptOrganizationInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AcceptOrganizationInvitationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// UpdateOrganizationMemberRequest is the request message for UpdateOrganizationMember.
+type UpdateOrganizationMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          OrgRole                `protobuf:"varint,3,opt,name=role,proto3,enum=v1.OrgRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRequest) Reset() {
+	*x = UpdateOrganizationMemberRequest{}
+	mi := &file_prompt_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateOrganizationMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRequest) GetRole() OrgRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+// UpdateOrganizationMemberResponse is the response message for UpdateOrganizationMember.
+type UpdateOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *OrganizationMember    `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberResponse) Reset() {
+	*x = UpdateOrganizationMemberResponse{}
+	mi := &file_prompt_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateOrganizationMemberResponse) GetMember() *OrganizationMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RemoveOrganizationMemberRequest is the request message for RemoveOrganizationMember.
+type RemoveOrganizationMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberRequest) Reset() {
+	*x = RemoveOrganizationMemberRequest{}
+	mi := &file_prompt_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RemoveOrganizationMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveOrganizationMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RemoveOrganizationMemberResponse is the response message for RemoveOrganizationMember.
+type RemoveOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberResponse) Reset() {
+	*x = RemoveOrganizationMemberResponse{}
+	mi := &file_prompt_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveOrganizationMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// User is a user account as seen by administrators.
+type User struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email       string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role        UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=v1.UserRole" json:"role,omitempty"`
+	// Set while the user is suspended.
+	SuspendedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_prompt_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *User) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListUsersRequest is the request message for ListUsers.
+type ListUsersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Matches the ID, email or display name, case-insensitively.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// Filter by role.
+	Role UserRole `protobuf:"varint,4,opt,name=role,proto3,enum=v1.UserRole" json:"role,omitempty"`
+	// Only list suspended users.
+	SuspendedOnly bool `protobuf:"varint,5,opt,name=suspended_only,json=suspendedOnly,proto3" json:"suspended_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_prompt_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetSuspendedOnly() bool {
+	if x != nil {
+		return x.SuspendedOnly
+	}
+	return false
+}
+
+// ListUsersResponse is the response message for ListUsers.
+type ListUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Users, newest first.
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Token of the next page, empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_prompt_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SuspendUserRequest is the request message for SuspendUser.
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_prompt_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// SuspendUserResponse is the response message for SuspendUser.
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_prompt_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SuspendUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ReinstateUserRequest is the request message for ReinstateUser.
+type ReinstateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateUserRequest) Reset() {
+	*x = ReinstateUserRequest{}
+	mi := &file_prompt_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateUserRequest) ProtoMessage() {}
+
+func (x *ReinstateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateUserRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReinstateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ReinstateUserResponse is the response message for ReinstateUser.
+type ReinstateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateUserResponse) Reset() {
+	*x = ReinstateUserResponse{}
+	mi := &file_prompt_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateUserResponse) ProtoMessage() {}
+
+func (x *ReinstateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReinstateUserResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReinstateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// TransferTemplateOwnershipRequest is the request message for TransferTemplateOwnership.
+type TransferTemplateOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTemplateOwnershipRequest) Reset() {
+	*x = TransferTemplateOwnershipRequest{}
+	mi := &file_prompt_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTemplateOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTemplateOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferTemplateOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTemplateOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferTemplateOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TransferTemplateOwnershipRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TransferTemplateOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+// TransferTemplateOwnershipResponse is the response message for TransferTemplateOwnership.
+type TransferTemplateOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTemplateOwnershipResponse) Reset() {
+	*x = TransferTemplateOwnershipResponse{}
+	mi := &file_prompt_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTemplateOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTemplateOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferTemplateOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTemplateOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferTemplateOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TransferTemplateOwnershipResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// SetTemplateFeaturedRequest is the request message for SetTemplateFeatured.
+type SetTemplateFeaturedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Featured      bool                   `protobuf:"varint,2,opt,name=featured,proto3" json:"featured,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTemplateFeaturedRequest) Reset() {
+	*x = SetTemplateFeaturedRequest{}
+	mi := &file_prompt_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTemplateFeaturedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTemplateFeaturedRequest) ProtoMessage() {}
+
+func (x *SetTemplateFeaturedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTemplateFeaturedRequest.ProtoReflect.Descriptor instead.
+func (*SetTemplateFeaturedRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetTemplateFeaturedRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SetTemplateFeaturedRequest) GetFeatured() bool {
+	if x != nil {
+		return x.Featured
+	}
+	return false
+}
+
+// SetTemplateFeaturedResponse is the response message for SetTemplateFeatured.
+type SetTemplateFeaturedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTemplateFeaturedResponse) Reset() {
+	*x = SetTemplateFeaturedResponse{}
+	mi := &file_prompt_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTemplateFeaturedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTemplateFeaturedResponse) ProtoMessage() {}
+
+func (x *SetTemplateFeaturedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTemplateFeaturedResponse.ProtoReflect.Descriptor instead.
+func (*SetTemplateFeaturedResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetTemplateFeaturedResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// TemplateReport is a user's report of a template.
+type TemplateReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=v1.ReportReason" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=v1.ReportStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateReport) Reset() {
+	*x = TemplateReport{}
+	mi := &file_prompt_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateReport) ProtoMessage() {}
+
+func (x *TemplateReport) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateReport.ProtoReflect.Descriptor instead.
+func (*TemplateReport) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TemplateReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplateReport) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TemplateReport) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *TemplateReport) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *TemplateReport) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *TemplateReport) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *TemplateReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ReportTemplateRequest is the request message for ReportTemplate.
+type ReportTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Reason     ReportReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=v1.ReportReason" json:"reason,omitempty"`
+	// Free-form explanation, at most 1000 characters.
+	Details       string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTemplateRequest) Reset() {
+	*x = ReportTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTemplateRequest) ProtoMessage() {}
+
+func (x *ReportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ReportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReportTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ReportTemplateRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportTemplateRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+// ReportTemplateResponse is the response message for ReportTemplate.
+type ReportTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *TemplateReport        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTemplateResponse) Reset() {
+	*x = ReportTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTemplateResponse) ProtoMessage() {}
+
+func (x *ReportTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ReportTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReportTemplateResponse) GetReport() *TemplateReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Comment is a comment on a template or a reply to another comment.
+type Comment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateId string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// ID of the comment replied to; empty for top-level comments.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Version of the template the comment refers to; 0 when it is about the template as a whole.
+	Version           int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	AuthorId          string `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorDisplayName string `protobuf:"bytes,6,opt,name=author_display_name,json=authorDisplayName,proto3" json:"author_display_name,omitempty"`
+	// Markdown source of the comment. Empty once deleted.
+	Body string `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	// Sanitized HTML rendering of the body.
+	BodyHtml string `protobuf:"bytes,8,opt,name=body_html,json=bodyHtml,proto3" json:"body_html,omitempty"`
+	// Number of replies, deleted ones excluded.
+	ReplyCount int32 `protobuf:"varint,9,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// Whether the comment was deleted. Deleted comments are listed while they have replies.
+	Deleted bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Timestamp of the last edit, unset if the comment was never edited.
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_prompt_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorDisplayName() string {
+	if x != nil {
+		return x.AuthorDisplayName
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetBodyHtml() string {
+	if x != nil {
+		return x.BodyHtml
+	}
+	return ""
+}
+
+func (x *Comment) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateCommentRequest is the request message for CreateComment.
+type CreateCommentRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// ID of the comment to reply to. Replies refer to the same version as their parent.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Version of the template the comment refers to, if any.
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Markdown source, at most 10000 characters.
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_prompt_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateCommentRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// CreateCommentResponse is the response message for CreateComment.
+type CreateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_prompt_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// UpdateCommentRequest is the request message for UpdateComment.
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_prompt_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// UpdateCommentResponse is the response message for UpdateComment.
+type UpdateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentResponse) Reset() {
+	*x = UpdateCommentResponse{}
+	mi := &file_prompt_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// DeleteCommentRequest is the request message for DeleteComment.
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_prompt_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteCommentResponse is the response message for DeleteComment.
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_prompt_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListCommentsRequest is the request message for ListComments.
+type ListCommentsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Lists the replies to this comment instead of the top-level comments.
+	ParentId  string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Token of a share link, giving read access to a template that is not otherwise visible.
+	ShareToken    string `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_prompt_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListCommentsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// ListCommentsResponse is the response message for ListComments.
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_prompt_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Review is a user's star rating of a template, with an optional written review.
+type Review struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateId      string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserDisplayName string                 `protobuf:"bytes,4,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// Star rating from 1 to 5.
+	Rating int32  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Body   string `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// Version of the template the review was written against.
+	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether a major version of the template came out after the reviewed one.
+	Stale         bool                   `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_prompt_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Review) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Review) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RateTemplateRequest is the request message for RateTemplate.
+type RateTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Star rating from 1 to 5.
+	Rating int32 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Optional written review, at most 5000 characters.
+	Body          string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateTemplateRequest) Reset() {
+	*x = RateTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTemplateRequest) ProtoMessage() {}
+
+func (x *RateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RateTemplateRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RateTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// RateTemplateResponse is the response message for RateTemplate.
+type RateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateTemplateResponse) Reset() {
+	*x = RateTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTemplateResponse) ProtoMessage() {}
+
+func (x *RateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RateTemplateResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// DeleteReviewRequest is the request message for DeleteReview.
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_prompt_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteReviewRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// DeleteReviewResponse is the response message for DeleteReview.
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_prompt_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListReviewsRequest is the request message for ListReviews.
+type ListReviewsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Leave out the reviews written before the latest major version.
+	ExcludeStale bool `protobuf:"varint,4,opt,name=exclude_stale,json=excludeStale,proto3" json:"exclude_stale,omitempty"`
+	// Token of a share link, giving read access to a template that is not otherwise visible.
+	ShareToken    string `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_prompt_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsRequest) ProtoMessage() {}
+
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListReviewsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetExcludeStale() bool {
+	if x != nil {
+		return x.ExcludeStale
+	}
+	return false
+}
+
+func (x *ListReviewsRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// ListReviewsResponse is the response message for ListReviews.
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_prompt_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// FeedItem is an activity of a followed user.
+type FeedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique per activity.
+	Id       string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     FeedItemType `protobuf:"varint,2,opt,name=type,proto3,enum=v1.FeedItemType" json:"type,omitempty"`
+	Template *Template    `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Version published; 1 for new templates and forks.
+	Version          int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	ActorId          string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorDisplayName string                 `protobuf:"bytes,6,opt,name=actor_display_name,json=actorDisplayName,proto3" json:"actor_display_name,omitempty"`
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeedItem) Reset() {
+	*x = FeedItem{}
+	mi := &file_prompt_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedItem) ProtoMessage() {}
+
+func (x *FeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
+func (*FeedItem) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FeedItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedItem) GetType() FeedItemType {
+	if x != nil {
+		return x.Type
+	}
+	return FeedItemType_FEED_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *FeedItem) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *FeedItem) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FeedItem) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *FeedItem) GetActorDisplayName() string {
+	if x != nil {
+		return x.ActorDisplayName
+	}
+	return ""
+}
+
+func (x *FeedItem) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// RecordTemplateEventRequest is the request message for RecordTemplateEvent.
+type RecordTemplateEventRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version number the event is about; 0 when unknown.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// TEMPLATE_EVENT_RENDER or TEMPLATE_EVENT_COPY.
+	Event TemplateEvent `protobuf:"varint,3,opt,name=event,proto3,enum=v1.TemplateEvent" json:"event,omitempty"`
+	// Share link token, for templates accessed through a share link.
+	ShareToken    string `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTemplateEventRequest) Reset() {
+	*x = RecordTemplateEventRequest{}
+	mi := &file_prompt_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTemplateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTemplateEventRequest) ProtoMessage() {}
+
+func (x *RecordTemplateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTemplateEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordTemplateEventRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RecordTemplateEventRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *RecordTemplateEventRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RecordTemplateEventRequest) GetEvent() TemplateEvent {
+	if x != nil {
+		return x.Event
+	}
+	return TemplateEvent_TEMPLATE_EVENT_UNSPECIFIED
+}
+
+func (x *RecordTemplateEventRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// RecordTemplateEventResponse is the response message for RecordTemplateEvent.
+type RecordTemplateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTemplateEventResponse) Reset() {
+	*x = RecordTemplateEventResponse{}
+	mi := &file_prompt_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTemplateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTemplateEventResponse) ProtoMessage() {}
+
+func (x *RecordTemplateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTemplateEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordTemplateEventResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{101}
+}
+
+// TemplateUsage counts the usage events of a template over a day or a period.
+type TemplateUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UTC day as YYYY-MM-DD; empty for totals.
+	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views          int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	Renders        int64  `protobuf:"varint,3,opt,name=renders,proto3" json:"renders,omitempty"`
+	Copies         int64  `protobuf:"varint,4,opt,name=copies,proto3" json:"copies,omitempty"`
+	Instantiations int64  `protobuf:"varint,5,opt,name=instantiations,proto3" json:"instantiations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TemplateUsage) Reset() {
+	*x = TemplateUsage{}
+	mi := &file_prompt_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateUsage) ProtoMessage() {}
+
+func (x *TemplateUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateUsage.ProtoReflect.Descriptor instead.
+func (*TemplateUsage) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TemplateUsage) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TemplateUsage) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetRenders() int64 {
+	if x != nil {
+		return x.Renders
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetCopies() int64 {
+	if x != nil {
+		return x.Copies
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetInstantiations() int64 {
+	if x != nil {
+		return x.Instantiations
+	}
+	return 0
+}
+
+// GetTemplateStatsRequest is the request message for GetTemplateStats.
+type GetTemplateStatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Restricts the statistics to a version number; 0 for all versions.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// First UTC day as YYYY-MM-DD; defaults to 29 days before end_date.
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Last UTC day as YYYY-MM-DD; defaults to today.
+	EndDate       string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateStatsRequest) Reset() {
+	*x = GetTemplateStatsRequest{}
+	mi := &file_prompt_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateStatsRequest) ProtoMessage() {}
+
+func (x *GetTemplateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetTemplateStatsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *GetTemplateStatsRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetTemplateStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetTemplateStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// GetTemplateStatsResponse is the response message for GetTemplateStats.
+type GetTemplateStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per day of the period, oldest first, including days without usage.
+	Days          []*TemplateUsage `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Totals        *TemplateUsage   `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateStatsResponse) Reset() {
+	*x = GetTemplateStatsResponse{}
+	mi := &file_prompt_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateStatsResponse) ProtoMessage() {}
+
+func (x *GetTemplateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetTemplateStatsResponse) GetDays() []*TemplateUsage {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetTemplateStatsResponse) GetTotals() *TemplateUsage {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+// GetFeedRequest is the request message for GetFeed.
+type GetFeedRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	mi := &file_prompt_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRequest) ProtoMessage() {}
+
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetFeedResponse is the response message for GetFeed.
+type GetFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*FeedItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
+	mi := &file_prompt_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedResponse) ProtoMessage() {}
+
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetFeedResponse) GetItems() []*FeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ModerationQueueItem is a reported template with its open reports.
+type ModerationQueueItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Template        *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Reports         []*TemplateReport      `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	FirstReportedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_reported_at,json=firstReportedAt,proto3" json:"first_reported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationQueueItem) Reset() {
+	*x = ModerationQueueItem{}
+	mi := &file_prompt_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationQueueItem) ProtoMessage() {}
+
+func (x *ModerationQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationQueueItem.ProtoReflect.Descriptor instead.
+func (*ModerationQueueItem) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ModerationQueueItem) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *ModerationQueueItem) GetReports() []*TemplateReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ModerationQueueItem) GetFirstReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstReportedAt
+	}
+	return nil
+}
+
+// ListModerationQueueRequest is the request message for ListModerationQueue.
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_prompt_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListModerationQueueResponse is the response message for ListModerationQueue.
+type ListModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ModerationQueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	mi := &file_prompt_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListModerationQueueResponse) GetItems() []*ModerationQueueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ModerateTemplateRequest is the request message for ModerateTemplate.
+type ModerateTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Action     ModerationAction       `protobuf:"varint,2,opt,name=action,proto3,enum=v1.ModerationAction" json:"action,omitempty"`
+	// Explanation included in the warning sent to the owner when removing.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateTemplateRequest) Reset() {
+	*x = ModerateTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateTemplateRequest) ProtoMessage() {}
+
+func (x *ModerateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ModerateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ModerateTemplateRequest) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *ModerateTemplateRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// ModerateTemplateResponse is the response message for ModerateTemplate.
+type ModerateTemplateResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Template *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Number of open reports resolved by the action.
+	ResolvedReports int32 `protobuf:"varint,2,opt,name=resolved_reports,json=resolvedReports,proto3" json:"resolved_reports,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerateTemplateResponse) Reset() {
+	*x = ModerateTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateTemplateResponse) ProtoMessage() {}
+
+func (x *ModerateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ModerateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ModerateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *ModerateTemplateResponse) GetResolvedReports() int32 {
+	if x != nil {
+		return x.ResolvedReports
+	}
+	return 0
+}
+
+// DeleteTemplateRequest is the request message for DeleteTemplate.
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Ignored, the caller is authorized from the token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_prompt_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// DeleteTemplateResponse is the response message for DeleteTemplate.
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_prompt_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ToggleLikeRequest is the request message for ToggleLikeTemplate.
+type ToggleLikeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLikeRequest) Reset() {
+	*x = ToggleLikeRequest{}
+	mi := &file_prompt_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLikeRequest) ProtoMessage() {}
+
+func (x *ToggleLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLikeRequest.ProtoReflect.Descriptor instead.
+func (*ToggleLikeRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ToggleLikeRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// ToggleLikeResponse is the response message for ToggleLikeTemplate.
+type ToggleLikeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsLiked       bool                   `protobuf:"varint,1,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	LikeCount     int32                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleLikeResponse) Reset() {
+	*x = ToggleLikeResponse{}
+	mi := &file_prompt_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLikeResponse) ProtoMessage() {}
+
+func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLikeResponse.ProtoReflect.Descriptor instead.
+func (*ToggleLikeResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ToggleLikeResponse) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *ToggleLikeResponse) GetLikeCount() int32 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+// ToggleFavoriteRequest is the request message for ToggleFavoriteTemplate.
+type ToggleFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteRequest) Reset() {
+	*x = ToggleFavoriteRequest{}
+	mi := &file_prompt_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteRequest) ProtoMessage() {}
+
+func (x *ToggleFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ToggleFavoriteRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// ToggleFavoriteResponse is the response message for ToggleFavoriteTemplate.
+type ToggleFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFavorited   bool                   `protobuf:"varint,1,opt,name=is_favorited,json=isFavorited,proto3" json:"is_favorited,omitempty"`
+	FavoriteCount int32                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleFavoriteResponse) Reset() {
+	*x = ToggleFavoriteResponse{}
+	mi := &file_prompt_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFavoriteResponse) ProtoMessage() {}
+
+func (x *ToggleFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*ToggleFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ToggleFavoriteResponse) GetIsFavorited() bool {
+	if x != nil {
+		return x.IsFavorited
+	}
+	return false
+}
+
+func (x *ToggleFavoriteResponse) GetFavoriteCount() int32 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+// CreatePromptRequest is the request message for CreatePrompt.
+type CreatePromptRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	VersionId  int32                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// The prompt always belongs to the caller; when set, owner_id must be the caller.
+	OwnerId   string   `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Variables []string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	Title     string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Notes     string   `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags      []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pinned    bool     `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Values of the named {{placeholders}}, overriding the ones of the preset.
+	NamedVariables map[string]string `protobuf:"bytes,9,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Preset filling the named placeholders that are not given explicitly.
+	PresetId      string `protobuf:"bytes,10,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptRequest) Reset() {
+	*x = CreatePromptRequest{}
+	mi := &file_prompt_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptRequest) ProtoMessage() {}
+
+func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreatePromptRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetVersionId() int32 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *CreatePromptRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreatePromptRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreatePromptRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePromptRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *CreatePromptRequest) GetNamedVariables() map[string]string {
+	if x != nil {
+		return x.NamedVariables
+	}
+	return nil
+}
+
+func (x *CreatePromptRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+// CreatePromptResponse is the response message for CreatePrompt.
+type CreatePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Report        *PlaceholderReport     `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptResponse) Reset() {
+	*x = CreatePromptResponse{}
+	mi := &file_prompt_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptResponse) ProtoMessage() {}
+
+func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CreatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *CreatePromptResponse) GetReport() *PlaceholderReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// GetPromptRequest is the request message for GetPrompt.
+type GetPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromptRequest) Reset() {
+	*x = GetPromptRequest{}
+	mi := &file_prompt_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptRequest) ProtoMessage() {}
+
+func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetPromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetPromptResponse is the response message for GetPrompt.
+type GetPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromptResponse) Reset() {
+	*x = GetPromptResponse{}
+	mi := &file_prompt_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptResponse) ProtoMessage() {}
+
+func (x *GetPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptResponse.ProtoReflect.Descriptor instead.
+func (*GetPromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetPromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+// ListPromptsRequest is the request message for ListPrompts.
+type ListPromptsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token by the previous page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only the caller's prompts are listed; when set, owner_id must be the caller.
+	OwnerId string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Filter by template_id.
+	TemplateId string `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Whether to compute total_count.
+	IncludeTotalCount bool `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// Only list the prompts created at or after start_time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Only list the prompts created before end_time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Matches the title, notes or variable values, case-insensitively.
+	Query string     `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	Sort  PromptSort `protobuf:"varint,9,opt,name=sort,proto3,enum=v1.PromptSort" json:"sort,omitempty"`
+	// Only list pinned prompts.
+	PinnedOnly bool `protobuf:"varint,10,opt,name=pinned_only,json=pinnedOnly,proto3" json:"pinned_only,omitempty"`
+	// Filter by tag.
+	Tag           string `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
+	mi := &file_prompt_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsRequest) ProtoMessage() {}
+
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListPromptsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPromptsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPromptsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListPromptsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListPromptsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListPromptsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListPromptsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListPromptsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListPromptsRequest) GetSort() PromptSort {
+	if x != nil {
+		return x.Sort
+	}
+	return PromptSort_PROMPT_SORT_UNSPECIFIED
+}
+
+func (x *ListPromptsRequest) GetPinnedOnly() bool {
+	if x != nil {
+		return x.PinnedOnly
+	}
+	return false
+}
+
+func (x *ListPromptsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// ListPromptsResponse is the response message for ListPrompts.
+type ListPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of matching prompts, only set when include_total_count was requested.
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
+	mi := &file_prompt_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsResponse) ProtoMessage() {}
+
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListPromptsResponse) GetPrompts() []*Prompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+func (x *ListPromptsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPromptsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdatePromptRequest is the request message for UpdatePrompt.
+type UpdatePromptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Left unchanged when empty.
+	Title  string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes  string   `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags   []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pinned bool     `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Marks the prompt as used now, for example after it was copied.
+	MarkUsed      bool `protobuf:"varint,6,opt,name=mark_used,json=markUsed,proto3" json:"mark_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptRequest) Reset() {
+	*x = UpdatePromptRequest{}
+	mi := &file_prompt_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptRequest) ProtoMessage() {}
+
+func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdatePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePromptRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePromptRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdatePromptRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePromptRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *UpdatePromptRequest) GetMarkUsed() bool {
+	if x != nil {
+		return x.MarkUsed
+	}
+	return false
+}
+
+// UpdatePromptResponse is the response message for UpdatePrompt.
+type UpdatePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptResponse) Reset() {
+	*x = UpdatePromptResponse{}
+	mi := &file_prompt_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptResponse) ProtoMessage() {}
+
+func (x *UpdatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+// DuplicatePromptRequest is the request message for DuplicatePrompt.
+type DuplicatePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicatePromptRequest) Reset() {
+	*x = DuplicatePromptRequest{}
+	mi := &file_prompt_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicatePromptRequest) ProtoMessage() {}
+
+func (x *DuplicatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicatePromptRequest.ProtoReflect.Descriptor instead.
+func (*DuplicatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DuplicatePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DuplicatePromptResponse is the response message for DuplicatePrompt.
+type DuplicatePromptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new prompt, instantiated from the latest version.
+	Prompt *Prompt           `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Drift  *PlaceholderDrift `protobuf:"bytes,2,opt,name=drift,proto3" json:"drift,omitempty"`
+	// Named placeholders of the latest version the original prompt had no value for.
+	Report        *PlaceholderReport `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicatePromptResponse) Reset() {
+	*x = DuplicatePromptResponse{}
+	mi := &file_prompt_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicatePromptResponse) ProtoMessage() {}
+
+func (x *DuplicatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicatePromptResponse.ProtoReflect.Descriptor instead.
+func (*DuplicatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DuplicatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *DuplicatePromptResponse) GetDrift() *PlaceholderDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *DuplicatePromptResponse) GetReport() *PlaceholderReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// DeletePromptRequest is the request message for DeletePrompt.
+type DeletePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Ignored, the caller is authorized from the token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptRequest) Reset() {
+	*x = DeletePromptRequest{}
+	mi := &file_prompt_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptRequest) ProtoMessage() {}
+
+func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DeletePromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletePromptRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// DeletePromptResponse is the response message for DeletePrompt.
+type DeletePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptResponse) Reset() {
+	*x = DeletePromptResponse{}
+	mi := &file_prompt_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptResponse) ProtoMessage() {}
+
+func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *DeletePromptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RegisterRequest is the request message for Register.
+type RegisterRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Mobile           string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Password         string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	DisplayName      string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	VerificationCode string                 `protobuf:"bytes,6,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_prompt_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RegisterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+// RegisterResponse is the response message for Register.
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // JWT token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_prompt_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RegisterResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// LoginRequest is the request message for Login.
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // Or mobile/id
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_prompt_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// LoginResponse is the response message for Login.
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Role          UserRole               `protobuf:"varint,5,opt,name=role,proto3,enum=v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_prompt_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *LoginResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// LoginWithOAuthRequest is the request message for LoginWithOAuth.
+type LoginWithOAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithOAuthRequest) Reset() {
+	*x = LoginWithOAuthRequest{}
+	mi := &file_prompt_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithOAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithOAuthRequest) ProtoMessage() {}
+
+func (x *LoginWithOAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithOAuthRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithOAuthRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *LoginWithOAuthRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginWithOAuthRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type SendVerificationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // "en" or "zh"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeRequest) Reset() {
+	*x = SendVerificationCodeRequest{}
+	mi := &file_prompt_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeRequest) ProtoMessage() {}
+
+func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SendVerificationCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SendVerificationCodeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type SendVerificationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeResponse) Reset() {
+	*x = SendVerificationCodeResponse{}
+	mi := &file_prompt_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeResponse) ProtoMessage() {}
+
+func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *SendVerificationCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListCategoriesRequest is the request message for ListCategories.
+type ListCategoriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId  string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Language string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// Count the library of an organization the current user is a member of.
+	OrgId         string `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_prompt_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListCategoriesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// CategoryStats represents a category and its usage count.
+type CategoryStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryStats) Reset() {
+	*x = CategoryStats{}
+	mi := &file_prompt_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStats) ProtoMessage() {}
+
+func (x *CategoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStats.ProtoReflect.Descriptor instead.
+func (*CategoryStats) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CategoryStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ListCategoriesResponse is the response message for ListCategories.
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryStats       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_prompt_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryStats {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// ListTagsRequest is the request message for ListTags.
+type ListTagsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Language string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Count the library of an organization the current user is a member of.
+	OrgId         string `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_prompt_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListTagsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// TagStats represents a tag and its usage count.
+type TagStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagStats) Reset() {
+	*x = TagStats{}
+	mi := &file_prompt_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagStats) ProtoMessage() {}
+
+func (x *TagStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagStats.ProtoReflect.Descriptor instead.
+func (*TagStats) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *TagStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ListTagsResponse is the response message for ListTags.
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagStats            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_prompt_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagStats {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// UpdateProfileRequest is the request message for UpdateProfile.
+type UpdateProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the caller, the only user whose profile can be updated.
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar      string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Short public description shown on the profile page.
+	Bio           string `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_prompt_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *UpdateProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+// UpdateProfileResponse is the response message for UpdateProfile.
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_prompt_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpdateProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProfileResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+// GetProfileRequest is the request message for GetProfile.
+type GetProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the caller, the only user whose profile can be read.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_prompt_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetProfileResponse is the response message for GetProfile.
+type GetProfileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar         string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Role           UserRole               `protobuf:"varint,5,opt,name=role,proto3,enum=v1.UserRole" json:"role,omitempty"`
+	FollowerCount  int32                  `protobuf:"varint,6,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount int32                  `protobuf:"varint,7,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	Bio            string                 `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_prompt_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProfileResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetProfileResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *GetProfileResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *GetProfileResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *GetProfileResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *GetProfileResponse) GetFollowingCount() int32 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *GetProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+// Notification tells a user about activity on their templates or comments.
+// Repeated events of the same type on the same template are collapsed into one
+// notification while it is unread.
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=v1.NotificationType" json:"type,omitempty"`
+	// User who caused the latest event.
+	ActorId          string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorDisplayName string `protobuf:"bytes,4,opt,name=actor_display_name,json=actorDisplayName,proto3" json:"actor_display_name,omitempty"`
+	// Number of distinct users who caused the events.
+	ActorCount int32 `protobuf:"varint,5,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"`
+	// Number of events collapsed into the notification.
+	EventCount    int32  `protobuf:"varint,6,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	TemplateId    string `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateTitle string `protobuf:"bytes,8,opt,name=template_title,json=templateTitle,proto3" json:"template_title,omitempty"`
+	// Latest comment, for comment and reply notifications.
+	CommentId string                 `protobuf:"bytes,9,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Read      bool                   `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Time of the latest event.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_prompt_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *Notification) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Notification) GetActorDisplayName() string {
+	if x != nil {
+		return x.ActorDisplayName
+	}
+	return ""
+}
+
+func (x *Notification) GetActorCount() int32 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *Notification) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *Notification) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Notification) GetTemplateTitle() string {
+	if x != nil {
+		return x.TemplateTitle
+	}
+	return ""
+}
+
+func (x *Notification) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AuditEvent is an entry of the audit log: a mutating operation performed by a user.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User who performed the operation; empty when it was anonymous.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Role of the actor at the time, e.g. "admin".
+	ActorRole string `protobuf:"bytes,3,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	// Operation performed, as <resource_type>.<verb>, e.g. "template.update".
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Kind of the resource acted on, e.g. "template", "user" or "collection".
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// User owning the resource acted on.
+	OwnerId string `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Summary of the resource before the operation; absent on creation.
+	Before *structpb.Struct `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	// Summary of the resource after the operation; absent on deletion.
+	After *structpb.Struct `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	// Client address and request ID of the operation. Only administrators see
+	// them on operations performed by someone else.
+	ClientIp      string                 `protobuf:"bytes,10,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	RequestId     string                 `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_prompt_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListAuditEventsRequest is the request message for ListAuditEvents.
+type ListAuditEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filters.
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_prompt_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// ListAuditEventsResponse is the response message for ListAuditEvents.
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_prompt_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListNotificationsRequest is the request message for ListNotifications.
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_prompt_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListNotificationsResponse is the response message for ListNotifications.
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of unread notifications of the caller.
+	UnreadCount   int32 `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_prompt_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// MarkReadRequest is the request message for MarkRead.
+type MarkReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_prompt_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *MarkReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// MarkReadResponse is the response message for MarkRead.
+type MarkReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of notifications that were unread.
+	Updated       int32 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_prompt_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *MarkReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// MarkAllReadRequest is the request message for MarkAllRead.
+type MarkAllReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadRequest) Reset() {
+	*x = MarkAllReadRequest{}
+	mi := &file_prompt_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadRequest) ProtoMessage() {}
+
+func (x *MarkAllReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllReadRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{155}
+}
+
+// MarkAllReadResponse is the response message for MarkAllRead.
+type MarkAllReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of notifications that were unread.
+	Updated       int32 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadResponse) Reset() {
+	*x = MarkAllReadResponse{}
+	mi := &file_prompt_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadResponse) ProtoMessage() {}
+
+func (x *MarkAllReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllReadResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *MarkAllReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// FollowUserRequest is the request message for FollowUser.
+type FollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	mi := &file_prompt_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *FollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// FollowUserResponse is the response message for FollowUser.
+type FollowUserResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	// Number of followers of the followed user.
+	FollowerCount int32 `protobuf:"varint,2,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	mi := &file_prompt_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *FollowUserResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *FollowUserResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+// UnfollowUserRequest is the request message for UnfollowUser.
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Template) Reset() {
-	*x = Template{}
-	mi := &file_prompt_proto_msgTypes[0]
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	mi := &file_prompt_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Template) String() string {
+func (x *UnfollowUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Template) ProtoMessage() {}
+func (*UnfollowUserRequest) ProtoMessage() {}
 
-func (x *Template) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[0]
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,155 +11041,155 @@ func (x *Template) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Template.ProtoReflect.Descriptor instead.
-func (*Template) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *Template) GetId() string {
+func (x *UnfollowUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Template) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
+// UnfollowUserResponse is the response message for UnfollowUser.
+type UnfollowUserResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	// Number of followers of the unfollowed user.
+	FollowerCount int32 `protobuf:"varint,2,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Template) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	mi := &file_prompt_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Template) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Template) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
-}
+func (*UnfollowUserResponse) ProtoMessage() {}
 
-func (x *Template) GetType() TemplateType {
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[160]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TemplateType_TEMPLATE_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Template) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *Template) GetCategory() string {
+func (x *UnfollowUserResponse) GetIsFollowing() bool {
 	if x != nil {
-		return x.Category
+		return x.IsFollowing
 	}
-	return ""
+	return false
 }
 
-func (x *Template) GetLikeCount() int32 {
+func (x *UnfollowUserResponse) GetFollowerCount() int32 {
 	if x != nil {
-		return x.LikeCount
+		return x.FollowerCount
 	}
 	return 0
 }
 
-func (x *Template) GetFavoriteCount() int32 {
-	if x != nil {
-		return x.FavoriteCount
-	}
-	return 0
+// GetPublicProfileRequest is the request message for GetPublicProfile.
+type GetPublicProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	mi := &file_prompt_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Template) GetLatestVersion() *TemplateVersion {
-	if x != nil {
-		return x.LatestVersion
-	}
-	return nil
-}
+func (*GetPublicProfileRequest) ProtoMessage() {}
 
-func (x *Template) GetIsLiked() bool {
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[161]
 	if x != nil {
-		return x.IsLiked
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Template) GetIsFavorited() bool {
-	if x != nil {
-		return x.IsFavorited
-	}
-	return false
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *Template) GetLanguage() string {
+func (x *GetPublicProfileRequest) GetUserId() string {
 	if x != nil {
-		return x.Language
+		return x.UserId
 	}
 	return ""
 }
 
-// TemplateVersion represents a specific version of a template's content.
-type TemplateVersion struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the version record.
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// ID of the parent template.
-	TemplateId string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	// Logical version number (1, 2, 3...).
-	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// The actual prompt content with placeholders (e.g., $$).
-	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	// Timestamp when this version was created.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// GetPublicProfileResponse is the response message for GetPublicProfile.
+type GetPublicProfileResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName         string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar              string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Bio                 string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	JoinedAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	PublicTemplateCount int32                  `protobuf:"varint,6,opt,name=public_template_count,json=publicTemplateCount,proto3" json:"public_template_count,omitempty"`
+	// Likes received by the public templates of the user.
+	TotalLikes int64 `protobuf:"varint,7,opt,name=total_likes,json=totalLikes,proto3" json:"total_likes,omitempty"`
+	// Favorites received by the public templates of the user.
+	TotalFavorites int64 `protobuf:"varint,8,opt,name=total_favorites,json=totalFavorites,proto3" json:"total_favorites,omitempty"`
+	FollowerCount  int32 `protobuf:"varint,9,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount int32 `protobuf:"varint,10,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	// Whether the caller follows the user; false for anonymous callers.
+	IsFollowing bool `protobuf:"varint,11,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	// Most liked public templates of the user.
+	TopTemplates  []*Template `protobuf:"bytes,12,rep,name=top_templates,json=topTemplates,proto3" json:"top_templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TemplateVersion) Reset() {
-	*x = TemplateVersion{}
-	mi := &file_prompt_proto_msgTypes[1]
+func (x *GetPublicProfileResponse) Reset() {
+	*x = GetPublicProfileResponse{}
+	mi := &file_prompt_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TemplateVersion) String() string {
+func (x *GetPublicProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateVersion) ProtoMessage() {}
+func (*GetPublicProfileResponse) ProtoMessage() {}
 
-func (x *TemplateVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[1]
+func (x *GetPublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,71 +11200,123 @@ func (x *TemplateVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateVersion.ProtoReflect.Descriptor instead.
-func (*TemplateVersion) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetPublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *TemplateVersion) GetId() int32 {
+func (x *GetPublicProfileResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *TemplateVersion) GetTemplateId() string {
+func (x *GetPublicProfileResponse) GetDisplayName() string {
 	if x != nil {
-		return x.TemplateId
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *TemplateVersion) GetVersion() int32 {
+func (x *GetPublicProfileResponse) GetAvatar() string {
 	if x != nil {
-		return x.Version
+		return x.Avatar
 	}
-	return 0
+	return ""
 }
 
-func (x *TemplateVersion) GetContent() string {
+func (x *GetPublicProfileResponse) GetBio() string {
 	if x != nil {
-		return x.Content
+		return x.Bio
 	}
 	return ""
 }
 
-func (x *TemplateVersion) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetPublicProfileResponse) GetJoinedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.JoinedAt
 	}
 	return nil
 }
 
-// ListTemplateVersionsRequest is the request message for ListTemplateVersions.
-type ListTemplateVersionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetPublicProfileResponse) GetPublicTemplateCount() int32 {
+	if x != nil {
+		return x.PublicTemplateCount
+	}
+	return 0
 }
 
-func (x *ListTemplateVersionsRequest) Reset() {
-	*x = ListTemplateVersionsRequest{}
-	mi := &file_prompt_proto_msgTypes[2]
+func (x *GetPublicProfileResponse) GetTotalLikes() int64 {
+	if x != nil {
+		return x.TotalLikes
+	}
+	return 0
+}
+
+func (x *GetPublicProfileResponse) GetTotalFavorites() int64 {
+	if x != nil {
+		return x.TotalFavorites
+	}
+	return 0
+}
+
+func (x *GetPublicProfileResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *GetPublicProfileResponse) GetFollowingCount() int32 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *GetPublicProfileResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *GetPublicProfileResponse) GetTopTemplates() []*Template {
+	if x != nil {
+		return x.TopTemplates
+	}
+	return nil
+}
+
+// PlaceholderReport tells how the named placeholders of a version were filled.
+type PlaceholderReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Placeholders filled from the preset.
+	FilledFromPreset []string `protobuf:"bytes,1,rep,name=filled_from_preset,json=filledFromPreset,proto3" json:"filled_from_preset,omitempty"`
+	// Placeholders without a value, kept as written.
+	Missing []string `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	// Reserved {{sys.*}} placeholders filled by the server.
+	FilledBySystem []string `protobuf:"bytes,3,rep,name=filled_by_system,json=filledBySystem,proto3" json:"filled_by_system,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlaceholderReport) Reset() {
+	*x = PlaceholderReport{}
+	mi := &file_prompt_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTemplateVersionsRequest) String() string {
+func (x *PlaceholderReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTemplateVersionsRequest) ProtoMessage() {}
+func (*PlaceholderReport) ProtoMessage() {}
 
-func (x *ListTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[2]
+func (x *PlaceholderReport) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,56 +11327,63 @@ func (x *ListTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTemplateVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTemplateVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PlaceholderReport.ProtoReflect.Descriptor instead.
+func (*PlaceholderReport) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *ListTemplateVersionsRequest) GetTemplateId() string {
+func (x *PlaceholderReport) GetFilledFromPreset() []string {
 	if x != nil {
-		return x.TemplateId
+		return x.FilledFromPreset
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTemplateVersionsRequest) GetPageSize() int32 {
+func (x *PlaceholderReport) GetMissing() []string {
 	if x != nil {
-		return x.PageSize
+		return x.Missing
 	}
-	return 0
+	return nil
 }
 
-func (x *ListTemplateVersionsRequest) GetPageToken() string {
+func (x *PlaceholderReport) GetFilledBySystem() []string {
 	if x != nil {
-		return x.PageToken
+		return x.FilledBySystem
 	}
-	return ""
+	return nil
 }
 
-// ListTemplateVersionsResponse is the response message for ListTemplateVersions.
-type ListTemplateVersionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Versions      []*TemplateVersion     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+// RenderPromptRequest is the request message for RenderPrompt.
+type RenderPromptRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// ID of the version to render; defaults to the latest version.
+	VersionId int32 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Values of the $$ placeholders, in order.
+	Variables []string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Values of the named {{placeholders}}, overriding the ones of the preset.
+	NamedVariables map[string]string `protobuf:"bytes,4,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Preset filling the named placeholders that are not given explicitly.
+	PresetId      string `protobuf:"bytes,5,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTemplateVersionsResponse) Reset() {
-	*x = ListTemplateVersionsResponse{}
-	mi := &file_prompt_proto_msgTypes[3]
+func (x *RenderPromptRequest) Reset() {
+	*x = RenderPromptRequest{}
+	mi := &file_prompt_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTemplateVersionsResponse) String() string {
+func (x *RenderPromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTemplateVersionsResponse) ProtoMessage() {}
+func (*RenderPromptRequest) ProtoMessage() {}
 
-func (x *ListTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[3]
+func (x *RenderPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,59 +11394,72 @@ func (x *ListTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTemplateVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTemplateVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RenderPromptRequest.ProtoReflect.Descriptor instead.
+func (*RenderPromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *ListTemplateVersionsResponse) GetVersions() []*TemplateVersion {
+func (x *RenderPromptRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Versions
+		return x.TemplateId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTemplateVersionsResponse) GetNextPageToken() string {
+func (x *RenderPromptRequest) GetVersionId() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.VersionId
 	}
-	return ""
+	return 0
 }
 
-// Prompt represents an instantiated prompt saved by a user.
-type Prompt struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the prompt (UUID).
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// ID of the template used.
-	TemplateId string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	// ID of the specific version used.
-	VersionId int32 `protobuf:"varint,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	// ID of the user who saved this prompt.
-	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	// List of variable values used to replace placeholders.
-	Variables []string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty"`
-	// Timestamp when the prompt was created.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *RenderPromptRequest) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *RenderPromptRequest) GetNamedVariables() map[string]string {
+	if x != nil {
+		return x.NamedVariables
+	}
+	return nil
+}
+
+func (x *RenderPromptRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+// RenderPromptResponse is the response message for RenderPrompt.
+type RenderPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RenderedText  string                 `protobuf:"bytes,1,opt,name=rendered_text,json=renderedText,proto3" json:"rendered_text,omitempty"`
+	VersionId     int32                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Report        *PlaceholderReport     `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Prompt) Reset() {
-	*x = Prompt{}
-	mi := &file_prompt_proto_msgTypes[4]
+func (x *RenderPromptResponse) Reset() {
+	*x = RenderPromptResponse{}
+	mi := &file_prompt_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Prompt) String() string {
+func (x *RenderPromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Prompt) ProtoMessage() {}
+func (*RenderPromptResponse) ProtoMessage() {}
 
-func (x *Prompt) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[4]
+func (x *RenderPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,86 +11470,71 @@ func (x *Prompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
-func (*Prompt) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *Prompt) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RenderPromptResponse.ProtoReflect.Descriptor instead.
+func (*RenderPromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *Prompt) GetTemplateId() string {
+func (x *RenderPromptResponse) GetRenderedText() string {
 	if x != nil {
-		return x.TemplateId
+		return x.RenderedText
 	}
 	return ""
 }
 
-func (x *Prompt) GetVersionId() int32 {
+func (x *RenderPromptResponse) GetVersionId() int32 {
 	if x != nil {
 		return x.VersionId
 	}
 	return 0
 }
 
-func (x *Prompt) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
-}
-
-func (x *Prompt) GetVariables() []string {
+func (x *RenderPromptResponse) GetVersion() int32 {
 	if x != nil {
-		return x.Variables
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *Prompt) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RenderPromptResponse) GetReport() *PlaceholderReport {
 	if x != nil {
-		return x.CreatedAt
+		return x.Report
 	}
 	return nil
 }
 
-// CreateTemplateRequest is the request message for CreateTemplate.
-type CreateTemplateRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId     string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility  Visibility             `protobuf:"varint,4,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
-	Type        TemplateType           `protobuf:"varint,5,opt,name=type,proto3,enum=v1.TemplateType" json:"type,omitempty"`
-	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Category    string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	// Initial content for the first version.
-	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
-	// Language of the template.
-	Language      string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+// VariablePreset is a named set of placeholder values owned by a user or an organization.
+type VariablePreset struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User who created the preset.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Organization sharing the preset with its members, empty for personal presets.
+	OrgId string `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name  string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Placeholder values, by placeholder name.
+	Values        map[string]string      `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTemplateRequest) Reset() {
-	*x = CreateTemplateRequest{}
-	mi := &file_prompt_proto_msgTypes[5]
+func (x *VariablePreset) Reset() {
+	*x = VariablePreset{}
+	mi := &file_prompt_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTemplateRequest) String() string {
+func (x *VariablePreset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTemplateRequest) ProtoMessage() {}
+func (*VariablePreset) ProtoMessage() {}
 
-func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[5]
+func (x *VariablePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,98 +11545,86 @@ func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
-func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use VariablePreset.ProtoReflect.Descriptor instead.
+func (*VariablePreset) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *CreateTemplateRequest) GetOwnerId() string {
+func (x *VariablePreset) GetId() string {
 	if x != nil {
-		return x.OwnerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTemplateRequest) GetTitle() string {
+func (x *VariablePreset) GetOwnerId() string {
 	if x != nil {
-		return x.Title
+		return x.OwnerId
 	}
 	return ""
 }
 
-func (x *CreateTemplateRequest) GetDescription() string {
+func (x *VariablePreset) GetOrgId() string {
 	if x != nil {
-		return x.Description
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *CreateTemplateRequest) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
-}
-
-func (x *CreateTemplateRequest) GetType() TemplateType {
+func (x *VariablePreset) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
-	return TemplateType_TEMPLATE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateTemplateRequest) GetTags() []string {
+func (x *VariablePreset) GetValues() map[string]string {
 	if x != nil {
-		return x.Tags
+		return x.Values
 	}
 	return nil
 }
 
-func (x *CreateTemplateRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *CreateTemplateRequest) GetContent() string {
+func (x *VariablePreset) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Content
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTemplateRequest) GetLanguage() string {
+func (x *VariablePreset) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Language
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// CreateTemplateResponse is the response message for CreateTemplate.
-type CreateTemplateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	Version       *TemplateVersion       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+// CreateVariablePresetRequest is the request message for CreateVariablePreset.
+type CreateVariablePresetRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Organization to create the preset for; personal when empty.
+	OrgId         string `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTemplateResponse) Reset() {
-	*x = CreateTemplateResponse{}
-	mi := &file_prompt_proto_msgTypes[6]
+func (x *CreateVariablePresetRequest) Reset() {
+	*x = CreateVariablePresetRequest{}
+	mi := &file_prompt_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTemplateResponse) String() string {
+func (x *CreateVariablePresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTemplateResponse) ProtoMessage() {}
+func (*CreateVariablePresetRequest) ProtoMessage() {}
 
-func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[6]
+func (x *CreateVariablePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,58 +11635,55 @@ func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
-func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CreateVariablePresetRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariablePresetRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *CreateTemplateResponse) GetTemplate() *Template {
+func (x *CreateVariablePresetRequest) GetName() string {
 	if x != nil {
-		return x.Template
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateTemplateResponse) GetVersion() *TemplateVersion {
+func (x *CreateVariablePresetRequest) GetValues() map[string]string {
 	if x != nil {
-		return x.Version
+		return x.Values
 	}
 	return nil
 }
 
-// UpdateTemplateRequest is the request message for UpdateTemplate.
-type UpdateTemplateRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	TemplateId  string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	OwnerId     string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // For authorization check
-	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility  Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
-	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Category    string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	// New content, which triggers a new version creation.
-	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
-	// Language of the template.
-	Language      string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+func (x *CreateVariablePresetRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// CreateVariablePresetResponse is the response message for CreateVariablePreset.
+type CreateVariablePresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preset        *VariablePreset        `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTemplateRequest) Reset() {
-	*x = UpdateTemplateRequest{}
-	mi := &file_prompt_proto_msgTypes[7]
+func (x *CreateVariablePresetResponse) Reset() {
+	*x = CreateVariablePresetResponse{}
+	mi := &file_prompt_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTemplateRequest) String() string {
+func (x *CreateVariablePresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTemplateRequest) ProtoMessage() {}
+func (*CreateVariablePresetResponse) ProtoMessage() {}
 
-func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[7]
+func (x *CreateVariablePresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,98 +11694,103 @@ func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateVariablePresetResponse.ProtoReflect.Descriptor instead.
+func (*CreateVariablePresetResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *UpdateTemplateRequest) GetTemplateId() string {
+func (x *CreateVariablePresetResponse) GetPreset() *VariablePreset {
 	if x != nil {
-		return x.TemplateId
+		return x.Preset
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateTemplateRequest) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
+// UpdateVariablePresetRequest is the request message for UpdateVariablePreset.
+type UpdateVariablePresetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Left unchanged when empty.
+	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Values        map[string]string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTemplateRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *UpdateVariablePresetRequest) Reset() {
+	*x = UpdateVariablePresetRequest{}
+	mi := &file_prompt_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTemplateRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *UpdateVariablePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateTemplateRequest) GetVisibility() Visibility {
+func (*UpdateVariablePresetRequest) ProtoMessage() {}
+
+func (x *UpdateVariablePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[169]
 	if x != nil {
-		return x.Visibility
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTemplateRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use UpdateVariablePresetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariablePresetRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *UpdateTemplateRequest) GetCategory() string {
+func (x *UpdateVariablePresetRequest) GetId() string {
 	if x != nil {
-		return x.Category
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTemplateRequest) GetContent() string {
+func (x *UpdateVariablePresetRequest) GetName() string {
 	if x != nil {
-		return x.Content
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateTemplateRequest) GetLanguage() string {
+func (x *UpdateVariablePresetRequest) GetValues() map[string]string {
 	if x != nil {
-		return x.Language
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-// UpdateTemplateResponse is the response message for UpdateTemplate.
-type UpdateTemplateResponse struct {
+// UpdateVariablePresetResponse is the response message for UpdateVariablePreset.
+type UpdateVariablePresetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	NewVersion    *TemplateVersion       `protobuf:"bytes,2,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	Preset        *VariablePreset        `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTemplateResponse) Reset() {
-	*x = UpdateTemplateResponse{}
-	mi := &file_prompt_proto_msgTypes[8]
+func (x *UpdateVariablePresetResponse) Reset() {
+	*x = UpdateVariablePresetResponse{}
+	mi := &file_prompt_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTemplateResponse) String() string {
+func (x *UpdateVariablePresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTemplateResponse) ProtoMessage() {}
+func (*UpdateVariablePresetResponse) ProtoMessage() {}
 
-func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[8]
+func (x *UpdateVariablePresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,48 +11801,41 @@ func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *UpdateTemplateResponse) GetTemplate() *Template {
-	if x != nil {
-		return x.Template
-	}
-	return nil
+// Deprecated: Use UpdateVariablePresetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVariablePresetResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *UpdateTemplateResponse) GetNewVersion() *TemplateVersion {
+func (x *UpdateVariablePresetResponse) GetPreset() *VariablePreset {
 	if x != nil {
-		return x.NewVersion
+		return x.Preset
 	}
 	return nil
 }
 
-// GetTemplateRequest is the request message for GetTemplate.
-type GetTemplateRequest struct {
+// DeleteVariablePresetRequest is the request message for DeleteVariablePreset.
+type DeleteVariablePresetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTemplateRequest) Reset() {
-	*x = GetTemplateRequest{}
-	mi := &file_prompt_proto_msgTypes[9]
+func (x *DeleteVariablePresetRequest) Reset() {
+	*x = DeleteVariablePresetRequest{}
+	mi := &file_prompt_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTemplateRequest) String() string {
+func (x *DeleteVariablePresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTemplateRequest) ProtoMessage() {}
+func (*DeleteVariablePresetRequest) ProtoMessage() {}
 
-func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[9]
+func (x *DeleteVariablePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,44 +11846,41 @@ func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
-func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteVariablePresetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVariablePresetRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *GetTemplateRequest) GetId() string {
+func (x *DeleteVariablePresetRequest) GetId() string {
 	if x != nil {
 		return x.Id
-	}
-	return ""
-}
-
-// GetTemplateResponse is the response message for GetTemplate.
-type GetTemplateResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Template *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	// Optionally return the latest version or all versions.
-	// For simplicity, let's return the latest version.
-	LatestVersion *TemplateVersion `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	}
+	return ""
+}
+
+// DeleteVariablePresetResponse is the response message for DeleteVariablePreset.
+type DeleteVariablePresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTemplateResponse) Reset() {
-	*x = GetTemplateResponse{}
-	mi := &file_prompt_proto_msgTypes[10]
+func (x *DeleteVariablePresetResponse) Reset() {
+	*x = DeleteVariablePresetResponse{}
+	mi := &file_prompt_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTemplateResponse) String() string {
+func (x *DeleteVariablePresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTemplateResponse) ProtoMessage() {}
+func (*DeleteVariablePresetResponse) ProtoMessage() {}
 
-func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[10]
+func (x *DeleteVariablePresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,63 +11891,42 @@ func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
-func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetTemplateResponse) GetTemplate() *Template {
-	if x != nil {
-		return x.Template
-	}
-	return nil
+// Deprecated: Use DeleteVariablePresetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVariablePresetResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *GetTemplateResponse) GetLatestVersion() *TemplateVersion {
+func (x *DeleteVariablePresetResponse) GetSuccess() bool {
 	if x != nil {
-		return x.LatestVersion
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// ListTemplatesRequest is the request message for ListTemplates.
-type ListTemplatesRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Filter by visibility (e.g., public only, or my private ones).
-	Visibility Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=v1.Visibility" json:"visibility,omitempty"`
-	// Filter by owner.
-	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	// Filter by category.
-	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	// Filter by tags.
-	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	// Filter by user likes.
-	MyLikes bool `protobuf:"varint,7,opt,name=my_likes,json=myLikes,proto3" json:"my_likes,omitempty"`
-	// Filter by user favorites.
-	MyFavorites bool `protobuf:"varint,8,opt,name=my_favorites,json=myFavorites,proto3" json:"my_favorites,omitempty"`
-	// Filter by language.
-	Language      string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+// ListVariablePresetsRequest is the request message for ListVariablePresets.
+type ListVariablePresetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization whose presets are listed; the caller's personal presets when empty.
+	OrgId         string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTemplatesRequest) Reset() {
-	*x = ListTemplatesRequest{}
-	mi := &file_prompt_proto_msgTypes[11]
+func (x *ListVariablePresetsRequest) Reset() {
+	*x = ListVariablePresetsRequest{}
+	mi := &file_prompt_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTemplatesRequest) String() string {
+func (x *ListVariablePresetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTemplatesRequest) ProtoMessage() {}
+func (*ListVariablePresetsRequest) ProtoMessage() {}
 
-func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[11]
+func (x *ListVariablePresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,100 +11937,95 @@ func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
-func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ListTemplatesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ListVariablePresetsRequest.ProtoReflect.Descriptor instead.
+func (*ListVariablePresetsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *ListTemplatesRequest) GetPageToken() string {
+func (x *ListVariablePresetsRequest) GetOrgId() string {
 	if x != nil {
-		return x.PageToken
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *ListTemplatesRequest) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+// ListVariablePresetsResponse is the response message for ListVariablePresets.
+type ListVariablePresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []*VariablePreset      `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTemplatesRequest) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
+func (x *ListVariablePresetsResponse) Reset() {
+	*x = ListVariablePresetsResponse{}
+	mi := &file_prompt_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTemplatesRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+func (x *ListVariablePresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListTemplatesRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
+func (*ListVariablePresetsResponse) ProtoMessage() {}
 
-func (x *ListTemplatesRequest) GetMyLikes() bool {
+func (x *ListVariablePresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[174]
 	if x != nil {
-		return x.MyLikes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ListTemplatesRequest) GetMyFavorites() bool {
-	if x != nil {
-		return x.MyFavorites
-	}
-	return false
+// Deprecated: Use ListVariablePresetsResponse.ProtoReflect.Descriptor instead.
+func (*ListVariablePresetsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *ListTemplatesRequest) GetLanguage() string {
+func (x *ListVariablePresetsResponse) GetPresets() []*VariablePreset {
 	if x != nil {
-		return x.Language
+		return x.Presets
 	}
-	return ""
+	return nil
 }
 
-// ListTemplatesResponse is the response message for ListTemplates.
-type ListTemplatesResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Templates            []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`                                // Used for public templates when mixed
-	NextPageToken        string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Used for public pagination when mixed
-	PrivateTemplates     []*Template            `protobuf:"bytes,3,rep,name=private_templates,json=privateTemplates,proto3" json:"private_templates,omitempty"`
-	PrivateNextPageToken string                 `protobuf:"bytes,4,opt,name=private_next_page_token,json=privateNextPageToken,proto3" json:"private_next_page_token,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// ModelParameters selects the model that runs a prompt and how it samples.
+// Unset parameters are left to the defaults of the provider.
+type ModelParameters struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Model to run; defaults to the model configured on the server.
+	Model       string   `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Temperature *float64 `protobuf:"fixed64,2,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	TopP        *float64 `protobuf:"fixed64,3,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
+	// Maximum number of tokens to generate; 0 leaves it to the provider.
+	MaxTokens int32    `protobuf:"varint,4,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Stop      []string `protobuf:"bytes,5,rep,name=stop,proto3" json:"stop,omitempty"`
+	// Instructions sent as a system message before the prompt.
+	SystemPrompt  string `protobuf:"bytes,6,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTemplatesResponse) Reset() {
-	*x = ListTemplatesResponse{}
-	mi := &file_prompt_proto_msgTypes[12]
+func (x *ModelParameters) Reset() {
+	*x = ModelParameters{}
+	mi := &file_prompt_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTemplatesResponse) String() string {
+func (x *ModelParameters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTemplatesResponse) ProtoMessage() {}
+func (*ModelParameters) ProtoMessage() {}
 
-func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[12]
+func (x *ModelParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,63 +12036,95 @@ func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
-func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ModelParameters.ProtoReflect.Descriptor instead.
+func (*ModelParameters) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListTemplatesResponse) GetTemplates() []*Template {
+func (x *ModelParameters) GetModel() string {
 	if x != nil {
-		return x.Templates
+		return x.Model
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTemplatesResponse) GetNextPageToken() string {
+func (x *ModelParameters) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *ModelParameters) GetTopP() float64 {
+	if x != nil && x.TopP != nil {
+		return *x.TopP
+	}
+	return 0
+}
+
+func (x *ModelParameters) GetMaxTokens() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.MaxTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *ListTemplatesResponse) GetPrivateTemplates() []*Template {
+func (x *ModelParameters) GetStop() []string {
 	if x != nil {
-		return x.PrivateTemplates
+		return x.Stop
 	}
 	return nil
 }
 
-func (x *ListTemplatesResponse) GetPrivateNextPageToken() string {
+func (x *ModelParameters) GetSystemPrompt() string {
 	if x != nil {
-		return x.PrivateNextPageToken
+		return x.SystemPrompt
 	}
 	return ""
 }
 
-// DeleteTemplateRequest is the request message for DeleteTemplate.
-type DeleteTemplateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // For auth
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Execution is a run of a prompt on a language model.
+type Execution struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Saved prompt that was run, empty when the text was given directly.
+	PromptId string `protobuf:"bytes,3,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	// Provider that ran the prompt, such as openai.
+	Provider   string           `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Parameters *ModelParameters `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Text sent to the model.
+	Input string `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	// Text answered by the model.
+	Output       string          `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
+	FinishReason string          `protobuf:"bytes,8,opt,name=finish_reason,json=finishReason,proto3" json:"finish_reason,omitempty"`
+	Status       ExecutionStatus `protobuf:"varint,9,opt,name=status,proto3,enum=v1.ExecutionStatus" json:"status,omitempty"`
+	// Error of the provider when the run failed.
+	Error            string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs        int32                  `protobuf:"varint,11,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	PromptTokens     int32                  `protobuf:"varint,12,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int32                  `protobuf:"varint,13,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens      int32                  `protobuf:"varint,14,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteTemplateRequest) Reset() {
-	*x = DeleteTemplateRequest{}
-	mi := &file_prompt_proto_msgTypes[13]
+func (x *Execution) Reset() {
+	*x = Execution{}
+	mi := &file_prompt_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTemplateRequest) String() string {
+func (x *Execution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTemplateRequest) ProtoMessage() {}
+func (*Execution) ProtoMessage() {}
 
-func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[13]
+func (x *Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,139 +12135,144 @@ func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Execution.ProtoReflect.Descriptor instead.
+func (*Execution) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *DeleteTemplateRequest) GetId() string {
+func (x *Execution) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteTemplateRequest) GetOwnerId() string {
+func (x *Execution) GetOwnerId() string {
 	if x != nil {
 		return x.OwnerId
 	}
 	return ""
 }
 
-// DeleteTemplateResponse is the response message for DeleteTemplate.
-type DeleteTemplateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Execution) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
 }
 
-func (x *DeleteTemplateResponse) Reset() {
-	*x = DeleteTemplateResponse{}
-	mi := &file_prompt_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Execution) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
 }
 
-func (x *DeleteTemplateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Execution) GetParameters() *ModelParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
 }
 
-func (*DeleteTemplateResponse) ProtoMessage() {}
-
-func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[14]
+func (x *Execution) GetInput() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Input
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{14}
+func (x *Execution) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
 }
 
-func (x *DeleteTemplateResponse) GetSuccess() bool {
+func (x *Execution) GetFinishReason() string {
 	if x != nil {
-		return x.Success
+		return x.FinishReason
 	}
-	return false
+	return ""
 }
 
-// ToggleLikeRequest is the request message for ToggleLikeTemplate.
-type ToggleLikeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Execution) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
 }
 
-func (x *ToggleLikeRequest) Reset() {
-	*x = ToggleLikeRequest{}
-	mi := &file_prompt_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Execution) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ToggleLikeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Execution) GetLatencyMs() int32 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
 }
 
-func (*ToggleLikeRequest) ProtoMessage() {}
+func (x *Execution) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
 
-func (x *ToggleLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[15]
+func (x *Execution) GetCompletionTokens() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CompletionTokens
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ToggleLikeRequest.ProtoReflect.Descriptor instead.
-func (*ToggleLikeRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{15}
+func (x *Execution) GetTotalTokens() int32 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
 }
 
-func (x *ToggleLikeRequest) GetTemplateId() string {
+func (x *Execution) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TemplateId
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// ToggleLikeResponse is the response message for ToggleLikeTemplate.
-type ToggleLikeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsLiked       bool                   `protobuf:"varint,1,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	LikeCount     int32                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+// ExecutePromptRequest is the request message for ExecutePrompt.
+// Exactly one of rendered_text and prompt_id is set.
+type ExecutePromptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Text to run, such as the output of RenderPrompt.
+	RenderedText string `protobuf:"bytes,1,opt,name=rendered_text,json=renderedText,proto3" json:"rendered_text,omitempty"`
+	// Saved prompt of the caller whose rendered text to run.
+	PromptId      string           `protobuf:"bytes,2,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Parameters    *ModelParameters `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleLikeResponse) Reset() {
-	*x = ToggleLikeResponse{}
-	mi := &file_prompt_proto_msgTypes[16]
+func (x *ExecutePromptRequest) Reset() {
+	*x = ExecutePromptRequest{}
+	mi := &file_prompt_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleLikeResponse) String() string {
+func (x *ExecutePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleLikeResponse) ProtoMessage() {}
+func (*ExecutePromptRequest) ProtoMessage() {}
 
-func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[16]
+func (x *ExecutePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,48 +12283,55 @@ func (x *ToggleLikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleLikeResponse.ProtoReflect.Descriptor instead.
-func (*ToggleLikeResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExecutePromptRequest.ProtoReflect.Descriptor instead.
+func (*ExecutePromptRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *ToggleLikeResponse) GetIsLiked() bool {
+func (x *ExecutePromptRequest) GetRenderedText() string {
 	if x != nil {
-		return x.IsLiked
+		return x.RenderedText
 	}
-	return false
+	return ""
 }
 
-func (x *ToggleLikeResponse) GetLikeCount() int32 {
+func (x *ExecutePromptRequest) GetPromptId() string {
 	if x != nil {
-		return x.LikeCount
+		return x.PromptId
 	}
-	return 0
+	return ""
 }
 
-// ToggleFavoriteRequest is the request message for ToggleFavoriteTemplate.
-type ToggleFavoriteRequest struct {
+func (x *ExecutePromptRequest) GetParameters() *ModelParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// ExecutePromptResponse is the response message for ExecutePrompt.
+type ExecutePromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleFavoriteRequest) Reset() {
-	*x = ToggleFavoriteRequest{}
-	mi := &file_prompt_proto_msgTypes[17]
+func (x *ExecutePromptResponse) Reset() {
+	*x = ExecutePromptResponse{}
+	mi := &file_prompt_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleFavoriteRequest) String() string {
+func (x *ExecutePromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFavoriteRequest) ProtoMessage() {}
+func (*ExecutePromptResponse) ProtoMessage() {}
 
-func (x *ToggleFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[17]
+func (x *ExecutePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,42 +12342,44 @@ func (x *ToggleFavoriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFavoriteRequest.ProtoReflect.Descriptor instead.
-func (*ToggleFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExecutePromptResponse.ProtoReflect.Descriptor instead.
+func (*ExecutePromptResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ToggleFavoriteRequest) GetTemplateId() string {
+func (x *ExecutePromptResponse) GetExecution() *Execution {
 	if x != nil {
-		return x.TemplateId
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-// ToggleFavoriteResponse is the response message for ToggleFavoriteTemplate.
-type ToggleFavoriteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFavorited   bool                   `protobuf:"varint,1,opt,name=is_favorited,json=isFavorited,proto3" json:"is_favorited,omitempty"`
-	FavoriteCount int32                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+// ExecutePromptChunk is a message of the stream of StreamExecutePrompt.
+type ExecutePromptChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Text generated since the previous message.
+	Delta string `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The stored run, only set on the last message.
+	Execution     *Execution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleFavoriteResponse) Reset() {
-	*x = ToggleFavoriteResponse{}
-	mi := &file_prompt_proto_msgTypes[18]
+func (x *ExecutePromptChunk) Reset() {
+	*x = ExecutePromptChunk{}
+	mi := &file_prompt_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleFavoriteResponse) String() string {
+func (x *ExecutePromptChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFavoriteResponse) ProtoMessage() {}
+func (*ExecutePromptChunk) ProtoMessage() {}
 
-func (x *ToggleFavoriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[18]
+func (x *ExecutePromptChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,51 +12390,51 @@ func (x *ToggleFavoriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFavoriteResponse.ProtoReflect.Descriptor instead.
-func (*ToggleFavoriteResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExecutePromptChunk.ProtoReflect.Descriptor instead.
+func (*ExecutePromptChunk) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *ToggleFavoriteResponse) GetIsFavorited() bool {
+func (x *ExecutePromptChunk) GetDelta() string {
 	if x != nil {
-		return x.IsFavorited
+		return x.Delta
 	}
-	return false
+	return ""
 }
 
-func (x *ToggleFavoriteResponse) GetFavoriteCount() int32 {
+func (x *ExecutePromptChunk) GetExecution() *Execution {
 	if x != nil {
-		return x.FavoriteCount
+		return x.Execution
 	}
-	return 0
+	return nil
 }
 
-// CreatePromptRequest is the request message for CreatePrompt.
-type CreatePromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	VersionId     int32                  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Variables     []string               `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+// ListExecutionsRequest is the request message for ListExecutions.
+type ListExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filter on the saved prompt that was run.
+	PromptId      string `protobuf:"bytes,3,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromptRequest) Reset() {
-	*x = CreatePromptRequest{}
-	mi := &file_prompt_proto_msgTypes[19]
+func (x *ListExecutionsRequest) Reset() {
+	*x = ListExecutionsRequest{}
+	mi := &file_prompt_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromptRequest) String() string {
+func (x *ListExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromptRequest) ProtoMessage() {}
+func (*ListExecutionsRequest) ProtoMessage() {}
 
-func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[19]
+func (x *ListExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,62 +12445,56 @@ func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
-func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *CreatePromptRequest) GetTemplateId() string {
-	if x != nil {
-		return x.TemplateId
-	}
-	return ""
+// Deprecated: Use ListExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *CreatePromptRequest) GetVersionId() int32 {
+func (x *ListExecutionsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.VersionId
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *CreatePromptRequest) GetOwnerId() string {
+func (x *ListExecutionsRequest) GetPageToken() string {
 	if x != nil {
-		return x.OwnerId
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *CreatePromptRequest) GetVariables() []string {
+func (x *ListExecutionsRequest) GetPromptId() string {
 	if x != nil {
-		return x.Variables
+		return x.PromptId
 	}
-	return nil
+	return ""
 }
 
-// CreatePromptResponse is the response message for CreatePrompt.
-type CreatePromptResponse struct {
+// ListExecutionsResponse is the response message for ListExecutions.
+type ListExecutionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Executions    []*Execution           `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromptResponse) Reset() {
-	*x = CreatePromptResponse{}
-	mi := &file_prompt_proto_msgTypes[20]
+func (x *ListExecutionsResponse) Reset() {
+	*x = ListExecutionsResponse{}
+	mi := &file_prompt_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromptResponse) String() string {
+func (x *ListExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromptResponse) ProtoMessage() {}
+func (*ListExecutionsResponse) ProtoMessage() {}
 
-func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[20]
+func (x *ListExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,41 +12505,50 @@ func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
-func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *CreatePromptResponse) GetPrompt() *Prompt {
+func (x *ListExecutionsResponse) GetExecutions() []*Execution {
 	if x != nil {
-		return x.Prompt
+		return x.Executions
 	}
 	return nil
 }
 
-// GetPromptRequest is the request message for GetPrompt.
-type GetPromptRequest struct {
+func (x *ListExecutionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Assertion is a check of the output of a test case.
+type Assertion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          AssertionType          `protobuf:"varint,1,opt,name=type,proto3,enum=v1.AssertionType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MaxLength     int32                  `protobuf:"varint,3,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPromptRequest) Reset() {
-	*x = GetPromptRequest{}
-	mi := &file_prompt_proto_msgTypes[21]
+func (x *Assertion) Reset() {
+	*x = Assertion{}
+	mi := &file_prompt_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPromptRequest) String() string {
+func (x *Assertion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPromptRequest) ProtoMessage() {}
+func (*Assertion) ProtoMessage() {}
 
-func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[21]
+func (x *Assertion) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,90 +12559,66 @@ func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
-func (*GetPromptRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
+func (*Assertion) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *GetPromptRequest) GetId() string {
+func (x *Assertion) GetType() AssertionType {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return ""
-}
-
-// GetPromptResponse is the response message for GetPrompt.
-type GetPromptResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetPromptResponse) Reset() {
-	*x = GetPromptResponse{}
-	mi := &file_prompt_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetPromptResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return AssertionType_ASSERTION_TYPE_UNSPECIFIED
 }
 
-func (*GetPromptResponse) ProtoMessage() {}
-
-func (x *GetPromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[22]
+func (x *Assertion) GetValue() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Value
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPromptResponse.ProtoReflect.Descriptor instead.
-func (*GetPromptResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{22}
+	return ""
 }
 
-func (x *GetPromptResponse) GetPrompt() *Prompt {
+func (x *Assertion) GetMaxLength() int32 {
 	if x != nil {
-		return x.Prompt
+		return x.MaxLength
 	}
-	return nil
-}
-
-// ListPromptsRequest is the request message for ListPrompts.
-type ListPromptsRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OwnerId   string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	// Filter by template_id.
-	TemplateId    string `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *ListPromptsRequest) Reset() {
-	*x = ListPromptsRequest{}
-	mi := &file_prompt_proto_msgTypes[23]
+// EvaluationCase is a test case of a template: the values filling its
+// placeholders and the assertions its outputs must pass.
+type EvaluationCase struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateId string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Values of the $$ placeholders, in order.
+	Variables []string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Values of the named {{placeholders}}.
+	NamedVariables map[string]string      `protobuf:"bytes,5,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Assertions     []*Assertion           `protobuf:"bytes,6,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvaluationCase) Reset() {
+	*x = EvaluationCase{}
+	mi := &file_prompt_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPromptsRequest) String() string {
+func (x *EvaluationCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromptsRequest) ProtoMessage() {}
+func (*EvaluationCase) ProtoMessage() {}
 
-func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[23]
+func (x *EvaluationCase) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,116 +12629,100 @@ func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
-func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use EvaluationCase.ProtoReflect.Descriptor instead.
+func (*EvaluationCase) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *ListPromptsRequest) GetPageSize() int32 {
+func (x *EvaluationCase) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPromptsRequest) GetPageToken() string {
+func (x *EvaluationCase) GetTemplateId() string {
 	if x != nil {
-		return x.PageToken
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *ListPromptsRequest) GetOwnerId() string {
+func (x *EvaluationCase) GetName() string {
 	if x != nil {
-		return x.OwnerId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListPromptsRequest) GetTemplateId() string {
+func (x *EvaluationCase) GetVariables() []string {
 	if x != nil {
-		return x.TemplateId
+		return x.Variables
 	}
-	return ""
-}
-
-// ListPromptsResponse is the response message for ListPrompts.
-type ListPromptsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListPromptsResponse) Reset() {
-	*x = ListPromptsResponse{}
-	mi := &file_prompt_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *ListPromptsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EvaluationCase) GetNamedVariables() map[string]string {
+	if x != nil {
+		return x.NamedVariables
+	}
+	return nil
 }
 
-func (*ListPromptsResponse) ProtoMessage() {}
-
-func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[24]
+func (x *EvaluationCase) GetAssertions() []*Assertion {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Assertions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
-func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{24}
+func (x *EvaluationCase) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *ListPromptsResponse) GetPrompts() []*Prompt {
+func (x *EvaluationCase) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Prompts
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ListPromptsResponse) GetNextPageToken() string {
+func (x *EvaluationCase) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextPageToken
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// DeletePromptRequest is the request message for DeletePrompt.
-type DeletePromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // For auth
+// AssertionResult is the outcome of an assertion for the output of a case.
+type AssertionResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Assertion *Assertion             `protobuf:"bytes,1,opt,name=assertion,proto3" json:"assertion,omitempty"`
+	Passed    bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Why the assertion failed, or what the judge answered.
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePromptRequest) Reset() {
-	*x = DeletePromptRequest{}
-	mi := &file_prompt_proto_msgTypes[25]
+func (x *AssertionResult) Reset() {
+	*x = AssertionResult{}
+	mi := &file_prompt_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromptRequest) String() string {
+func (x *AssertionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromptRequest) ProtoMessage() {}
+func (*AssertionResult) ProtoMessage() {}
 
-func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[25]
+func (x *AssertionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,48 +12733,66 @@ func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
-func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AssertionResult.ProtoReflect.Descriptor instead.
+func (*AssertionResult) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *DeletePromptRequest) GetId() string {
+func (x *AssertionResult) GetAssertion() *Assertion {
 	if x != nil {
-		return x.Id
+		return x.Assertion
 	}
-	return ""
+	return nil
 }
 
-func (x *DeletePromptRequest) GetOwnerId() string {
+func (x *AssertionResult) GetPassed() bool {
 	if x != nil {
-		return x.OwnerId
+		return x.Passed
+	}
+	return false
+}
+
+func (x *AssertionResult) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-// DeletePromptResponse is the response message for DeletePrompt.
-type DeletePromptResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+// EvaluationResult is the outcome of a test case in a run.
+type EvaluationResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CaseId   string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	CaseName string                 `protobuf:"bytes,2,opt,name=case_name,json=caseName,proto3" json:"case_name,omitempty"`
+	// Text sent to the model.
+	Input string `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// Text answered by the model.
+	Output string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	// Whether the model answered and every assertion passed.
+	Passed     bool               `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
+	Assertions []*AssertionResult `protobuf:"bytes,6,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	// Error of the provider, or of rendering the case, when the case could not run.
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs     int32  `protobuf:"varint,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePromptResponse) Reset() {
-	*x = DeletePromptResponse{}
-	mi := &file_prompt_proto_msgTypes[26]
+func (x *EvaluationResult) Reset() {
+	*x = EvaluationResult{}
+	mi := &file_prompt_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromptResponse) String() string {
+func (x *EvaluationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromptResponse) ProtoMessage() {}
+func (*EvaluationResult) ProtoMessage() {}
 
-func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[26]
+func (x *EvaluationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,127 +12803,100 @@ func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromptResponse.ProtoReflect.Descriptor instead.
-func (*DeletePromptResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EvaluationResult.ProtoReflect.Descriptor instead.
+func (*EvaluationResult) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *DeletePromptResponse) GetSuccess() bool {
+func (x *EvaluationResult) GetCaseId() string {
 	if x != nil {
-		return x.Success
+		return x.CaseId
 	}
-	return false
-}
-
-// RegisterRequest is the request message for Register.
-type RegisterRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Mobile           string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Password         string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	DisplayName      string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	VerificationCode string                 `protobuf:"bytes,6,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
-	mi := &file_prompt_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RegisterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*RegisterRequest) ProtoMessage() {}
-
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[27]
+func (x *EvaluationResult) GetCaseName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CaseName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{27}
+	return ""
 }
 
-func (x *RegisterRequest) GetId() string {
+func (x *EvaluationResult) GetInput() string {
 	if x != nil {
-		return x.Id
+		return x.Input
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetEmail() string {
+func (x *EvaluationResult) GetOutput() string {
 	if x != nil {
-		return x.Email
+		return x.Output
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetMobile() string {
+func (x *EvaluationResult) GetPassed() bool {
 	if x != nil {
-		return x.Mobile
+		return x.Passed
 	}
-	return ""
+	return false
 }
 
-func (x *RegisterRequest) GetPassword() string {
+func (x *EvaluationResult) GetAssertions() []*AssertionResult {
 	if x != nil {
-		return x.Password
+		return x.Assertions
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterRequest) GetDisplayName() string {
+func (x *EvaluationResult) GetError() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Error
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetVerificationCode() string {
+func (x *EvaluationResult) GetLatencyMs() int32 {
 	if x != nil {
-		return x.VerificationCode
+		return x.LatencyMs
 	}
-	return ""
+	return 0
 }
 
-// RegisterResponse is the response message for Register.
-type RegisterResponse struct {
+// EvaluationRun is a run of the test cases of a template on one of its versions.
+type EvaluationRun struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // JWT token
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	VersionId     int32                  `protobuf:"varint,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Provider      string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model         string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	Passed        int32                  `protobuf:"varint,8,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed        int32                  `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*EvaluationResult    `protobuf:"bytes,10,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
-	mi := &file_prompt_proto_msgTypes[28]
+func (x *EvaluationRun) Reset() {
+	*x = EvaluationRun{}
+	mi := &file_prompt_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResponse) String() string {
+func (x *EvaluationRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*EvaluationRun) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[28]
+func (x *EvaluationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,104 +12907,115 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use EvaluationRun.ProtoReflect.Descriptor instead.
+func (*EvaluationRun) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *RegisterResponse) GetId() string {
+func (x *EvaluationRun) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RegisterResponse) GetToken() string {
+func (x *EvaluationRun) GetTemplateId() string {
 	if x != nil {
-		return x.Token
+		return x.TemplateId
 	}
 	return ""
 }
 
-// LoginRequest is the request message for Login.
-type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // Or mobile/id
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EvaluationRun) GetVersionId() int32 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_prompt_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EvaluationRun) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *LoginRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EvaluationRun) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (x *EvaluationRun) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[29]
+func (x *EvaluationRun) GetModel() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Model
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{29}
+func (x *EvaluationRun) GetPassed() int32 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
 }
 
-func (x *LoginRequest) GetEmail() string {
+func (x *EvaluationRun) GetFailed() int32 {
 	if x != nil {
-		return x.Email
+		return x.Failed
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *EvaluationRun) GetResults() []*EvaluationResult {
 	if x != nil {
-		return x.Password
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-// LoginResponse is the response message for Login.
-type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EvaluationRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_prompt_proto_msgTypes[30]
+// CreateEvaluationCaseRequest is the request message for CreateEvaluationCase.
+type CreateEvaluationCaseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId     string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variables      []string               `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	NamedVariables map[string]string      `protobuf:"bytes,4,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Assertions     []*Assertion           `protobuf:"bytes,5,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateEvaluationCaseRequest) Reset() {
+	*x = CreateEvaluationCaseRequest{}
+	mi := &file_prompt_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *CreateEvaluationCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*CreateEvaluationCaseRequest) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[30]
+func (x *CreateEvaluationCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,63 +13026,69 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CreateEvaluationCaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateEvaluationCaseRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *LoginResponse) GetId() string {
+func (x *CreateEvaluationCaseRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Id
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *LoginResponse) GetToken() string {
+func (x *CreateEvaluationCaseRequest) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LoginResponse) GetDisplayName() string {
+func (x *CreateEvaluationCaseRequest) GetVariables() []string {
 	if x != nil {
-		return x.DisplayName
+		return x.Variables
 	}
-	return ""
+	return nil
 }
 
-func (x *LoginResponse) GetAvatar() string {
+func (x *CreateEvaluationCaseRequest) GetNamedVariables() map[string]string {
 	if x != nil {
-		return x.Avatar
+		return x.NamedVariables
 	}
-	return ""
+	return nil
 }
 
-// LoginWithOAuthRequest is the request message for LoginWithOAuth.
-type LoginWithOAuthRequest struct {
+func (x *CreateEvaluationCaseRequest) GetAssertions() []*Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+// CreateEvaluationCaseResponse is the response message for CreateEvaluationCase.
+type CreateEvaluationCaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Case          *EvaluationCase        `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginWithOAuthRequest) Reset() {
-	*x = LoginWithOAuthRequest{}
-	mi := &file_prompt_proto_msgTypes[31]
+func (x *CreateEvaluationCaseResponse) Reset() {
+	*x = CreateEvaluationCaseResponse{}
+	mi := &file_prompt_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginWithOAuthRequest) String() string {
+func (x *CreateEvaluationCaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginWithOAuthRequest) ProtoMessage() {}
+func (*CreateEvaluationCaseResponse) ProtoMessage() {}
 
-func (x *LoginWithOAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[31]
+func (x *CreateEvaluationCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,48 +13099,46 @@ func (x *LoginWithOAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginWithOAuthRequest.ProtoReflect.Descriptor instead.
-func (*LoginWithOAuthRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *LoginWithOAuthRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+// Deprecated: Use CreateEvaluationCaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateEvaluationCaseResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *LoginWithOAuthRequest) GetCode() string {
+func (x *CreateEvaluationCaseResponse) GetCase() *EvaluationCase {
 	if x != nil {
-		return x.Code
+		return x.Case
 	}
-	return ""
-}
-
-type SendVerificationCodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // "en" or "zh"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *SendVerificationCodeRequest) Reset() {
-	*x = SendVerificationCodeRequest{}
-	mi := &file_prompt_proto_msgTypes[32]
+// UpdateEvaluationCaseRequest is the request message for UpdateEvaluationCase.
+type UpdateEvaluationCaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name; unchanged when empty.
+	Name           string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variables      []string          `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	NamedVariables map[string]string `protobuf:"bytes,4,rep,name=named_variables,json=namedVariables,proto3" json:"named_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Assertions     []*Assertion      `protobuf:"bytes,5,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateEvaluationCaseRequest) Reset() {
+	*x = UpdateEvaluationCaseRequest{}
+	mi := &file_prompt_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendVerificationCodeRequest) String() string {
+func (x *UpdateEvaluationCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendVerificationCodeRequest) ProtoMessage() {}
+func (*UpdateEvaluationCaseRequest) ProtoMessage() {}
 
-func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[32]
+func (x *UpdateEvaluationCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,47 +13149,69 @@ func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
-func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UpdateEvaluationCaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEvaluationCaseRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *SendVerificationCodeRequest) GetEmail() string {
+func (x *UpdateEvaluationCaseRequest) GetId() string {
 	if x != nil {
-		return x.Email
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SendVerificationCodeRequest) GetLanguage() string {
+func (x *UpdateEvaluationCaseRequest) GetName() string {
 	if x != nil {
-		return x.Language
+		return x.Name
 	}
 	return ""
 }
 
-type SendVerificationCodeResponse struct {
+func (x *UpdateEvaluationCaseRequest) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *UpdateEvaluationCaseRequest) GetNamedVariables() map[string]string {
+	if x != nil {
+		return x.NamedVariables
+	}
+	return nil
+}
+
+func (x *UpdateEvaluationCaseRequest) GetAssertions() []*Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+// UpdateEvaluationCaseResponse is the response message for UpdateEvaluationCase.
+type UpdateEvaluationCaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Case          *EvaluationCase        `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendVerificationCodeResponse) Reset() {
-	*x = SendVerificationCodeResponse{}
-	mi := &file_prompt_proto_msgTypes[33]
+func (x *UpdateEvaluationCaseResponse) Reset() {
+	*x = UpdateEvaluationCaseResponse{}
+	mi := &file_prompt_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendVerificationCodeResponse) String() string {
+func (x *UpdateEvaluationCaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendVerificationCodeResponse) ProtoMessage() {}
+func (*UpdateEvaluationCaseResponse) ProtoMessage() {}
 
-func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[33]
+func (x *UpdateEvaluationCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,42 +13222,41 @@ func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
-func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UpdateEvaluationCaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEvaluationCaseResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *SendVerificationCodeResponse) GetSuccess() bool {
+func (x *UpdateEvaluationCaseResponse) GetCase() *EvaluationCase {
 	if x != nil {
-		return x.Success
+		return x.Case
 	}
-	return false
+	return nil
 }
 
-// ListCategoriesRequest is the request message for ListCategories.
-type ListCategoriesRequest struct {
+// DeleteEvaluationCaseRequest is the request message for DeleteEvaluationCase.
+type DeleteEvaluationCaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_prompt_proto_msgTypes[34]
+func (x *DeleteEvaluationCaseRequest) Reset() {
+	*x = DeleteEvaluationCaseRequest{}
+	mi := &file_prompt_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *DeleteEvaluationCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*DeleteEvaluationCaseRequest) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[34]
+func (x *DeleteEvaluationCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,49 +13267,41 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *ListCategoriesRequest) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
+// Deprecated: Use DeleteEvaluationCaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEvaluationCaseRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *ListCategoriesRequest) GetLanguage() string {
+func (x *DeleteEvaluationCaseRequest) GetId() string {
 	if x != nil {
-		return x.Language
+		return x.Id
 	}
 	return ""
 }
 
-// CategoryStats represents a category and its usage count.
-type CategoryStats struct {
+// DeleteEvaluationCaseResponse is the response message for DeleteEvaluationCase.
+type DeleteEvaluationCaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryStats) Reset() {
-	*x = CategoryStats{}
-	mi := &file_prompt_proto_msgTypes[35]
+func (x *DeleteEvaluationCaseResponse) Reset() {
+	*x = DeleteEvaluationCaseResponse{}
+	mi := &file_prompt_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryStats) String() string {
+func (x *DeleteEvaluationCaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryStats) ProtoMessage() {}
+func (*DeleteEvaluationCaseResponse) ProtoMessage() {}
 
-func (x *CategoryStats) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[35]
+func (x *DeleteEvaluationCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,48 +13312,41 @@ func (x *CategoryStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryStats.ProtoReflect.Descriptor instead.
-func (*CategoryStats) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *CategoryStats) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use DeleteEvaluationCaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEvaluationCaseResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *CategoryStats) GetCount() int32 {
+func (x *DeleteEvaluationCaseResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Count
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// ListCategoriesResponse is the response message for ListCategories.
-type ListCategoriesResponse struct {
+// ListEvaluationCasesRequest is the request message for ListEvaluationCases.
+type ListEvaluationCasesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*CategoryStats       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_prompt_proto_msgTypes[36]
+func (x *ListEvaluationCasesRequest) Reset() {
+	*x = ListEvaluationCasesRequest{}
+	mi := &file_prompt_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *ListEvaluationCasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*ListEvaluationCasesRequest) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[36]
+func (x *ListEvaluationCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,41 +13357,41 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListEvaluationCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationCasesRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*CategoryStats {
+func (x *ListEvaluationCasesRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Categories
+		return x.TemplateId
 	}
-	return nil
+	return ""
 }
 
-// ListTagsRequest is the request message for ListTags.
-type ListTagsRequest struct {
+// ListEvaluationCasesResponse is the response message for ListEvaluationCases.
+type ListEvaluationCasesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Cases         []*EvaluationCase      `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsRequest) Reset() {
-	*x = ListTagsRequest{}
-	mi := &file_prompt_proto_msgTypes[37]
+func (x *ListEvaluationCasesResponse) Reset() {
+	*x = ListEvaluationCasesResponse{}
+	mi := &file_prompt_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsRequest) String() string {
+func (x *ListEvaluationCasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsRequest) ProtoMessage() {}
+func (*ListEvaluationCasesResponse) ProtoMessage() {}
 
-func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[37]
+func (x *ListEvaluationCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,42 +13402,48 @@ func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
-func (*ListTagsRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListEvaluationCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationCasesResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *ListTagsRequest) GetLanguage() string {
+func (x *ListEvaluationCasesResponse) GetCases() []*EvaluationCase {
 	if x != nil {
-		return x.Language
+		return x.Cases
 	}
-	return ""
+	return nil
 }
 
-// TagStats represents a tag and its usage count.
-type TagStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+// RunEvaluationRequest is the request message for RunEvaluation.
+type RunEvaluationRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version to run; the latest when 0 and all_versions is not set.
+	VersionId int32 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Runs every version of the template, oldest first. The cases run on all
+	// versions are limited to 200.
+	AllVersions bool `protobuf:"varint,3,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`
+	// Model the cases run on. Judge assertions run on the same model.
+	Parameters    *ModelParameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagStats) Reset() {
-	*x = TagStats{}
-	mi := &file_prompt_proto_msgTypes[38]
+func (x *RunEvaluationRequest) Reset() {
+	*x = RunEvaluationRequest{}
+	mi := &file_prompt_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagStats) String() string {
+func (x *RunEvaluationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagStats) ProtoMessage() {}
+func (*RunEvaluationRequest) ProtoMessage() {}
 
-func (x *TagStats) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[38]
+func (x *RunEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2561,48 +13454,63 @@ func (x *TagStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagStats.ProtoReflect.Descriptor instead.
-func (*TagStats) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RunEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*RunEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *TagStats) GetName() string {
+func (x *RunEvaluationRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Name
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *TagStats) GetCount() int32 {
+func (x *RunEvaluationRequest) GetVersionId() int32 {
 	if x != nil {
-		return x.Count
+		return x.VersionId
 	}
 	return 0
 }
 
-// ListTagsResponse is the response message for ListTags.
-type ListTagsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*TagStats            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+func (x *RunEvaluationRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+func (x *RunEvaluationRequest) GetParameters() *ModelParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// RunEvaluationResponse is the response message for RunEvaluation.
+type RunEvaluationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One run per version.
+	Runs          []*EvaluationRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsResponse) Reset() {
-	*x = ListTagsResponse{}
-	mi := &file_prompt_proto_msgTypes[39]
+func (x *RunEvaluationResponse) Reset() {
+	*x = RunEvaluationResponse{}
+	mi := &file_prompt_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsResponse) String() string {
+func (x *RunEvaluationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsResponse) ProtoMessage() {}
+func (*RunEvaluationResponse) ProtoMessage() {}
 
-func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[39]
+func (x *RunEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,44 +13521,45 @@ func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
-func (*ListTagsResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RunEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*RunEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *ListTagsResponse) GetTags() []*TagStats {
+func (x *RunEvaluationResponse) GetRuns() []*EvaluationRun {
 	if x != nil {
-		return x.Tags
+		return x.Runs
 	}
 	return nil
 }
 
-// UpdateProfileRequest is the request message for UpdateProfile.
-type UpdateProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+// ListEvaluationRunsRequest is the request message for ListEvaluationRuns.
+type ListEvaluationRunsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Optional filter on the version that was run.
+	VersionId     int32  `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
-	mi := &file_prompt_proto_msgTypes[40]
+func (x *ListEvaluationRunsRequest) Reset() {
+	*x = ListEvaluationRunsRequest{}
+	mi := &file_prompt_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *ListEvaluationRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*ListEvaluationRunsRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[40]
+func (x *ListEvaluationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2661,64 +13570,119 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListEvaluationRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *UpdateProfileRequest) GetId() string {
+func (x *ListEvaluationRunsRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Id
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetDisplayName() string {
+func (x *ListEvaluationRunsRequest) GetVersionId() int32 {
 	if x != nil {
-		return x.DisplayName
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *ListEvaluationRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEvaluationRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetAvatar() string {
+// ListEvaluationRunsResponse is the response message for ListEvaluationRuns.
+type ListEvaluationRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*EvaluationRun       `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationRunsResponse) Reset() {
+	*x = ListEvaluationRunsResponse{}
+	mi := &file_prompt_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationRunsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[198]
 	if x != nil {
-		return x.Avatar
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *ListEvaluationRunsResponse) GetRuns() []*EvaluationRun {
+	if x != nil {
+		return x.Runs
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProfileRequest) GetPassword() string {
+func (x *ListEvaluationRunsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Password
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// UpdateProfileResponse is the response message for UpdateProfile.
-type UpdateProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+// CompareEvaluationRunsRequest is the request message for CompareEvaluationRuns.
+type CompareEvaluationRunsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version compared against, such as version N.
+	BaseVersionId int32 `protobuf:"varint,2,opt,name=base_version_id,json=baseVersionId,proto3" json:"base_version_id,omitempty"`
+	// Version compared, such as version N+1.
+	HeadVersionId int32 `protobuf:"varint,3,opt,name=head_version_id,json=headVersionId,proto3" json:"head_version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
-	mi := &file_prompt_proto_msgTypes[41]
+func (x *CompareEvaluationRunsRequest) Reset() {
+	*x = CompareEvaluationRunsRequest{}
+	mi := &file_prompt_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *CompareEvaluationRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*CompareEvaluationRunsRequest) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[41]
+func (x *CompareEvaluationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,55 +13693,61 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CompareEvaluationRunsRequest.ProtoReflect.Descriptor instead.
+func (*CompareEvaluationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *UpdateProfileResponse) GetId() string {
+func (x *CompareEvaluationRunsRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Id
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *UpdateProfileResponse) GetDisplayName() string {
+func (x *CompareEvaluationRunsRequest) GetBaseVersionId() int32 {
 	if x != nil {
-		return x.DisplayName
+		return x.BaseVersionId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateProfileResponse) GetAvatar() string {
+func (x *CompareEvaluationRunsRequest) GetHeadVersionId() int32 {
 	if x != nil {
-		return x.Avatar
+		return x.HeadVersionId
 	}
-	return ""
+	return 0
 }
 
-// GetProfileRequest is the request message for GetProfile.
-type GetProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// EvaluationComparison is the outcome of a case on both versions.
+type EvaluationComparison struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CaseId   string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	CaseName string                 `protobuf:"bytes,2,opt,name=case_name,json=caseName,proto3" json:"case_name,omitempty"`
+	// Result on the base version, unset when the case was not run on it.
+	Base *EvaluationResult `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Result on the head version, unset when the case was not run on it.
+	Head          *EvaluationResult `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+	Change        EvaluationChange  `protobuf:"varint,5,opt,name=change,proto3,enum=v1.EvaluationChange" json:"change,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
-	mi := &file_prompt_proto_msgTypes[42]
+func (x *EvaluationComparison) Reset() {
+	*x = EvaluationComparison{}
+	mi := &file_prompt_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *EvaluationComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*EvaluationComparison) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[42]
+func (x *EvaluationComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,44 +13758,72 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use EvaluationComparison.ProtoReflect.Descriptor instead.
+func (*EvaluationComparison) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *GetProfileRequest) GetId() string {
+func (x *EvaluationComparison) GetCaseId() string {
 	if x != nil {
-		return x.Id
+		return x.CaseId
 	}
 	return ""
 }
 
-// GetProfileResponse is the response message for GetProfile.
-type GetProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+func (x *EvaluationComparison) GetCaseName() string {
+	if x != nil {
+		return x.CaseName
+	}
+	return ""
+}
+
+func (x *EvaluationComparison) GetBase() *EvaluationResult {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EvaluationComparison) GetHead() *EvaluationResult {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+func (x *EvaluationComparison) GetChange() EvaluationChange {
+	if x != nil {
+		return x.Change
+	}
+	return EvaluationChange_EVALUATION_CHANGE_UNSPECIFIED
+}
+
+// CompareEvaluationRunsResponse is the response message for CompareEvaluationRuns.
+type CompareEvaluationRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latest runs of the base and head versions, without their results.
+	Base          *EvaluationRun          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Head          *EvaluationRun          `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Cases         []*EvaluationComparison `protobuf:"bytes,3,rep,name=cases,proto3" json:"cases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileResponse) Reset() {
-	*x = GetProfileResponse{}
-	mi := &file_prompt_proto_msgTypes[43]
+func (x *CompareEvaluationRunsResponse) Reset() {
+	*x = CompareEvaluationRunsResponse{}
+	mi := &file_prompt_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileResponse) String() string {
+func (x *CompareEvaluationRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileResponse) ProtoMessage() {}
+func (*CompareEvaluationRunsResponse) ProtoMessage() {}
 
-func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_prompt_proto_msgTypes[43]
+func (x *CompareEvaluationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prompt_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2836,44 +13834,37 @@ func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileResponse) Descriptor() ([]byte, []int) {
-	return file_prompt_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *GetProfileResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CompareEvaluationRunsResponse.ProtoReflect.Descriptor instead.
+func (*CompareEvaluationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_prompt_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *GetProfileResponse) GetEmail() string {
+func (x *CompareEvaluationRunsResponse) GetBase() *EvaluationRun {
 	if x != nil {
-		return x.Email
+		return x.Base
 	}
-	return ""
+	return nil
 }
 
-func (x *GetProfileResponse) GetDisplayName() string {
+func (x *CompareEvaluationRunsResponse) GetHead() *EvaluationRun {
 	if x != nil {
-		return x.DisplayName
+		return x.Head
 	}
-	return ""
+	return nil
 }
 
-func (x *GetProfileResponse) GetAvatar() string {
+func (x *CompareEvaluationRunsResponse) GetCases() []*EvaluationComparison {
 	if x != nil {
-		return x.Avatar
+		return x.Cases
 	}
-	return ""
+	return nil
 }
 
 var File_prompt_proto protoreflect.FileDescriptor
 
 const file_prompt_proto_rawDesc = "" +
 	"\n" +
-	"\fprompt.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x04\n" +
+	"\fprompt.proto\x12\x02v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\a\n" +
 	"\bTemplate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
@@ -2896,7 +13887,17 @@ const file_prompt_proto_rawDesc = "" +
 	"\x0elatest_version\x18\r \x01(\v2\x13.v1.TemplateVersionR\rlatestVersion\x12\x19\n" +
 	"\bis_liked\x18\x0e \x01(\bR\aisLiked\x12!\n" +
 	"\fis_favorited\x18\x0f \x01(\bR\visFavorited\x12\x1a\n" +
-	"\blanguage\x18\x10 \x01(\tR\blanguage\"\xb1\x01\n" +
+	"\blanguage\x18\x10 \x01(\tR\blanguage\x12%\n" +
+	"\x0etrending_score\x18\x11 \x01(\x01R\rtrendingScore\x12\x1f\n" +
+	"\vforked_from\x18\x12 \x01(\tR\n" +
+	"forkedFrom\x12\x15\n" +
+	"\x06org_id\x18\x13 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bfeatured\x18\x14 \x01(\bR\bfeatured\x12>\n" +
+	"\x10moderation_state\x18\x15 \x01(\x0e2\x13.v1.ModerationStateR\x0fmoderationState\x12#\n" +
+	"\rcomment_count\x18\x16 \x01(\x05R\fcommentCount\x12!\n" +
+	"\frating_count\x18\x17 \x01(\x05R\vratingCount\x12%\n" +
+	"\x0erating_average\x18\x18 \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_score\x18\x19 \x01(\x01R\vratingScore\"\xfc\x01\n" +
 	"\x0fTemplateVersion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\tR\n" +
@@ -2904,16 +13905,21 @@ const file_prompt_proto_rawDesc = "" +
 	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"z\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05major\x18\x06 \x01(\bR\x05major\x123\n" +
+	"\x15overridable_variables\x18\a \x03(\tR\x14overridableVariables\"\xaa\x01\n" +
 	"\x1bListTemplateVersionsRequest\x12\x1f\n" +
 	"\vtemplate_id\x18\x01 \x01(\tR\n" +
 	"templateId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCount\"\x98\x01\n" +
 	"\x1cListTemplateVersionsResponse\x12/\n" +
 	"\bversions\x18\x01 \x03(\v2\x13.v1.TemplateVersionR\bversions\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xcc\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xd4\x04\n" +
 	"\x06Prompt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vtemplate_id\x18\x02 \x01(\tR\n" +
@@ -2923,7 +13929,28 @@ const file_prompt_proto_rawDesc = "" +
 	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1c\n" +
 	"\tvariables\x18\x05 \x03(\tR\tvariables\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa6\x02\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0etemplate_title\x18\a \x01(\tR\rtemplateTitle\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x16\n" +
+	"\x06pinned\x18\f \x01(\bR\x06pinned\x12#\n" +
+	"\rrendered_text\x18\r \x01(\tR\frenderedText\x12<\n" +
+	"\flast_used_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12G\n" +
+	"\x0fnamed_variables\x18\x0f \x03(\v2\x1e.v1.Prompt.NamedVariablesEntryR\x0enamedVariables\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\x02\n" +
+	"\x10PlaceholderDrift\x12)\n" +
+	"\x10previous_version\x18\x01 \x01(\x05R\x0fpreviousVersion\x12%\n" +
+	"\x0elatest_version\x18\x02 \x01(\x05R\rlatestVersion\x123\n" +
+	"\x15previous_placeholders\x18\x03 \x01(\x05R\x14previousPlaceholders\x12/\n" +
+	"\x13latest_placeholders\x18\x04 \x01(\x05R\x12latestPlaceholders\x12+\n" +
+	"\x11dropped_variables\x18\x05 \x03(\tR\x10droppedVariables\x123\n" +
+	"\x15unfilled_placeholders\x18\x06 \x01(\x05R\x14unfilledPlaceholders\"\xf2\x02\n" +
 	"\x15CreateTemplateRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2935,10 +13962,13 @@ const file_prompt_proto_rawDesc = "" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1a\n" +
 	"\bcategory\x18\a \x01(\tR\bcategory\x12\x18\n" +
 	"\acontent\x18\b \x01(\tR\acontent\x12\x1a\n" +
-	"\blanguage\x18\t \x01(\tR\blanguage\"q\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12\x15\n" +
+	"\x06org_id\x18\n" +
+	" \x01(\tR\x05orgId\x123\n" +
+	"\x15overridable_variables\x18\v \x03(\tR\x14overridableVariables\"q\n" +
 	"\x16CreateTemplateResponse\x12(\n" +
 	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\x12-\n" +
-	"\aversion\x18\x02 \x01(\v2\x13.v1.TemplateVersionR\aversion\"\xa1\x02\n" +
+	"\aversion\x18\x02 \x01(\v2\x13.v1.TemplateVersionR\aversion\"\xff\x02\n" +
 	"\x15UpdateTemplateRequest\x12\x1f\n" +
 	"\vtemplate_id\x18\x01 \x01(\tR\n" +
 	"templateId\x12\x19\n" +
@@ -2951,16 +13981,23 @@ const file_prompt_proto_rawDesc = "" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1a\n" +
 	"\bcategory\x18\a \x01(\tR\bcategory\x12\x18\n" +
 	"\acontent\x18\b \x01(\tR\acontent\x12\x1a\n" +
-	"\blanguage\x18\t \x01(\tR\blanguage\"x\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12\x14\n" +
+	"\x05major\x18\n" +
+	" \x01(\bR\x05major\x12F\n" +
+	"\x15overridable_variables\x18\v \x01(\v2\x11.v1.VariableNamesR\x14overridableVariables\"%\n" +
+	"\rVariableNames\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"x\n" +
 	"\x16UpdateTemplateResponse\x12(\n" +
 	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\x124\n" +
 	"\vnew_version\x18\x02 \x01(\v2\x13.v1.TemplateVersionR\n" +
-	"newVersion\"$\n" +
+	"newVersion\"E\n" +
 	"\x12GetTemplateRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"{\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\"{\n" +
 	"\x13GetTemplateResponse\x12(\n" +
 	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\x12:\n" +
-	"\x0elatest_version\x18\x02 \x01(\v2\x13.v1.TemplateVersionR\rlatestVersion\"\xa7\x02\n" +
+	"\x0elatest_version\x18\x02 \x01(\v2\x13.v1.TemplateVersionR\rlatestVersion\"\xa3\x04\n" +
 	"\x14ListTemplatesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -2973,12 +14010,437 @@ const file_prompt_proto_rawDesc = "" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x19\n" +
 	"\bmy_likes\x18\a \x01(\bR\amyLikes\x12!\n" +
 	"\fmy_favorites\x18\b \x01(\bR\vmyFavorites\x12\x1a\n" +
-	"\blanguage\x18\t \x01(\tR\blanguage\"\xdd\x01\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12$\n" +
+	"\x04sort\x18\n" +
+	" \x01(\x0e2\x10.v1.TemplateSortR\x04sort\x12.\n" +
+	"\x13include_total_count\x18\v \x01(\bR\x11includeTotalCount\x12#\n" +
+	"\rcollection_id\x18\f \x01(\tR\fcollectionId\x12$\n" +
+	"\x0eshared_with_me\x18\r \x01(\bR\fsharedWithMe\x12\x15\n" +
+	"\x06org_id\x18\x0e \x01(\tR\x05orgId\x12#\n" +
+	"\rfeatured_only\x18\x0f \x01(\bR\ffeaturedOnly\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\x10 \x01(\x01R\tminRating\"\xae\x02\n" +
 	"\x15ListTemplatesResponse\x12*\n" +
 	"\ttemplates\x18\x01 \x03(\v2\f.v1.TemplateR\ttemplates\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x129\n" +
 	"\x11private_templates\x18\x03 \x03(\v2\f.v1.TemplateR\x10privateTemplates\x125\n" +
-	"\x17private_next_page_token\x18\x04 \x01(\tR\x14privateNextPageToken\"B\n" +
+	"\x17private_next_page_token\x18\x04 \x01(\tR\x14privateNextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x05 \x01(\x05R\n" +
+	"totalCount\x12.\n" +
+	"\x13private_total_count\x18\x06 \x01(\x05R\x11privateTotalCount\"s\n" +
+	"\x1cListTrendingTemplatesRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"K\n" +
+	"\x1dListTrendingTemplatesResponse\x12*\n" +
+	"\ttemplates\x18\x01 \x03(\v2\f.v1.TemplateR\ttemplates\"\xfc\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x0e.v1.VisibilityR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12%\n" +
+	"\x0efollower_count\x18\a \x01(\x05R\rfollowerCount\x12!\n" +
+	"\fis_following\x18\b \x01(\bR\visFollowing\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x7f\n" +
+	"\x17CreateCollectionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x0e.v1.VisibilityR\n" +
+	"visibility\"J\n" +
+	"\x18CreateCollectionResponse\x12.\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x0e.v1.CollectionR\n" +
+	"collection\"&\n" +
+	"\x14GetCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x15GetCollectionResponse\x12.\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x0e.v1.CollectionR\n" +
+	"collection\"\x8f\x01\n" +
+	"\x17UpdateCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x0e.v1.VisibilityR\n" +
+	"visibility\"J\n" +
+	"\x18UpdateCollectionResponse\x12.\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x0e.v1.CollectionR\n" +
+	"collection\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8b\x01\n" +
+	"\x16ListCollectionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bfollowed\x18\x04 \x01(\bR\bfollowed\"s\n" +
+	"\x17ListCollectionsResponse\x120\n" +
+	"\vcollections\x18\x01 \x03(\v2\x0e.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"f\n" +
+	"\x1eAddTemplateToCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"Q\n" +
+	"\x1fAddTemplateToCollectionResponse\x12.\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x0e.v1.CollectionR\n" +
+	"collection\"k\n" +
+	"#RemoveTemplateFromCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"V\n" +
+	"$RemoveTemplateFromCollectionResponse\x12.\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x0e.v1.CollectionR\n" +
+	"collection\"b\n" +
+	"\x18ReorderCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12!\n" +
+	"\ftemplate_ids\x18\x02 \x03(\tR\vtemplateIds\"5\n" +
+	"\x19ReorderCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x1dToggleFollowCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\"j\n" +
+	"\x1eToggleFollowCollectionResponse\x12!\n" +
+	"\fis_following\x18\x01 \x01(\bR\visFollowing\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\"\xc6\x01\n" +
+	"\rTemplateGrant\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\x04role\x18\x03 \x01(\x0e2\r.v1.ShareRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x81\x02\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\x05 \x01(\bR\arevoked\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\x1aGrantTemplateAccessRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\x04role\x18\x03 \x01(\x0e2\r.v1.ShareRoleR\x04role\"F\n" +
+	"\x1bGrantTemplateAccessResponse\x12'\n" +
+	"\x05grant\x18\x01 \x01(\v2\x11.v1.TemplateGrantR\x05grant\"W\n" +
+	"\x1bRevokeTemplateAccessRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x1cRevokeTemplateAccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x19ListTemplateGrantsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"G\n" +
+	"\x1aListTemplateGrantsResponse\x12)\n" +
+	"\x06grants\x18\x01 \x03(\v2\x11.v1.TemplateGrantR\x06grants\"t\n" +
+	"\x16CreateShareLinkRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"<\n" +
+	"\x17CreateShareLinkResponse\x12!\n" +
+	"\x04link\x18\x01 \x01(\v2\r.v1.ShareLinkR\x04link\"8\n" +
+	"\x15ListShareLinksRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"=\n" +
+	"\x16ListShareLinksResponse\x12#\n" +
+	"\x05links\x18\x01 \x03(\v2\r.v1.ShareLinkR\x05links\"R\n" +
+	"\x16RevokeShareLinkRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\"3\n" +
+	"\x17RevokeShareLinkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb1\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\x04role\x18\x03 \x01(\x0e2\v.v1.OrgRoleR\x04role\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x01\n" +
+	"\x12OrganizationMember\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1f\n" +
+	"\x04role\x18\x04 \x01(\x0e2\v.v1.OrgRoleR\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\x8b\x02\n" +
+	"\x16OrganizationInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1f\n" +
+	"\x04role\x18\x04 \x01(\x0e2\v.v1.OrgRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"R\n" +
+	"\x1aCreateOrganizationResponse\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.v1.OrganizationR\forganization\"(\n" +
+	"\x16GetOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x17GetOrganizationResponse\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.v1.OrganizationR\forganization\"\x1a\n" +
+	"\x18ListOrganizationsRequest\"S\n" +
+	"\x19ListOrganizationsResponse\x126\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x10.v1.OrganizationR\rorganizations\"7\n" +
+	"\x1eListOrganizationMembersRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"S\n" +
+	"\x1fListOrganizationMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.v1.OrganizationMemberR\amembers\"\x8b\x01\n" +
+	"\x1fInviteOrganizationMemberRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n" +
+	"\x04role\x18\x03 \x01(\x0e2\v.v1.OrgRoleR\x04role\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\"^\n" +
+	" InviteOrganizationMemberResponse\x12:\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1a.v1.OrganizationInvitationR\n" +
+	"invitation\";\n" +
+	"#AcceptOrganizationInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\\\n" +
+	"$AcceptOrganizationInvitationResponse\x124\n" +
+	"\forganization\x18\x01 \x01(\v2\x10.v1.OrganizationR\forganization\"r\n" +
+	"\x1fUpdateOrganizationMemberRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x04role\x18\x03 \x01(\x0e2\v.v1.OrgRoleR\x04role\"R\n" +
+	" UpdateOrganizationMemberResponse\x12.\n" +
+	"\x06member\x18\x01 \x01(\v2\x16.v1.OrganizationMemberR\x06member\"Q\n" +
+	"\x1fRemoveOrganizationMemberRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
+	" RemoveOrganizationMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xeb\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12 \n" +
+	"\x04role\x18\x04 \x01(\x0e2\f.v1.UserRoleR\x04role\x12=\n" +
+	"\fsuspended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xad\x01\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12 \n" +
+	"\x04role\x18\x04 \x01(\x0e2\f.v1.UserRoleR\x04role\x12%\n" +
+	"\x0esuspended_only\x18\x05 \x01(\bR\rsuspendedOnly\"[\n" +
+	"\x11ListUsersResponse\x12\x1e\n" +
+	"\x05users\x18\x01 \x03(\v2\b.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x12SuspendUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"3\n" +
+	"\x13SuspendUserResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.v1.UserR\x04user\"/\n" +
+	"\x14ReinstateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
+	"\x15ReinstateUserResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.v1.UserR\x04user\"e\n" +
+	" TransferTemplateOwnershipRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"M\n" +
+	"!TransferTemplateOwnershipResponse\x12(\n" +
+	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\"Y\n" +
+	"\x1aSetTemplateFeaturedRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1a\n" +
+	"\bfeatured\x18\x02 \x01(\bR\bfeatured\"G\n" +
+	"\x1bSetTemplateFeaturedResponse\x12(\n" +
+	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\"\x8b\x02\n" +
+	"\x0eTemplateReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x1f\n" +
+	"\vreporter_id\x18\x03 \x01(\tR\n" +
+	"reporterId\x12(\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x10.v1.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12(\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x10.v1.ReportStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"|\n" +
+	"\x15ReportTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12(\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x10.v1.ReportReasonR\x06reason\x12\x18\n" +
+	"\adetails\x18\x03 \x01(\tR\adetails\"D\n" +
+	"\x16ReportTemplateResponse\x12*\n" +
+	"\x06report\x18\x01 \x01(\v2\x12.v1.TemplateReportR\x06report\"\x9e\x03\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\tR\bauthorId\x12.\n" +
+	"\x13author_display_name\x18\x06 \x01(\tR\x11authorDisplayName\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x1b\n" +
+	"\tbody_html\x18\b \x01(\tR\bbodyHtml\x12\x1f\n" +
+	"\vreply_count\x18\t \x01(\x05R\n" +
+	"replyCount\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x127\n" +
+	"\tedited_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\x14CreateCommentRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\">\n" +
+	"\x15CreateCommentResponse\x12%\n" +
+	"\acomment\x18\x01 \x01(\v2\v.v1.CommentR\acomment\":\n" +
+	"\x14UpdateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\">\n" +
+	"\x15UpdateCommentResponse\x12%\n" +
+	"\acomment\x18\x01 \x01(\v2\v.v1.CommentR\acomment\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x15DeleteCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb0\x01\n" +
+	"\x13ListCommentsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\"g\n" +
+	"\x14ListCommentsResponse\x12'\n" +
+	"\bcomments\x18\x01 \x03(\v2\v.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd0\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
+	"\x11user_display_name\x18\x04 \x01(\tR\x0fuserDisplayName\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x12\x14\n" +
+	"\x05stale\x18\b \x01(\bR\x05stale\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"b\n" +
+	"\x13RateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\":\n" +
+	"\x14RateTemplateResponse\x12\"\n" +
+	"\x06review\x18\x01 \x01(\v2\n" +
+	".v1.ReviewR\x06review\"6\n" +
+	"\x13DeleteReviewRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"0\n" +
+	"\x14DeleteReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb7\x01\n" +
+	"\x12ListReviewsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12#\n" +
+	"\rexclude_stale\x18\x04 \x01(\bR\fexcludeStale\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\"c\n" +
+	"\x13ListReviewsResponse\x12$\n" +
+	"\areviews\x18\x01 \x03(\v2\n" +
+	".v1.ReviewR\areviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x02\n" +
+	"\bFeedItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x10.v1.FeedItemTypeR\x04type\x12(\n" +
+	"\btemplate\x18\x03 \x01(\v2\f.v1.TemplateR\btemplate\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12,\n" +
+	"\x12actor_display_name\x18\x06 \x01(\tR\x10actorDisplayName\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xa1\x01\n" +
+	"\x1aRecordTemplateEventRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12'\n" +
+	"\x05event\x18\x03 \x01(\x0e2\x11.v1.TemplateEventR\x05event\x12\x1f\n" +
+	"\vshare_token\x18\x04 \x01(\tR\n" +
+	"shareToken\"\x1d\n" +
+	"\x1bRecordTemplateEventResponse\"\x93\x01\n" +
+	"\rTemplateUsage\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\x12\x18\n" +
+	"\arenders\x18\x03 \x01(\x03R\arenders\x12\x16\n" +
+	"\x06copies\x18\x04 \x01(\x03R\x06copies\x12&\n" +
+	"\x0einstantiations\x18\x05 \x01(\x03R\x0einstantiations\"\x8e\x01\n" +
+	"\x17GetTemplateStatsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"l\n" +
+	"\x18GetTemplateStatsResponse\x12%\n" +
+	"\x04days\x18\x01 \x03(\v2\x11.v1.TemplateUsageR\x04days\x12)\n" +
+	"\x06totals\x18\x02 \x01(\v2\x11.v1.TemplateUsageR\x06totals\"L\n" +
+	"\x0eGetFeedRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"]\n" +
+	"\x0fGetFeedResponse\x12\"\n" +
+	"\x05items\x18\x01 \x03(\v2\f.v1.FeedItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb5\x01\n" +
+	"\x13ModerationQueueItem\x12(\n" +
+	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\x12,\n" +
+	"\areports\x18\x02 \x03(\v2\x12.v1.TemplateReportR\areports\x12F\n" +
+	"\x11first_reported_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstReportedAt\"X\n" +
+	"\x1aListModerationQueueRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"t\n" +
+	"\x1bListModerationQueueResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.v1.ModerationQueueItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"|\n" +
+	"\x17ModerateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.v1.ModerationActionR\x06action\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"o\n" +
+	"\x18ModerateTemplateResponse\x12(\n" +
+	"\btemplate\x18\x01 \x01(\v2\f.v1.TemplateR\btemplate\x12)\n" +
+	"\x10resolved_reports\x18\x02 \x01(\x05R\x0fresolvedReports\"B\n" +
 	"\x15DeleteTemplateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\"2\n" +
@@ -2996,33 +14458,73 @@ const file_prompt_proto_rawDesc = "" +
 	"templateId\"b\n" +
 	"\x16ToggleFavoriteResponse\x12!\n" +
 	"\fis_favorited\x18\x01 \x01(\bR\visFavorited\x12%\n" +
-	"\x0efavorite_count\x18\x02 \x01(\x05R\rfavoriteCount\"\x8e\x01\n" +
+	"\x0efavorite_count\x18\x02 \x01(\x05R\rfavoriteCount\"\x9c\x03\n" +
 	"\x13CreatePromptRequest\x12\x1f\n" +
 	"\vtemplate_id\x18\x01 \x01(\tR\n" +
 	"templateId\x12\x1d\n" +
 	"\n" +
 	"version_id\x18\x02 \x01(\x05R\tversionId\x12\x19\n" +
 	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x1c\n" +
-	"\tvariables\x18\x04 \x03(\tR\tvariables\":\n" +
+	"\tvariables\x18\x04 \x03(\tR\tvariables\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x16\n" +
+	"\x06pinned\x18\b \x01(\bR\x06pinned\x12T\n" +
+	"\x0fnamed_variables\x18\t \x03(\v2+.v1.CreatePromptRequest.NamedVariablesEntryR\x0enamedVariables\x12\x1b\n" +
+	"\tpreset_id\x18\n" +
+	" \x01(\tR\bpresetId\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
 	"\x14CreatePromptResponse\x12\"\n" +
 	"\x06prompt\x18\x01 \x01(\v2\n" +
-	".v1.PromptR\x06prompt\"\"\n" +
+	".v1.PromptR\x06prompt\x12-\n" +
+	"\x06report\x18\x02 \x01(\v2\x15.v1.PlaceholderReportR\x06report\"\"\n" +
 	"\x10GetPromptRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
 	"\x11GetPromptResponse\x12\"\n" +
 	"\x06prompt\x18\x01 \x01(\v2\n" +
-	".v1.PromptR\x06prompt\"\x8c\x01\n" +
+	".v1.PromptR\x06prompt\"\x9b\x03\n" +
 	"\x12ListPromptsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
 	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x1f\n" +
 	"\vtemplate_id\x18\x04 \x01(\tR\n" +
-	"templateId\"c\n" +
+	"templateId\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12\"\n" +
+	"\x04sort\x18\t \x01(\x0e2\x0e.v1.PromptSortR\x04sort\x12\x1f\n" +
+	"\vpinned_only\x18\n" +
+	" \x01(\bR\n" +
+	"pinnedOnly\x12\x10\n" +
+	"\x03tag\x18\v \x01(\tR\x03tag\"\x84\x01\n" +
 	"\x13ListPromptsResponse\x12$\n" +
 	"\aprompts\x18\x01 \x03(\v2\n" +
 	".v1.PromptR\aprompts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"@\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x9a\x01\n" +
+	"\x13UpdatePromptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\x12\x1b\n" +
+	"\tmark_used\x18\x06 \x01(\bR\bmarkUsed\":\n" +
+	"\x14UpdatePromptResponse\x12\"\n" +
+	"\x06prompt\x18\x01 \x01(\v2\n" +
+	".v1.PromptR\x06prompt\"(\n" +
+	"\x16DuplicatePromptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x98\x01\n" +
+	"\x17DuplicatePromptResponse\x12\"\n" +
+	"\x06prompt\x18\x01 \x01(\v2\n" +
+	".v1.PromptR\x06prompt\x12*\n" +
+	"\x05drift\x18\x02 \x01(\v2\x14.v1.PlaceholderDriftR\x05drift\x12-\n" +
+	"\x06report\x18\x03 \x01(\v2\x15.v1.PlaceholderReportR\x06report\"@\n" +
 	"\x13DeletePromptRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\"0\n" +
@@ -3040,12 +14542,13 @@ const file_prompt_proto_rawDesc = "" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"p\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x92\x01\n" +
 	"\rLoginResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12!\n" +
 	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x16\n" +
-	"\x06avatar\x18\x04 \x01(\tR\x06avatar\"G\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12 \n" +
+	"\x04role\x18\x05 \x01(\x0e2\f.v1.UserRoleR\x04role\"G\n" +
 	"\x15LoginWithOAuthRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\"O\n" +
@@ -3053,49 +14556,479 @@ const file_prompt_proto_rawDesc = "" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\blanguage\x18\x02 \x01(\tR\blanguage\"8\n" +
 	"\x1cSendVerificationCodeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
 	"\x15ListCategoriesRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x1a\n" +
-	"\blanguage\x18\x02 \x01(\tR\blanguage\"9\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\"9\n" +
 	"\rCategoryStats\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"K\n" +
 	"\x16ListCategoriesResponse\x121\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x11.v1.CategoryStatsR\n" +
-	"categories\"-\n" +
+	"categories\"D\n" +
 	"\x0fListTagsRequest\x12\x1a\n" +
-	"\blanguage\x18\x01 \x01(\tR\blanguage\"4\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\"4\n" +
 	"\bTagStats\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"4\n" +
 	"\x10ListTagsResponse\x12 \n" +
-	"\x04tags\x18\x01 \x03(\v2\f.v1.TagStatsR\x04tags\"}\n" +
+	"\x04tags\x18\x01 \x03(\v2\f.v1.TagStatsR\x04tags\"\x8f\x01\n" +
 	"\x14UpdateProfileRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
 	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"b\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\"t\n" +
 	"\x15UpdateProfileResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
-	"\x06avatar\x18\x03 \x01(\tR\x06avatar\"#\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\"#\n" +
 	"\x11GetProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf9\x01\n" +
 	"\x12GetProfileResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
 	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x16\n" +
-	"\x06avatar\x18\x04 \x01(\tR\x06avatar*W\n" +
+	"\x06avatar\x18\x04 \x01(\tR\x06avatar\x12 \n" +
+	"\x04role\x18\x05 \x01(\x0e2\f.v1.UserRoleR\x04role\x12%\n" +
+	"\x0efollower_count\x18\x06 \x01(\x05R\rfollowerCount\x12'\n" +
+	"\x0ffollowing_count\x18\a \x01(\x05R\x0efollowingCount\x12\x10\n" +
+	"\x03bio\x18\b \x01(\tR\x03bio\"\xc4\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.v1.NotificationTypeR\x04type\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12,\n" +
+	"\x12actor_display_name\x18\x04 \x01(\tR\x10actorDisplayName\x12\x1f\n" +
+	"\vactor_count\x18\x05 \x01(\x05R\n" +
+	"actorCount\x12\x1f\n" +
+	"\vevent_count\x18\x06 \x01(\x05R\n" +
+	"eventCount\x12\x1f\n" +
+	"\vtemplate_id\x18\a \x01(\tR\n" +
+	"templateId\x12%\n" +
+	"\x0etemplate_title\x18\b \x01(\tR\rtemplateTitle\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\t \x01(\tR\tcommentId\x12\x12\n" +
+	"\x04read\x18\n" +
+	" \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa6\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x03 \x01(\tR\tactorRole\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\x12/\n" +
+	"\x06before\x18\b \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\t \x01(\v2\x17.google.protobuf.StructR\x05after\x12\x1b\n" +
+	"\tclient_ip\x18\n" +
+	" \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tR\trequestId\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbf\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"i\n" +
+	"\x17ListAuditEventsResponse\x12&\n" +
+	"\x06events\x18\x01 \x03(\v2\x0e.v1.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"w\n" +
+	"\x18ListNotificationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\x9e\x01\n" +
+	"\x19ListNotificationsResponse\x126\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x10.v1.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"#\n" +
+	"\x0fMarkReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\",\n" +
+	"\x10MarkReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"\x14\n" +
+	"\x12MarkAllReadRequest\"/\n" +
+	"\x13MarkAllReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\",\n" +
+	"\x11FollowUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
+	"\x12FollowUserResponse\x12!\n" +
+	"\fis_following\x18\x01 \x01(\bR\visFollowing\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\".\n" +
+	"\x13UnfollowUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"`\n" +
+	"\x14UnfollowUserResponse\x12!\n" +
+	"\fis_following\x18\x01 \x01(\bR\visFollowing\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\"2\n" +
+	"\x17GetPublicProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd4\x03\n" +
+	"\x18GetPublicProfileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x122\n" +
+	"\x15public_template_count\x18\x06 \x01(\x05R\x13publicTemplateCount\x12\x1f\n" +
+	"\vtotal_likes\x18\a \x01(\x03R\n" +
+	"totalLikes\x12'\n" +
+	"\x0ftotal_favorites\x18\b \x01(\x03R\x0etotalFavorites\x12%\n" +
+	"\x0efollower_count\x18\t \x01(\x05R\rfollowerCount\x12'\n" +
+	"\x0ffollowing_count\x18\n" +
+	" \x01(\x05R\x0efollowingCount\x12!\n" +
+	"\fis_following\x18\v \x01(\bR\visFollowing\x121\n" +
+	"\rtop_templates\x18\f \x03(\v2\f.v1.TemplateR\ftopTemplates\"\x85\x01\n" +
+	"\x11PlaceholderReport\x12,\n" +
+	"\x12filled_from_preset\x18\x01 \x03(\tR\x10filledFromPreset\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\x12(\n" +
+	"\x10filled_by_system\x18\x03 \x03(\tR\x0efilledBySystem\"\xa9\x02\n" +
+	"\x13RenderPromptRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x05R\tversionId\x12\x1c\n" +
+	"\tvariables\x18\x03 \x03(\tR\tvariables\x12T\n" +
+	"\x0fnamed_variables\x18\x04 \x03(\v2+.v1.RenderPromptRequest.NamedVariablesEntryR\x0enamedVariables\x12\x1b\n" +
+	"\tpreset_id\x18\x05 \x01(\tR\bpresetId\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x01\n" +
+	"\x14RenderPromptResponse\x12#\n" +
+	"\rrendered_text\x18\x01 \x01(\tR\frenderedText\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x05R\tversionId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12-\n" +
+	"\x06report\x18\x04 \x01(\v2\x15.v1.PlaceholderReportR\x06report\"\xcf\x02\n" +
+	"\x0eVariablePreset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x126\n" +
+	"\x06values\x18\x05 \x03(\v2\x1e.v1.VariablePreset.ValuesEntryR\x06values\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc8\x01\n" +
+	"\x1bCreateVariablePresetRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\x06values\x18\x02 \x03(\v2+.v1.CreateVariablePresetRequest.ValuesEntryR\x06values\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x1cCreateVariablePresetResponse\x12*\n" +
+	"\x06preset\x18\x01 \x01(\v2\x12.v1.VariablePresetR\x06preset\"\xc1\x01\n" +
+	"\x1bUpdateVariablePresetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12C\n" +
+	"\x06values\x18\x03 \x03(\v2+.v1.UpdateVariablePresetRequest.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x1cUpdateVariablePresetResponse\x12*\n" +
+	"\x06preset\x18\x01 \x01(\v2\x12.v1.VariablePresetR\x06preset\"-\n" +
+	"\x1bDeleteVariablePresetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x1cDeleteVariablePresetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
+	"\x1aListVariablePresetsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"K\n" +
+	"\x1bListVariablePresetsResponse\x12,\n" +
+	"\apresets\x18\x01 \x03(\v2\x12.v1.VariablePresetR\apresets\"\xda\x01\n" +
+	"\x0fModelParameters\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12%\n" +
+	"\vtemperature\x18\x02 \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12\x18\n" +
+	"\x05top_p\x18\x03 \x01(\x01H\x01R\x04topP\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x04 \x01(\x05R\tmaxTokens\x12\x12\n" +
+	"\x04stop\x18\x05 \x03(\tR\x04stop\x12#\n" +
+	"\rsystem_prompt\x18\x06 \x01(\tR\fsystemPromptB\x0e\n" +
+	"\f_temperatureB\b\n" +
+	"\x06_top_p\"\x89\x04\n" +
+	"\tExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
+	"\tprompt_id\x18\x03 \x01(\tR\bpromptId\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x123\n" +
+	"\n" +
+	"parameters\x18\x05 \x01(\v2\x13.v1.ModelParametersR\n" +
+	"parameters\x12\x14\n" +
+	"\x05input\x18\x06 \x01(\tR\x05input\x12\x16\n" +
+	"\x06output\x18\a \x01(\tR\x06output\x12#\n" +
+	"\rfinish_reason\x18\b \x01(\tR\ffinishReason\x12+\n" +
+	"\x06status\x18\t \x01(\x0e2\x13.v1.ExecutionStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\v \x01(\x05R\tlatencyMs\x12#\n" +
+	"\rprompt_tokens\x18\f \x01(\x05R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\r \x01(\x05R\x10completionTokens\x12!\n" +
+	"\ftotal_tokens\x18\x0e \x01(\x05R\vtotalTokens\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\x14ExecutePromptRequest\x12#\n" +
+	"\rrendered_text\x18\x01 \x01(\tR\frenderedText\x12\x1b\n" +
+	"\tprompt_id\x18\x02 \x01(\tR\bpromptId\x123\n" +
+	"\n" +
+	"parameters\x18\x03 \x01(\v2\x13.v1.ModelParametersR\n" +
+	"parameters\"D\n" +
+	"\x15ExecutePromptResponse\x12+\n" +
+	"\texecution\x18\x01 \x01(\v2\r.v1.ExecutionR\texecution\"W\n" +
+	"\x12ExecutePromptChunk\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\tR\x05delta\x12+\n" +
+	"\texecution\x18\x02 \x01(\v2\r.v1.ExecutionR\texecution\"p\n" +
+	"\x15ListExecutionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tprompt_id\x18\x03 \x01(\tR\bpromptId\"o\n" +
+	"\x16ListExecutionsResponse\x12-\n" +
+	"\n" +
+	"executions\x18\x01 \x03(\v2\r.v1.ExecutionR\n" +
+	"executions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"g\n" +
+	"\tAssertion\x12%\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x11.v1.AssertionTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x03 \x01(\x05R\tmaxLength\"\xcb\x03\n" +
+	"\x0eEvaluationCase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tvariables\x18\x04 \x03(\tR\tvariables\x12O\n" +
+	"\x0fnamed_variables\x18\x05 \x03(\v2&.v1.EvaluationCase.NamedVariablesEntryR\x0enamedVariables\x12-\n" +
+	"\n" +
+	"assertions\x18\x06 \x03(\v2\r.v1.AssertionR\n" +
+	"assertions\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
+	"\x0fAssertionResult\x12+\n" +
+	"\tassertion\x18\x01 \x01(\v2\r.v1.AssertionR\tassertion\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xf8\x01\n" +
+	"\x10EvaluationResult\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12\x1b\n" +
+	"\tcase_name\x18\x02 \x01(\tR\bcaseName\x12\x14\n" +
+	"\x05input\x18\x03 \x01(\tR\x05input\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\x12\x16\n" +
+	"\x06passed\x18\x05 \x01(\bR\x06passed\x123\n" +
+	"\n" +
+	"assertions\x18\x06 \x03(\v2\x13.v1.AssertionResultR\n" +
+	"assertions\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\b \x01(\x05R\tlatencyMs\"\xe5\x02\n" +
+	"\rEvaluationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\x05R\tversionId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1a\n" +
+	"\bprovider\x18\x06 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05model\x18\a \x01(\tR\x05model\x12\x16\n" +
+	"\x06passed\x18\b \x01(\x05R\x06passed\x12\x16\n" +
+	"\x06failed\x18\t \x01(\x05R\x06failed\x12.\n" +
+	"\aresults\x18\n" +
+	" \x03(\v2\x14.v1.EvaluationResultR\aresults\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x02\n" +
+	"\x1bCreateEvaluationCaseRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tvariables\x18\x03 \x03(\tR\tvariables\x12\\\n" +
+	"\x0fnamed_variables\x18\x04 \x03(\v23.v1.CreateEvaluationCaseRequest.NamedVariablesEntryR\x0enamedVariables\x12-\n" +
+	"\n" +
+	"assertions\x18\x05 \x03(\v2\r.v1.AssertionR\n" +
+	"assertions\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x1cCreateEvaluationCaseResponse\x12&\n" +
+	"\x04case\x18\x01 \x01(\v2\x12.v1.EvaluationCaseR\x04case\"\xaf\x02\n" +
+	"\x1bUpdateEvaluationCaseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tvariables\x18\x03 \x03(\tR\tvariables\x12\\\n" +
+	"\x0fnamed_variables\x18\x04 \x03(\v23.v1.UpdateEvaluationCaseRequest.NamedVariablesEntryR\x0enamedVariables\x12-\n" +
+	"\n" +
+	"assertions\x18\x05 \x03(\v2\r.v1.AssertionR\n" +
+	"assertions\x1aA\n" +
+	"\x13NamedVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x1cUpdateEvaluationCaseResponse\x12&\n" +
+	"\x04case\x18\x01 \x01(\v2\x12.v1.EvaluationCaseR\x04case\"-\n" +
+	"\x1bDeleteEvaluationCaseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x1cDeleteEvaluationCaseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x1aListEvaluationCasesRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"G\n" +
+	"\x1bListEvaluationCasesResponse\x12(\n" +
+	"\x05cases\x18\x01 \x03(\v2\x12.v1.EvaluationCaseR\x05cases\"\xae\x01\n" +
+	"\x14RunEvaluationRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x05R\tversionId\x12!\n" +
+	"\fall_versions\x18\x03 \x01(\bR\vallVersions\x123\n" +
+	"\n" +
+	"parameters\x18\x04 \x01(\v2\x13.v1.ModelParametersR\n" +
+	"parameters\">\n" +
+	"\x15RunEvaluationResponse\x12%\n" +
+	"\x04runs\x18\x01 \x03(\v2\x11.v1.EvaluationRunR\x04runs\"\x97\x01\n" +
+	"\x19ListEvaluationRunsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x05R\tversionId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"k\n" +
+	"\x1aListEvaluationRunsResponse\x12%\n" +
+	"\x04runs\x18\x01 \x03(\v2\x11.v1.EvaluationRunR\x04runs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x1cCompareEvaluationRunsRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12&\n" +
+	"\x0fbase_version_id\x18\x02 \x01(\x05R\rbaseVersionId\x12&\n" +
+	"\x0fhead_version_id\x18\x03 \x01(\x05R\rheadVersionId\"\xce\x01\n" +
+	"\x14EvaluationComparison\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12\x1b\n" +
+	"\tcase_name\x18\x02 \x01(\tR\bcaseName\x12(\n" +
+	"\x04base\x18\x03 \x01(\v2\x14.v1.EvaluationResultR\x04base\x12(\n" +
+	"\x04head\x18\x04 \x01(\v2\x14.v1.EvaluationResultR\x04head\x12,\n" +
+	"\x06change\x18\x05 \x01(\x0e2\x14.v1.EvaluationChangeR\x06change\"\x9d\x01\n" +
+	"\x1dCompareEvaluationRunsResponse\x12%\n" +
+	"\x04base\x18\x01 \x01(\v2\x11.v1.EvaluationRunR\x04base\x12%\n" +
+	"\x04head\x18\x02 \x01(\v2\x11.v1.EvaluationRunR\x04head\x12.\n" +
+	"\x05cases\x18\x03 \x03(\v2\x18.v1.EvaluationComparisonR\x05cases*k\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12VISIBILITY_PRIVATE\x10\x01\x12\x15\n" +
-	"\x11VISIBILITY_PUBLIC\x10\x02*_\n" +
+	"\x11VISIBILITY_PUBLIC\x10\x02\x12\x12\n" +
+	"\x0eVISIBILITY_ORG\x10\x03*_\n" +
 	"\fTemplateType\x12\x1d\n" +
 	"\x19TEMPLATE_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14TEMPLATE_TYPE_SYSTEM\x10\x01\x12\x16\n" +
-	"\x12TEMPLATE_TYPE_USER\x10\x022\x90\x03\n" +
+	"\x12TEMPLATE_TYPE_USER\x10\x02*}\n" +
+	"\fTemplateSort\x12\x1d\n" +
+	"\x19TEMPLATE_SORT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TEMPLATE_SORT_NEWEST\x10\x01\x12\x1a\n" +
+	"\x16TEMPLATE_SORT_TRENDING\x10\x02\x12\x18\n" +
+	"\x14TEMPLATE_SORT_RATING\x10\x03*U\n" +
+	"\tShareRole\x12\x1a\n" +
+	"\x16SHARE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SHARE_ROLE_VIEWER\x10\x01\x12\x15\n" +
+	"\x11SHARE_ROLE_EDITOR\x10\x02*`\n" +
+	"\aOrgRole\x12\x18\n" +
+	"\x14ORG_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fORG_ROLE_MEMBER\x10\x01\x12\x12\n" +
+	"\x0eORG_ROLE_ADMIN\x10\x02\x12\x12\n" +
+	"\x0eORG_ROLE_OWNER\x10\x03*\x8c\x01\n" +
+	"\x0fModerationState\x12 \n" +
+	"\x1cMODERATION_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MODERATION_STATE_VISIBLE\x10\x01\x12\x1b\n" +
+	"\x17MODERATION_STATE_HIDDEN\x10\x02\x12\x1c\n" +
+	"\x18MODERATION_STATE_REMOVED\x10\x03*\xb5\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_REASON_SPAM\x10\x01\x12\x17\n" +
+	"\x13REPORT_REASON_ABUSE\x10\x02\x12\x1f\n" +
+	"\x1bREPORT_REASON_INAPPROPRIATE\x10\x03\x12\x1b\n" +
+	"\x17REPORT_REASON_COPYRIGHT\x10\x04\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\x05*~\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x02\x12\x1a\n" +
+	"\x16REPORT_STATUS_ACTIONED\x10\x03*\x9c\x01\n" +
+	"\rTemplateEvent\x12\x1e\n" +
+	"\x1aTEMPLATE_EVENT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TEMPLATE_EVENT_VIEW\x10\x01\x12\x19\n" +
+	"\x15TEMPLATE_EVENT_RENDER\x10\x02\x12\x17\n" +
+	"\x13TEMPLATE_EVENT_COPY\x10\x03\x12\x1e\n" +
+	"\x1aTEMPLATE_EVENT_INSTANTIATE\x10\x04*\x80\x01\n" +
+	"\fFeedItemType\x12\x1e\n" +
+	"\x1aFEED_ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FEED_ITEM_TYPE_TEMPLATE\x10\x01\x12\x1a\n" +
+	"\x16FEED_ITEM_TYPE_VERSION\x10\x02\x12\x17\n" +
+	"\x13FEED_ITEM_TYPE_FORK\x10\x03*\xe7\x01\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16NOTIFICATION_TYPE_LIKE\x10\x01\x12\x1e\n" +
+	"\x1aNOTIFICATION_TYPE_FAVORITE\x10\x02\x12\x1a\n" +
+	"\x16NOTIFICATION_TYPE_FORK\x10\x03\x12\x1d\n" +
+	"\x19NOTIFICATION_TYPE_COMMENT\x10\x04\x12\x1b\n" +
+	"\x17NOTIFICATION_TYPE_REPLY\x10\x05\x12\x1c\n" +
+	"\x18NOTIFICATION_TYPE_REVIEW\x10\x06*\x8e\x01\n" +
+	"\x10ModerationAction\x12!\n" +
+	"\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_ACTION_DISMISS\x10\x01\x12\x1a\n" +
+	"\x16MODERATION_ACTION_HIDE\x10\x02\x12\x1c\n" +
+	"\x18MODERATION_ACTION_REMOVE\x10\x03*N\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x02*\\\n" +
+	"\n" +
+	"PromptSort\x12\x1b\n" +
+	"\x17PROMPT_SORT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PROMPT_SORT_NEWEST\x10\x01\x12\x19\n" +
+	"\x15PROMPT_SORT_LAST_USED\x10\x02*\x90\x01\n" +
+	"\x0fExecutionStatus\x12 \n" +
+	"\x1cEXECUTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEXECUTION_STATUS_SUCCEEDED\x10\x01\x12\x1b\n" +
+	"\x17EXECUTION_STATUS_FAILED\x10\x02\x12\x1e\n" +
+	"\x1aEXECUTION_STATUS_CANCELLED\x10\x03*\xbf\x01\n" +
+	"\rAssertionType\x12\x1e\n" +
+	"\x1aASSERTION_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ASSERTION_TYPE_CONTAINS\x10\x01\x12\x18\n" +
+	"\x14ASSERTION_TYPE_REGEX\x10\x02\x12\x1e\n" +
+	"\x1aASSERTION_TYPE_JSON_SCHEMA\x10\x03\x12\x1d\n" +
+	"\x19ASSERTION_TYPE_MAX_LENGTH\x10\x04\x12\x18\n" +
+	"\x14ASSERTION_TYPE_JUDGE\x10\x05*\x94\x01\n" +
+	"\x10EvaluationChange\x12!\n" +
+	"\x1dEVALUATION_CHANGE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEVALUATION_CHANGE_UNCHANGED\x10\x01\x12\x1b\n" +
+	"\x17EVALUATION_CHANGE_FIXED\x10\x02\x12\x1f\n" +
+	"\x1bEVALUATION_CHANGE_REGRESSED\x10\x032\xdf\x04\n" +
 	"\vUserService\x125\n" +
 	"\bRegister\x12\x13.v1.RegisterRequest\x1a\x14.v1.RegisterResponse\x12,\n" +
 	"\x05Login\x12\x10.v1.LoginRequest\x1a\x11.v1.LoginResponse\x12>\n" +
@@ -3103,7 +15036,34 @@ const file_prompt_proto_rawDesc = "" +
 	"\x14SendVerificationCode\x12\x1f.v1.SendVerificationCodeRequest\x1a .v1.SendVerificationCodeResponse\x12D\n" +
 	"\rUpdateProfile\x12\x18.v1.UpdateProfileRequest\x1a\x19.v1.UpdateProfileResponse\x12;\n" +
 	"\n" +
-	"GetProfile\x12\x15.v1.GetProfileRequest\x1a\x16.v1.GetProfileResponse2\xa1\a\n" +
+	"GetProfile\x12\x15.v1.GetProfileRequest\x1a\x16.v1.GetProfileResponse\x12M\n" +
+	"\x10GetPublicProfile\x12\x1b.v1.GetPublicProfileRequest\x1a\x1c.v1.GetPublicProfileResponse\x12;\n" +
+	"\n" +
+	"FollowUser\x12\x15.v1.FollowUserRequest\x1a\x16.v1.FollowUserResponse\x12A\n" +
+	"\fUnfollowUser\x12\x17.v1.UnfollowUserRequest\x1a\x18.v1.UnfollowUserResponse2\x94\x06\n" +
+	"\x13OrganizationService\x12S\n" +
+	"\x12CreateOrganization\x12\x1d.v1.CreateOrganizationRequest\x1a\x1e.v1.CreateOrganizationResponse\x12J\n" +
+	"\x0fGetOrganization\x12\x1a.v1.GetOrganizationRequest\x1a\x1b.v1.GetOrganizationResponse\x12P\n" +
+	"\x11ListOrganizations\x12\x1c.v1.ListOrganizationsRequest\x1a\x1d.v1.ListOrganizationsResponse\x12b\n" +
+	"\x17ListOrganizationMembers\x12\".v1.ListOrganizationMembersRequest\x1a#.v1.ListOrganizationMembersResponse\x12e\n" +
+	"\x18InviteOrganizationMember\x12#.v1.InviteOrganizationMemberRequest\x1a$.v1.InviteOrganizationMemberResponse\x12q\n" +
+	"\x1cAcceptOrganizationInvitation\x12'.v1.AcceptOrganizationInvitationRequest\x1a(.v1.AcceptOrganizationInvitationResponse\x12e\n" +
+	"\x18UpdateOrganizationMember\x12#.v1.UpdateOrganizationMemberRequest\x1a$.v1.UpdateOrganizationMemberResponse\x12e\n" +
+	"\x18RemoveOrganizationMember\x12#.v1.RemoveOrganizationMemberRequest\x1a$.v1.RemoveOrganizationMemberResponse2\xb7\x04\n" +
+	"\fAdminService\x128\n" +
+	"\tListUsers\x12\x14.v1.ListUsersRequest\x1a\x15.v1.ListUsersResponse\x12>\n" +
+	"\vSuspendUser\x12\x16.v1.SuspendUserRequest\x1a\x17.v1.SuspendUserResponse\x12D\n" +
+	"\rReinstateUser\x12\x18.v1.ReinstateUserRequest\x1a\x19.v1.ReinstateUserResponse\x12h\n" +
+	"\x19TransferTemplateOwnership\x12$.v1.TransferTemplateOwnershipRequest\x1a%.v1.TransferTemplateOwnershipResponse\x12V\n" +
+	"\x13SetTemplateFeatured\x12\x1e.v1.SetTemplateFeaturedRequest\x1a\x1f.v1.SetTemplateFeaturedResponse\x12V\n" +
+	"\x13ListModerationQueue\x12\x1e.v1.ListModerationQueueRequest\x1a\x1f.v1.ListModerationQueueResponse\x12M\n" +
+	"\x10ModerateTemplate\x12\x1b.v1.ModerateTemplateRequest\x1a\x1c.v1.ModerateTemplateResponse2\xde\x01\n" +
+	"\x13NotificationService\x12P\n" +
+	"\x11ListNotifications\x12\x1c.v1.ListNotificationsRequest\x1a\x1d.v1.ListNotificationsResponse\x125\n" +
+	"\bMarkRead\x12\x13.v1.MarkReadRequest\x1a\x14.v1.MarkReadResponse\x12>\n" +
+	"\vMarkAllRead\x12\x16.v1.MarkAllReadRequest\x1a\x17.v1.MarkAllReadResponse2Z\n" +
+	"\fAuditService\x12J\n" +
+	"\x0fListAuditEvents\x12\x1a.v1.ListAuditEventsRequest\x1a\x1b.v1.ListAuditEventsResponse2\xa7#\n" +
 	"\rPromptService\x12G\n" +
 	"\x0eCreateTemplate\x12\x19.v1.CreateTemplateRequest\x1a\x1a.v1.CreateTemplateResponse\x12G\n" +
 	"\x0eUpdateTemplate\x12\x19.v1.UpdateTemplateRequest\x1a\x1a.v1.UpdateTemplateResponse\x12>\n" +
@@ -3113,11 +15073,56 @@ const file_prompt_proto_rawDesc = "" +
 	"\x12ToggleLikeTemplate\x12\x15.v1.ToggleLikeRequest\x1a\x16.v1.ToggleLikeResponse\x12O\n" +
 	"\x16ToggleFavoriteTemplate\x12\x19.v1.ToggleFavoriteRequest\x1a\x1a.v1.ToggleFavoriteResponse\x12A\n" +
 	"\fCreatePrompt\x12\x17.v1.CreatePromptRequest\x1a\x18.v1.CreatePromptResponse\x128\n" +
-	"\tGetPrompt\x12\x14.v1.GetPromptRequest\x1a\x15.v1.GetPromptResponse\x12A\n" +
-	"\fDeletePrompt\x12\x17.v1.DeletePromptRequest\x1a\x18.v1.DeletePromptResponse\x12G\n" +
+	"\tGetPrompt\x12\x14.v1.GetPromptRequest\x1a\x15.v1.GetPromptResponse\x12>\n" +
+	"\vListPrompts\x12\x16.v1.ListPromptsRequest\x1a\x17.v1.ListPromptsResponse\x12A\n" +
+	"\fUpdatePrompt\x12\x17.v1.UpdatePromptRequest\x1a\x18.v1.UpdatePromptResponse\x12J\n" +
+	"\x0fDuplicatePrompt\x12\x1a.v1.DuplicatePromptRequest\x1a\x1b.v1.DuplicatePromptResponse\x12A\n" +
+	"\fDeletePrompt\x12\x17.v1.DeletePromptRequest\x1a\x18.v1.DeletePromptResponse\x12A\n" +
+	"\fRenderPrompt\x12\x17.v1.RenderPromptRequest\x1a\x18.v1.RenderPromptResponse\x12Y\n" +
+	"\x14CreateVariablePreset\x12\x1f.v1.CreateVariablePresetRequest\x1a .v1.CreateVariablePresetResponse\x12Y\n" +
+	"\x14UpdateVariablePreset\x12\x1f.v1.UpdateVariablePresetRequest\x1a .v1.UpdateVariablePresetResponse\x12Y\n" +
+	"\x14DeleteVariablePreset\x12\x1f.v1.DeleteVariablePresetRequest\x1a .v1.DeleteVariablePresetResponse\x12V\n" +
+	"\x13ListVariablePresets\x12\x1e.v1.ListVariablePresetsRequest\x1a\x1f.v1.ListVariablePresetsResponse\x12D\n" +
+	"\rExecutePrompt\x12\x18.v1.ExecutePromptRequest\x1a\x19.v1.ExecutePromptResponse\x12I\n" +
+	"\x13StreamExecutePrompt\x12\x18.v1.ExecutePromptRequest\x1a\x16.v1.ExecutePromptChunk0\x01\x12G\n" +
+	"\x0eListExecutions\x12\x19.v1.ListExecutionsRequest\x1a\x1a.v1.ListExecutionsResponse\x12Y\n" +
+	"\x14CreateEvaluationCase\x12\x1f.v1.CreateEvaluationCaseRequest\x1a .v1.CreateEvaluationCaseResponse\x12Y\n" +
+	"\x14UpdateEvaluationCase\x12\x1f.v1.UpdateEvaluationCaseRequest\x1a .v1.UpdateEvaluationCaseResponse\x12Y\n" +
+	"\x14DeleteEvaluationCase\x12\x1f.v1.DeleteEvaluationCaseRequest\x1a .v1.DeleteEvaluationCaseResponse\x12V\n" +
+	"\x13ListEvaluationCases\x12\x1e.v1.ListEvaluationCasesRequest\x1a\x1f.v1.ListEvaluationCasesResponse\x12D\n" +
+	"\rRunEvaluation\x12\x18.v1.RunEvaluationRequest\x1a\x19.v1.RunEvaluationResponse\x12S\n" +
+	"\x12ListEvaluationRuns\x12\x1d.v1.ListEvaluationRunsRequest\x1a\x1e.v1.ListEvaluationRunsResponse\x12\\\n" +
+	"\x15CompareEvaluationRuns\x12 .v1.CompareEvaluationRunsRequest\x1a!.v1.CompareEvaluationRunsResponse\x12G\n" +
 	"\x0eListCategories\x12\x19.v1.ListCategoriesRequest\x1a\x1a.v1.ListCategoriesResponse\x125\n" +
 	"\bListTags\x12\x13.v1.ListTagsRequest\x1a\x14.v1.ListTagsResponse\x12Y\n" +
-	"\x14ListTemplateVersions\x12\x1f.v1.ListTemplateVersionsRequest\x1a .v1.ListTemplateVersionsResponseB'Z%awsome-prompt/backend/api/proto/v1;v1b\x06proto3"
+	"\x14ListTemplateVersions\x12\x1f.v1.ListTemplateVersionsRequest\x1a .v1.ListTemplateVersionsResponse\x12\\\n" +
+	"\x15ListTrendingTemplates\x12 .v1.ListTrendingTemplatesRequest\x1a!.v1.ListTrendingTemplatesResponse\x12M\n" +
+	"\x10CreateCollection\x12\x1b.v1.CreateCollectionRequest\x1a\x1c.v1.CreateCollectionResponse\x12D\n" +
+	"\rGetCollection\x12\x18.v1.GetCollectionRequest\x1a\x19.v1.GetCollectionResponse\x12M\n" +
+	"\x10UpdateCollection\x12\x1b.v1.UpdateCollectionRequest\x1a\x1c.v1.UpdateCollectionResponse\x12M\n" +
+	"\x10DeleteCollection\x12\x1b.v1.DeleteCollectionRequest\x1a\x1c.v1.DeleteCollectionResponse\x12J\n" +
+	"\x0fListCollections\x12\x1a.v1.ListCollectionsRequest\x1a\x1b.v1.ListCollectionsResponse\x12b\n" +
+	"\x17AddTemplateToCollection\x12\".v1.AddTemplateToCollectionRequest\x1a#.v1.AddTemplateToCollectionResponse\x12q\n" +
+	"\x1cRemoveTemplateFromCollection\x12'.v1.RemoveTemplateFromCollectionRequest\x1a(.v1.RemoveTemplateFromCollectionResponse\x12P\n" +
+	"\x11ReorderCollection\x12\x1c.v1.ReorderCollectionRequest\x1a\x1d.v1.ReorderCollectionResponse\x12_\n" +
+	"\x16ToggleFollowCollection\x12!.v1.ToggleFollowCollectionRequest\x1a\".v1.ToggleFollowCollectionResponse\x12V\n" +
+	"\x13GrantTemplateAccess\x12\x1e.v1.GrantTemplateAccessRequest\x1a\x1f.v1.GrantTemplateAccessResponse\x12Y\n" +
+	"\x14RevokeTemplateAccess\x12\x1f.v1.RevokeTemplateAccessRequest\x1a .v1.RevokeTemplateAccessResponse\x12S\n" +
+	"\x12ListTemplateGrants\x12\x1d.v1.ListTemplateGrantsRequest\x1a\x1e.v1.ListTemplateGrantsResponse\x12J\n" +
+	"\x0fCreateShareLink\x12\x1a.v1.CreateShareLinkRequest\x1a\x1b.v1.CreateShareLinkResponse\x12G\n" +
+	"\x0eListShareLinks\x12\x19.v1.ListShareLinksRequest\x1a\x1a.v1.ListShareLinksResponse\x12J\n" +
+	"\x0fRevokeShareLink\x12\x1a.v1.RevokeShareLinkRequest\x1a\x1b.v1.RevokeShareLinkResponse\x12G\n" +
+	"\x0eReportTemplate\x12\x19.v1.ReportTemplateRequest\x1a\x1a.v1.ReportTemplateResponse\x12D\n" +
+	"\rCreateComment\x12\x18.v1.CreateCommentRequest\x1a\x19.v1.CreateCommentResponse\x12D\n" +
+	"\rUpdateComment\x12\x18.v1.UpdateCommentRequest\x1a\x19.v1.UpdateCommentResponse\x12D\n" +
+	"\rDeleteComment\x12\x18.v1.DeleteCommentRequest\x1a\x19.v1.DeleteCommentResponse\x12A\n" +
+	"\fListComments\x12\x17.v1.ListCommentsRequest\x1a\x18.v1.ListCommentsResponse\x12A\n" +
+	"\fRateTemplate\x12\x17.v1.RateTemplateRequest\x1a\x18.v1.RateTemplateResponse\x12A\n" +
+	"\fDeleteReview\x12\x17.v1.DeleteReviewRequest\x1a\x18.v1.DeleteReviewResponse\x12>\n" +
+	"\vListReviews\x12\x16.v1.ListReviewsRequest\x1a\x17.v1.ListReviewsResponse\x122\n" +
+	"\aGetFeed\x12\x12.v1.GetFeedRequest\x1a\x13.v1.GetFeedResponse\x12V\n" +
+	"\x13RecordTemplateEvent\x12\x1e.v1.RecordTemplateEventRequest\x1a\x1f.v1.RecordTemplateEventResponse\x12M\n" +
+	"\x10GetTemplateStats\x12\x1b.v1.GetTemplateStatsRequest\x1a\x1c.v1.GetTemplateStatsResponseB'Z%awsome-prompt/backend/api/proto/v1;v1b\x06proto3"
 
 var (
 	file_prompt_proto_rawDescOnce sync.Once
@@ -3131,126 +15136,587 @@ func file_prompt_proto_rawDescGZIP() []byte {
 	return file_prompt_proto_rawDescData
 }
 
-var file_prompt_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_prompt_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_prompt_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_prompt_proto_msgTypes = make([]protoimpl.MessageInfo, 211)
 var file_prompt_proto_goTypes = []any{
-	(Visibility)(0),                      // 0: v1.Visibility
-	(TemplateType)(0),                    // 1: v1.TemplateType
-	(*Template)(nil),                     // 2: v1.Template
-	(*TemplateVersion)(nil),              // 3: v1.TemplateVersion
-	(*ListTemplateVersionsRequest)(nil),  // 4: v1.ListTemplateVersionsRequest
-	(*ListTemplateVersionsResponse)(nil), // 5: v1.ListTemplateVersionsResponse
-	(*Prompt)(nil),                       // 6: v1.Prompt
-	(*CreateTemplateRequest)(nil),        // 7: v1.CreateTemplateRequest
-	(*CreateTemplateResponse)(nil),       // 8: v1.CreateTemplateResponse
-	(*UpdateTemplateRequest)(nil),        // 9: v1.UpdateTemplateRequest
-	(*UpdateTemplateResponse)(nil),       // 10: v1.UpdateTemplateResponse
-	(*GetTemplateRequest)(nil),           // 11: v1.GetTemplateRequest
-	(*GetTemplateResponse)(nil),          // 12: v1.GetTemplateResponse
-	(*ListTemplatesRequest)(nil),         // 13: v1.ListTemplatesRequest
-	(*ListTemplatesResponse)(nil),        // 14: v1.ListTemplatesResponse
-	(*DeleteTemplateRequest)(nil),        // 15: v1.DeleteTemplateRequest
-	(*DeleteTemplateResponse)(nil),       // 16: v1.DeleteTemplateResponse
-	(*ToggleLikeRequest)(nil),            // 17: v1.ToggleLikeRequest
-	(*ToggleLikeResponse)(nil),           // 18: v1.ToggleLikeResponse
-	(*ToggleFavoriteRequest)(nil),        // 19: v1.ToggleFavoriteRequest
-	(*ToggleFavoriteResponse)(nil),       // 20: v1.ToggleFavoriteResponse
-	(*CreatePromptRequest)(nil),          // 21: v1.CreatePromptRequest
-	(*CreatePromptResponse)(nil),         // 22: v1.CreatePromptResponse
-	(*GetPromptRequest)(nil),             // 23: v1.GetPromptRequest
-	(*GetPromptResponse)(nil),            // 24: v1.GetPromptResponse
-	(*ListPromptsRequest)(nil),           // 25: v1.ListPromptsRequest
-	(*ListPromptsResponse)(nil),          // 26: v1.ListPromptsResponse
-	(*DeletePromptRequest)(nil),          // 27: v1.DeletePromptRequest
-	(*DeletePromptResponse)(nil),         // 28: v1.DeletePromptResponse
-	(*RegisterRequest)(nil),              // 29: v1.RegisterRequest
-	(*RegisterResponse)(nil),             // 30: v1.RegisterResponse
-	(*LoginRequest)(nil),                 // 31: v1.LoginRequest
-	(*LoginResponse)(nil),                // 32: v1.LoginResponse
-	(*LoginWithOAuthRequest)(nil),        // 33: v1.LoginWithOAuthRequest
-	(*SendVerificationCodeRequest)(nil),  // 34: v1.SendVerificationCodeRequest
-	(*SendVerificationCodeResponse)(nil), // 35: v1.SendVerificationCodeResponse
-	(*ListCategoriesRequest)(nil),        // 36: v1.ListCategoriesRequest
-	(*CategoryStats)(nil),                // 37: v1.CategoryStats
-	(*ListCategoriesResponse)(nil),       // 38: v1.ListCategoriesResponse
-	(*ListTagsRequest)(nil),              // 39: v1.ListTagsRequest
-	(*TagStats)(nil),                     // 40: v1.TagStats
-	(*ListTagsResponse)(nil),             // 41: v1.ListTagsResponse
-	(*UpdateProfileRequest)(nil),         // 42: v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),        // 43: v1.UpdateProfileResponse
-	(*GetProfileRequest)(nil),            // 44: v1.GetProfileRequest
-	(*GetProfileResponse)(nil),           // 45: v1.GetProfileResponse
-	(*timestamppb.Timestamp)(nil),        // 46: google.protobuf.Timestamp
+	(Visibility)(0),                              // 0: v1.Visibility
+	(TemplateType)(0),                            // 1: v1.TemplateType
+	(TemplateSort)(0),                            // 2: v1.TemplateSort
+	(ShareRole)(0),                               // 3: v1.ShareRole
+	(OrgRole)(0),                                 // 4: v1.OrgRole
+	(ModerationState)(0),                         // 5: v1.ModerationState
+	(ReportReason)(0),                            // 6: v1.ReportReason
+	(ReportStatus)(0),                            // 7: v1.ReportStatus
+	(TemplateEvent)(0),                           // 8: v1.TemplateEvent
+	(FeedItemType)(0),                            // 9: v1.FeedItemType
+	(NotificationType)(0),                        // 10: v1.NotificationType
+	(ModerationAction)(0),                        // 11: v1.ModerationAction
+	(UserRole)(0),                                // 12: v1.UserRole
+	(PromptSort)(0),                              // 13: v1.PromptSort
+	(ExecutionStatus)(0),                         // 14: v1.ExecutionStatus
+	(AssertionType)(0),                           // 15: v1.AssertionType
+	(EvaluationChange)(0),                        // 16: v1.EvaluationChange
+	(*Template)(nil),                             // 17: v1.Template
+	(*TemplateVersion)(nil),                      // 18: v1.TemplateVersion
+	(*ListTemplateVersionsRequest)(nil),          // 19: v1.ListTemplateVersionsRequest
+	(*ListTemplateVersionsResponse)(nil),         // 20: v1.ListTemplateVersionsResponse
+	(*Prompt)(nil),                               // 21: v1.Prompt
+	(*PlaceholderDrift)(nil),                     // 22: v1.PlaceholderDrift
+	(*CreateTemplateRequest)(nil),                // 23: v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),               // 24: v1.CreateTemplateResponse
+	(*UpdateTemplateRequest)(nil),                // 25: v1.UpdateTemplateRequest
+	(*VariableNames)(nil),                        // 26: v1.VariableNames
+	(*UpdateTemplateResponse)(nil),               // 27: v1.UpdateTemplateResponse
+	(*GetTemplateRequest)(nil),                   // 28: v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),                  // 29: v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),                 // 30: v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),                // 31: v1.ListTemplatesResponse
+	(*ListTrendingTemplatesRequest)(nil),         // 32: v1.ListTrendingTemplatesRequest
+	(*ListTrendingTemplatesResponse)(nil),        // 33: v1.ListTrendingTemplatesResponse
+	(*Collection)(nil),                           // 34: v1.Collection
+	(*CreateCollectionRequest)(nil),              // 35: v1.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),             // 36: v1.CreateCollectionResponse
+	(*GetCollectionRequest)(nil),                 // 37: v1.GetCollectionRequest
+	(*GetCollectionResponse)(nil),                // 38: v1.GetCollectionResponse
+	(*UpdateCollectionRequest)(nil),              // 39: v1.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),             // 40: v1.UpdateCollectionResponse
+	(*DeleteCollectionRequest)(nil),              // 41: v1.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),             // 42: v1.DeleteCollectionResponse
+	(*ListCollectionsRequest)(nil),               // 43: v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),              // 44: v1.ListCollectionsResponse
+	(*AddTemplateToCollectionRequest)(nil),       // 45: v1.AddTemplateToCollectionRequest
+	(*AddTemplateToCollectionResponse)(nil),      // 46: v1.AddTemplateToCollectionResponse
+	(*RemoveTemplateFromCollectionRequest)(nil),  // 47: v1.RemoveTemplateFromCollectionRequest
+	(*RemoveTemplateFromCollectionResponse)(nil), // 48: v1.RemoveTemplateFromCollectionResponse
+	(*ReorderCollectionRequest)(nil),             // 49: v1.ReorderCollectionRequest
+	(*ReorderCollectionResponse)(nil),            // 50: v1.ReorderCollectionResponse
+	(*ToggleFollowCollectionRequest)(nil),        // 51: v1.ToggleFollowCollectionRequest
+	(*ToggleFollowCollectionResponse)(nil),       // 52: v1.ToggleFollowCollectionResponse
+	(*TemplateGrant)(nil),                        // 53: v1.TemplateGrant
+	(*ShareLink)(nil),                            // 54: v1.ShareLink
+	(*GrantTemplateAccessRequest)(nil),           // 55: v1.GrantTemplateAccessRequest
+	(*GrantTemplateAccessResponse)(nil),          // 56: v1.GrantTemplateAccessResponse
+	(*RevokeTemplateAccessRequest)(nil),          // 57: v1.RevokeTemplateAccessRequest
+	(*RevokeTemplateAccessResponse)(nil),         // 58: v1.RevokeTemplateAccessResponse
+	(*ListTemplateGrantsRequest)(nil),            // 59: v1.ListTemplateGrantsRequest
+	(*ListTemplateGrantsResponse)(nil),           // 60: v1.ListTemplateGrantsResponse
+	(*CreateShareLinkRequest)(nil),               // 61: v1.CreateShareLinkRequest
+	(*CreateShareLinkResponse)(nil),              // 62: v1.CreateShareLinkResponse
+	(*ListShareLinksRequest)(nil),                // 63: v1.ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),               // 64: v1.ListShareLinksResponse
+	(*RevokeShareLinkRequest)(nil),               // 65: v1.RevokeShareLinkRequest
+	(*RevokeShareLinkResponse)(nil),              // 66: v1.RevokeShareLinkResponse
+	(*Organization)(nil),                         // 67: v1.Organization
+	(*OrganizationMember)(nil),                   // 68: v1.OrganizationMember
+	(*OrganizationInvitation)(nil),               // 69: v1.OrganizationInvitation
+	(*CreateOrganizationRequest)(nil),            // 70: v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),           // 71: v1.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),               // 72: v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),              // 73: v1.GetOrganizationResponse
+	(*ListOrganizationsRequest)(nil),             // 74: v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),            // 75: v1.ListOrganizationsResponse
+	(*ListOrganizationMembersRequest)(nil),       // 76: v1.ListOrganizationMembersRequest
+	(*ListOrganizationMembersResponse)(nil),      // 77: v1.ListOrganizationMembersResponse
+	(*InviteOrganizationMemberRequest)(nil),      // 78: v1.InviteOrganizationMemberRequest
+	(*InviteOrganizationMemberResponse)(nil),     // 79: v1.InviteOrganizationMemberResponse
+	(*AcceptOrganizationInvitationRequest)(nil),  // 80: v1.AcceptOrganizationInvitationRequest
+	(*AcceptOrganizationInvitationResponse)(nil), // 81: v1.AcceptOrganizationInvitationResponse
+	(*UpdateOrganizationMemberRequest)(nil),      // 82: v1.UpdateOrganizationMemberRequest
+	(*UpdateOrganizationMemberResponse)(nil),     // 83: v1.UpdateOrganizationMemberResponse
+	(*RemoveOrganizationMemberRequest)(nil),      // 84: v1.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),     // 85: v1.RemoveOrganizationMemberResponse
+	(*User)(nil),                                 // 86: v1.User
+	(*ListUsersRequest)(nil),                     // 87: v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                    // 88: v1.ListUsersResponse
+	(*SuspendUserRequest)(nil),                   // 89: v1.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                  // 90: v1.SuspendUserResponse
+	(*ReinstateUserRequest)(nil),                 // 91: v1.ReinstateUserRequest
+	(*ReinstateUserResponse)(nil),                // 92: v1.ReinstateUserResponse
+	(*TransferTemplateOwnershipRequest)(nil),     // 93: v1.TransferTemplateOwnershipRequest
+	(*TransferTemplateOwnershipResponse)(nil),    // 94: v1.TransferTemplateOwnershipResponse
+	(*SetTemplateFeaturedRequest)(nil),           // 95: v1.SetTemplateFeaturedRequest
+	(*SetTemplateFeaturedResponse)(nil),          // 96: v1.SetTemplateFeaturedResponse
+	(*TemplateReport)(nil),                       // 97: v1.TemplateReport
+	(*ReportTemplateRequest)(nil),                // 98: v1.ReportTemplateRequest
+	(*ReportTemplateResponse)(nil),               // 99: v1.ReportTemplateResponse
+	(*Comment)(nil),                              // 100: v1.Comment
+	(*CreateCommentRequest)(nil),                 // 101: v1.CreateCommentRequest
+	(*CreateCommentResponse)(nil),                // 102: v1.CreateCommentResponse
+	(*UpdateCommentRequest)(nil),                 // 103: v1.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),                // 104: v1.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),                 // 105: v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),                // 106: v1.DeleteCommentResponse
+	(*ListCommentsRequest)(nil),                  // 107: v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),                 // 108: v1.ListCommentsResponse
+	(*Review)(nil),                               // 109: v1.Review
+	(*RateTemplateRequest)(nil),                  // 110: v1.RateTemplateRequest
+	(*RateTemplateResponse)(nil),                 // 111: v1.RateTemplateResponse
+	(*DeleteReviewRequest)(nil),                  // 112: v1.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),                 // 113: v1.DeleteReviewResponse
+	(*ListReviewsRequest)(nil),                   // 114: v1.ListReviewsRequest
+	(*ListReviewsResponse)(nil),                  // 115: v1.ListReviewsResponse
+	(*FeedItem)(nil),                             // 116: v1.FeedItem
+	(*RecordTemplateEventRequest)(nil),           // 117: v1.RecordTemplateEventRequest
+	(*RecordTemplateEventResponse)(nil),          // 118: v1.RecordTemplateEventResponse
+	(*TemplateUsage)(nil),                        // 119: v1.TemplateUsage
+	(*GetTemplateStatsRequest)(nil),              // 120: v1.GetTemplateStatsRequest
+	(*GetTemplateStatsResponse)(nil),             // 121: v1.GetTemplateStatsResponse
+	(*GetFeedRequest)(nil),                       // 122: v1.GetFeedRequest
+	(*GetFeedResponse)(nil),                      // 123: v1.GetFeedResponse
+	(*ModerationQueueItem)(nil),                  // 124: v1.ModerationQueueItem
+	(*ListModerationQueueRequest)(nil),           // 125: v1.ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil),          // 126: v1.ListModerationQueueResponse
+	(*ModerateTemplateRequest)(nil),              // 127: v1.ModerateTemplateRequest
+	(*ModerateTemplateResponse)(nil),             // 128: v1.ModerateTemplateResponse
+	(*DeleteTemplateRequest)(nil),                // 129: v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),               // 130: v1.DeleteTemplateResponse
+	(*ToggleLikeRequest)(nil),                    // 131: v1.ToggleLikeRequest
+	(*ToggleLikeResponse)(nil),                   // 132: v1.ToggleLikeResponse
+	(*ToggleFavoriteRequest)(nil),                // 133: v1.ToggleFavoriteRequest
+	(*ToggleFavoriteResponse)(nil),               // 134: v1.ToggleFavoriteResponse
+	(*CreatePromptRequest)(nil),                  // 135: v1.CreatePromptRequest
+	(*CreatePromptResponse)(nil),                 // 136: v1.CreatePromptResponse
+	(*GetPromptRequest)(nil),                     // 137: v1.GetPromptRequest
+	(*GetPromptResponse)(nil),                    // 138: v1.GetPromptResponse
+	(*ListPromptsRequest)(nil),                   // 139: v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),                  // 140: v1.ListPromptsResponse
+	(*UpdatePromptRequest)(nil),                  // 141: v1.UpdatePromptRequest
+	(*UpdatePromptResponse)(nil),                 // 142: v1.UpdatePromptResponse
+	(*DuplicatePromptRequest)(nil),               // 143: v1.DuplicatePromptRequest
+	(*DuplicatePromptResponse)(nil),              // 144: v1.DuplicatePromptResponse
+	(*DeletePromptRequest)(nil),                  // 145: v1.DeletePromptRequest
+	(*DeletePromptResponse)(nil),                 // 146: v1.DeletePromptResponse
+	(*RegisterRequest)(nil),                      // 147: v1.RegisterRequest
+	(*RegisterResponse)(nil),                     // 148: v1.RegisterResponse
+	(*LoginRequest)(nil),                         // 149: v1.LoginRequest
+	(*LoginResponse)(nil),                        // 150: v1.LoginResponse
+	(*LoginWithOAuthRequest)(nil),                // 151: v1.LoginWithOAuthRequest
+	(*SendVerificationCodeRequest)(nil),          // 152: v1.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil),         // 153: v1.SendVerificationCodeResponse
+	(*ListCategoriesRequest)(nil),                // 154: v1.ListCategoriesRequest
+	(*CategoryStats)(nil),                        // 155: v1.CategoryStats
+	(*ListCategoriesResponse)(nil),               // 156: v1.ListCategoriesResponse
+	(*ListTagsRequest)(nil),                      // 157: v1.ListTagsRequest
+	(*TagStats)(nil),                             // 158: v1.TagStats
+	(*ListTagsResponse)(nil),                     // 159: v1.ListTagsResponse
+	(*UpdateProfileRequest)(nil),                 // 160: v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                // 161: v1.UpdateProfileResponse
+	(*GetProfileRequest)(nil),                    // 162: v1.GetProfileRequest
+	(*GetProfileResponse)(nil),                   // 163: v1.GetProfileResponse
+	(*Notification)(nil),                         // 164: v1.Notification
+	(*AuditEvent)(nil),                           // 165: v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),               // 166: v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),              // 167: v1.ListAuditEventsResponse
+	(*ListNotificationsRequest)(nil),             // 168: v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 169: v1.ListNotificationsResponse
+	(*MarkReadRequest)(nil),                      // 170: v1.MarkReadRequest
+	(*MarkReadResponse)(nil),                     // 171: v1.MarkReadResponse
+	(*MarkAllReadRequest)(nil),                   // 172: v1.MarkAllReadRequest
+	(*MarkAllReadResponse)(nil),                  // 173: v1.MarkAllReadResponse
+	(*FollowUserRequest)(nil),                    // 174: v1.FollowUserRequest
+	(*FollowUserResponse)(nil),                   // 175: v1.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                  // 176: v1.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                 // 177: v1.UnfollowUserResponse
+	(*GetPublicProfileRequest)(nil),              // 178: v1.GetPublicProfileRequest
+	(*GetPublicProfileResponse)(nil),             // 179: v1.GetPublicProfileResponse
+	(*PlaceholderReport)(nil),                    // 180: v1.PlaceholderReport
+	(*RenderPromptRequest)(nil),                  // 181: v1.RenderPromptRequest
+	(*RenderPromptResponse)(nil),                 // 182: v1.RenderPromptResponse
+	(*VariablePreset)(nil),                       // 183: v1.VariablePreset
+	(*CreateVariablePresetRequest)(nil),          // 184: v1.CreateVariablePresetRequest
+	(*CreateVariablePresetResponse)(nil),         // 185: v1.CreateVariablePresetResponse
+	(*UpdateVariablePresetRequest)(nil),          // 186: v1.UpdateVariablePresetRequest
+	(*UpdateVariablePresetResponse)(nil),         // 187: v1.UpdateVariablePresetResponse
+	(*DeleteVariablePresetRequest)(nil),          // 188: v1.DeleteVariablePresetRequest
+	(*DeleteVariablePresetResponse)(nil),         // 189: v1.DeleteVariablePresetResponse
+	(*ListVariablePresetsRequest)(nil),           // 190: v1.ListVariablePresetsRequest
+	(*ListVariablePresetsResponse)(nil),          // 191: v1.ListVariablePresetsResponse
+	(*ModelParameters)(nil),                      // 192: v1.ModelParameters
+	(*Execution)(nil),                            // 193: v1.Execution
+	(*ExecutePromptRequest)(nil),                 // 194: v1.ExecutePromptRequest
+	(*ExecutePromptResponse)(nil),                // 195: v1.ExecutePromptResponse
+	(*ExecutePromptChunk)(nil),                   // 196: v1.ExecutePromptChunk
+	(*ListExecutionsRequest)(nil),                // 197: v1.ListExecutionsRequest
+	(*ListExecutionsResponse)(nil),               // 198: v1.ListExecutionsResponse
+	(*Assertion)(nil),                            // 199: v1.Assertion
+	(*EvaluationCase)(nil),                       // 200: v1.EvaluationCase
+	(*AssertionResult)(nil),                      // 201: v1.AssertionResult
+	(*EvaluationResult)(nil),                     // 202: v1.EvaluationResult
+	(*EvaluationRun)(nil),                        // 203: v1.EvaluationRun
+	(*CreateEvaluationCaseRequest)(nil),          // 204: v1.CreateEvaluationCaseRequest
+	(*CreateEvaluationCaseResponse)(nil),         // 205: v1.CreateEvaluationCaseResponse
+	(*UpdateEvaluationCaseRequest)(nil),          // 206: v1.UpdateEvaluationCaseRequest
+	(*UpdateEvaluationCaseResponse)(nil),         // 207: v1.UpdateEvaluationCaseResponse
+	(*DeleteEvaluationCaseRequest)(nil),          // 208: v1.DeleteEvaluationCaseRequest
+	(*DeleteEvaluationCaseResponse)(nil),         // 209: v1.DeleteEvaluationCaseResponse
+	(*ListEvaluationCasesRequest)(nil),           // 210: v1.ListEvaluationCasesRequest
+	(*ListEvaluationCasesResponse)(nil),          // 211: v1.ListEvaluationCasesResponse
+	(*RunEvaluationRequest)(nil),                 // 212: v1.RunEvaluationRequest
+	(*RunEvaluationResponse)(nil),                // 213: v1.RunEvaluationResponse
+	(*ListEvaluationRunsRequest)(nil),            // 214: v1.ListEvaluationRunsRequest
+	(*ListEvaluationRunsResponse)(nil),           // 215: v1.ListEvaluationRunsResponse
+	(*CompareEvaluationRunsRequest)(nil),         // 216: v1.CompareEvaluationRunsRequest
+	(*EvaluationComparison)(nil),                 // 217: v1.EvaluationComparison
+	(*CompareEvaluationRunsResponse)(nil),        // 218: v1.CompareEvaluationRunsResponse
+	nil,                                          // 219: v1.Prompt.NamedVariablesEntry
+	nil,                                          // 220: v1.CreatePromptRequest.NamedVariablesEntry
+	nil,                                          // 221: v1.RenderPromptRequest.NamedVariablesEntry
+	nil,                                          // 222: v1.VariablePreset.ValuesEntry
+	nil,                                          // 223: v1.CreateVariablePresetRequest.ValuesEntry
+	nil,                                          // 224: v1.UpdateVariablePresetRequest.ValuesEntry
+	nil,                                          // 225: v1.EvaluationCase.NamedVariablesEntry
+	nil,                                          // 226: v1.CreateEvaluationCaseRequest.NamedVariablesEntry
+	nil,                                          // 227: v1.UpdateEvaluationCaseRequest.NamedVariablesEntry
+	(*timestamppb.Timestamp)(nil),                // 228: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                      // 229: google.protobuf.Struct
 }
 var file_prompt_proto_depIdxs = []int32{
-	0,  // 0: v1.Template.visibility:type_name -> v1.Visibility
-	1,  // 1: v1.Template.type:type_name -> v1.TemplateType
-	46, // 2: v1.Template.created_at:type_name -> google.protobuf.Timestamp
-	46, // 3: v1.Template.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 4: v1.Template.latest_version:type_name -> v1.TemplateVersion
-	46, // 5: v1.TemplateVersion.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: v1.ListTemplateVersionsResponse.versions:type_name -> v1.TemplateVersion
-	46, // 7: v1.Prompt.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: v1.CreateTemplateRequest.visibility:type_name -> v1.Visibility
-	1,  // 9: v1.CreateTemplateRequest.type:type_name -> v1.TemplateType
-	2,  // 10: v1.CreateTemplateResponse.template:type_name -> v1.Template
-	3,  // 11: v1.CreateTemplateResponse.version:type_name -> v1.TemplateVersion
-	0,  // 12: v1.UpdateTemplateRequest.visibility:type_name -> v1.Visibility
-	2,  // 13: v1.UpdateTemplateResponse.template:type_name -> v1.Template
-	3,  // 14: v1.UpdateTemplateResponse.new_version:type_name -> v1.TemplateVersion
-	2,  // 15: v1.GetTemplateResponse.template:type_name -> v1.Template
-	3,  // 16: v1.GetTemplateResponse.latest_version:type_name -> v1.TemplateVersion
-	0,  // 17: v1.ListTemplatesRequest.visibility:type_name -> v1.Visibility
-	2,  // 18: v1.ListTemplatesResponse.templates:type_name -> v1.Template
-	2,  // 19: v1.ListTemplatesResponse.private_templates:type_name -> v1.Template
-	6,  // 20: v1.CreatePromptResponse.prompt:type_name -> v1.Prompt
-	6,  // 21: v1.GetPromptResponse.prompt:type_name -> v1.Prompt
-	6,  // 22: v1.ListPromptsResponse.prompts:type_name -> v1.Prompt
-	37, // 23: v1.ListCategoriesResponse.categories:type_name -> v1.CategoryStats
-	40, // 24: v1.ListTagsResponse.tags:type_name -> v1.TagStats
-	29, // 25: v1.UserService.Register:input_type -> v1.RegisterRequest
-	31, // 26: v1.UserService.Login:input_type -> v1.LoginRequest
-	33, // 27: v1.UserService.LoginWithOAuth:input_type -> v1.LoginWithOAuthRequest
-	34, // 28: v1.UserService.SendVerificationCode:input_type -> v1.SendVerificationCodeRequest
-	42, // 29: v1.UserService.UpdateProfile:input_type -> v1.UpdateProfileRequest
-	44, // 30: v1.UserService.GetProfile:input_type -> v1.GetProfileRequest
-	7,  // 31: v1.PromptService.CreateTemplate:input_type -> v1.CreateTemplateRequest
-	9,  // 32: v1.PromptService.UpdateTemplate:input_type -> v1.UpdateTemplateRequest
-	11, // 33: v1.PromptService.GetTemplate:input_type -> v1.GetTemplateRequest
-	13, // 34: v1.PromptService.ListTemplates:input_type -> v1.ListTemplatesRequest
-	15, // 35: v1.PromptService.DeleteTemplate:input_type -> v1.DeleteTemplateRequest
-	17, // 36: v1.PromptService.ToggleLikeTemplate:input_type -> v1.ToggleLikeRequest
-	19, // 37: v1.PromptService.ToggleFavoriteTemplate:input_type -> v1.ToggleFavoriteRequest
-	21, // 38: v1.PromptService.CreatePrompt:input_type -> v1.CreatePromptRequest
-	23, // 39: v1.PromptService.GetPrompt:input_type -> v1.GetPromptRequest
-	27, // 40: v1.PromptService.DeletePrompt:input_type -> v1.DeletePromptRequest
-	36, // 41: v1.PromptService.ListCategories:input_type -> v1.ListCategoriesRequest
-	39, // 42: v1.PromptService.ListTags:input_type -> v1.ListTagsRequest
-	4,  // 43: v1.PromptService.ListTemplateVersions:input_type -> v1.ListTemplateVersionsRequest
-	30, // 44: v1.UserService.Register:output_type -> v1.RegisterResponse
-	32, // 45: v1.UserService.Login:output_type -> v1.LoginResponse
-	32, // 46: v1.UserService.LoginWithOAuth:output_type -> v1.LoginResponse
-	35, // 47: v1.UserService.SendVerificationCode:output_type -> v1.SendVerificationCodeResponse
-	43, // 48: v1.UserService.UpdateProfile:output_type -> v1.UpdateProfileResponse
-	45, // 49: v1.UserService.GetProfile:output_type -> v1.GetProfileResponse
-	8,  // 50: v1.PromptService.CreateTemplate:output_type -> v1.CreateTemplateResponse
-	10, // 51: v1.PromptService.UpdateTemplate:output_type -> v1.UpdateTemplateResponse
-	12, // 52: v1.PromptService.GetTemplate:output_type -> v1.GetTemplateResponse
-	14, // 53: v1.PromptService.ListTemplates:output_type -> v1.ListTemplatesResponse
-	16, // 54: v1.PromptService.DeleteTemplate:output_type -> v1.DeleteTemplateResponse
-	18, // 55: v1.PromptService.ToggleLikeTemplate:output_type -> v1.ToggleLikeResponse
-	20, // 56: v1.PromptService.ToggleFavoriteTemplate:output_type -> v1.ToggleFavoriteResponse
-	22, // 57: v1.PromptService.CreatePrompt:output_type -> v1.CreatePromptResponse
-	24, // 58: v1.PromptService.GetPrompt:output_type -> v1.GetPromptResponse
-	28, // 59: v1.PromptService.DeletePrompt:output_type -> v1.DeletePromptResponse
-	38, // 60: v1.PromptService.ListCategories:output_type -> v1.ListCategoriesResponse
-	41, // 61: v1.PromptService.ListTags:output_type -> v1.ListTagsResponse
-	5,  // 62: v1.PromptService.ListTemplateVersions:output_type -> v1.ListTemplateVersionsResponse
-	44, // [44:63] is the sub-list for method output_type
-	25, // [25:44] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	0,   // 0: v1.Template.visibility:type_name -> v1.Visibility
+	1,   // 1: v1.Template.type:type_name -> v1.TemplateType
+	228, // 2: v1.Template.created_at:type_name -> google.protobuf.Timestamp
+	228, // 3: v1.Template.updated_at:type_name -> google.protobuf.Timestamp
+	18,  // 4: v1.Template.latest_version:type_name -> v1.TemplateVersion
+	5,   // 5: v1.Template.moderation_state:type_name -> v1.ModerationState
+	228, // 6: v1.TemplateVersion.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 7: v1.ListTemplateVersionsResponse.versions:type_name -> v1.TemplateVersion
+	228, // 8: v1.Prompt.created_at:type_name -> google.protobuf.Timestamp
+	228, // 9: v1.Prompt.last_used_at:type_name -> google.protobuf.Timestamp
+	219, // 10: v1.Prompt.named_variables:type_name -> v1.Prompt.NamedVariablesEntry
+	0,   // 11: v1.CreateTemplateRequest.visibility:type_name -> v1.Visibility
+	1,   // 12: v1.CreateTemplateRequest.type:type_name -> v1.TemplateType
+	17,  // 13: v1.CreateTemplateResponse.template:type_name -> v1.Template
+	18,  // 14: v1.CreateTemplateResponse.version:type_name -> v1.TemplateVersion
+	0,   // 15: v1.UpdateTemplateRequest.visibility:type_name -> v1.Visibility
+	26,  // 16: v1.UpdateTemplateRequest.overridable_variables:type_name -> v1.VariableNames
+	17,  // 17: v1.UpdateTemplateResponse.template:type_name -> v1.Template
+	18,  // 18: v1.UpdateTemplateResponse.new_version:type_name -> v1.TemplateVersion
+	17,  // 19: v1.GetTemplateResponse.template:type_name -> v1.Template
+	18,  // 20: v1.GetTemplateResponse.latest_version:type_name -> v1.TemplateVersion
+	0,   // 21: v1.ListTemplatesRequest.visibility:type_name -> v1.Visibility
+	2,   // 22: v1.ListTemplatesRequest.sort:type_name -> v1.TemplateSort
+	17,  // 23: v1.ListTemplatesResponse.templates:type_name -> v1.Template
+	17,  // 24: v1.ListTemplatesResponse.private_templates:type_name -> v1.Template
+	17,  // 25: v1.ListTrendingTemplatesResponse.templates:type_name -> v1.Template
+	0,   // 26: v1.Collection.visibility:type_name -> v1.Visibility
+	228, // 27: v1.Collection.created_at:type_name -> google.protobuf.Timestamp
+	228, // 28: v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 29: v1.CreateCollectionRequest.visibility:type_name -> v1.Visibility
+	34,  // 30: v1.CreateCollectionResponse.collection:type_name -> v1.Collection
+	34,  // 31: v1.GetCollectionResponse.collection:type_name -> v1.Collection
+	0,   // 32: v1.UpdateCollectionRequest.visibility:type_name -> v1.Visibility
+	34,  // 33: v1.UpdateCollectionResponse.collection:type_name -> v1.Collection
+	34,  // 34: v1.ListCollectionsResponse.collections:type_name -> v1.Collection
+	34,  // 35: v1.AddTemplateToCollectionResponse.collection:type_name -> v1.Collection
+	34,  // 36: v1.RemoveTemplateFromCollectionResponse.collection:type_name -> v1.Collection
+	3,   // 37: v1.TemplateGrant.role:type_name -> v1.ShareRole
+	228, // 38: v1.TemplateGrant.created_at:type_name -> google.protobuf.Timestamp
+	228, // 39: v1.ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	228, // 40: v1.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 41: v1.GrantTemplateAccessRequest.role:type_name -> v1.ShareRole
+	53,  // 42: v1.GrantTemplateAccessResponse.grant:type_name -> v1.TemplateGrant
+	53,  // 43: v1.ListTemplateGrantsResponse.grants:type_name -> v1.TemplateGrant
+	228, // 44: v1.CreateShareLinkRequest.expires_at:type_name -> google.protobuf.Timestamp
+	54,  // 45: v1.CreateShareLinkResponse.link:type_name -> v1.ShareLink
+	54,  // 46: v1.ListShareLinksResponse.links:type_name -> v1.ShareLink
+	4,   // 47: v1.Organization.role:type_name -> v1.OrgRole
+	228, // 48: v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 49: v1.OrganizationMember.role:type_name -> v1.OrgRole
+	228, // 50: v1.OrganizationMember.joined_at:type_name -> google.protobuf.Timestamp
+	4,   // 51: v1.OrganizationInvitation.role:type_name -> v1.OrgRole
+	228, // 52: v1.OrganizationInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	228, // 53: v1.OrganizationInvitation.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 54: v1.CreateOrganizationResponse.organization:type_name -> v1.Organization
+	67,  // 55: v1.GetOrganizationResponse.organization:type_name -> v1.Organization
+	67,  // 56: v1.ListOrganizationsResponse.organizations:type_name -> v1.Organization
+	68,  // 57: v1.ListOrganizationMembersResponse.members:type_name -> v1.OrganizationMember
+	4,   // 58: v1.InviteOrganizationMemberRequest.role:type_name -> v1.OrgRole
+	69,  // 59: v1.InviteOrganizationMemberResponse.invitation:type_name -> v1.OrganizationInvitation
+	67,  // 60: v1.AcceptOrganizationInvitationResponse.organization:type_name -> v1.Organization
+	4,   // 61: v1.UpdateOrganizationMemberRequest.role:type_name -> v1.OrgRole
+	68,  // 62: v1.UpdateOrganizationMemberResponse.member:type_name -> v1.OrganizationMember
+	12,  // 63: v1.User.role:type_name -> v1.UserRole
+	228, // 64: v1.User.suspended_at:type_name -> google.protobuf.Timestamp
+	228, // 65: v1.User.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 66: v1.ListUsersRequest.role:type_name -> v1.UserRole
+	86,  // 67: v1.ListUsersResponse.users:type_name -> v1.User
+	86,  // 68: v1.SuspendUserResponse.user:type_name -> v1.User
+	86,  // 69: v1.ReinstateUserResponse.user:type_name -> v1.User
+	17,  // 70: v1.TransferTemplateOwnershipResponse.template:type_name -> v1.Template
+	17,  // 71: v1.SetTemplateFeaturedResponse.template:type_name -> v1.Template
+	6,   // 72: v1.TemplateReport.reason:type_name -> v1.ReportReason
+	7,   // 73: v1.TemplateReport.status:type_name -> v1.ReportStatus
+	228, // 74: v1.TemplateReport.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 75: v1.ReportTemplateRequest.reason:type_name -> v1.ReportReason
+	97,  // 76: v1.ReportTemplateResponse.report:type_name -> v1.TemplateReport
+	228, // 77: v1.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	228, // 78: v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	100, // 79: v1.CreateCommentResponse.comment:type_name -> v1.Comment
+	100, // 80: v1.UpdateCommentResponse.comment:type_name -> v1.Comment
+	100, // 81: v1.ListCommentsResponse.comments:type_name -> v1.Comment
+	228, // 82: v1.Review.created_at:type_name -> google.protobuf.Timestamp
+	228, // 83: v1.Review.updated_at:type_name -> google.protobuf.Timestamp
+	109, // 84: v1.RateTemplateResponse.review:type_name -> v1.Review
+	109, // 85: v1.ListReviewsResponse.reviews:type_name -> v1.Review
+	9,   // 86: v1.FeedItem.type:type_name -> v1.FeedItemType
+	17,  // 87: v1.FeedItem.template:type_name -> v1.Template
+	228, // 88: v1.FeedItem.occurred_at:type_name -> google.protobuf.Timestamp
+	8,   // 89: v1.RecordTemplateEventRequest.event:type_name -> v1.TemplateEvent
+	119, // 90: v1.GetTemplateStatsResponse.days:type_name -> v1.TemplateUsage
+	119, // 91: v1.GetTemplateStatsResponse.totals:type_name -> v1.TemplateUsage
+	116, // 92: v1.GetFeedResponse.items:type_name -> v1.FeedItem
+	17,  // 93: v1.ModerationQueueItem.template:type_name -> v1.Template
+	97,  // 94: v1.ModerationQueueItem.reports:type_name -> v1.TemplateReport
+	228, // 95: v1.ModerationQueueItem.first_reported_at:type_name -> google.protobuf.Timestamp
+	124, // 96: v1.ListModerationQueueResponse.items:type_name -> v1.ModerationQueueItem
+	11,  // 97: v1.ModerateTemplateRequest.action:type_name -> v1.ModerationAction
+	17,  // 98: v1.ModerateTemplateResponse.template:type_name -> v1.Template
+	220, // 99: v1.CreatePromptRequest.named_variables:type_name -> v1.CreatePromptRequest.NamedVariablesEntry
+	21,  // 100: v1.CreatePromptResponse.prompt:type_name -> v1.Prompt
+	180, // 101: v1.CreatePromptResponse.report:type_name -> v1.PlaceholderReport
+	21,  // 102: v1.GetPromptResponse.prompt:type_name -> v1.Prompt
+	228, // 103: v1.ListPromptsRequest.start_time:type_name -> google.protobuf.Timestamp
+	228, // 104: v1.ListPromptsRequest.end_time:type_name -> google.protobuf.Timestamp
+	13,  // 105: v1.ListPromptsRequest.sort:type_name -> v1.PromptSort
+	21,  // 106: v1.ListPromptsResponse.prompts:type_name -> v1.Prompt
+	21,  // 107: v1.UpdatePromptResponse.prompt:type_name -> v1.Prompt
+	21,  // 108: v1.DuplicatePromptResponse.prompt:type_name -> v1.Prompt
+	22,  // 109: v1.DuplicatePromptResponse.drift:type_name -> v1.PlaceholderDrift
+	180, // 110: v1.DuplicatePromptResponse.report:type_name -> v1.PlaceholderReport
+	12,  // 111: v1.LoginResponse.role:type_name -> v1.UserRole
+	155, // 112: v1.ListCategoriesResponse.categories:type_name -> v1.CategoryStats
+	158, // 113: v1.ListTagsResponse.tags:type_name -> v1.TagStats
+	12,  // 114: v1.GetProfileResponse.role:type_name -> v1.UserRole
+	10,  // 115: v1.Notification.type:type_name -> v1.NotificationType
+	228, // 116: v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	228, // 117: v1.Notification.updated_at:type_name -> google.protobuf.Timestamp
+	229, // 118: v1.AuditEvent.before:type_name -> google.protobuf.Struct
+	229, // 119: v1.AuditEvent.after:type_name -> google.protobuf.Struct
+	228, // 120: v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	228, // 121: v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	228, // 122: v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	165, // 123: v1.ListAuditEventsResponse.events:type_name -> v1.AuditEvent
+	164, // 124: v1.ListNotificationsResponse.notifications:type_name -> v1.Notification
+	228, // 125: v1.GetPublicProfileResponse.joined_at:type_name -> google.protobuf.Timestamp
+	17,  // 126: v1.GetPublicProfileResponse.top_templates:type_name -> v1.Template
+	221, // 127: v1.RenderPromptRequest.named_variables:type_name -> v1.RenderPromptRequest.NamedVariablesEntry
+	180, // 128: v1.RenderPromptResponse.report:type_name -> v1.PlaceholderReport
+	222, // 129: v1.VariablePreset.values:type_name -> v1.VariablePreset.ValuesEntry
+	228, // 130: v1.VariablePreset.created_at:type_name -> google.protobuf.Timestamp
+	228, // 131: v1.VariablePreset.updated_at:type_name -> google.protobuf.Timestamp
+	223, // 132: v1.CreateVariablePresetRequest.values:type_name -> v1.CreateVariablePresetRequest.ValuesEntry
+	183, // 133: v1.CreateVariablePresetResponse.preset:type_name -> v1.VariablePreset
+	224, // 134: v1.UpdateVariablePresetRequest.values:type_name -> v1.UpdateVariablePresetRequest.ValuesEntry
+	183, // 135: v1.UpdateVariablePresetResponse.preset:type_name -> v1.VariablePreset
+	183, // 136: v1.ListVariablePresetsResponse.presets:type_name -> v1.VariablePreset
+	192, // 137: v1.Execution.parameters:type_name -> v1.ModelParameters
+	14,  // 138: v1.Execution.status:type_name -> v1.ExecutionStatus
+	228, // 139: v1.Execution.created_at:type_name -> google.protobuf.Timestamp
+	192, // 140: v1.ExecutePromptRequest.parameters:type_name -> v1.ModelParameters
+	193, // 141: v1.ExecutePromptResponse.execution:type_name -> v1.Execution
+	193, // 142: v1.ExecutePromptChunk.execution:type_name -> v1.Execution
+	193, // 143: v1.ListExecutionsResponse.executions:type_name -> v1.Execution
+	15,  // 144: v1.Assertion.type:type_name -> v1.AssertionType
+	225, // 145: v1.EvaluationCase.named_variables:type_name -> v1.EvaluationCase.NamedVariablesEntry
+	199, // 146: v1.EvaluationCase.assertions:type_name -> v1.Assertion
+	228, // 147: v1.EvaluationCase.created_at:type_name -> google.protobuf.Timestamp
+	228, // 148: v1.EvaluationCase.updated_at:type_name -> google.protobuf.Timestamp
+	199, // 149: v1.AssertionResult.assertion:type_name -> v1.Assertion
+	201, // 150: v1.EvaluationResult.assertions:type_name -> v1.AssertionResult
+	202, // 151: v1.EvaluationRun.results:type_name -> v1.EvaluationResult
+	228, // 152: v1.EvaluationRun.created_at:type_name -> google.protobuf.Timestamp
+	226, // 153: v1.CreateEvaluationCaseRequest.named_variables:type_name -> v1.CreateEvaluationCaseRequest.NamedVariablesEntry
+	199, // 154: v1.CreateEvaluationCaseRequest.assertions:type_name -> v1.Assertion
+	200, // 155: v1.CreateEvaluationCaseResponse.case:type_name -> v1.EvaluationCase
+	227, // 156: v1.UpdateEvaluationCaseRequest.named_variables:type_name -> v1.UpdateEvaluationCaseRequest.NamedVariablesEntry
+	199, // 157: v1.UpdateEvaluationCaseRequest.assertions:type_name -> v1.Assertion
+	200, // 158: v1.UpdateEvaluationCaseResponse.case:type_name -> v1.EvaluationCase
+	200, // 159: v1.ListEvaluationCasesResponse.cases:type_name -> v1.EvaluationCase
+	192, // 160: v1.RunEvaluationRequest.parameters:type_name -> v1.ModelParameters
+	203, // 161: v1.RunEvaluationResponse.runs:type_name -> v1.EvaluationRun
+	203, // 162: v1.ListEvaluationRunsResponse.runs:type_name -> v1.EvaluationRun
+	202, // 163: v1.EvaluationComparison.base:type_name -> v1.EvaluationResult
+	202, // 164: v1.EvaluationComparison.head:type_name -> v1.EvaluationResult
+	16,  // 165: v1.EvaluationComparison.change:type_name -> v1.EvaluationChange
+	203, // 166: v1.CompareEvaluationRunsResponse.base:type_name -> v1.EvaluationRun
+	203, // 167: v1.CompareEvaluationRunsResponse.head:type_name -> v1.EvaluationRun
+	217, // 168: v1.CompareEvaluationRunsResponse.cases:type_name -> v1.EvaluationComparison
+	147, // 169: v1.UserService.Register:input_type -> v1.RegisterRequest
+	149, // 170: v1.UserService.Login:input_type -> v1.LoginRequest
+	151, // 171: v1.UserService.LoginWithOAuth:input_type -> v1.LoginWithOAuthRequest
+	152, // 172: v1.UserService.SendVerificationCode:input_type -> v1.SendVerificationCodeRequest
+	160, // 173: v1.UserService.UpdateProfile:input_type -> v1.UpdateProfileRequest
+	162, // 174: v1.UserService.GetProfile:input_type -> v1.GetProfileRequest
+	178, // 175: v1.UserService.GetPublicProfile:input_type -> v1.GetPublicProfileRequest
+	174, // 176: v1.UserService.FollowUser:input_type -> v1.FollowUserRequest
+	176, // 177: v1.UserService.UnfollowUser:input_type -> v1.UnfollowUserRequest
+	70,  // 178: v1.OrganizationService.CreateOrganization:input_type -> v1.CreateOrganizationRequest
+	72,  // 179: v1.OrganizationService.GetOrganization:input_type -> v1.GetOrganizationRequest
+	74,  // 180: v1.OrganizationService.ListOrganizations:input_type -> v1.ListOrganizationsRequest
+	76,  // 181: v1.OrganizationService.ListOrganizationMembers:input_type -> v1.ListOrganizationMembersRequest
+	78,  // 182: v1.OrganizationService.InviteOrganizationMember:input_type -> v1.InviteOrganizationMemberRequest
+	80,  // 183: v1.OrganizationService.AcceptOrganizationInvitation:input_type -> v1.AcceptOrganizationInvitationRequest
+	82,  // 184: v1.OrganizationService.UpdateOrganizationMember:input_type -> v1.UpdateOrganizationMemberRequest
+	84,  // 185: v1.OrganizationService.RemoveOrganizationMember:input_type -> v1.RemoveOrganizationMemberRequest
+	87,  // 186: v1.AdminService.ListUsers:input_type -> v1.ListUsersRequest
+	89,  // 187: v1.AdminService.SuspendUser:input_type -> v1.SuspendUserRequest
+	91,  // 188: v1.AdminService.ReinstateUser:input_type -> v1.ReinstateUserRequest
+	93,  // 189: v1.AdminService.TransferTemplateOwnership:input_type -> v1.TransferTemplateOwnershipRequest
+	95,  // 190: v1.AdminService.SetTemplateFeatured:input_type -> v1.SetTemplateFeaturedRequest
+	125, // 191: v1.AdminService.ListModerationQueue:input_type -> v1.ListModerationQueueRequest
+	127, // 192: v1.AdminService.ModerateTemplate:input_type -> v1.ModerateTemplateRequest
+	168, // 193: v1.NotificationService.ListNotifications:input_type -> v1.ListNotificationsRequest
+	170, // 194: v1.NotificationService.MarkRead:input_type -> v1.MarkReadRequest
+	172, // 195: v1.NotificationService.MarkAllRead:input_type -> v1.MarkAllReadRequest
+	166, // 196: v1.AuditService.ListAuditEvents:input_type -> v1.ListAuditEventsRequest
+	23,  // 197: v1.PromptService.CreateTemplate:input_type -> v1.CreateTemplateRequest
+	25,  // 198: v1.PromptService.UpdateTemplate:input_type -> v1.UpdateTemplateRequest
+	28,  // 199: v1.PromptService.GetTemplate:input_type -> v1.GetTemplateRequest
+	30,  // 200: v1.PromptService.ListTemplates:input_type -> v1.ListTemplatesRequest
+	129, // 201: v1.PromptService.DeleteTemplate:input_type -> v1.DeleteTemplateRequest
+	131, // 202: v1.PromptService.ToggleLikeTemplate:input_type -> v1.ToggleLikeRequest
+	133, // 203: v1.PromptService.ToggleFavoriteTemplate:input_type -> v1.ToggleFavoriteRequest
+	135, // 204: v1.PromptService.CreatePrompt:input_type -> v1.CreatePromptRequest
+	137, // 205: v1.PromptService.GetPrompt:input_type -> v1.GetPromptRequest
+	139, // 206: v1.PromptService.ListPrompts:input_type -> v1.ListPromptsRequest
+	141, // 207: v1.PromptService.UpdatePrompt:input_type -> v1.UpdatePromptRequest
+	143, // 208: v1.PromptService.DuplicatePrompt:input_type -> v1.DuplicatePromptRequest
+	145, // 209: v1.PromptService.DeletePrompt:input_type -> v1.DeletePromptRequest
+	181, // 210: v1.PromptService.RenderPrompt:input_type -> v1.RenderPromptRequest
+	184, // 211: v1.PromptService.CreateVariablePreset:input_type -> v1.CreateVariablePresetRequest
+	186, // 212: v1.PromptService.UpdateVariablePreset:input_type -> v1.UpdateVariablePresetRequest
+	188, // 213: v1.PromptService.DeleteVariablePreset:input_type -> v1.DeleteVariablePresetRequest
+	190, // 214: v1.PromptService.ListVariablePresets:input_type -> v1.ListVariablePresetsRequest
+	194, // 215: v1.PromptService.ExecutePrompt:input_type -> v1.ExecutePromptRequest
+	194, // 216: v1.PromptService.StreamExecutePrompt:input_type -> v1.ExecutePromptRequest
+	197, // 217: v1.PromptService.ListExecutions:input_type -> v1.ListExecutionsRequest
+	204, // 218: v1.PromptService.CreateEvaluationCase:input_type -> v1.CreateEvaluationCaseRequest
+	206, // 219: v1.PromptService.UpdateEvaluationCase:input_type -> v1.UpdateEvaluationCaseRequest
+	208, // 220: v1.PromptService.DeleteEvaluationCase:input_type -> v1.DeleteEvaluationCaseRequest
+	210, // 221: v1.PromptService.ListEvaluationCases:input_type -> v1.ListEvaluationCasesRequest
+	212, // 222: v1.PromptService.RunEvaluation:input_type -> v1.RunEvaluationRequest
+	214, // 223: v1.PromptService.ListEvaluationRuns:input_type -> v1.ListEvaluationRunsRequest
+	216, // 224: v1.PromptService.CompareEvaluationRuns:input_type -> v1.CompareEvaluationRunsRequest
+	154, // 225: v1.PromptService.ListCategories:input_type -> v1.ListCategoriesRequest
+	157, // 226: v1.PromptService.ListTags:input_type -> v1.ListTagsRequest
+	19,  // 227: v1.PromptService.ListTemplateVersions:input_type -> v1.ListTemplateVersionsRequest
+	32,  // 228: v1.PromptService.ListTrendingTemplates:input_type -> v1.ListTrendingTemplatesRequest
+	35,  // 229: v1.PromptService.CreateCollection:input_type -> v1.CreateCollectionRequest
+	37,  // 230: v1.PromptService.GetCollection:input_type -> v1.GetCollectionRequest
+	39,  // 231: v1.PromptService.UpdateCollection:input_type -> v1.UpdateCollectionRequest
+	41,  // 232: v1.PromptService.DeleteCollection:input_type -> v1.DeleteCollectionRequest
+	43,  // 233: v1.PromptService.ListCollections:input_type -> v1.ListCollectionsRequest
+	45,  // 234: v1.PromptService.AddTemplateToCollection:input_type -> v1.AddTemplateToCollectionRequest
+	47,  // 235: v1.PromptService.RemoveTemplateFromCollection:input_type -> v1.RemoveTemplateFromCollectionRequest
+	49,  // 236: v1.PromptService.ReorderCollection:input_type -> v1.ReorderCollectionRequest
+	51,  // 237: v1.PromptService.ToggleFollowCollection:input_type -> v1.ToggleFollowCollectionRequest
+	55,  // 238: v1.PromptService.GrantTemplateAccess:input_type -> v1.GrantTemplateAccessRequest
+	57,  // 239: v1.PromptService.RevokeTemplateAccess:input_type -> v1.RevokeTemplateAccessRequest
+	59,  // 240: v1.PromptService.ListTemplateGrants:input_type -> v1.ListTemplateGrantsRequest
+	61,  // 241: v1.PromptService.CreateShareLink:input_type -> v1.CreateShareLinkRequest
+	63,  // 242: v1.PromptService.ListShareLinks:input_type -> v1.ListShareLinksRequest
+	65,  // 243: v1.PromptService.RevokeShareLink:input_type -> v1.RevokeShareLinkRequest
+	98,  // 244: v1.PromptService.ReportTemplate:input_type -> v1.ReportTemplateRequest
+	101, // 245: v1.PromptService.CreateComment:input_type -> v1.CreateCommentRequest
+	103, // 246: v1.PromptService.UpdateComment:input_type -> v1.UpdateCommentRequest
+	105, // 247: v1.PromptService.DeleteComment:input_type -> v1.DeleteCommentRequest
+	107, // 248: v1.PromptService.ListComments:input_type -> v1.ListCommentsRequest
+	110, // 249: v1.PromptService.RateTemplate:input_type -> v1.RateTemplateRequest
+	112, // 250: v1.PromptService.DeleteReview:input_type -> v1.DeleteReviewRequest
+	114, // 251: v1.PromptService.ListReviews:input_type -> v1.ListReviewsRequest
+	122, // 252: v1.PromptService.GetFeed:input_type -> v1.GetFeedRequest
+	117, // 253: v1.PromptService.RecordTemplateEvent:input_type -> v1.RecordTemplateEventRequest
+	120, // 254: v1.PromptService.GetTemplateStats:input_type -> v1.GetTemplateStatsRequest
+	148, // 255: v1.UserService.Register:output_type -> v1.RegisterResponse
+	150, // 256: v1.UserService.Login:output_type -> v1.LoginResponse
+	150, // 257: v1.UserService.LoginWithOAuth:output_type -> v1.LoginResponse
+	153, // 258: v1.UserService.SendVerificationCode:output_type -> v1.SendVerificationCodeResponse
+	161, // 259: v1.UserService.UpdateProfile:output_type -> v1.UpdateProfileResponse
+	163, // 260: v1.UserService.GetProfile:output_type -> v1.GetProfileResponse
+	179, // 261: v1.UserService.GetPublicProfile:output_type -> v1.GetPublicProfileResponse
+	175, // 262: v1.UserService.FollowUser:output_type -> v1.FollowUserResponse
+	177, // 263: v1.UserService.UnfollowUser:output_type -> v1.UnfollowUserResponse
+	71,  // 264: v1.OrganizationService.CreateOrganization:output_type -> v1.CreateOrganizationResponse
+	73,  // 265: v1.OrganizationService.GetOrganization:output_type -> v1.GetOrganizationResponse
+	75,  // 266: v1.OrganizationService.ListOrganizations:output_type -> v1.ListOrganizationsResponse
+	77,  // 267: v1.OrganizationService.ListOrganizationMembers:output_type -> v1.ListOrganizationMembersResponse
+	79,  // 268: v1.OrganizationService.InviteOrganizationMember:output_type -> v1.InviteOrganizationMemberResponse
+	81,  // 269: v1.OrganizationService.AcceptOrganizationInvitation:output_type -> v1.AcceptOrganizationInvitationResponse
+	83,  // 270: v1.OrganizationService.UpdateOrganizationMember:output_type -> v1.UpdateOrganizationMemberResponse
+	85,  // 271: v1.OrganizationService.RemoveOrganizationMember:output_type -> v1.RemoveOrganizationMemberResponse
+	88,  // 272: v1.AdminService.ListUsers:output_type -> v1.ListUsersResponse
+	90,  // 273: v1.AdminService.SuspendUser:output_type -> v1.SuspendUserResponse
+	92,  // 274: v1.AdminService.ReinstateUser:output_type -> v1.ReinstateUserResponse
+	94,  // 275: v1.AdminService.TransferTemplateOwnership:output_type -> v1.TransferTemplateOwnershipResponse
+	96,  // 276: v1.AdminService.SetTemplateFeatured:output_type -> v1.SetTemplateFeaturedResponse
+	126, // 277: v1.AdminService.ListModerationQueue:output_type -> v1.ListModerationQueueResponse
+	128, // 278: v1.AdminService.ModerateTemplate:output_type -> v1.ModerateTemplateResponse
+	169, // 279: v1.NotificationService.ListNotifications:output_type -> v1.ListNotificationsResponse
+	171, // 280: v1.NotificationService.MarkRead:output_type -> v1.MarkReadResponse
+	173, // 281: v1.NotificationService.MarkAllRead:output_type -> v1.MarkAllReadResponse
+	167, // 282: v1.AuditService.ListAuditEvents:output_type -> v1.ListAuditEventsResponse
+	24,  // 283: v1.PromptService.CreateTemplate:output_type -> v1.CreateTemplateResponse
+	27,  // 284: v1.PromptService.UpdateTemplate:output_type -> v1.UpdateTemplateResponse
+	29,  // 285: v1.PromptService.GetTemplate:output_type -> v1.GetTemplateResponse
+	31,  // 286: v1.PromptService.ListTemplates:output_type -> v1.ListTemplatesResponse
+	130, // 287: v1.PromptService.DeleteTemplate:output_type -> v1.DeleteTemplateResponse
+	132, // 288: v1.PromptService.ToggleLikeTemplate:output_type -> v1.ToggleLikeResponse
+	134, // 289: v1.PromptService.ToggleFavoriteTemplate:output_type -> v1.ToggleFavoriteResponse
+	136, // 290: v1.PromptService.CreatePrompt:output_type -> v1.CreatePromptResponse
+	138, // 291: v1.PromptService.GetPrompt:output_type -> v1.GetPromptResponse
+	140, // 292: v1.PromptService.ListPrompts:output_type -> v1.ListPromptsResponse
+	142, // 293: v1.PromptService.UpdatePrompt:output_type -> v1.UpdatePromptResponse
+	144, // 294: v1.PromptService.DuplicatePrompt:output_type -> v1.DuplicatePromptResponse
+	146, // 295: v1.PromptService.DeletePrompt:output_type -> v1.DeletePromptResponse
+	182, // 296: v1.PromptService.RenderPrompt:output_type -> v1.RenderPromptResponse
+	185, // 297: v1.PromptService.CreateVariablePreset:output_type -> v1.CreateVariablePresetResponse
+	187, // 298: v1.PromptService.UpdateVariablePreset:output_type -> v1.UpdateVariablePresetResponse
+	189, // 299: v1.PromptService.DeleteVariablePreset:output_type -> v1.DeleteVariablePresetResponse
+	191, // 300: v1.PromptService.ListVariablePresets:output_type -> v1.ListVariablePresetsResponse
+	195, // 301: v1.PromptService.ExecutePrompt:output_type -> v1.ExecutePromptResponse
+	196, // 302: v1.PromptService.StreamExecutePrompt:output_type -> v1.ExecutePromptChunk
+	198, // 303: v1.PromptService.ListExecutions:output_type -> v1.ListExecutionsResponse
+	205, // 304: v1.PromptService.CreateEvaluationCase:output_type -> v1.CreateEvaluationCaseResponse
+	207, // 305: v1.PromptService.UpdateEvaluationCase:output_type -> v1.UpdateEvaluationCaseResponse
+	209, // 306: v1.PromptService.DeleteEvaluationCase:output_type -> v1.DeleteEvaluationCaseResponse
+	211, // 307: v1.PromptService.ListEvaluationCases:output_type -> v1.ListEvaluationCasesResponse
+	213, // 308: v1.PromptService.RunEvaluation:output_type -> v1.RunEvaluationResponse
+	215, // 309: v1.PromptService.ListEvaluationRuns:output_type -> v1.ListEvaluationRunsResponse
+	218, // 310: v1.PromptService.CompareEvaluationRuns:output_type -> v1.CompareEvaluationRunsResponse
+	156, // 311: v1.PromptService.ListCategories:output_type -> v1.ListCategoriesResponse
+	159, // 312: v1.PromptService.ListTags:output_type -> v1.ListTagsResponse
+	20,  // 313: v1.PromptService.ListTemplateVersions:output_type -> v1.ListTemplateVersionsResponse
+	33,  // 314: v1.PromptService.ListTrendingTemplates:output_type -> v1.ListTrendingTemplatesResponse
+	36,  // 315: v1.PromptService.CreateCollection:output_type -> v1.CreateCollectionResponse
+	38,  // 316: v1.PromptService.GetCollection:output_type -> v1.GetCollectionResponse
+	40,  // 317: v1.PromptService.UpdateCollection:output_type -> v1.UpdateCollectionResponse
+	42,  // 318: v1.PromptService.DeleteCollection:output_type -> v1.DeleteCollectionResponse
+	44,  // 319: v1.PromptService.ListCollections:output_type -> v1.ListCollectionsResponse
+	46,  // 320: v1.PromptService.AddTemplateToCollection:output_type -> v1.AddTemplateToCollectionResponse
+	48,  // 321: v1.PromptService.RemoveTemplateFromCollection:output_type -> v1.RemoveTemplateFromCollectionResponse
+	50,  // 322: v1.PromptService.ReorderCollection:output_type -> v1.ReorderCollectionResponse
+	52,  // 323: v1.PromptService.ToggleFollowCollection:output_type -> v1.ToggleFollowCollectionResponse
+	56,  // 324: v1.PromptService.GrantTemplateAccess:output_type -> v1.GrantTemplateAccessResponse
+	58,  // 325: v1.PromptService.RevokeTemplateAccess:output_type -> v1.RevokeTemplateAccessResponse
+	60,  // 326: v1.PromptService.ListTemplateGrants:output_type -> v1.ListTemplateGrantsResponse
+	62,  // 327: v1.PromptService.CreateShareLink:output_type -> v1.CreateShareLinkResponse
+	64,  // 328: v1.PromptService.ListShareLinks:output_type -> v1.ListShareLinksResponse
+	66,  // 329: v1.PromptService.RevokeShareLink:output_type -> v1.RevokeShareLinkResponse
+	99,  // 330: v1.PromptService.ReportTemplate:output_type -> v1.ReportTemplateResponse
+	102, // 331: v1.PromptService.CreateComment:output_type -> v1.CreateCommentResponse
+	104, // 332: v1.PromptService.UpdateComment:output_type -> v1.UpdateCommentResponse
+	106, // 333: v1.PromptService.DeleteComment:output_type -> v1.DeleteCommentResponse
+	108, // 334: v1.PromptService.ListComments:output_type -> v1.ListCommentsResponse
+	111, // 335: v1.PromptService.RateTemplate:output_type -> v1.RateTemplateResponse
+	113, // 336: v1.PromptService.DeleteReview:output_type -> v1.DeleteReviewResponse
+	115, // 337: v1.PromptService.ListReviews:output_type -> v1.ListReviewsResponse
+	123, // 338: v1.PromptService.GetFeed:output_type -> v1.GetFeedResponse
+	118, // 339: v1.PromptService.RecordTemplateEvent:output_type -> v1.RecordTemplateEventResponse
+	121, // 340: v1.PromptService.GetTemplateStats:output_type -> v1.GetTemplateStatsResponse
+	255, // [255:341] is the sub-list for method output_type
+	169, // [169:255] is the sub-list for method input_type
+	169, // [169:169] is the sub-list for extension type_name
+	169, // [169:169] is the sub-list for extension extendee
+	0,   // [0:169] is the sub-list for field type_name
 }
 
 func init() { file_prompt_proto_init() }
@@ -3258,15 +15724,16 @@ func file_prompt_proto_init() {
 	if File_prompt_proto != nil {
 		return
 	}
+	file_prompt_proto_msgTypes[175].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prompt_proto_rawDesc), len(file_prompt_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   44,
+			NumEnums:      17,
+			NumMessages:   211,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   6,
 		},
 		GoTypes:           file_prompt_proto_goTypes,
 		DependencyIndexes: file_prompt_proto_depIdxs,
@@ -25,6 +25,9 @@ const (
 	UserService_SendVerificationCode_FullMethodName = "/v1.UserService/SendVerificationCode"
 	UserService_UpdateProfile_FullMethodName        = "/v1.UserService/UpdateProfile"
 	UserService_GetProfile_FullMethodName           = "/v1.UserService/GetProfile"
+	UserService_GetPublicProfile_FullMethodName     = "/v1.UserService/GetPublicProfile"
+	UserService_FollowUser_FullMethodName           = "/v1.UserService/FollowUser"
+	UserService_UnfollowUser_FullMethodName         = "/v1.UserService/UnfollowUser"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -45,6 +48,13 @@ type UserServiceClient interface {
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	// GetProfile retrieves the profile of the logged-in user.
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	// GetPublicProfile retrieves the public profile of any user. It never
+	// includes private fields such as the email address or mobile number.
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error)
+	// FollowUser makes the current user follow another user.
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
+	// UnfollowUser makes the current user stop following another user.
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
 }
 
 type userServiceClient struct {
@@ -115,6 +125,36 @@ func (c *userServiceClient) GetProfile(ctx context.Context, in *GetProfileReques
 	return out, nil
 }
 
+func (c *userServiceClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowUserResponse)
+	err := c.cc.Invoke(ctx, UserService_FollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnfollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -133,6 +173,13 @@ type UserServiceServer interface {
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	// GetProfile retrieves the profile of the logged-in user.
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	// GetPublicProfile retrieves the public profile of any user. It never
+	// includes private fields such as the email address or mobile number.
+	GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error)
+	// FollowUser makes the current user follow another user.
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	// UnfollowUser makes the current user stop following another user.
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -161,6 +208,15 @@ func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProf
 func (UnimplementedUserServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
 }
+func (UnimplementedUserServiceServer) GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedUserServiceServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfollowUser not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -290,6 +346,60 @@ func _UserService_GetProfile_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, req.(*GetPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,531 +431,3223 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfile",
 			Handler:    _UserService_GetProfile_Handler,
 		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _UserService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _UserService_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _UserService_UnfollowUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "prompt.proto",
 }
 
 const (
-	PromptService_CreateTemplate_FullMethodName         = "/v1.PromptService/CreateTemplate"
-	PromptService_UpdateTemplate_FullMethodName         = "/v1.PromptService/UpdateTemplate"
-	PromptService_GetTemplate_FullMethodName            = "/v1.PromptService/GetTemplate"
-	PromptService_ListTemplates_FullMethodName          = "/v1.PromptService/ListTemplates"
-	PromptService_DeleteTemplate_FullMethodName         = "/v1.PromptService/DeleteTemplate"
-	PromptService_ToggleLikeTemplate_FullMethodName     = "/v1.PromptService/ToggleLikeTemplate"
-	PromptService_ToggleFavoriteTemplate_FullMethodName = "/v1.PromptService/ToggleFavoriteTemplate"
-	PromptService_CreatePrompt_FullMethodName           = "/v1.PromptService/CreatePrompt"
-	PromptService_GetPrompt_FullMethodName              = "/v1.PromptService/GetPrompt"
-	PromptService_DeletePrompt_FullMethodName           = "/v1.PromptService/DeletePrompt"
-	PromptService_ListCategories_FullMethodName         = "/v1.PromptService/ListCategories"
-	PromptService_ListTags_FullMethodName               = "/v1.PromptService/ListTags"
-	PromptService_ListTemplateVersions_FullMethodName   = "/v1.PromptService/ListTemplateVersions"
+	OrganizationService_CreateOrganization_FullMethodName           = "/v1.OrganizationService/CreateOrganization"
+	OrganizationService_GetOrganization_FullMethodName              = "/v1.OrganizationService/GetOrganization"
+	OrganizationService_ListOrganizations_FullMethodName            = "/v1.OrganizationService/ListOrganizations"
+	OrganizationService_ListOrganizationMembers_FullMethodName      = "/v1.OrganizationService/ListOrganizationMembers"
+	OrganizationService_InviteOrganizationMember_FullMethodName     = "/v1.OrganizationService/InviteOrganizationMember"
+	OrganizationService_AcceptOrganizationInvitation_FullMethodName = "/v1.OrganizationService/AcceptOrganizationInvitation"
+	OrganizationService_UpdateOrganizationMember_FullMethodName     = "/v1.OrganizationService/UpdateOrganizationMember"
+	OrganizationService_RemoveOrganizationMember_FullMethodName     = "/v1.OrganizationService/RemoveOrganizationMember"
 )
 
-// PromptServiceClient is the client API for PromptService service.
+// OrganizationServiceClient is the client API for OrganizationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// PromptService defines the RPC methods for managing templates and prompts.
-type PromptServiceClient interface {
-	// CreateTemplate creates a new prompt template.
-	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
-	// UpdateTemplate updates an existing template, creating a new version.
-	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
-	// GetTemplate retrieves a template by ID.
-	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
-	// ListTemplates lists templates with optional filtering.
-	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
-	// DeleteTemplate deletes a template.
-	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
-	// ToggleLikeTemplate toggles the like status of a template for the current user.
-	ToggleLikeTemplate(ctx context.Context, in *ToggleLikeRequest, opts ...grpc.CallOption) (*ToggleLikeResponse, error)
-	// ToggleFavoriteTemplate toggles the favorite status of a template for the current user.
-	ToggleFavoriteTemplate(ctx context.Context, in *ToggleFavoriteRequest, opts ...grpc.CallOption) (*ToggleFavoriteResponse, error)
-	// CreatePrompt creates a new prompt instance from a template.
-	CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error)
-	// GetPrompt retrieves a prompt by ID.
-	GetPrompt(ctx context.Context, in *GetPromptRequest, opts ...grpc.CallOption) (*GetPromptResponse, error)
-	// DeletePrompt deletes a prompt.
-	DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error)
-	// ListCategories lists all categories with their template counts.
-	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
-	// ListTags lists all tags with their template counts.
-	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
-	// ListTemplateVersions lists all versions of a template.
-	ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error)
+// OrganizationService defines the RPC methods for managing organizations,
+// their members and invitations.
+type OrganizationServiceClient interface {
+	// CreateOrganization creates an organization owned by the current user.
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// GetOrganization retrieves an organization the current user is a member of.
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	// ListOrganizations lists the organizations the current user is a member of.
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// ListOrganizationMembers lists the members of an organization.
+	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+	// InviteOrganizationMember emails an invitation to join an organization. Admins and owners only.
+	InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error)
+	// AcceptOrganizationInvitation adds the current user to the organization of an invitation.
+	AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error)
+	// UpdateOrganizationMember changes the role of a member. Owners only.
+	UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error)
+	// RemoveOrganizationMember removes a member from an organization, or lets the current user leave it.
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
 }
 
-type promptServiceClient struct {
+type organizationServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPromptServiceClient(cc grpc.ClientConnInterface) PromptServiceClient {
-	return &promptServiceClient{cc}
-}
-
-func (c *promptServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateTemplateResponse)
-	err := c.cc.Invoke(ctx, PromptService_CreateTemplate_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *promptServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateTemplateResponse)
-	err := c.cc.Invoke(ctx, PromptService_UpdateTemplate_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *promptServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTemplateResponse)
-	err := c.cc.Invoke(ctx, PromptService_GetTemplate_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *promptServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTemplatesResponse)
-	err := c.cc.Invoke(ctx, PromptService_ListTemplates_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *promptServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteTemplateResponse)
-	err := c.cc.Invoke(ctx, PromptService_DeleteTemplate_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
 }
 
-func (c *promptServiceClient) ToggleLikeTemplate(ctx context.Context, in *ToggleLikeRequest, opts ...grpc.CallOption) (*ToggleLikeResponse, error) {
+func (c *organizationServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ToggleLikeResponse)
-	err := c.cc.Invoke(ctx, PromptService_ToggleLikeTemplate_FullMethodName, in, out, cOpts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateOrganization_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) ToggleFavoriteTemplate(ctx context.Context, in *ToggleFavoriteRequest, opts ...grpc.CallOption) (*ToggleFavoriteResponse, error) {
+func (c *organizationServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ToggleFavoriteResponse)
-	err := c.cc.Invoke(ctx, PromptService_ToggleFavoriteTemplate_FullMethodName, in, out, cOpts...)
+	out := new(GetOrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganization_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error) {
+func (c *organizationServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreatePromptResponse)
-	err := c.cc.Invoke(ctx, PromptService_CreatePrompt_FullMethodName, in, out, cOpts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListOrganizations_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) GetPrompt(ctx context.Context, in *GetPromptRequest, opts ...grpc.CallOption) (*GetPromptResponse, error) {
+func (c *organizationServiceClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPromptResponse)
-	err := c.cc.Invoke(ctx, PromptService_GetPrompt_FullMethodName, in, out, cOpts...)
+	out := new(ListOrganizationMembersResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListOrganizationMembers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error) {
+func (c *organizationServiceClient) InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeletePromptResponse)
-	err := c.cc.Invoke(ctx, PromptService_DeletePrompt_FullMethodName, in, out, cOpts...)
+	out := new(InviteOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_InviteOrganizationMember_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+func (c *organizationServiceClient) AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListCategoriesResponse)
-	err := c.cc.Invoke(ctx, PromptService_ListCategories_FullMethodName, in, out, cOpts...)
+	out := new(AcceptOrganizationInvitationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_AcceptOrganizationInvitation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+func (c *organizationServiceClient) UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTagsResponse)
-	err := c.cc.Invoke(ctx, PromptService_ListTags_FullMethodName, in, out, cOpts...)
+	out := new(UpdateOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_UpdateOrganizationMember_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *promptServiceClient) ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error) {
+func (c *organizationServiceClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTemplateVersionsResponse)
-	err := c.cc.Invoke(ctx, PromptService_ListTemplateVersions_FullMethodName, in, out, cOpts...)
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveOrganizationMember_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PromptServiceServer is the server API for PromptService service.
-// All implementations must embed UnimplementedPromptServiceServer
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
 // for forward compatibility.
 //
-// PromptService defines the RPC methods for managing templates and prompts.
-type PromptServiceServer interface {
-	// CreateTemplate creates a new prompt template.
-	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
-	// UpdateTemplate updates an existing template, creating a new version.
-	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
-	// GetTemplate retrieves a template by ID.
-	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
-	// ListTemplates lists templates with optional filtering.
-	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
-	// DeleteTemplate deletes a template.
-	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
-	// ToggleLikeTemplate toggles the like status of a template for the current user.
-	ToggleLikeTemplate(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error)
-	// ToggleFavoriteTemplate toggles the favorite status of a template for the current user.
-	ToggleFavoriteTemplate(context.Context, *ToggleFavoriteRequest) (*ToggleFavoriteResponse, error)
-	// CreatePrompt creates a new prompt instance from a template.
-	CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error)
-	// GetPrompt retrieves a prompt by ID.
-	GetPrompt(context.Context, *GetPromptRequest) (*GetPromptResponse, error)
-	// DeletePrompt deletes a prompt.
-	DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error)
-	// ListCategories lists all categories with their template counts.
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	// ListTags lists all tags with their template counts.
-	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
-	// ListTemplateVersions lists all versions of a template.
-	ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error)
-	mustEmbedUnimplementedPromptServiceServer()
+// OrganizationService defines the RPC methods for managing organizations,
+// their members and invitations.
+type OrganizationServiceServer interface {
+	// CreateOrganization creates an organization owned by the current user.
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// GetOrganization retrieves an organization the current user is a member of.
+	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// ListOrganizations lists the organizations the current user is a member of.
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// ListOrganizationMembers lists the members of an organization.
+	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	// InviteOrganizationMember emails an invitation to join an organization. Admins and owners only.
+	InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error)
+	// AcceptOrganizationInvitation adds the current user to the organization of an invitation.
+	AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error)
+	// UpdateOrganizationMember changes the role of a member. Owners only.
+	UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error)
+	// RemoveOrganizationMember removes a member from an organization, or lets the current user leave it.
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
 }
 
-// UnimplementedPromptServiceServer must be embedded to have
+// UnimplementedOrganizationServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPromptServiceServer struct{}
+type UnimplementedOrganizationServiceServer struct{}
 
-func (UnimplementedPromptServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
-}
-func (UnimplementedPromptServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
-}
-func (UnimplementedPromptServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTemplate not implemented")
-}
-func (UnimplementedPromptServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
-}
-func (UnimplementedPromptServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
-}
-func (UnimplementedPromptServiceServer) ToggleLikeTemplate(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ToggleLikeTemplate not implemented")
+func (UnimplementedOrganizationServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOrganization not implemented")
 }
-func (UnimplementedPromptServiceServer) ToggleFavoriteTemplate(context.Context, *ToggleFavoriteRequest) (*ToggleFavoriteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ToggleFavoriteTemplate not implemented")
+func (UnimplementedOrganizationServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrganization not implemented")
 }
-func (UnimplementedPromptServiceServer) CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreatePrompt not implemented")
+func (UnimplementedOrganizationServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOrganizations not implemented")
 }
-func (UnimplementedPromptServiceServer) GetPrompt(context.Context, *GetPromptRequest) (*GetPromptResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetPrompt not implemented")
+func (UnimplementedOrganizationServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
-func (UnimplementedPromptServiceServer) DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeletePrompt not implemented")
+func (UnimplementedOrganizationServiceServer) InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteOrganizationMember not implemented")
 }
-func (UnimplementedPromptServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+func (UnimplementedOrganizationServiceServer) AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptOrganizationInvitation not implemented")
 }
-func (UnimplementedPromptServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateOrganizationMember not implemented")
 }
-func (UnimplementedPromptServiceServer) ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTemplateVersions not implemented")
+func (UnimplementedOrganizationServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
 }
-func (UnimplementedPromptServiceServer) mustEmbedUnimplementedPromptServiceServer() {}
-func (UnimplementedPromptServiceServer) testEmbeddedByValue()                       {}
+func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
+func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafePromptServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PromptServiceServer will
+// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
 // result in compilation errors.
-type UnsafePromptServiceServer interface {
-	mustEmbedUnimplementedPromptServiceServer()
+type UnsafeOrganizationServiceServer interface {
+	mustEmbedUnimplementedOrganizationServiceServer()
 }
 
-func RegisterPromptServiceServer(s grpc.ServiceRegistrar, srv PromptServiceServer) {
-	// If the following call panics, it indicates UnimplementedPromptServiceServer was
+func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
+	// If the following call panics, it indicates UnimplementedOrganizationServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PromptService_ServiceDesc, srv)
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
 }
 
-func _PromptService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateRequest)
+func _OrganizationService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).CreateTemplate(ctx, in)
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_CreateTemplate_FullMethodName,
+		FullMethod: OrganizationService_CreateOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateRequest)
+func _OrganizationService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).UpdateTemplate(ctx, in)
+		return srv.(OrganizationServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListOrganizationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListOrganizationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_InviteOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).InviteOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_InviteOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).InviteOrganizationMember(ctx, req.(*InviteOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AcceptOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AcceptOrganizationInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AcceptOrganizationInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AcceptOrganizationInvitation(ctx, req.(*AcceptOrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationMember(ctx, req.(*UpdateOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.OrganizationService",
+	HandlerType: (*OrganizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _OrganizationService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _OrganizationService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _OrganizationService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "ListOrganizationMembers",
+			Handler:    _OrganizationService_ListOrganizationMembers_Handler,
+		},
+		{
+			MethodName: "InviteOrganizationMember",
+			Handler:    _OrganizationService_InviteOrganizationMember_Handler,
+		},
+		{
+			MethodName: "AcceptOrganizationInvitation",
+			Handler:    _OrganizationService_AcceptOrganizationInvitation_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationMember",
+			Handler:    _OrganizationService_UpdateOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _OrganizationService_RemoveOrganizationMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prompt.proto",
+}
+
+const (
+	AdminService_ListUsers_FullMethodName                 = "/v1.AdminService/ListUsers"
+	AdminService_SuspendUser_FullMethodName               = "/v1.AdminService/SuspendUser"
+	AdminService_ReinstateUser_FullMethodName             = "/v1.AdminService/ReinstateUser"
+	AdminService_TransferTemplateOwnership_FullMethodName = "/v1.AdminService/TransferTemplateOwnership"
+	AdminService_SetTemplateFeatured_FullMethodName       = "/v1.AdminService/SetTemplateFeatured"
+	AdminService_ListModerationQueue_FullMethodName       = "/v1.AdminService/ListModerationQueue"
+	AdminService_ModerateTemplate_FullMethodName          = "/v1.AdminService/ModerateTemplate"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService defines the RPC methods reserved to administrators.
+type AdminServiceClient interface {
+	// ListUsers lists and searches user accounts.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// SuspendUser prevents a user from signing in.
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
+	// ReinstateUser lifts the suspension of a user.
+	ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ReinstateUserResponse, error)
+	// TransferTemplateOwnership gives a template to another user.
+	TransferTemplateOwnership(ctx context.Context, in *TransferTemplateOwnershipRequest, opts ...grpc.CallOption) (*TransferTemplateOwnershipResponse, error)
+	// SetTemplateFeatured features a public template, or stops featuring it.
+	SetTemplateFeatured(ctx context.Context, in *SetTemplateFeaturedRequest, opts ...grpc.CallOption) (*SetTemplateFeaturedResponse, error)
+	// ListModerationQueue lists the templates with open reports, longest waiting first.
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	// ModerateTemplate resolves the open reports of a template by dismissing them,
+	// hiding the template or removing it and warning its owner.
+	ModerateTemplate(ctx context.Context, in *ModerateTemplateRequest, opts ...grpc.CallOption) (*ModerateTemplateResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ReinstateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReinstateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReinstateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TransferTemplateOwnership(ctx context.Context, in *TransferTemplateOwnershipRequest, opts ...grpc.CallOption) (*TransferTemplateOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferTemplateOwnershipResponse)
+	err := c.cc.Invoke(ctx, AdminService_TransferTemplateOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetTemplateFeatured(ctx context.Context, in *SetTemplateFeaturedRequest, opts ...grpc.CallOption) (*SetTemplateFeaturedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTemplateFeaturedResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetTemplateFeatured_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ModerateTemplate(ctx context.Context, in *ModerateTemplateRequest, opts ...grpc.CallOption) (*ModerateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_ModerateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService defines the RPC methods reserved to administrators.
+type AdminServiceServer interface {
+	// ListUsers lists and searches user accounts.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// SuspendUser prevents a user from signing in.
+	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
+	// ReinstateUser lifts the suspension of a user.
+	ReinstateUser(context.Context, *ReinstateUserRequest) (*ReinstateUserResponse, error)
+	// TransferTemplateOwnership gives a template to another user.
+	TransferTemplateOwnership(context.Context, *TransferTemplateOwnershipRequest) (*TransferTemplateOwnershipResponse, error)
+	// SetTemplateFeatured features a public template, or stops featuring it.
+	SetTemplateFeatured(context.Context, *SetTemplateFeaturedRequest) (*SetTemplateFeaturedResponse, error)
+	// ListModerationQueue lists the templates with open reports, longest waiting first.
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	// ModerateTemplate resolves the open reports of a template by dismissing them,
+	// hiding the template or removing it and warning its owner.
+	ModerateTemplate(context.Context, *ModerateTemplateRequest) (*ModerateTemplateResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ReinstateUser(context.Context, *ReinstateUserRequest) (*ReinstateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReinstateUser not implemented")
+}
+func (UnimplementedAdminServiceServer) TransferTemplateOwnership(context.Context, *TransferTemplateOwnershipRequest) (*TransferTemplateOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferTemplateOwnership not implemented")
+}
+func (UnimplementedAdminServiceServer) SetTemplateFeatured(context.Context, *SetTemplateFeaturedRequest) (*SetTemplateFeaturedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTemplateFeatured not implemented")
+}
+func (UnimplementedAdminServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) ModerateTemplate(context.Context, *ModerateTemplateRequest) (*ModerateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModerateTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReinstateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReinstateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReinstateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReinstateUser(ctx, req.(*ReinstateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TransferTemplateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTemplateOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TransferTemplateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TransferTemplateOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TransferTemplateOwnership(ctx, req.(*TransferTemplateOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetTemplateFeatured_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTemplateFeaturedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetTemplateFeatured(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetTemplateFeatured_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetTemplateFeatured(ctx, req.(*SetTemplateFeaturedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ModerateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ModerateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ModerateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ModerateTemplate(ctx, req.(*ModerateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _AdminService_ListUsers_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _AdminService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ReinstateUser",
+			Handler:    _AdminService_ReinstateUser_Handler,
+		},
+		{
+			MethodName: "TransferTemplateOwnership",
+			Handler:    _AdminService_TransferTemplateOwnership_Handler,
+		},
+		{
+			MethodName: "SetTemplateFeatured",
+			Handler:    _AdminService_SetTemplateFeatured_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _AdminService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ModerateTemplate",
+			Handler:    _AdminService_ModerateTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prompt.proto",
+}
+
+const (
+	NotificationService_ListNotifications_FullMethodName = "/v1.NotificationService/ListNotifications"
+	NotificationService_MarkRead_FullMethodName          = "/v1.NotificationService/MarkRead"
+	NotificationService_MarkAllRead_FullMethodName       = "/v1.NotificationService/MarkAllRead"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationService defines the RPC methods of the caller's notification inbox.
+type NotificationServiceClient interface {
+	// ListNotifications lists the caller's notifications, latest activity first.
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// MarkRead marks some of the caller's notifications as read.
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	// MarkAllRead marks all of the caller's notifications as read.
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAllReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkAllRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// NotificationService defines the RPC methods of the caller's notification inbox.
+type NotificationServiceServer interface {
+	// ListNotifications lists the caller's notifications, latest activity first.
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// MarkRead marks some of the caller's notifications as read.
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
+	// MarkAllRead marks all of the caller's notifications as read.
+	MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkAllRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call panics, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkAllRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, req.(*MarkAllReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListNotifications",
+			Handler:    _NotificationService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _NotificationService_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _NotificationService_MarkAllRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prompt.proto",
+}
+
+const (
+	AuditService_ListAuditEvents_FullMethodName = "/v1.AuditService/ListAuditEvents"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditService defines the RPC methods for reading the audit log.
+type AuditServiceClient interface {
+	// ListAuditEvents lists audit events, latest first. Administrators see every
+	// event; other users see the events about resources they own and the ones
+	// they performed themselves.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// AuditService defines the RPC methods for reading the audit log.
+type AuditServiceServer interface {
+	// ListAuditEvents lists audit events, latest first. Administrators see every
+	// event; other users see the events about resources they own and the ones
+	// they performed themselves.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prompt.proto",
+}
+
+const (
+	PromptService_CreateTemplate_FullMethodName               = "/v1.PromptService/CreateTemplate"
+	PromptService_UpdateTemplate_FullMethodName               = "/v1.PromptService/UpdateTemplate"
+	PromptService_GetTemplate_FullMethodName                  = "/v1.PromptService/GetTemplate"
+	PromptService_ListTemplates_FullMethodName                = "/v1.PromptService/ListTemplates"
+	PromptService_DeleteTemplate_FullMethodName               = "/v1.PromptService/DeleteTemplate"
+	PromptService_ToggleLikeTemplate_FullMethodName           = "/v1.PromptService/ToggleLikeTemplate"
+	PromptService_ToggleFavoriteTemplate_FullMethodName       = "/v1.PromptService/ToggleFavoriteTemplate"
+	PromptService_CreatePrompt_FullMethodName                 = "/v1.PromptService/CreatePrompt"
+	PromptService_GetPrompt_FullMethodName                    = "/v1.PromptService/GetPrompt"
+	PromptService_ListPrompts_FullMethodName                  = "/v1.PromptService/ListPrompts"
+	PromptService_UpdatePrompt_FullMethodName                 = "/v1.PromptService/UpdatePrompt"
+	PromptService_DuplicatePrompt_FullMethodName              = "/v1.PromptService/DuplicatePrompt"
+	PromptService_DeletePrompt_FullMethodName                 = "/v1.PromptService/DeletePrompt"
+	PromptService_RenderPrompt_FullMethodName                 = "/v1.PromptService/RenderPrompt"
+	PromptService_CreateVariablePreset_FullMethodName         = "/v1.PromptService/CreateVariablePreset"
+	PromptService_UpdateVariablePreset_FullMethodName         = "/v1.PromptService/UpdateVariablePreset"
+	PromptService_DeleteVariablePreset_FullMethodName         = "/v1.PromptService/DeleteVariablePreset"
+	PromptService_ListVariablePresets_FullMethodName          = "/v1.PromptService/ListVariablePresets"
+	PromptService_ExecutePrompt_FullMethodName                = "/v1.PromptService/ExecutePrompt"
+	PromptService_StreamExecutePrompt_FullMethodName          = "/v1.PromptService/StreamExecutePrompt"
+	PromptService_ListExecutions_FullMethodName               = "/v1.PromptService/ListExecutions"
+	PromptService_CreateEvaluationCase_FullMethodName         = "/v1.PromptService/CreateEvaluationCase"
+	PromptService_UpdateEvaluationCase_FullMethodName         = "/v1.PromptService/UpdateEvaluationCase"
+	PromptService_DeleteEvaluationCase_FullMethodName         = "/v1.PromptService/DeleteEvaluationCase"
+	PromptService_ListEvaluationCases_FullMethodName          = "/v1.PromptService/ListEvaluationCases"
+	PromptService_RunEvaluation_FullMethodName                = "/v1.PromptService/RunEvaluation"
+	PromptService_ListEvaluationRuns_FullMethodName           = "/v1.PromptService/ListEvaluationRuns"
+	PromptService_CompareEvaluationRuns_FullMethodName        = "/v1.PromptService/CompareEvaluationRuns"
+	PromptService_ListCategories_FullMethodName               = "/v1.PromptService/ListCategories"
+	PromptService_ListTags_FullMethodName                     = "/v1.PromptService/ListTags"
+	PromptService_ListTemplateVersions_FullMethodName         = "/v1.PromptService/ListTemplateVersions"
+	PromptService_ListTrendingTemplates_FullMethodName        = "/v1.PromptService/ListTrendingTemplates"
+	PromptService_CreateCollection_FullMethodName             = "/v1.PromptService/CreateCollection"
+	PromptService_GetCollection_FullMethodName                = "/v1.PromptService/GetCollection"
+	PromptService_UpdateCollection_FullMethodName             = "/v1.PromptService/UpdateCollection"
+	PromptService_DeleteCollection_FullMethodName             = "/v1.PromptService/DeleteCollection"
+	PromptService_ListCollections_FullMethodName              = "/v1.PromptService/ListCollections"
+	PromptService_AddTemplateToCollection_FullMethodName      = "/v1.PromptService/AddTemplateToCollection"
+	PromptService_RemoveTemplateFromCollection_FullMethodName = "/v1.PromptService/RemoveTemplateFromCollection"
+	PromptService_ReorderCollection_FullMethodName            = "/v1.PromptService/ReorderCollection"
+	PromptService_ToggleFollowCollection_FullMethodName       = "/v1.PromptService/ToggleFollowCollection"
+	PromptService_GrantTemplateAccess_FullMethodName          = "/v1.PromptService/GrantTemplateAccess"
+	PromptService_RevokeTemplateAccess_FullMethodName         = "/v1.PromptService/RevokeTemplateAccess"
+	PromptService_ListTemplateGrants_FullMethodName           = "/v1.PromptService/ListTemplateGrants"
+	PromptService_CreateShareLink_FullMethodName              = "/v1.PromptService/CreateShareLink"
+	PromptService_ListShareLinks_FullMethodName               = "/v1.PromptService/ListShareLinks"
+	PromptService_RevokeShareLink_FullMethodName              = "/v1.PromptService/RevokeShareLink"
+	PromptService_ReportTemplate_FullMethodName               = "/v1.PromptService/ReportTemplate"
+	PromptService_CreateComment_FullMethodName                = "/v1.PromptService/CreateComment"
+	PromptService_UpdateComment_FullMethodName                = "/v1.PromptService/UpdateComment"
+	PromptService_DeleteComment_FullMethodName                = "/v1.PromptService/DeleteComment"
+	PromptService_ListComments_FullMethodName                 = "/v1.PromptService/ListComments"
+	PromptService_RateTemplate_FullMethodName                 = "/v1.PromptService/RateTemplate"
+	PromptService_DeleteReview_FullMethodName                 = "/v1.PromptService/DeleteReview"
+	PromptService_ListReviews_FullMethodName                  = "/v1.PromptService/ListReviews"
+	PromptService_GetFeed_FullMethodName                      = "/v1.PromptService/GetFeed"
+	PromptService_RecordTemplateEvent_FullMethodName          = "/v1.PromptService/RecordTemplateEvent"
+	PromptService_GetTemplateStats_FullMethodName             = "/v1.PromptService/GetTemplateStats"
+)
+
+// PromptServiceClient is the client API for PromptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PromptService defines the RPC methods for managing templates and prompts.
+type PromptServiceClient interface {
+	// CreateTemplate creates a new prompt template.
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	// UpdateTemplate updates an existing template, creating a new version.
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
+	// GetTemplate retrieves a template by ID.
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	// ListTemplates lists templates with optional filtering.
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// DeleteTemplate deletes a template.
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	// ToggleLikeTemplate toggles the like status of a template for the current user.
+	ToggleLikeTemplate(ctx context.Context, in *ToggleLikeRequest, opts ...grpc.CallOption) (*ToggleLikeResponse, error)
+	// ToggleFavoriteTemplate toggles the favorite status of a template for the current user.
+	ToggleFavoriteTemplate(ctx context.Context, in *ToggleFavoriteRequest, opts ...grpc.CallOption) (*ToggleFavoriteResponse, error)
+	// CreatePrompt creates a new prompt instance from a template.
+	CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error)
+	// GetPrompt retrieves a prompt by ID.
+	GetPrompt(ctx context.Context, in *GetPromptRequest, opts ...grpc.CallOption) (*GetPromptResponse, error)
+	// ListPrompts lists the caller's saved prompts, newest first.
+	ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error)
+	// UpdatePrompt updates the title, notes, tags and pinned state of a prompt.
+	UpdatePrompt(ctx context.Context, in *UpdatePromptRequest, opts ...grpc.CallOption) (*UpdatePromptResponse, error)
+	// DuplicatePrompt re-instantiates a prompt against the latest version of its template.
+	DuplicatePrompt(ctx context.Context, in *DuplicatePromptRequest, opts ...grpc.CallOption) (*DuplicatePromptResponse, error)
+	// DeletePrompt deletes a prompt.
+	DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error)
+	// RenderPrompt renders a version of a template without saving a prompt.
+	RenderPrompt(ctx context.Context, in *RenderPromptRequest, opts ...grpc.CallOption) (*RenderPromptResponse, error)
+	// CreateVariablePreset creates a named set of placeholder values for the
+	// caller or, for its admins and owners, for an organization.
+	CreateVariablePreset(ctx context.Context, in *CreateVariablePresetRequest, opts ...grpc.CallOption) (*CreateVariablePresetResponse, error)
+	// UpdateVariablePreset replaces the name and values of a preset.
+	UpdateVariablePreset(ctx context.Context, in *UpdateVariablePresetRequest, opts ...grpc.CallOption) (*UpdateVariablePresetResponse, error)
+	// DeleteVariablePreset deletes a preset.
+	DeleteVariablePreset(ctx context.Context, in *DeleteVariablePresetRequest, opts ...grpc.CallOption) (*DeleteVariablePresetResponse, error)
+	// ListVariablePresets lists the caller's presets or the presets of an organization, by name.
+	ListVariablePresets(ctx context.Context, in *ListVariablePresetsRequest, opts ...grpc.CallOption) (*ListVariablePresetsResponse, error)
+	// ExecutePrompt runs a rendered text or a saved prompt on a language model
+	// and stores the run.
+	ExecutePrompt(ctx context.Context, in *ExecutePromptRequest, opts ...grpc.CallOption) (*ExecutePromptResponse, error)
+	// StreamExecutePrompt runs a prompt like ExecutePrompt, streaming the text
+	// as it is generated. The last message holds the stored run.
+	StreamExecutePrompt(ctx context.Context, in *ExecutePromptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecutePromptChunk], error)
+	// ListExecutions lists the caller's runs, latest first.
+	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
+	// CreateEvaluationCase adds a test case to a template.
+	CreateEvaluationCase(ctx context.Context, in *CreateEvaluationCaseRequest, opts ...grpc.CallOption) (*CreateEvaluationCaseResponse, error)
+	// UpdateEvaluationCase replaces the name, values and assertions of a test case.
+	UpdateEvaluationCase(ctx context.Context, in *UpdateEvaluationCaseRequest, opts ...grpc.CallOption) (*UpdateEvaluationCaseResponse, error)
+	// DeleteEvaluationCase deletes a test case. The results of past runs are kept.
+	DeleteEvaluationCase(ctx context.Context, in *DeleteEvaluationCaseRequest, opts ...grpc.CallOption) (*DeleteEvaluationCaseResponse, error)
+	// ListEvaluationCases lists the test cases of a template.
+	ListEvaluationCases(ctx context.Context, in *ListEvaluationCasesRequest, opts ...grpc.CallOption) (*ListEvaluationCasesResponse, error)
+	// RunEvaluation runs the test cases of a template on a version, or on every
+	// version, and stores the results.
+	RunEvaluation(ctx context.Context, in *RunEvaluationRequest, opts ...grpc.CallOption) (*RunEvaluationResponse, error)
+	// ListEvaluationRuns lists the runs of the test cases of a template, latest first.
+	ListEvaluationRuns(ctx context.Context, in *ListEvaluationRunsRequest, opts ...grpc.CallOption) (*ListEvaluationRunsResponse, error)
+	// CompareEvaluationRuns puts the latest runs of two versions of a template
+	// side by side, case by case.
+	CompareEvaluationRuns(ctx context.Context, in *CompareEvaluationRunsRequest, opts ...grpc.CallOption) (*CompareEvaluationRunsResponse, error)
+	// ListCategories lists all categories with their template counts.
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// ListTags lists all tags with their template counts.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// ListTemplateVersions lists all versions of a template.
+	ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error)
+	// ListTrendingTemplates lists the public templates that are trending now, optionally per category.
+	ListTrendingTemplates(ctx context.Context, in *ListTrendingTemplatesRequest, opts ...grpc.CallOption) (*ListTrendingTemplatesResponse, error)
+	// CreateCollection creates a new collection for the current user.
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	// GetCollection retrieves a collection by ID.
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	// UpdateCollection updates the metadata of a collection.
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	// DeleteCollection deletes a collection. The templates it holds are not affected.
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	// ListCollections lists a user's collections, the collections the current user follows, or all public collections.
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// AddTemplateToCollection appends a template to a collection.
+	AddTemplateToCollection(ctx context.Context, in *AddTemplateToCollectionRequest, opts ...grpc.CallOption) (*AddTemplateToCollectionResponse, error)
+	// RemoveTemplateFromCollection removes a template from a collection.
+	RemoveTemplateFromCollection(ctx context.Context, in *RemoveTemplateFromCollectionRequest, opts ...grpc.CallOption) (*RemoveTemplateFromCollectionResponse, error)
+	// ReorderCollection sets the order of the templates in a collection.
+	ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error)
+	// ToggleFollowCollection toggles whether the current user follows a public collection.
+	ToggleFollowCollection(ctx context.Context, in *ToggleFollowCollectionRequest, opts ...grpc.CallOption) (*ToggleFollowCollectionResponse, error)
+	// GrantTemplateAccess shares a template with a user as viewer or editor, replacing any previous grant.
+	GrantTemplateAccess(ctx context.Context, in *GrantTemplateAccessRequest, opts ...grpc.CallOption) (*GrantTemplateAccessResponse, error)
+	// RevokeTemplateAccess removes a user's grant on a template.
+	RevokeTemplateAccess(ctx context.Context, in *RevokeTemplateAccessRequest, opts ...grpc.CallOption) (*RevokeTemplateAccessResponse, error)
+	// ListTemplateGrants lists the users a template is shared with.
+	ListTemplateGrants(ctx context.Context, in *ListTemplateGrantsRequest, opts ...grpc.CallOption) (*ListTemplateGrantsResponse, error)
+	// CreateShareLink creates a secret link giving read access to a template.
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	// ListShareLinks lists the share links of a template. Tokens are not returned.
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	// RevokeShareLink revokes a share link.
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	// ReportTemplate flags a template for review by the administrators.
+	// Each user can report a template once.
+	ReportTemplate(ctx context.Context, in *ReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplateResponse, error)
+	// CreateComment posts a comment on a template, or a reply to one of its comments.
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	// UpdateComment edits the body of the caller's own comment.
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	// DeleteComment deletes a comment. Authors, the template owner and administrators may delete it.
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// ListComments lists the top-level comments of a template, or the replies to a comment, oldest first.
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// RateTemplate rates a template from 1 to 5 stars with an optional written review.
+	// Each user has one review per template; rating again replaces it.
+	RateTemplate(ctx context.Context, in *RateTemplateRequest, opts ...grpc.CallOption) (*RateTemplateResponse, error)
+	// DeleteReview deletes the caller's review of a template.
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	// ListReviews lists the reviews of a template, most recently updated first.
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// GetFeed lists the recent activity of the users the current user follows, latest first.
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	// RecordTemplateEvent records a usage event that happens on the client, such
+	// as rendering a template or copying it, once an hour per caller. Views and
+	// instantiations are recorded by the server.
+	RecordTemplateEvent(ctx context.Context, in *RecordTemplateEventRequest, opts ...grpc.CallOption) (*RecordTemplateEventResponse, error)
+	// GetTemplateStats returns the daily usage of a template. Owners only.
+	GetTemplateStats(ctx context.Context, in *GetTemplateStatsRequest, opts ...grpc.CallOption) (*GetTemplateStatsResponse, error)
+}
+
+type promptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromptServiceClient(cc grpc.ClientConnInterface) PromptServiceClient {
+	return &promptServiceClient{cc}
+}
+
+func (c *promptServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ToggleLikeTemplate(ctx context.Context, in *ToggleLikeRequest, opts ...grpc.CallOption) (*ToggleLikeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToggleLikeResponse)
+	err := c.cc.Invoke(ctx, PromptService_ToggleLikeTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ToggleFavoriteTemplate(ctx context.Context, in *ToggleFavoriteRequest, opts ...grpc.CallOption) (*ToggleFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToggleFavoriteResponse)
+	err := c.cc.Invoke(ctx, PromptService_ToggleFavoriteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreatePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GetPrompt(ctx context.Context, in *GetPromptRequest, opts ...grpc.CallOption) (*GetPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_GetPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdatePrompt(ctx context.Context, in *UpdatePromptRequest, opts ...grpc.CallOption) (*UpdatePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdatePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DuplicatePrompt(ctx context.Context, in *DuplicatePromptRequest, opts ...grpc.CallOption) (*DuplicatePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicatePromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_DuplicatePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeletePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RenderPrompt(ctx context.Context, in *RenderPromptRequest, opts ...grpc.CallOption) (*RenderPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderPromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_RenderPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreateVariablePreset(ctx context.Context, in *CreateVariablePresetRequest, opts ...grpc.CallOption) (*CreateVariablePresetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVariablePresetResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateVariablePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdateVariablePreset(ctx context.Context, in *UpdateVariablePresetRequest, opts ...grpc.CallOption) (*UpdateVariablePresetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVariablePresetResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdateVariablePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteVariablePreset(ctx context.Context, in *DeleteVariablePresetRequest, opts ...grpc.CallOption) (*DeleteVariablePresetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVariablePresetResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteVariablePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListVariablePresets(ctx context.Context, in *ListVariablePresetsRequest, opts ...grpc.CallOption) (*ListVariablePresetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariablePresetsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListVariablePresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ExecutePrompt(ctx context.Context, in *ExecutePromptRequest, opts ...grpc.CallOption) (*ExecutePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecutePromptResponse)
+	err := c.cc.Invoke(ctx, PromptService_ExecutePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) StreamExecutePrompt(ctx context.Context, in *ExecutePromptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecutePromptChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PromptService_ServiceDesc.Streams[0], PromptService_StreamExecutePrompt_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecutePromptRequest, ExecutePromptChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PromptService_StreamExecutePromptClient = grpc.ServerStreamingClient[ExecutePromptChunk]
+
+func (c *promptServiceClient) ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExecutionsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreateEvaluationCase(ctx context.Context, in *CreateEvaluationCaseRequest, opts ...grpc.CallOption) (*CreateEvaluationCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEvaluationCaseResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateEvaluationCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdateEvaluationCase(ctx context.Context, in *UpdateEvaluationCaseRequest, opts ...grpc.CallOption) (*UpdateEvaluationCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEvaluationCaseResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdateEvaluationCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteEvaluationCase(ctx context.Context, in *DeleteEvaluationCaseRequest, opts ...grpc.CallOption) (*DeleteEvaluationCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEvaluationCaseResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteEvaluationCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListEvaluationCases(ctx context.Context, in *ListEvaluationCasesRequest, opts ...grpc.CallOption) (*ListEvaluationCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEvaluationCasesResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListEvaluationCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RunEvaluation(ctx context.Context, in *RunEvaluationRequest, opts ...grpc.CallOption) (*RunEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunEvaluationResponse)
+	err := c.cc.Invoke(ctx, PromptService_RunEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListEvaluationRuns(ctx context.Context, in *ListEvaluationRunsRequest, opts ...grpc.CallOption) (*ListEvaluationRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEvaluationRunsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListEvaluationRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CompareEvaluationRuns(ctx context.Context, in *CompareEvaluationRunsRequest, opts ...grpc.CallOption) (*CompareEvaluationRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareEvaluationRunsResponse)
+	err := c.cc.Invoke(ctx, PromptService_CompareEvaluationRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListTemplateVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListTrendingTemplates(ctx context.Context, in *ListTrendingTemplatesRequest, opts ...grpc.CallOption) (*ListTrendingTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingTemplatesResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListTrendingTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) AddTemplateToCollection(ctx context.Context, in *AddTemplateToCollectionRequest, opts ...grpc.CallOption) (*AddTemplateToCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTemplateToCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_AddTemplateToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RemoveTemplateFromCollection(ctx context.Context, in *RemoveTemplateFromCollectionRequest, opts ...grpc.CallOption) (*RemoveTemplateFromCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTemplateFromCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_RemoveTemplateFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_ReorderCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ToggleFollowCollection(ctx context.Context, in *ToggleFollowCollectionRequest, opts ...grpc.CallOption) (*ToggleFollowCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToggleFollowCollectionResponse)
+	err := c.cc.Invoke(ctx, PromptService_ToggleFollowCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GrantTemplateAccess(ctx context.Context, in *GrantTemplateAccessRequest, opts ...grpc.CallOption) (*GrantTemplateAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantTemplateAccessResponse)
+	err := c.cc.Invoke(ctx, PromptService_GrantTemplateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RevokeTemplateAccess(ctx context.Context, in *RevokeTemplateAccessRequest, opts ...grpc.CallOption) (*RevokeTemplateAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTemplateAccessResponse)
+	err := c.cc.Invoke(ctx, PromptService_RevokeTemplateAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListTemplateGrants(ctx context.Context, in *ListTemplateGrantsRequest, opts ...grpc.CallOption) (*ListTemplateGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplateGrantsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListTemplateGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, PromptService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ReportTemplate(ctx context.Context, in *ReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_ReportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, PromptService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, PromptService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RateTemplate(ctx context.Context, in *RateTemplateRequest, opts ...grpc.CallOption) (*RateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptService_RateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, PromptService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, PromptService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PromptService_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) RecordTemplateEvent(ctx context.Context, in *RecordTemplateEventRequest, opts ...grpc.CallOption) (*RecordTemplateEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordTemplateEventResponse)
+	err := c.cc.Invoke(ctx, PromptService_RecordTemplateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptServiceClient) GetTemplateStats(ctx context.Context, in *GetTemplateStatsRequest, opts ...grpc.CallOption) (*GetTemplateStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplateStatsResponse)
+	err := c.cc.Invoke(ctx, PromptService_GetTemplateStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromptServiceServer is the server API for PromptService service.
+// All implementations must embed UnimplementedPromptServiceServer
+// for forward compatibility.
+//
+// PromptService defines the RPC methods for managing templates and prompts.
+type PromptServiceServer interface {
+	// CreateTemplate creates a new prompt template.
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	// UpdateTemplate updates an existing template, creating a new version.
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
+	// GetTemplate retrieves a template by ID.
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	// ListTemplates lists templates with optional filtering.
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// DeleteTemplate deletes a template.
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	// ToggleLikeTemplate toggles the like status of a template for the current user.
+	ToggleLikeTemplate(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error)
+	// ToggleFavoriteTemplate toggles the favorite status of a template for the current user.
+	ToggleFavoriteTemplate(context.Context, *ToggleFavoriteRequest) (*ToggleFavoriteResponse, error)
+	// CreatePrompt creates a new prompt instance from a template.
+	CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error)
+	// GetPrompt retrieves a prompt by ID.
+	GetPrompt(context.Context, *GetPromptRequest) (*GetPromptResponse, error)
+	// ListPrompts lists the caller's saved prompts, newest first.
+	ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error)
+	// UpdatePrompt updates the title, notes, tags and pinned state of a prompt.
+	UpdatePrompt(context.Context, *UpdatePromptRequest) (*UpdatePromptResponse, error)
+	// DuplicatePrompt re-instantiates a prompt against the latest version of its template.
+	DuplicatePrompt(context.Context, *DuplicatePromptRequest) (*DuplicatePromptResponse, error)
+	// DeletePrompt deletes a prompt.
+	DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error)
+	// RenderPrompt renders a version of a template without saving a prompt.
+	RenderPrompt(context.Context, *RenderPromptRequest) (*RenderPromptResponse, error)
+	// CreateVariablePreset creates a named set of placeholder values for the
+	// caller or, for its admins and owners, for an organization.
+	CreateVariablePreset(context.Context, *CreateVariablePresetRequest) (*CreateVariablePresetResponse, error)
+	// UpdateVariablePreset replaces the name and values of a preset.
+	UpdateVariablePreset(context.Context, *UpdateVariablePresetRequest) (*UpdateVariablePresetResponse, error)
+	// DeleteVariablePreset deletes a preset.
+	DeleteVariablePreset(context.Context, *DeleteVariablePresetRequest) (*DeleteVariablePresetResponse, error)
+	// ListVariablePresets lists the caller's presets or the presets of an organization, by name.
+	ListVariablePresets(context.Context, *ListVariablePresetsRequest) (*ListVariablePresetsResponse, error)
+	// ExecutePrompt runs a rendered text or a saved prompt on a language model
+	// and stores the run.
+	ExecutePrompt(context.Context, *ExecutePromptRequest) (*ExecutePromptResponse, error)
+	// StreamExecutePrompt runs a prompt like ExecutePrompt, streaming the text
+	// as it is generated. The last message holds the stored run.
+	StreamExecutePrompt(*ExecutePromptRequest, grpc.ServerStreamingServer[ExecutePromptChunk]) error
+	// ListExecutions lists the caller's runs, latest first.
+	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
+	// CreateEvaluationCase adds a test case to a template.
+	CreateEvaluationCase(context.Context, *CreateEvaluationCaseRequest) (*CreateEvaluationCaseResponse, error)
+	// UpdateEvaluationCase replaces the name, values and assertions of a test case.
+	UpdateEvaluationCase(context.Context, *UpdateEvaluationCaseRequest) (*UpdateEvaluationCaseResponse, error)
+	// DeleteEvaluationCase deletes a test case. The results of past runs are kept.
+	DeleteEvaluationCase(context.Context, *DeleteEvaluationCaseRequest) (*DeleteEvaluationCaseResponse, error)
+	// ListEvaluationCases lists the test cases of a template.
+	ListEvaluationCases(context.Context, *ListEvaluationCasesRequest) (*ListEvaluationCasesResponse, error)
+	// RunEvaluation runs the test cases of a template on a version, or on every
+	// version, and stores the results.
+	RunEvaluation(context.Context, *RunEvaluationRequest) (*RunEvaluationResponse, error)
+	// ListEvaluationRuns lists the runs of the test cases of a template, latest first.
+	ListEvaluationRuns(context.Context, *ListEvaluationRunsRequest) (*ListEvaluationRunsResponse, error)
+	// CompareEvaluationRuns puts the latest runs of two versions of a template
+	// side by side, case by case.
+	CompareEvaluationRuns(context.Context, *CompareEvaluationRunsRequest) (*CompareEvaluationRunsResponse, error)
+	// ListCategories lists all categories with their template counts.
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// ListTags lists all tags with their template counts.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// ListTemplateVersions lists all versions of a template.
+	ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error)
+	// ListTrendingTemplates lists the public templates that are trending now, optionally per category.
+	ListTrendingTemplates(context.Context, *ListTrendingTemplatesRequest) (*ListTrendingTemplatesResponse, error)
+	// CreateCollection creates a new collection for the current user.
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	// GetCollection retrieves a collection by ID.
+	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	// UpdateCollection updates the metadata of a collection.
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	// DeleteCollection deletes a collection. The templates it holds are not affected.
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	// ListCollections lists a user's collections, the collections the current user follows, or all public collections.
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// AddTemplateToCollection appends a template to a collection.
+	AddTemplateToCollection(context.Context, *AddTemplateToCollectionRequest) (*AddTemplateToCollectionResponse, error)
+	// RemoveTemplateFromCollection removes a template from a collection.
+	RemoveTemplateFromCollection(context.Context, *RemoveTemplateFromCollectionRequest) (*RemoveTemplateFromCollectionResponse, error)
+	// ReorderCollection sets the order of the templates in a collection.
+	ReorderCollection(context.Context, *ReorderCollectionRequest) (*ReorderCollectionResponse, error)
+	// ToggleFollowCollection toggles whether the current user follows a public collection.
+	ToggleFollowCollection(context.Context, *ToggleFollowCollectionRequest) (*ToggleFollowCollectionResponse, error)
+	// GrantTemplateAccess shares a template with a user as viewer or editor, replacing any previous grant.
+	GrantTemplateAccess(context.Context, *GrantTemplateAccessRequest) (*GrantTemplateAccessResponse, error)
+	// RevokeTemplateAccess removes a user's grant on a template.
+	RevokeTemplateAccess(context.Context, *RevokeTemplateAccessRequest) (*RevokeTemplateAccessResponse, error)
+	// ListTemplateGrants lists the users a template is shared with.
+	ListTemplateGrants(context.Context, *ListTemplateGrantsRequest) (*ListTemplateGrantsResponse, error)
+	// CreateShareLink creates a secret link giving read access to a template.
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	// ListShareLinks lists the share links of a template. Tokens are not returned.
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	// RevokeShareLink revokes a share link.
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	// ReportTemplate flags a template for review by the administrators.
+	// Each user can report a template once.
+	ReportTemplate(context.Context, *ReportTemplateRequest) (*ReportTemplateResponse, error)
+	// CreateComment posts a comment on a template, or a reply to one of its comments.
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	// UpdateComment edits the body of the caller's own comment.
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	// DeleteComment deletes a comment. Authors, the template owner and administrators may delete it.
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// ListComments lists the top-level comments of a template, or the replies to a comment, oldest first.
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// RateTemplate rates a template from 1 to 5 stars with an optional written review.
+	// Each user has one review per template; rating again replaces it.
+	RateTemplate(context.Context, *RateTemplateRequest) (*RateTemplateResponse, error)
+	// DeleteReview deletes the caller's review of a template.
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	// ListReviews lists the reviews of a template, most recently updated first.
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// GetFeed lists the recent activity of the users the current user follows, latest first.
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
+	// RecordTemplateEvent records a usage event that happens on the client, such
+	// as rendering a template or copying it, once an hour per caller. Views and
+	// instantiations are recorded by the server.
+	RecordTemplateEvent(context.Context, *RecordTemplateEventRequest) (*RecordTemplateEventResponse, error)
+	// GetTemplateStats returns the daily usage of a template. Owners only.
+	GetTemplateStats(context.Context, *GetTemplateStatsRequest) (*GetTemplateStatsResponse, error)
+	mustEmbedUnimplementedPromptServiceServer()
+}
+
+// UnimplementedPromptServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromptServiceServer struct{}
+
+func (UnimplementedPromptServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) ToggleLikeTemplate(context.Context, *ToggleLikeRequest) (*ToggleLikeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ToggleLikeTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) ToggleFavoriteTemplate(context.Context, *ToggleFavoriteRequest) (*ToggleFavoriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ToggleFavoriteTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) GetPrompt(context.Context, *GetPromptRequest) (*GetPromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPrompts not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdatePrompt(context.Context, *UpdatePromptRequest) (*UpdatePromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) DuplicatePrompt(context.Context, *DuplicatePromptRequest) (*DuplicatePromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicatePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) RenderPrompt(context.Context, *RenderPromptRequest) (*RenderPromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderPrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) CreateVariablePreset(context.Context, *CreateVariablePresetRequest) (*CreateVariablePresetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVariablePreset not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdateVariablePreset(context.Context, *UpdateVariablePresetRequest) (*UpdateVariablePresetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVariablePreset not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteVariablePreset(context.Context, *DeleteVariablePresetRequest) (*DeleteVariablePresetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVariablePreset not implemented")
+}
+func (UnimplementedPromptServiceServer) ListVariablePresets(context.Context, *ListVariablePresetsRequest) (*ListVariablePresetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVariablePresets not implemented")
+}
+func (UnimplementedPromptServiceServer) ExecutePrompt(context.Context, *ExecutePromptRequest) (*ExecutePromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecutePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) StreamExecutePrompt(*ExecutePromptRequest, grpc.ServerStreamingServer[ExecutePromptChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamExecutePrompt not implemented")
+}
+func (UnimplementedPromptServiceServer) ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExecutions not implemented")
+}
+func (UnimplementedPromptServiceServer) CreateEvaluationCase(context.Context, *CreateEvaluationCaseRequest) (*CreateEvaluationCaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEvaluationCase not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdateEvaluationCase(context.Context, *UpdateEvaluationCaseRequest) (*UpdateEvaluationCaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEvaluationCase not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteEvaluationCase(context.Context, *DeleteEvaluationCaseRequest) (*DeleteEvaluationCaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEvaluationCase not implemented")
+}
+func (UnimplementedPromptServiceServer) ListEvaluationCases(context.Context, *ListEvaluationCasesRequest) (*ListEvaluationCasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvaluationCases not implemented")
+}
+func (UnimplementedPromptServiceServer) RunEvaluation(context.Context, *RunEvaluationRequest) (*RunEvaluationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunEvaluation not implemented")
+}
+func (UnimplementedPromptServiceServer) ListEvaluationRuns(context.Context, *ListEvaluationRunsRequest) (*ListEvaluationRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvaluationRuns not implemented")
+}
+func (UnimplementedPromptServiceServer) CompareEvaluationRuns(context.Context, *CompareEvaluationRunsRequest) (*CompareEvaluationRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareEvaluationRuns not implemented")
+}
+func (UnimplementedPromptServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedPromptServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedPromptServiceServer) ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplateVersions not implemented")
+}
+func (UnimplementedPromptServiceServer) ListTrendingTemplates(context.Context, *ListTrendingTemplatesRequest) (*ListTrendingTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrendingTemplates not implemented")
+}
+func (UnimplementedPromptServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedPromptServiceServer) AddTemplateToCollection(context.Context, *AddTemplateToCollectionRequest) (*AddTemplateToCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTemplateToCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) RemoveTemplateFromCollection(context.Context, *RemoveTemplateFromCollectionRequest) (*RemoveTemplateFromCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTemplateFromCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) ReorderCollection(context.Context, *ReorderCollectionRequest) (*ReorderCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) ToggleFollowCollection(context.Context, *ToggleFollowCollectionRequest) (*ToggleFollowCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ToggleFollowCollection not implemented")
+}
+func (UnimplementedPromptServiceServer) GrantTemplateAccess(context.Context, *GrantTemplateAccessRequest) (*GrantTemplateAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantTemplateAccess not implemented")
+}
+func (UnimplementedPromptServiceServer) RevokeTemplateAccess(context.Context, *RevokeTemplateAccessRequest) (*RevokeTemplateAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeTemplateAccess not implemented")
+}
+func (UnimplementedPromptServiceServer) ListTemplateGrants(context.Context, *ListTemplateGrantsRequest) (*ListTemplateGrantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplateGrants not implemented")
+}
+func (UnimplementedPromptServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedPromptServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedPromptServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedPromptServiceServer) ReportTemplate(context.Context, *ReportTemplateRequest) (*ReportTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedPromptServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedPromptServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedPromptServiceServer) RateTemplate(context.Context, *RateTemplateRequest) (*RateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RateTemplate not implemented")
+}
+func (UnimplementedPromptServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedPromptServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedPromptServiceServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedPromptServiceServer) RecordTemplateEvent(context.Context, *RecordTemplateEventRequest) (*RecordTemplateEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordTemplateEvent not implemented")
+}
+func (UnimplementedPromptServiceServer) GetTemplateStats(context.Context, *GetTemplateStatsRequest) (*GetTemplateStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTemplateStats not implemented")
+}
+func (UnimplementedPromptServiceServer) mustEmbedUnimplementedPromptServiceServer() {}
+func (UnimplementedPromptServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePromptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromptServiceServer will
+// result in compilation errors.
+type UnsafePromptServiceServer interface {
+	mustEmbedUnimplementedPromptServiceServer()
+}
+
+func RegisterPromptServiceServer(s grpc.ServiceRegistrar, srv PromptServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromptServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromptService_ServiceDesc, srv)
+}
+
+func _PromptService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).UpdateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: PromptService_UpdateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+		return srv.(PromptServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ToggleLikeTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ToggleLikeTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ToggleLikeTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ToggleLikeTemplate(ctx, req.(*ToggleLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ToggleFavoriteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ToggleFavoriteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ToggleFavoriteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ToggleFavoriteTemplate(ctx, req.(*ToggleFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_CreatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CreatePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CreatePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CreatePrompt(ctx, req.(*CreatePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_GetPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).GetPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_GetPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).GetPrompt(ctx, req.(*GetPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListPrompts(ctx, req.(*ListPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_UpdatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).UpdatePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_UpdatePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).UpdatePrompt(ctx, req.(*UpdatePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DuplicatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicatePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DuplicatePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DuplicatePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DuplicatePrompt(ctx, req.(*DuplicatePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeletePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeletePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeletePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeletePrompt(ctx, req.(*DeletePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_RenderPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).RenderPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_RenderPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).RenderPrompt(ctx, req.(*RenderPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_CreateVariablePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariablePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CreateVariablePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CreateVariablePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CreateVariablePreset(ctx, req.(*CreateVariablePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_UpdateVariablePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariablePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).UpdateVariablePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_UpdateVariablePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).UpdateVariablePreset(ctx, req.(*UpdateVariablePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteVariablePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVariablePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteVariablePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteVariablePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteVariablePreset(ctx, req.(*DeleteVariablePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListVariablePresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariablePresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListVariablePresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListVariablePresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListVariablePresets(ctx, req.(*ListVariablePresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ExecutePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ExecutePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ExecutePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ExecutePrompt(ctx, req.(*ExecutePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_StreamExecutePrompt_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecutePromptRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PromptServiceServer).StreamExecutePrompt(m, &grpc.GenericServerStream[ExecutePromptRequest, ExecutePromptChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PromptService_StreamExecutePromptServer = grpc.ServerStreamingServer[ExecutePromptChunk]
+
+func _PromptService_ListExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListExecutions(ctx, req.(*ListExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_CreateEvaluationCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEvaluationCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CreateEvaluationCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CreateEvaluationCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CreateEvaluationCase(ctx, req.(*CreateEvaluationCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_UpdateEvaluationCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEvaluationCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).UpdateEvaluationCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_UpdateEvaluationCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).UpdateEvaluationCase(ctx, req.(*UpdateEvaluationCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteEvaluationCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEvaluationCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteEvaluationCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteEvaluationCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteEvaluationCase(ctx, req.(*DeleteEvaluationCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListEvaluationCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvaluationCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListEvaluationCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListEvaluationCases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListEvaluationCases(ctx, req.(*ListEvaluationCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_RunEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).RunEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_RunEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).RunEvaluation(ctx, req.(*RunEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListEvaluationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvaluationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListEvaluationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListEvaluationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListEvaluationRuns(ctx, req.(*ListEvaluationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_CompareEvaluationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareEvaluationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CompareEvaluationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CompareEvaluationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CompareEvaluationRuns(ctx, req.(*CompareEvaluationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListTemplateVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListTemplateVersions(ctx, req.(*ListTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListTrendingTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListTrendingTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListTrendingTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListTrendingTemplates(ctx, req.(*ListTrendingTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_AddTemplateToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTemplateToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).AddTemplateToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_AddTemplateToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).AddTemplateToCollection(ctx, req.(*AddTemplateToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_RemoveTemplateFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTemplateFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).RemoveTemplateFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_RemoveTemplateFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).RemoveTemplateFromCollection(ctx, req.(*RemoveTemplateFromCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTemplateRequest)
+func _PromptService_ReorderCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).GetTemplate(ctx, in)
+		return srv.(PromptServiceServer).ReorderCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_GetTemplate_FullMethodName,
+		FullMethod: PromptService_ReorderCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+		return srv.(PromptServiceServer).ReorderCollection(ctx, req.(*ReorderCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesRequest)
+func _PromptService_ToggleFollowCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleFollowCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ListTemplates(ctx, in)
+		return srv.(PromptServiceServer).ToggleFollowCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ListTemplates_FullMethodName,
+		FullMethod: PromptService_ToggleFollowCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+		return srv.(PromptServiceServer).ToggleFollowCollection(ctx, req.(*ToggleFollowCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateRequest)
+func _PromptService_GrantTemplateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantTemplateAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).DeleteTemplate(ctx, in)
+		return srv.(PromptServiceServer).GrantTemplateAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_DeleteTemplate_FullMethodName,
+		FullMethod: PromptService_GrantTemplateAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+		return srv.(PromptServiceServer).GrantTemplateAccess(ctx, req.(*GrantTemplateAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ToggleLikeTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ToggleLikeRequest)
+func _PromptService_RevokeTemplateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTemplateAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ToggleLikeTemplate(ctx, in)
+		return srv.(PromptServiceServer).RevokeTemplateAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ToggleLikeTemplate_FullMethodName,
+		FullMethod: PromptService_RevokeTemplateAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ToggleLikeTemplate(ctx, req.(*ToggleLikeRequest))
+		return srv.(PromptServiceServer).RevokeTemplateAccess(ctx, req.(*RevokeTemplateAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ToggleFavoriteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ToggleFavoriteRequest)
+func _PromptService_ListTemplateGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateGrantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ToggleFavoriteTemplate(ctx, in)
+		return srv.(PromptServiceServer).ListTemplateGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ToggleFavoriteTemplate_FullMethodName,
+		FullMethod: PromptService_ListTemplateGrants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ToggleFavoriteTemplate(ctx, req.(*ToggleFavoriteRequest))
+		return srv.(PromptServiceServer).ListTemplateGrants(ctx, req.(*ListTemplateGrantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_CreatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePromptRequest)
+func _PromptService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).CreatePrompt(ctx, in)
+		return srv.(PromptServiceServer).CreateShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_CreatePrompt_FullMethodName,
+		FullMethod: PromptService_CreateShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).CreatePrompt(ctx, req.(*CreatePromptRequest))
+		return srv.(PromptServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_GetPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPromptRequest)
+func _PromptService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).GetPrompt(ctx, in)
+		return srv.(PromptServiceServer).ListShareLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_GetPrompt_FullMethodName,
+		FullMethod: PromptService_ListShareLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).GetPrompt(ctx, req.(*GetPromptRequest))
+		return srv.(PromptServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_DeletePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePromptRequest)
+func _PromptService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).DeletePrompt(ctx, in)
+		return srv.(PromptServiceServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_DeletePrompt_FullMethodName,
+		FullMethod: PromptService_RevokeShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).DeletePrompt(ctx, req.(*DeletePromptRequest))
+		return srv.(PromptServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _PromptService_ReportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ListCategories(ctx, in)
+		return srv.(PromptServiceServer).ReportTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ListCategories_FullMethodName,
+		FullMethod: PromptService_ReportTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(PromptServiceServer).ReportTemplate(ctx, req.(*ReportTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTagsRequest)
+func _PromptService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ListTags(ctx, in)
+		return srv.(PromptServiceServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ListTags_FullMethodName,
+		FullMethod: PromptService_CreateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+		return srv.(PromptServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PromptService_ListTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateVersionsRequest)
+func _PromptService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PromptServiceServer).ListTemplateVersions(ctx, in)
+		return srv.(PromptServiceServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PromptService_ListTemplateVersions_FullMethodName,
+		FullMethod: PromptService_UpdateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PromptServiceServer).ListTemplateVersions(ctx, req.(*ListTemplateVersionsRequest))
+		return srv.(PromptServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_RateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).RateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_RateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).RateTemplate(ctx, req.(*RateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_RecordTemplateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTemplateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).RecordTemplateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_RecordTemplateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).RecordTemplateEvent(ctx, req.(*RecordTemplateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptService_GetTemplateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptServiceServer).GetTemplateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptService_GetTemplateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptServiceServer).GetTemplateStats(ctx, req.(*GetTemplateStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -893,10 +3695,78 @@ var PromptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrompt",
 			Handler:    _PromptService_GetPrompt_Handler,
 		},
+		{
+			MethodName: "ListPrompts",
+			Handler:    _PromptService_ListPrompts_Handler,
+		},
+		{
+			MethodName: "UpdatePrompt",
+			Handler:    _PromptService_UpdatePrompt_Handler,
+		},
+		{
+			MethodName: "DuplicatePrompt",
+			Handler:    _PromptService_DuplicatePrompt_Handler,
+		},
 		{
 			MethodName: "DeletePrompt",
 			Handler:    _PromptService_DeletePrompt_Handler,
 		},
+		{
+			MethodName: "RenderPrompt",
+			Handler:    _PromptService_RenderPrompt_Handler,
+		},
+		{
+			MethodName: "CreateVariablePreset",
+			Handler:    _PromptService_CreateVariablePreset_Handler,
+		},
+		{
+			MethodName: "UpdateVariablePreset",
+			Handler:    _PromptService_UpdateVariablePreset_Handler,
+		},
+		{
+			MethodName: "DeleteVariablePreset",
+			Handler:    _PromptService_DeleteVariablePreset_Handler,
+		},
+		{
+			MethodName: "ListVariablePresets",
+			Handler:    _PromptService_ListVariablePresets_Handler,
+		},
+		{
+			MethodName: "ExecutePrompt",
+			Handler:    _PromptService_ExecutePrompt_Handler,
+		},
+		{
+			MethodName: "ListExecutions",
+			Handler:    _PromptService_ListExecutions_Handler,
+		},
+		{
+			MethodName: "CreateEvaluationCase",
+			Handler:    _PromptService_CreateEvaluationCase_Handler,
+		},
+		{
+			MethodName: "UpdateEvaluationCase",
+			Handler:    _PromptService_UpdateEvaluationCase_Handler,
+		},
+		{
+			MethodName: "DeleteEvaluationCase",
+			Handler:    _PromptService_DeleteEvaluationCase_Handler,
+		},
+		{
+			MethodName: "ListEvaluationCases",
+			Handler:    _PromptService_ListEvaluationCases_Handler,
+		},
+		{
+			MethodName: "RunEvaluation",
+			Handler:    _PromptService_RunEvaluation_Handler,
+		},
+		{
+			MethodName: "ListEvaluationRuns",
+			Handler:    _PromptService_ListEvaluationRuns_Handler,
+		},
+		{
+			MethodName: "CompareEvaluationRuns",
+			Handler:    _PromptService_CompareEvaluationRuns_Handler,
+		},
 		{
 			MethodName: "ListCategories",
 			Handler:    _PromptService_ListCategories_Handler,
@@ -909,7 +3779,121 @@ var PromptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplateVersions",
 			Handler:    _PromptService_ListTemplateVersions_Handler,
 		},
+		{
+			MethodName: "ListTrendingTemplates",
+			Handler:    _PromptService_ListTrendingTemplates_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _PromptService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _PromptService_GetCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _PromptService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _PromptService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _PromptService_ListCollections_Handler,
+		},
+		{
+			MethodName: "AddTemplateToCollection",
+			Handler:    _PromptService_AddTemplateToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveTemplateFromCollection",
+			Handler:    _PromptService_RemoveTemplateFromCollection_Handler,
+		},
+		{
+			MethodName: "ReorderCollection",
+			Handler:    _PromptService_ReorderCollection_Handler,
+		},
+		{
+			MethodName: "ToggleFollowCollection",
+			Handler:    _PromptService_ToggleFollowCollection_Handler,
+		},
+		{
+			MethodName: "GrantTemplateAccess",
+			Handler:    _PromptService_GrantTemplateAccess_Handler,
+		},
+		{
+			MethodName: "RevokeTemplateAccess",
+			Handler:    _PromptService_RevokeTemplateAccess_Handler,
+		},
+		{
+			MethodName: "ListTemplateGrants",
+			Handler:    _PromptService_ListTemplateGrants_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _PromptService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _PromptService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _PromptService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "ReportTemplate",
+			Handler:    _PromptService_ReportTemplate_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _PromptService_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _PromptService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _PromptService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _PromptService_ListComments_Handler,
+		},
+		{
+			MethodName: "RateTemplate",
+			Handler:    _PromptService_RateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _PromptService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _PromptService_ListReviews_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _PromptService_GetFeed_Handler,
+		},
+		{
+			MethodName: "RecordTemplateEvent",
+			Handler:    _PromptService_RecordTemplateEvent_Handler,
+		},
+		{
+			MethodName: "GetTemplateStats",
+			Handler:    _PromptService_GetTemplateStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamExecutePrompt",
+			Handler:       _PromptService_StreamExecutePrompt_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "prompt.proto",
 }
@@ -2,17 +2,23 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"time"
+	// Embedded zone data for the time zones of clients; the runtime image has none.
+	_ "time/tzdata"
 
 	"go.uber.org/zap"
 
 	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/cache"
 	"awsome-prompt/backend/internal/data"
+	"awsome-prompt/backend/internal/llm"
 	"awsome-prompt/backend/internal/repository"
 	"awsome-prompt/backend/internal/service"
 
@@ -20,8 +26,139 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Helpers for JSON marshaling
+var (
+	marshaler = protojson.MarshalOptions{
+		EmitUnpopulated: true,
+		UseProtoNames:   true,
+	}
+	unmarshaler = protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	}
+)
+
+// writeJSON writes a proto message as the JSON response.
+func writeJSON(w http.ResponseWriter, m proto.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := marshaler.Marshal(m)
+	_, _ = w.Write(b)
+}
+
+// readJSON decodes the JSON request body into a proto message.
+func readJSON(r *http.Request, m proto.Message) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "failed to read body")
+	}
+	if err := unmarshaler.Unmarshal(body, m); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// userContext returns a context carrying the user authenticated by the bearer token
+// of the request. Without a token the context is anonymous, unless required.
+func userContext(r *http.Request, auth *service.AuthInterceptor, required bool) (context.Context, error) {
+	ctx := requestContext(r)
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		if required {
+			return nil, status.Error(codes.Unauthenticated, "Authorization header required")
+		}
+		return ctx, nil
+	}
+	p, err := auth.Authenticate(ctx, strings.TrimPrefix(authHeader, "Bearer "))
+	if err != nil {
+		return nil, err
+	}
+	return service.ContextWithPrincipal(ctx, p), nil
+}
+
+// requestContext returns the context of an anonymous REST request: the client
+// address and request ID the audit log records, and the locale of the caller.
+func requestContext(r *http.Request) context.Context {
+	ctx := service.ContextWithClientIP(context.Background(), requestIP(r))
+	ctx = service.ContextWithRequestID(ctx, r.Header.Get("X-Request-Id"))
+	ctx = service.ContextWithLanguage(ctx, r.Header.Get("Accept-Language"))
+	return service.ContextWithTimeZone(ctx, r.Header.Get("X-Timezone"))
+}
+
+// trustedProxies are the networks of the reverse proxies whose X-Forwarded-For
+// headers are honored, from TRUSTED_PROXIES.
+var trustedProxies []*net.IPNet
+
+// parseTrustedProxies parses a comma-separated list of CIDRs and addresses.
+func parseTrustedProxies(list string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestIP returns the address of the client of a REST request. When the
+// request comes from a trusted proxy, it is the right-most X-Forwarded-For hop
+// that is not a trusted proxy itself; otherwise it is the remote address, as
+// anyone can send X-Forwarded-For.
+func requestIP(r *http.Request) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	if !isTrustedProxy(ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if !isTrustedProxy(hop) {
+			return hop
+		}
+		ip = hop
+	}
+	return ip
+}
+
 func writeError(w http.ResponseWriter, err error) {
 	zap.S().Errorf("Error handling request: %v", err)
 	st, ok := status.FromError(err)
@@ -34,7 +171,7 @@ func writeError(w http.ResponseWriter, err error) {
 	switch st.Code() {
 	case codes.OK:
 		code = http.StatusOK
-	case codes.InvalidArgument:
+	case codes.InvalidArgument, codes.FailedPrecondition:
 		code = http.StatusBadRequest
 	case codes.NotFound:
 		code = http.StatusNotFound
@@ -46,8 +183,12 @@ func writeError(w http.ResponseWriter, err error) {
 		code = http.StatusUnauthorized
 	case codes.Unimplemented:
 		code = http.StatusNotImplemented
+	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
 	case codes.Unavailable:
 		code = http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		code = http.StatusGatewayTimeout
 	default:
 		code = http.StatusInternalServerError
 	}
@@ -55,6 +196,52 @@ func writeError(w http.ResponseWriter, err error) {
 	http.Error(w, st.Message(), code)
 }
 
+// executionEventStream sends the chunks of a streamed execution as server-sent
+// events: a "delta" event per piece of text, then an "execution" event with the
+// stored run. Errors once the stream has started are sent as an "error" event,
+// since the status code is already written.
+type executionEventStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *executionEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *executionEventStream) Send(chunk *pb.ExecutePromptChunk) error {
+	if !s.started {
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.started = true
+	}
+	if chunk.Execution != nil {
+		return s.writeEvent("execution", chunk.Execution)
+	}
+	return s.writeEvent("delta", chunk)
+}
+
+func (s *executionEventStream) writeEvent(event string, m proto.Message) error {
+	b, _ := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, b); err != nil {
+		return err
+	}
+	return http.NewResponseController(s.w).Flush()
+}
+
+// fail reports err, as an HTTP error when nothing was sent yet.
+func (s *executionEventStream) fail(err error) {
+	if !s.started {
+		writeError(s.w, err)
+		return
+	}
+	zap.S().Errorf("Error streaming execution: %v", err)
+	st, _ := status.FromError(err)
+	_ = s.writeEvent("error", st.Proto())
+}
+
 func main() {
 	logger, _ := zap.NewProduction()
 	defer func() { _ = logger.Sync() }()
@@ -71,12 +258,21 @@ func main() {
 		zap.S().Fatalf("failed to connect to database: %v", err)
 	}
 
-	// Repository and Service
-	templateRepo := repository.NewTemplateRepository(pgConn.DB)
-	promptRepo := repository.NewPromptRepository(pgConn.DB)
-	templateVersionRepo := repository.NewTemplateVersionRepository(pgConn.DB)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "default-secret-key-change-me"
+	}
+	// Page tokens are signed so that clients cannot forge cursors.
+	pageTokenSecret := os.Getenv("PAGE_TOKEN_SECRET")
+	if pageTokenSecret == "" {
+		pageTokenSecret = jwtSecret
+	}
 
-	svc := service.NewPromptService(promptRepo, templateRepo, templateVersionRepo)
+	proxies, err := parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		zap.S().Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
+	trustedProxies = proxies
 
 	// Redis
 	redisAddr := os.Getenv("REDIS_ADDR")
@@ -88,12 +284,102 @@ func main() {
 		zap.S().Fatalf("failed to connect to redis: %v", err)
 	}
 
-	// User Service
+	// Cache
+	cacheTTL := 5 * time.Minute
+	if v, err := time.ParseDuration(os.Getenv("CACHE_TTL")); err == nil && v > 0 {
+		cacheTTL = v
+	}
+	appCache := cache.New(redisClient, cacheTTL)
+	// Writes on other replicas are picked up through Postgres notifications.
+	cacheInvalidator := service.NewCacheInvalidator(dsn, appCache)
+	go cacheInvalidator.Run(context.Background())
+
+	// Repository and Service
+	templateRepo := repository.NewCachedTemplateRepository(repository.NewTemplateRepository(pgConn.DB), appCache)
+	promptRepo := repository.NewPromptRepository(pgConn.DB)
+	templateVersionRepo := repository.NewCachedTemplateVersionRepository(repository.NewTemplateVersionRepository(pgConn.DB), appCache)
+	collectionRepo := repository.NewCollectionRepository(pgConn.DB)
+	shareRepo := repository.NewShareRepository(pgConn.DB)
+	orgRepo := repository.NewOrganizationRepository(pgConn.DB)
+	moderationRepo := repository.NewModerationRepository(pgConn.DB)
+	commentRepo := repository.NewCommentRepository(pgConn.DB)
+	reviewRepo := repository.NewReviewRepository(pgConn.DB)
+	notificationRepo := repository.NewNotificationRepository(pgConn.DB)
+	followRepo := repository.NewFollowRepository(pgConn.DB)
+	usageRepo := repository.NewUsageRepository(pgConn.DB)
+	presetRepo := repository.NewPresetRepository(pgConn.DB)
+	executionRepo := repository.NewExecutionRepository(pgConn.DB)
+	evaluationRepo := repository.NewEvaluationRepository(pgConn.DB)
+	auditRepo := repository.NewAuditRepository(pgConn.DB)
+	auditor := service.NewAuditor(auditRepo)
 	userRepo := repository.NewUserRepository(pgConn.DB)
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "default-secret-key-change-me"
+
+	// Model Provider
+	llmTimeout := 2 * time.Minute
+	if v, err := time.ParseDuration(os.Getenv("LLM_TIMEOUT")); err == nil && v > 0 {
+		llmTimeout = v
+	}
+	var provider llm.Provider
+	switch llmProvider := os.Getenv("LLM_PROVIDER"); llmProvider {
+	case "fake":
+		provider = llm.NewFakeProvider()
+	case "", "openai":
+		if baseURL := os.Getenv("LLM_BASE_URL"); baseURL != "" {
+			provider = llm.NewOpenAIProvider(baseURL, os.Getenv("LLM_API_KEY"), llmTimeout)
+		}
+	default:
+		zap.S().Fatalf("unknown LLM_PROVIDER: %s", llmProvider)
+	}
+	if provider == nil {
+		zap.S().Warn("No model provider configured, prompt execution is disabled")
+	}
+
+	svc := service.NewPromptService(service.PromptServiceDeps{
+		PromptRepo:          promptRepo,
+		TemplateRepo:        templateRepo,
+		TemplateVersionRepo: templateVersionRepo,
+		CollectionRepo:      collectionRepo,
+		ShareRepo:           shareRepo,
+		OrgRepo:             orgRepo,
+		ModerationRepo:      moderationRepo,
+		CommentRepo:         commentRepo,
+		ReviewRepo:          reviewRepo,
+		NotificationRepo:    notificationRepo,
+		FollowRepo:          followRepo,
+		UsageRepo:           usageRepo,
+		PresetRepo:          presetRepo,
+		ExecutionRepo:       executionRepo,
+		EvaluationRepo:      evaluationRepo,
+		UserRepo:            userRepo,
+		Audit:               auditor,
+		Viewers:             redisClient,
+		Provider:            provider,
+		DefaultModel:        os.Getenv("LLM_MODEL"),
+		PageTokenSecret:     pageTokenSecret,
+	})
+	if v, err := strconv.Atoi(os.Getenv("REPORT_HIDE_THRESHOLD")); err == nil && v >= 0 {
+		svc.ReportHideThreshold = v
+	}
+
+	// Trending Worker
+	trendingInterval := 10 * time.Minute
+	if v, err := time.ParseDuration(os.Getenv("TRENDING_INTERVAL")); err == nil && v > 0 {
+		trendingInterval = v
 	}
+	trendingHalfLife := 48 * time.Hour
+	if v, err := time.ParseDuration(os.Getenv("TRENDING_HALF_LIFE")); err == nil && v > 0 {
+		trendingHalfLife = v
+	}
+	trendingWorker := service.NewTrendingWorker(templateRepo, trendingInterval, trendingHalfLife)
+	go trendingWorker.Run(context.Background())
+
+	// Audit Retention Worker
+	auditRetention := 365 * 24 * time.Hour
+	if v, err := time.ParseDuration(os.Getenv("AUDIT_RETENTION")); err == nil && v > 0 {
+		auditRetention = v
+	}
+	auditRetentionWorker := service.NewAuditRetentionWorker(auditRepo, time.Hour, auditRetention)
+	go auditRetentionWorker.Run(context.Background())
 
 	// Email Service
 	smtpHost := os.Getenv("SMTP_HOST")
@@ -106,10 +392,18 @@ func main() {
 	}
 	emailSvc := service.NewEmailService(smtpHost, smtpPort, smtpUser, smtpPassword, smtpFrom)
 
-	userSvc := service.NewUserService(userRepo, redisClient, emailSvc, jwtSecret)
+	userSvc := service.NewUserService(userRepo, followRepo, templateRepo, redisClient, emailSvc, jwtSecret)
+	orgSvc := service.NewOrganizationService(orgRepo, userRepo, emailSvc)
+	adminSvc := service.NewAdminService(userRepo, templateRepo, moderationRepo, emailSvc, pageTokenSecret)
+	notificationSvc := service.NewNotificationService(notificationRepo, pageTokenSecret)
+	auditSvc := service.NewAuditService(auditRepo, pageTokenSecret)
+	userSvc.Audit = auditor
+	orgSvc.Audit = auditor
+	adminSvc.Audit = auditor
 
 	// Auth Interceptor
 	authInterceptor := service.NewAuthInterceptor(jwtSecret)
+	authInterceptor.Users = userRepo
 
 	// gRPC Server
 	go func() {
@@ -121,30 +415,26 @@ func main() {
 		// Register Interceptor
 		s := grpc.NewServer(
 			grpc.UnaryInterceptor(authInterceptor.Unary()),
+			grpc.StreamInterceptor(authInterceptor.Stream()),
 		)
 
 		pb.RegisterPromptServiceServer(s, svc)
 		pb.RegisterUserServiceServer(s, userSvc)
+		pb.RegisterOrganizationServiceServer(s, orgSvc)
+		pb.RegisterAdminServiceServer(s, adminSvc)
+		pb.RegisterNotificationServiceServer(s, notificationSvc)
+		pb.RegisterAuditServiceServer(s, auditSvc)
 		zap.S().Infof("gRPC server listening at %v", lis.Addr())
 		if err := s.Serve(lis); err != nil {
 			zap.S().Fatalf("failed to serve: %v", err)
 		}
 	}()
 
-	// Helper for JSON marshaling
-	marshaler := protojson.MarshalOptions{
-		EmitUnpopulated: true,
-		UseProtoNames:   true,
-	}
-	unmarshaler := protojson.UnmarshalOptions{
-		DiscardUnknown: true,
-	}
-
 	// HTTP Server for FVT/REST
 	http.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == http.MethodOptions {
 			return
@@ -162,8 +452,14 @@ func main() {
 		if v := r.URL.Query().Get("language"); v != "" {
 			req.Language = v
 		}
+		req.OrgId = r.URL.Query().Get("org_id")
 
-		resp, err := svc.ListCategories(context.Background(), req)
+		ctx, err := userContext(r, authInterceptor, req.OrgId != "")
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := svc.ListCategories(ctx, req)
 		if err != nil {
 			writeError(w, err)
 			return
@@ -176,7 +472,7 @@ func main() {
 	http.HandleFunc("/api/v1/tags", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == http.MethodOptions {
 			return
@@ -191,8 +487,14 @@ func main() {
 		if v := r.URL.Query().Get("language"); v != "" {
 			req.Language = v
 		}
+		req.OrgId = r.URL.Query().Get("org_id")
 
-		resp, err := svc.ListTags(context.Background(), req)
+		ctx, err := userContext(r, authInterceptor, req.OrgId != "")
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := svc.ListTags(ctx, req)
 		if err != nil {
 			writeError(w, err)
 			return
@@ -215,18 +517,10 @@ func main() {
 		switch r.Method {
 		case http.MethodGet:
 			// Optional Auth for Mixed View
-			ctx := context.Background()
-			if authHeader := r.Header.Get("Authorization"); authHeader != "" {
-				tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-				if userID, err := authInterceptor.VerifyToken(tokenStr); err == nil {
-					ctx = service.ContextWithUserID(ctx, userID)
-				} else {
-					// Optional: fail if token matches format but is invalid?
-					// For now, let's just log and continue as anonymous (safe fallback)
-					// Or strictly fail:
-					http.Error(w, "Invalid token", http.StatusUnauthorized)
-					return
-				}
+			ctx, err := userContext(r, authInterceptor, false)
+			if err != nil {
+				writeError(w, err)
+				return
 			}
 
 			req := &pb.ListTemplatesRequest{}
@@ -258,6 +552,25 @@ func main() {
 			if v := q.Get("my_favorites"); v == "true" {
 				req.MyFavorites = true
 			}
+			if v := q.Get("sort"); v != "" {
+				req.Sort = pb.TemplateSort(pb.TemplateSort_value[v])
+			}
+			if v := q.Get("include_total_count"); v == "true" {
+				req.IncludeTotalCount = true
+			}
+			req.CollectionId = q.Get("collection_id")
+			if v := q.Get("shared_with_me"); v == "true" {
+				req.SharedWithMe = true
+			}
+			req.OrgId = q.Get("org_id")
+			if v := q.Get("featured_only"); v == "true" {
+				req.FeaturedOnly = true
+			}
+			if v := q.Get("min_rating"); v != "" {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					req.MinRating = f
+				}
+			}
 
 			resp, err := svc.ListTemplates(ctx, req)
 			if err != nil {
@@ -269,18 +582,11 @@ func main() {
 			_, _ = w.Write(b)
 
 		case http.MethodPost:
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
-				return
-			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
 
 			body, err := io.ReadAll(r.Body)
 			if err != nil {
@@ -306,11 +612,51 @@ func main() {
 		}
 	})
 
+	http.HandleFunc("/api/v1/trending", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		q := r.URL.Query()
+		req := &pb.ListTrendingTemplatesRequest{
+			Category: q.Get("category"),
+			Language: q.Get("language"),
+		}
+		if v := q.Get("page_size"); v != "" {
+			if i, err := strconv.Atoi(v); err == nil {
+				req.PageSize = int32(i)
+			}
+		}
+
+		ctx, err := userContext(r, authInterceptor, false)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := svc.ListTrendingTemplates(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := marshaler.Marshal(resp)
+		_, _ = w.Write(b)
+	})
+
 	http.HandleFunc("/api/v1/templates/", func(w http.ResponseWriter, r *http.Request) {
 		// Enable CORS
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Timezone")
 
 		if r.Method == http.MethodOptions {
 			return
@@ -327,18 +673,11 @@ func main() {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
-				return
-			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
 
 			templateID := strings.TrimSuffix(id, "/fork")
 			resp, err := svc.ForkTemplate(ctx, templateID)
@@ -367,8 +706,16 @@ func main() {
 				}
 			}
 			req.PageToken = q.Get("page_token")
+			if v := q.Get("include_total_count"); v == "true" {
+				req.IncludeTotalCount = true
+			}
 
-			resp, err := svc.ListTemplateVersions(context.Background(), req)
+			ctx, err := userContext(r, authInterceptor, false)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := svc.ListTemplateVersions(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
@@ -384,18 +731,11 @@ func main() {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
-				return
-			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
 
 			templateID := strings.TrimSuffix(id, "/like")
 			req := &pb.ToggleLikeRequest{TemplateId: templateID}
@@ -415,18 +755,11 @@ func main() {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
-				return
-			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
 
 			templateID := strings.TrimSuffix(id, "/favorite")
 			req := &pb.ToggleFavoriteRequest{TemplateId: templateID}
@@ -441,271 +774,1505 @@ func main() {
 			return
 		}
 
-		switch r.Method {
-		case http.MethodGet:
-			ctx := context.Background()
-			if authHeader := r.Header.Get("Authorization"); authHeader != "" {
-				tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-				if userID, err := authInterceptor.VerifyToken(tokenStr); err == nil {
-					ctx = service.ContextWithUserID(ctx, userID)
+		if strings.HasSuffix(id, "/stats") {
+			if r.Method != http.MethodGet {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			q := r.URL.Query()
+			req := &pb.GetTemplateStatsRequest{
+				TemplateId: strings.TrimSuffix(id, "/stats"),
+				StartDate:  q.Get("start_date"),
+				EndDate:    q.Get("end_date"),
+			}
+			if v := q.Get("version"); v != "" {
+				if i, err := strconv.Atoi(v); err == nil {
+					req.Version = int32(i)
 				}
 			}
-			req := &pb.GetTemplateRequest{Id: id}
-			resp, err := svc.GetTemplate(ctx, req)
+			resp, err := svc.GetTemplateStats(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
 			}
-			w.Header().Set("Content-Type", "application/json")
-			b, _ := marshaler.Marshal(resp)
-			_, _ = w.Write(b)
+			if q.Get("format") == "csv" {
+				w.Header().Set("Content-Type", "text/csv")
+				w.Header().Set("Content-Disposition", `attachment; filename="template-stats.csv"`)
+				if err := service.WriteTemplateStatsCSV(w, resp); err != nil {
+					zap.S().Errorf("failed to write stats CSV: %v", err)
+				}
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
 
-		case http.MethodPut:
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
+		if strings.HasSuffix(id, "/events") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			ctx, err := userContext(r, authInterceptor, false)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
-
-			body, err := io.ReadAll(r.Body)
+			var req pb.RecordTemplateEventRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.TemplateId = strings.TrimSuffix(id, "/events")
+			resp, err := svc.RecordTemplateEvent(ctx, &req)
 			if err != nil {
-				http.Error(w, "Failed to read body", http.StatusBadRequest)
+				writeError(w, err)
 				return
 			}
-			var req pb.UpdateTemplateRequest
-			if err := unmarshaler.Unmarshal(body, &req); err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
+			writeJSON(w, resp)
+			return
+		}
+
+		if strings.HasSuffix(id, "/render") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
-			req.TemplateId = id
-			resp, err := svc.UpdateTemplate(ctx, &req)
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
 				writeError(w, err)
 				return
 			}
-			w.Header().Set("Content-Type", "application/json")
-			b, _ := marshaler.Marshal(resp)
-			_, _ = w.Write(b)
-
-		case http.MethodDelete:
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "Authorization header required", http.StatusUnauthorized)
+			var req pb.RenderPromptRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
 				return
 			}
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			userID, err := authInterceptor.VerifyToken(tokenStr)
+			req.TemplateId = strings.TrimSuffix(id, "/render")
+			resp, err := svc.RenderPrompt(ctx, &req)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				writeError(w, err)
 				return
 			}
-			ctx := service.ContextWithUserID(context.Background(), userID)
+			writeJSON(w, resp)
+			return
+		}
 
-			ownerID := r.URL.Query().Get("owner_id")
-			req := &pb.DeleteTemplateRequest{Id: id, OwnerId: ownerID}
-			resp, err := svc.DeleteTemplate(ctx, req)
+		if strings.HasSuffix(id, "/evaluation-cases") {
+			ctx, err := userContext(r, authInterceptor, true)
 			if err != nil {
 				writeError(w, err)
 				return
 			}
-			w.Header().Set("Content-Type", "application/json")
-			b, _ := marshaler.Marshal(resp)
-			_, _ = w.Write(b)
-
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			templateID := strings.TrimSuffix(id, "/evaluation-cases")
+			switch r.Method {
+			case http.MethodGet:
+				resp, err := svc.ListEvaluationCases(ctx, &pb.ListEvaluationCasesRequest{TemplateId: templateID})
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				writeJSON(w, resp)
+			case http.MethodPost:
+				var req pb.CreateEvaluationCaseRequest
+				if err := readJSON(r, &req); err != nil {
+					writeError(w, err)
+					return
+				}
+				req.TemplateId = templateID
+				resp, err := svc.CreateEvaluationCase(ctx, &req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				writeJSON(w, resp)
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
 		}
-	})
-
-	// User Handlers
-	http.HandleFunc("/api/v1/verification-code", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
 
-		if r.Method == http.MethodOptions {
+		if strings.HasSuffix(id, "/evaluations/compare") {
+			if r.Method != http.MethodGet {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			q := r.URL.Query()
+			req := &pb.CompareEvaluationRunsRequest{TemplateId: strings.TrimSuffix(id, "/evaluations/compare")}
+			if v, err := strconv.Atoi(q.Get("base_version_id")); err == nil {
+				req.BaseVersionId = int32(v)
+			}
+			if v, err := strconv.Atoi(q.Get("head_version_id")); err == nil {
+				req.HeadVersionId = int32(v)
+			}
+			resp, err := svc.CompareEvaluationRuns(ctx, req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
 			return
 		}
 
-		if r.Method != http.MethodPost {
+		if strings.HasSuffix(id, "/evaluations") {
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			templateID := strings.TrimSuffix(id, "/evaluations")
+			switch r.Method {
+			case http.MethodGet:
+				q := r.URL.Query()
+				req := &pb.ListEvaluationRunsRequest{TemplateId: templateID, PageToken: q.Get("page_token")}
+				if v, err := strconv.Atoi(q.Get("version_id")); err == nil {
+					req.VersionId = int32(v)
+				}
+				if v, err := strconv.Atoi(q.Get("page_size")); err == nil {
+					req.PageSize = int32(v)
+				}
+				resp, err := svc.ListEvaluationRuns(ctx, req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				writeJSON(w, resp)
+			case http.MethodPost:
+				var req pb.RunEvaluationRequest
+				if err := readJSON(r, &req); err != nil {
+					writeError(w, err)
+					return
+				}
+				req.TemplateId = templateID
+				resp, err := svc.RunEvaluation(ctx, &req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				writeJSON(w, resp)
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+
+		if strings.HasSuffix(id, "/report") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			var req pb.ReportTemplateRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.TemplateId = strings.TrimSuffix(id, "/report")
+			resp, err := svc.ReportTemplate(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
+
+		if strings.HasSuffix(id, "/comments") {
+			templateID := strings.TrimSuffix(id, "/comments")
+			var resp proto.Message
+			switch r.Method {
+			case http.MethodGet:
+				ctx, err := userContext(r, authInterceptor, false)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				q := r.URL.Query()
+				req := &pb.ListCommentsRequest{
+					TemplateId: templateID,
+					ParentId:   q.Get("parent_id"),
+					PageToken:  q.Get("page_token"),
+					ShareToken: q.Get("share_token"),
+				}
+				if v := q.Get("page_size"); v != "" {
+					if i, err := strconv.Atoi(v); err == nil {
+						req.PageSize = int32(i)
+					}
+				}
+				resp, err = svc.ListComments(ctx, req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+			case http.MethodPost:
+				ctx, err := userContext(r, authInterceptor, true)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				var req pb.CreateCommentRequest
+				if err := readJSON(r, &req); err != nil {
+					writeError(w, err)
+					return
+				}
+				req.TemplateId = templateID
+				resp, err = svc.CreateComment(ctx, &req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
+
+		if strings.HasSuffix(id, "/reviews") {
+			templateID := strings.TrimSuffix(id, "/reviews")
+			var resp proto.Message
+			switch r.Method {
+			case http.MethodGet:
+				ctx, err := userContext(r, authInterceptor, false)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				q := r.URL.Query()
+				req := &pb.ListReviewsRequest{
+					TemplateId:   templateID,
+					PageToken:    q.Get("page_token"),
+					ExcludeStale: q.Get("exclude_stale") == "true",
+					ShareToken:   q.Get("share_token"),
+				}
+				if v := q.Get("page_size"); v != "" {
+					if i, err := strconv.Atoi(v); err == nil {
+						req.PageSize = int32(i)
+					}
+				}
+				resp, err = svc.ListReviews(ctx, req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+			case http.MethodPut:
+				ctx, err := userContext(r, authInterceptor, true)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				var req pb.RateTemplateRequest
+				if err := readJSON(r, &req); err != nil {
+					writeError(w, err)
+					return
+				}
+				req.TemplateId = templateID
+				resp, err = svc.RateTemplate(ctx, &req)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+			case http.MethodDelete:
+				ctx, err := userContext(r, authInterceptor, true)
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+				resp, err = svc.DeleteReview(ctx, &pb.DeleteReviewRequest{TemplateId: templateID})
+				if err != nil {
+					writeError(w, err)
+					return
+				}
+			default:
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
+
+		if parts := strings.Split(id, "/"); len(parts) > 1 && (parts[1] == "grants" || parts[1] == "links") {
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			var resp proto.Message
+			switch {
+			case len(parts) == 2 && parts[1] == "grants" && r.Method == http.MethodGet:
+				resp, err = svc.ListTemplateGrants(ctx, &pb.ListTemplateGrantsRequest{TemplateId: parts[0]})
+			case len(parts) == 2 && parts[1] == "grants" && r.Method == http.MethodPost:
+				var req pb.GrantTemplateAccessRequest
+				if err = readJSON(r, &req); err == nil {
+					req.TemplateId = parts[0]
+					resp, err = svc.GrantTemplateAccess(ctx, &req)
+				}
+			case len(parts) == 3 && parts[1] == "grants" && r.Method == http.MethodDelete:
+				resp, err = svc.RevokeTemplateAccess(ctx, &pb.RevokeTemplateAccessRequest{TemplateId: parts[0], UserId: parts[2]})
+			case len(parts) == 2 && parts[1] == "links" && r.Method == http.MethodGet:
+				resp, err = svc.ListShareLinks(ctx, &pb.ListShareLinksRequest{TemplateId: parts[0]})
+			case len(parts) == 2 && parts[1] == "links" && r.Method == http.MethodPost:
+				var req pb.CreateShareLinkRequest
+				if err = readJSON(r, &req); err == nil {
+					req.TemplateId = parts[0]
+					resp, err = svc.CreateShareLink(ctx, &req)
+				}
+			case len(parts) == 3 && parts[1] == "links" && r.Method == http.MethodDelete:
+				resp, err = svc.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{TemplateId: parts[0], LinkId: parts[2]})
+			default:
+				http.Error(w, "Not found", http.StatusNotFound)
+				return
+			}
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			ctx, err := userContext(r, authInterceptor, false)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			req := &pb.GetTemplateRequest{Id: id, ShareToken: r.URL.Query().Get("share_token")}
+			resp, err := svc.GetTemplate(ctx, req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			b, _ := marshaler.Marshal(resp)
+			_, _ = w.Write(b)
+
+		case http.MethodPut:
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Failed to read body", http.StatusBadRequest)
+				return
+			}
+			var req pb.UpdateTemplateRequest
+			if err := unmarshaler.Unmarshal(body, &req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.TemplateId = id
+			resp, err := svc.UpdateTemplate(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			b, _ := marshaler.Marshal(resp)
+			_, _ = w.Write(b)
+
+		case http.MethodDelete:
+			ctx, err := userContext(r, authInterceptor, true)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			req := &pb.DeleteTemplateRequest{Id: id}
+			resp, err := svc.DeleteTemplate(ctx, req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			b, _ := marshaler.Marshal(resp)
+			_, _ = w.Write(b)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Comment Handlers
+	http.HandleFunc("/api/v1/comments/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/comments/")
+		if id == "" {
+			http.Error(w, "ID required", http.StatusBadRequest)
+			return
+		}
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch r.Method {
+		case http.MethodPut:
+			var req pb.UpdateCommentRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.Id = id
+			resp, err = svc.UpdateComment(ctx, &req)
+		case http.MethodDelete:
+			resp, err = svc.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: id})
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// Notification Handlers
+	http.HandleFunc("/api/v1/notifications", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		q := r.URL.Query()
+		req := &pb.ListNotificationsRequest{
+			PageToken:  q.Get("page_token"),
+			UnreadOnly: q.Get("unread_only") == "true",
+		}
+		if v, err := strconv.Atoi(q.Get("page_size")); err == nil {
+			req.PageSize = int32(v)
+		}
+		resp, err := notificationSvc.ListNotifications(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// POST /api/v1/notifications/read and /api/v1/notifications/read-all
+	http.HandleFunc("/api/v1/notifications/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch strings.TrimPrefix(r.URL.Path, "/api/v1/notifications/") {
+		case "read":
+			var req pb.MarkReadRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err = notificationSvc.MarkRead(ctx, &req)
+		case "read-all":
+			resp, err = notificationSvc.MarkAllRead(ctx, &pb.MarkAllReadRequest{})
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// Collection Handlers
+	http.HandleFunc("/api/v1/collections", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, r.Method != http.MethodGet)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			req := &pb.ListCollectionsRequest{
+				PageToken: q.Get("page_token"),
+				OwnerId:   q.Get("owner_id"),
+				Followed:  q.Get("followed") == "true",
+			}
+			if v := q.Get("page_size"); v != "" {
+				if i, err := strconv.Atoi(v); err == nil {
+					req.PageSize = int32(i)
+				}
+			}
+			resp, err := svc.ListCollections(ctx, req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+
+		case http.MethodPost:
+			var req pb.CreateCollectionRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := svc.CreateCollection(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// /api/v1/collections/{id}, /{id}/items, /{id}/items/{template_id}, /{id}/order and /{id}/follow
+	http.HandleFunc("/api/v1/collections/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/collections/"), "/")
+		id := parts[0]
+		if id == "" {
+			http.Error(w, "ID required", http.StatusBadRequest)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, r.Method != http.MethodGet)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			resp, err = svc.GetCollection(ctx, &pb.GetCollectionRequest{Id: id})
+		case len(parts) == 1 && r.Method == http.MethodPut:
+			var req pb.UpdateCollectionRequest
+			if err = readJSON(r, &req); err == nil {
+				req.Id = id
+				resp, err = svc.UpdateCollection(ctx, &req)
+			}
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			resp, err = svc.DeleteCollection(ctx, &pb.DeleteCollectionRequest{Id: id})
+		case len(parts) == 2 && parts[1] == "items" && r.Method == http.MethodPost:
+			var req pb.AddTemplateToCollectionRequest
+			if err = readJSON(r, &req); err == nil {
+				req.CollectionId = id
+				resp, err = svc.AddTemplateToCollection(ctx, &req)
+			}
+		case len(parts) == 3 && parts[1] == "items" && r.Method == http.MethodDelete:
+			resp, err = svc.RemoveTemplateFromCollection(ctx, &pb.RemoveTemplateFromCollectionRequest{CollectionId: id, TemplateId: parts[2]})
+		case len(parts) == 2 && parts[1] == "order" && r.Method == http.MethodPut:
+			var req pb.ReorderCollectionRequest
+			if err = readJSON(r, &req); err == nil {
+				req.CollectionId = id
+				resp, err = svc.ReorderCollection(ctx, &req)
+			}
+		case len(parts) == 2 && parts[1] == "follow" && r.Method == http.MethodPost:
+			resp, err = svc.ToggleFollowCollection(ctx, &pb.ToggleFollowCollectionRequest{CollectionId: id})
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// Organization Handlers
+	http.HandleFunc("/api/v1/organizations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch r.Method {
+		case http.MethodGet:
+			resp, err = orgSvc.ListOrganizations(ctx, &pb.ListOrganizationsRequest{})
+		case http.MethodPost:
+			var req pb.CreateOrganizationRequest
+			if err = readJSON(r, &req); err == nil {
+				resp, err = orgSvc.CreateOrganization(ctx, &req)
+			}
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// /api/v1/organizations/{id}, /{id}/members, /{id}/members/{user_id} and /{id}/invitations
+	http.HandleFunc("/api/v1/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/organizations/"), "/")
+		id := parts[0]
+		if id == "" {
+			http.Error(w, "ID required", http.StatusBadRequest)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			resp, err = orgSvc.GetOrganization(ctx, &pb.GetOrganizationRequest{Id: id})
+		case len(parts) == 2 && parts[1] == "members" && r.Method == http.MethodGet:
+			resp, err = orgSvc.ListOrganizationMembers(ctx, &pb.ListOrganizationMembersRequest{OrgId: id})
+		case len(parts) == 3 && parts[1] == "members" && r.Method == http.MethodPut:
+			var req pb.UpdateOrganizationMemberRequest
+			if err = readJSON(r, &req); err == nil {
+				req.OrgId, req.UserId = id, parts[2]
+				resp, err = orgSvc.UpdateOrganizationMember(ctx, &req)
+			}
+		case len(parts) == 3 && parts[1] == "members" && r.Method == http.MethodDelete:
+			resp, err = orgSvc.RemoveOrganizationMember(ctx, &pb.RemoveOrganizationMemberRequest{OrgId: id, UserId: parts[2]})
+		case len(parts) == 2 && parts[1] == "invitations" && r.Method == http.MethodPost:
+			var req pb.InviteOrganizationMemberRequest
+			if err = readJSON(r, &req); err == nil {
+				req.OrgId = id
+				resp, err = orgSvc.InviteOrganizationMember(ctx, &req)
+			}
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	http.HandleFunc("/api/v1/invitations/accept", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		var req pb.AcceptOrganizationInvitationRequest
+		if err := readJSON(r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+		resp, err := orgSvc.AcceptOrganizationInvitation(ctx, &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// Admin Handlers
+	http.HandleFunc("/api/v1/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		q := r.URL.Query()
+		req := &pb.ListUsersRequest{
+			PageToken: q.Get("page_token"),
+			Query:     q.Get("query"),
+		}
+		if v, err := strconv.Atoi(q.Get("page_size")); err == nil {
+			req.PageSize = int32(v)
+		}
+		if v := q.Get("role"); v != "" {
+			req.Role = pb.UserRole(pb.UserRole_value[v])
+		}
+		if v := q.Get("suspended_only"); v == "true" {
+			req.SuspendedOnly = true
+		}
+		resp, err := adminSvc.ListUsers(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// /api/v1/admin/users/{id}/suspend and /{id}/reinstate
+	http.HandleFunc("/api/v1/admin/users/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/admin/users/"), "/")
+		if len(parts) != 2 || parts[0] == "" {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch parts[1] {
+		case "suspend":
+			resp, err = adminSvc.SuspendUser(ctx, &pb.SuspendUserRequest{UserId: parts[0]})
+		case "reinstate":
+			resp, err = adminSvc.ReinstateUser(ctx, &pb.ReinstateUserRequest{UserId: parts[0]})
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	http.HandleFunc("/api/v1/admin/moderation", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		q := r.URL.Query()
+		req := &pb.ListModerationQueueRequest{PageToken: q.Get("page_token")}
+		if v, err := strconv.Atoi(q.Get("page_size")); err == nil {
+			req.PageSize = int32(v)
+		}
+		resp, err := adminSvc.ListModerationQueue(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// /api/v1/admin/templates/{id}/transfer, /{id}/featured and /{id}/moderate
+	http.HandleFunc("/api/v1/admin/templates/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, PUT, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/admin/templates/"), "/")
+		if len(parts) != 2 || parts[0] == "" {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var resp proto.Message
+		switch {
+		case parts[1] == "transfer" && r.Method == http.MethodPost:
+			var req pb.TransferTemplateOwnershipRequest
+			if err = readJSON(r, &req); err == nil {
+				req.TemplateId = parts[0]
+				resp, err = adminSvc.TransferTemplateOwnership(ctx, &req)
+			}
+		case parts[1] == "featured" && r.Method == http.MethodPut:
+			var req pb.SetTemplateFeaturedRequest
+			if err = readJSON(r, &req); err == nil {
+				req.TemplateId = parts[0]
+				resp, err = adminSvc.SetTemplateFeatured(ctx, &req)
+			}
+		case parts[1] == "moderate" && r.Method == http.MethodPost:
+			var req pb.ModerateTemplateRequest
+			if err = readJSON(r, &req); err == nil {
+				req.TemplateId = parts[0]
+				resp, err = adminSvc.ModerateTemplate(ctx, &req)
+			}
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// User Handlers
+	http.HandleFunc("/api/v1/verification-code", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			return
+		}
+		var req pb.SendVerificationCodeRequest
+		if err := unmarshaler.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := userSvc.SendVerificationCode(requestContext(r), &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := marshaler.Marshal(resp)
+		_, _ = w.Write(b)
+	})
+
+	http.HandleFunc("/api/v1/register", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			return
+		}
+		var req pb.RegisterRequest
+		if err := unmarshaler.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := userSvc.Register(requestContext(r), &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := marshaler.Marshal(resp)
+		_, _ = w.Write(b)
+	})
+
+	http.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			return
+		}
+		var req pb.LoginRequest
+		if err := unmarshaler.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := userSvc.Login(requestContext(r), &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := marshaler.Marshal(resp)
+		_, _ = w.Write(b)
+	})
+
+	http.HandleFunc("/api/v1/profile", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, PUT, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Failed to read body", http.StatusBadRequest)
+				return
+			}
+			var req pb.UpdateProfileRequest
+			if err := unmarshaler.Unmarshal(body, &req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, err := userSvc.UpdateProfile(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			b, _ := marshaler.Marshal(resp)
+			_, _ = w.Write(b)
+
+		case http.MethodGet:
+			req := &pb.GetProfileRequest{}
+			resp, err := userSvc.GetProfile(ctx, req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			b, _ := marshaler.Marshal(resp)
+			_, _ = w.Write(b)
+
+		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// GET /api/v1/users/{id} (public profile), and POST (follow) and DELETE
+	// (unfollow) /api/v1/users/{id}/follow
+	http.HandleFunc("/api/v1/users/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
 			return
 		}
 
-		body, err := io.ReadAll(r.Body)
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/users/"), "/")
+		if len(parts) == 1 && parts[0] != "" {
+			if r.Method != http.MethodGet {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx, err := userContext(r, authInterceptor, false)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := userSvc.GetPublicProfile(ctx, &pb.GetPublicProfileRequest{UserId: parts[0]})
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
+		if len(parts) != 2 || parts[0] == "" || parts[1] != "follow" {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		ctx, err := userContext(r, authInterceptor, true)
 		if err != nil {
-			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			writeError(w, err)
 			return
 		}
-		var req pb.SendVerificationCodeRequest
-		if err := unmarshaler.Unmarshal(body, &req); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+
+		var resp proto.Message
+		switch r.Method {
+		case http.MethodPost:
+			resp, err = userSvc.FollowUser(ctx, &pb.FollowUserRequest{UserId: parts[0]})
+		case http.MethodDelete:
+			resp, err = userSvc.UnfollowUser(ctx, &pb.UnfollowUserRequest{UserId: parts[0]})
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
-		resp, err := userSvc.SendVerificationCode(context.Background(), &req)
 		if err != nil {
 			writeError(w, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		b, _ := marshaler.Marshal(resp)
-		_, _ = w.Write(b)
+		writeJSON(w, resp)
 	})
 
-	http.HandleFunc("/api/v1/register", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/api/v1/feed", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == http.MethodOptions {
 			return
 		}
-
-		if r.Method != http.MethodPost {
+		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		body, err := io.ReadAll(r.Body)
+		ctx, err := userContext(r, authInterceptor, true)
 		if err != nil {
-			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			writeError(w, err)
 			return
 		}
-		var req pb.RegisterRequest
-		if err := unmarshaler.Unmarshal(body, &req); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+		req := &pb.GetFeedRequest{PageToken: r.URL.Query().Get("page_token")}
+		if v, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil {
+			req.PageSize = int32(v)
 		}
-		resp, err := userSvc.Register(context.Background(), &req)
+		resp, err := svc.GetFeed(ctx, req)
 		if err != nil {
 			writeError(w, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		b, _ := marshaler.Marshal(resp)
-		_, _ = w.Write(b)
+		writeJSON(w, resp)
 	})
 
-	http.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+	// Audit Handlers
+	http.HandleFunc("/api/v1/audit-events", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == http.MethodOptions {
 			return
 		}
-
-		if r.Method != http.MethodPost {
+		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		body, err := io.ReadAll(r.Body)
+		ctx, err := userContext(r, authInterceptor, true)
 		if err != nil {
-			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			writeError(w, err)
 			return
 		}
-		var req pb.LoginRequest
-		if err := unmarshaler.Unmarshal(body, &req); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		q := r.URL.Query()
+		req := &pb.ListAuditEventsRequest{
+			PageToken:    q.Get("page_token"),
+			ActorId:      q.Get("actor_id"),
+			Action:       q.Get("action"),
+			ResourceType: q.Get("resource_type"),
+			ResourceId:   q.Get("resource_id"),
+		}
+		if v, err := strconv.Atoi(q.Get("page_size")); err == nil {
+			req.PageSize = int32(v)
+		}
+		if v, err := time.Parse(time.RFC3339, q.Get("start_time")); err == nil {
+			req.StartTime = timestamppb.New(v)
+		}
+		if v, err := time.Parse(time.RFC3339, q.Get("end_time")); err == nil {
+			req.EndTime = timestamppb.New(v)
+		}
+		resp, err := auditSvc.ListAuditEvents(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, resp)
+	})
+
+	// Prompt Handlers
+	http.HandleFunc("/api/v1/presets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
 			return
 		}
-		resp, err := userSvc.Login(context.Background(), &req)
+
+		ctx, err := userContext(r, authInterceptor, true)
 		if err != nil {
 			writeError(w, err)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		b, _ := marshaler.Marshal(resp)
-		_, _ = w.Write(b)
+
+		switch r.Method {
+		case http.MethodGet:
+			resp, err := svc.ListVariablePresets(ctx, &pb.ListVariablePresetsRequest{OrgId: r.URL.Query().Get("org_id")})
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+		case http.MethodPost:
+			var req pb.CreateVariablePresetRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := svc.CreateVariablePreset(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
 	})
 
-	http.HandleFunc("/api/v1/profile", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/api/v1/presets/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, PUT, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "PUT, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == http.MethodOptions {
 			return
 		}
 
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			http.Error(w, "Authorization header required", http.StatusUnauthorized)
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/presets/")
+		if id == "" {
+			http.Error(w, "ID required", http.StatusBadRequest)
 			return
 		}
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-		userID, err := authInterceptor.VerifyToken(tokenStr)
+		ctx, err := userContext(r, authInterceptor, true)
 		if err != nil {
-			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			writeError(w, err)
 			return
 		}
-		ctx := service.ContextWithUserID(context.Background(), userID)
 
 		switch r.Method {
 		case http.MethodPut:
-			body, err := io.ReadAll(r.Body)
+			var req pb.UpdateVariablePresetRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.Id = id
+			resp, err := svc.UpdateVariablePreset(ctx, &req)
 			if err != nil {
-				http.Error(w, "Failed to read body", http.StatusBadRequest)
+				writeError(w, err)
 				return
 			}
-			var req pb.UpdateProfileRequest
-			if err := unmarshaler.Unmarshal(body, &req); err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
+			writeJSON(w, resp)
+		case http.MethodDelete:
+			resp, err := svc.DeleteVariablePreset(ctx, &pb.DeleteVariablePresetRequest{Id: id})
+			if err != nil {
+				writeError(w, err)
 				return
 			}
-			req.Id = userID
+			writeJSON(w, resp)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
 
-			resp, err := userSvc.UpdateProfile(ctx, &req)
+	http.HandleFunc("/api/v1/executions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			req := &pb.ListExecutionsRequest{PromptId: q.Get("prompt_id"), PageToken: q.Get("page_token")}
+			if v := q.Get("page_size"); v != "" {
+				if i, err := strconv.Atoi(v); err == nil {
+					req.PageSize = int32(i)
+				}
+			}
+			resp, err := svc.ListExecutions(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
 			}
-			w.Header().Set("Content-Type", "application/json")
-			b, _ := marshaler.Marshal(resp)
-			_, _ = w.Write(b)
+			writeJSON(w, resp)
+		case http.MethodPost:
+			var req pb.ExecutePromptRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := svc.ExecutePrompt(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
 
-		case http.MethodGet:
-			req := &pb.GetProfileRequest{}
-			req.Id = userID
+	http.HandleFunc("/api/v1/evaluation-cases/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
-			resp, err := userSvc.GetProfile(ctx, req)
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/evaluation-cases/")
+		if id == "" {
+			http.Error(w, "ID required", http.StatusBadRequest)
+			return
+		}
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var req pb.UpdateEvaluationCaseRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.Id = id
+			resp, err := svc.UpdateEvaluationCase(ctx, &req)
 			if err != nil {
 				writeError(w, err)
 				return
 			}
-			w.Header().Set("Content-Type", "application/json")
-			b, _ := marshaler.Marshal(resp)
-			_, _ = w.Write(b)
-
+			writeJSON(w, resp)
+		case http.MethodDelete:
+			resp, err := svc.DeleteEvaluationCase(ctx, &pb.DeleteEvaluationCaseRequest{Id: id})
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
 
-	// Prompt Handlers
+	http.HandleFunc("/api/v1/executions/stream", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		var req pb.ExecutePromptRequest
+		if err := readJSON(r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		// Stop the execution when the client disconnects.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stop := context.AfterFunc(r.Context(), cancel)
+		defer stop()
+
+		stream := &executionEventStream{ctx: ctx, w: w}
+		if err := svc.StreamExecutePrompt(&req, stream); err != nil {
+			stream.fail(err)
+		}
+	})
+
 	http.HandleFunc("/api/v1/prompts", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Timezone")
 
 		if r.Method == http.MethodOptions {
 			return
 		}
 
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
 		switch r.Method {
 		case http.MethodGet:
 			q := r.URL.Query()
 			req := &pb.ListPromptsRequest{
 				OwnerId:    q.Get("owner_id"),
 				TemplateId: q.Get("template_id"),
+				Query:      q.Get("query"),
+				Tag:        q.Get("tag"),
+				PinnedOnly: q.Get("pinned_only") == "true",
+			}
+			if v := q.Get("sort"); v != "" {
+				req.Sort = pb.PromptSort(pb.PromptSort_value[v])
+			}
+			if v, err := time.Parse(time.RFC3339, q.Get("start_time")); err == nil {
+				req.StartTime = timestamppb.New(v)
+			}
+			if v, err := time.Parse(time.RFC3339, q.Get("end_time")); err == nil {
+				req.EndTime = timestamppb.New(v)
 			}
 			if v := q.Get("page_size"); v != "" {
 				if i, err := strconv.Atoi(v); err == nil {
@@ -713,8 +2280,11 @@ func main() {
 				}
 			}
 			req.PageToken = q.Get("page_token")
+			if v := q.Get("include_total_count"); v == "true" {
+				req.IncludeTotalCount = true
+			}
 
-			resp, err := svc.ListPrompts(context.Background(), req)
+			resp, err := svc.ListPrompts(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
@@ -734,7 +2304,7 @@ func main() {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
-			resp, err := svc.CreatePrompt(context.Background(), &req)
+			resp, err := svc.CreatePrompt(ctx, &req)
 			if err != nil {
 				writeError(w, err)
 				return
@@ -749,8 +2319,8 @@ func main() {
 
 	http.HandleFunc("/api/v1/prompts/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Timezone")
 
 		if r.Method == http.MethodOptions {
 			return
@@ -761,11 +2331,30 @@ func main() {
 			http.Error(w, "ID required", http.StatusBadRequest)
 			return
 		}
+		ctx, err := userContext(r, authInterceptor, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if strings.HasSuffix(id, "/duplicate") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			resp, err := svc.DuplicatePrompt(ctx, &pb.DuplicatePromptRequest{Id: strings.TrimSuffix(id, "/duplicate")})
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+			return
+		}
 
 		switch r.Method {
 		case http.MethodGet:
 			req := &pb.GetPromptRequest{Id: id}
-			resp, err := svc.GetPrompt(context.Background(), req)
+			resp, err := svc.GetPrompt(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
@@ -774,10 +2363,23 @@ func main() {
 			b, _ := marshaler.Marshal(resp)
 			_, _ = w.Write(b)
 
+		case http.MethodPut:
+			var req pb.UpdatePromptRequest
+			if err := readJSON(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			req.Id = id
+			resp, err := svc.UpdatePrompt(ctx, &req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, resp)
+
 		case http.MethodDelete:
-			ownerID := r.URL.Query().Get("owner_id")
-			req := &pb.DeletePromptRequest{Id: id, OwnerId: ownerID}
-			resp, err := svc.DeletePrompt(context.Background(), req)
+			req := &pb.DeletePromptRequest{Id: id}
+			resp, err := svc.DeletePrompt(ctx, req)
 			if err != nil {
 				writeError(w, err)
 				return
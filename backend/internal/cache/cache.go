@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+
+	"awsome-prompt/backend/internal/data"
+)
+
+// metrics exposes hit/miss/error counters per namespace on /debug/vars,
+// e.g. "template.hits" or "tags.misses".
+var metrics = expvar.NewMap("cache")
+
+// Store is the key-value backend of the cache.
+// Get must return data.ErrNotFound for missing keys.
+type Store interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Del(ctx context.Context, key string) error
+	Incr(ctx context.Context, key string) (int64, error)
+	// SetNX sets key only if it does not exist, and reports whether it did.
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+}
+
+// Cache is a read-through cache storing JSON-encoded values.
+// Concurrent misses for the same key are collapsed into a single load so that
+// an expired hot key does not stampede the database.
+type Cache struct {
+	store Store
+	ttl   time.Duration
+	group group
+}
+
+// New creates a new Cache whose entries expire after roughly ttl.
+func New(store Store, ttl time.Duration) *Cache {
+	return &Cache{store: store, ttl: ttl}
+}
+
+// GetOrLoad fills dst with the value cached under key, calling load on a miss
+// and caching its result. namespace only labels the metrics.
+// Cache failures are logged and fall back to load; they never fail the read.
+func (c *Cache) GetOrLoad(ctx context.Context, namespace, key string, dst interface{}, load func() (interface{}, error)) error {
+	key = keyPrefix + key
+	if raw, err := c.store.Get(ctx, key); err == nil {
+		if err := json.Unmarshal([]byte(raw), dst); err == nil {
+			metrics.Add(namespace+".hits", 1)
+			return nil
+		}
+	} else if !errors.Is(err, data.ErrNotFound) {
+		metrics.Add(namespace+".errors", 1)
+		zap.S().Warnf("Cache: failed to get %s: %v", key, err)
+	}
+	metrics.Add(namespace+".misses", 1)
+
+	raw, err := c.group.Do(key, func() ([]byte, error) {
+		val, err := load()
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.store.Set(ctx, key, b, c.jitteredTTL()); err != nil {
+			metrics.Add(namespace+".errors", 1)
+			zap.S().Warnf("Cache: failed to set %s: %v", key, err)
+		}
+		return b, nil
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// Delete evicts the given keys.
+func (c *Cache) Delete(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := c.store.Del(ctx, keyPrefix+key); err != nil {
+			zap.S().Warnf("Cache: failed to delete %s: %v", key, err)
+		}
+	}
+}
+
+// Generation returns the current generation of a namespace.
+// Embedding it in keys lets a whole namespace be invalidated at once by Bump,
+// stale entries simply expiring on their own.
+func (c *Cache) Generation(ctx context.Context, namespace string) string {
+	gen, err := c.store.Get(ctx, generationKey(namespace))
+	if err != nil {
+		if !errors.Is(err, data.ErrNotFound) {
+			zap.S().Warnf("Cache: failed to get generation of %s: %v", namespace, err)
+		}
+		return "0"
+	}
+	return gen
+}
+
+// Bump invalidates every key built on the current generation of a namespace.
+func (c *Cache) Bump(ctx context.Context, namespace string) {
+	if _, err := c.store.Incr(ctx, generationKey(namespace)); err != nil {
+		zap.S().Warnf("Cache: failed to bump generation of %s: %v", namespace, err)
+	}
+}
+
+// Claim reports whether the caller is the first to claim key within ttl, so
+// that work every instance is told to do, such as evicting the entries of a
+// change, is done once. When the store fails, the claim is granted.
+func (c *Cache) Claim(ctx context.Context, key string, ttl time.Duration) bool {
+	ok, err := c.store.SetNX(ctx, keyPrefix+"claim:"+key, []byte("1"), ttl)
+	if err != nil {
+		zap.S().Warnf("Cache: failed to claim %s: %v", key, err)
+		return true
+	}
+	return ok
+}
+
+// jitteredTTL spreads expirations by ±10% so entries written together do not expire together.
+func (c *Cache) jitteredTTL() time.Duration {
+	jitter := time.Duration(rand.Int64N(int64(c.ttl)/5+1)) - c.ttl/10
+	return c.ttl + jitter
+}
+
+// keyPrefix is prepended to every key stored by the cache so that they do not
+// collide with unrelated data in the store.
+const keyPrefix = "cache:"
+
+func generationKey(namespace string) string {
+	return keyPrefix + "gen:" + namespace
+}
+
+// Key builds a cache key from a prefix and the JSON encoding of parts.
+// Long or structured parts (filter maps, cursors) are hashed to keep keys short.
+func Key(prefix string, parts ...interface{}) string {
+	b, _ := json.Marshal(parts)
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf("%s:%s", prefix, hex.EncodeToString(sum[:12]))
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"awsome-prompt/backend/internal/data"
+)
+
+// memoryStore is an in-memory Store for tests.
+type memoryStore struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{data: make(map[string]string)}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	val, ok := s.data[key]
+	if !ok {
+		return "", data.ErrNotFound
+	}
+	return val, nil
+}
+
+func (s *memoryStore) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = string(value.([]byte))
+	return nil
+}
+
+func (s *memoryStore) Del(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data, key)
+	return nil
+}
+
+func (s *memoryStore) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, _ := strconv.ParseInt(s.data[key], 10, 64)
+	n++
+	s.data[key] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+func (s *memoryStore) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data[key]; ok {
+		return false, nil
+	}
+	s.data[key] = string(value.([]byte))
+	return true, nil
+}
+
+func TestGetOrLoad(t *testing.T) {
+	ctx := context.Background()
+	c := New(newMemoryStore(), time.Minute)
+
+	var loads int
+	load := func() (interface{}, error) {
+		loads++
+		return []string{"a", "b"}, nil
+	}
+
+	var got []string
+	assert.NoError(t, c.GetOrLoad(ctx, "test", "k", &got, load))
+	assert.Equal(t, []string{"a", "b"}, got)
+
+	got = nil
+	assert.NoError(t, c.GetOrLoad(ctx, "test", "k", &got, load))
+	assert.Equal(t, []string{"a", "b"}, got)
+	assert.Equal(t, 1, loads)
+
+	c.Delete(ctx, "k")
+	assert.NoError(t, c.GetOrLoad(ctx, "test", "k", &got, load))
+	assert.Equal(t, 2, loads)
+}
+
+func TestGetOrLoadCollapsesConcurrentMisses(t *testing.T) {
+	ctx := context.Background()
+	c := New(newMemoryStore(), time.Minute)
+
+	var loads int32
+	release := make(chan struct{})
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var got int
+			assert.NoError(t, c.GetOrLoad(ctx, "test", "hot", &got, load))
+			assert.Equal(t, 42, got)
+		}()
+	}
+	// Give the goroutines time to pile up on the in-flight load.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+}
+
+func TestBumpChangesGeneration(t *testing.T) {
+	ctx := context.Background()
+	c := New(newMemoryStore(), time.Minute)
+
+	before := c.Generation(ctx, "list")
+	assert.Equal(t, "0", before)
+	c.Bump(ctx, "list")
+	after := c.Generation(ctx, "list")
+	assert.NotEqual(t, before, after)
+	assert.NotEqual(t, Key("list", before, "q"), Key("list", after, "q"))
+}
+
+func TestClaim(t *testing.T) {
+	ctx := context.Background()
+	c := New(newMemoryStore(), time.Minute)
+	assert.True(t, c.Claim(ctx, "change:1", time.Minute))
+	assert.False(t, c.Claim(ctx, "change:1", time.Minute))
+	assert.True(t, c.Claim(ctx, "change:2", time.Minute))
+}
+
+func TestJitteredTTL(t *testing.T) {
+	c := New(newMemoryStore(), 10*time.Minute)
+	for i := 0; i < 100; i++ {
+		ttl := c.jitteredTTL()
+		assert.GreaterOrEqual(t, ttl, 9*time.Minute)
+		assert.LessOrEqual(t, ttl, 11*time.Minute)
+	}
+}
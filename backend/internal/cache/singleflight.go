@@ -0,0 +1,42 @@
+package cache
+
+import "sync"
+
+// call is an in-flight or completed group.Do call.
+type call struct {
+	wg  sync.WaitGroup
+	val []byte
+	err error
+}
+
+// group collapses concurrent calls for the same key into one execution.
+type group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// Do executes fn once for all concurrent callers sharing key and hands each of them its result.
+func (g *group) Do(key string, fn func() ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := &call{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	c.val, c.err = fn()
+	c.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return c.val, c.err
+}
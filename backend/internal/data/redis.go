@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// ErrNotFound is returned by Get when the key does not exist.
+var ErrNotFound = errors.New("key not found")
+
 // RedisClient holds the redis client
 type RedisClient struct {
 	Client *redis.Client
@@ -38,12 +42,28 @@ func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, ex
 	return r.Client.Set(ctx, key, value, expiration).Err()
 }
 
-// Get retrieves a value by key
+// Get retrieves a value by key.
+// It returns ErrNotFound if the key does not exist.
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
-	return r.Client.Get(ctx, key).Result()
+	val, err := r.Client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+// Incr increments the integer value of a key by one, creating it if needed
+func (r *RedisClient) Incr(ctx context.Context, key string) (int64, error) {
+	return r.Client.Incr(ctx, key).Result()
 }
 
 // Del deletes a key
 func (r *RedisClient) Del(ctx context.Context, key string) error {
 	return r.Client.Del(ctx, key).Err()
 }
+
+// SetNX stores a key-value pair with expiration unless the key exists. It
+// reports whether the pair was stored.
+func (r *RedisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.Client.SetNX(ctx, key, value, expiration).Result()
+}
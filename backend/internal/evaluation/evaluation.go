@@ -0,0 +1,175 @@
+// Package evaluation checks the outputs of prompts against the assertions of
+// their test cases.
+//
+// Assertions are deterministic checks of the output (it contains a text,
+// matches a regular expression, is a JSON document valid against a schema or
+// is at most so long) or a judge prompt, where a model grades the output
+// against criteria written in plain language.
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"awsome-prompt/backend/internal/llm"
+)
+
+// Types of assertions.
+const (
+	TypeContains   = "contains"
+	TypeRegex      = "regex"
+	TypeJSONSchema = "json_schema"
+	TypeMaxLength  = "max_length"
+	TypeJudge      = "judge"
+)
+
+// Assertion is a check of the output of a test case.
+type Assertion struct {
+	Type string `json:"type"`
+	// Value is the text to contain, the regular expression, the JSON schema or
+	// the criteria of the judge, depending on the type.
+	Value string `json:"value,omitempty"`
+	// MaxLength is the maximum number of characters of max_length assertions.
+	MaxLength int32 `json:"max_length,omitempty"`
+}
+
+// Result is the outcome of an assertion for an output.
+type Result struct {
+	Assertion
+	Passed bool `json:"passed"`
+	// Message explains why the assertion failed, or what the judge answered.
+	Message string `json:"message,omitempty"`
+}
+
+// CaseResult is the outcome of a test case in a run.
+type CaseResult struct {
+	CaseID   string `json:"case_id"`
+	CaseName string `json:"case_name"`
+	// Input is the text sent to the model.
+	Input  string `json:"input"`
+	Output string `json:"output"`
+	// Passed is set when the model answered and every assertion passed.
+	Passed     bool     `json:"passed"`
+	Assertions []Result `json:"assertions"`
+	// Error tells why the case could not run.
+	Error     string `json:"error,omitempty"`
+	LatencyMs int32  `json:"latency_ms"`
+}
+
+// Validate checks that an assertion can be evaluated.
+func (a Assertion) Validate() error {
+	switch a.Type {
+	case TypeContains, TypeJudge:
+		if strings.TrimSpace(a.Value) == "" {
+			return fmt.Errorf("%s assertions need a value", a.Type)
+		}
+	case TypeRegex:
+		if _, err := regexp.Compile(a.Value); err != nil {
+			return fmt.Errorf("invalid regular expression: %v", err)
+		}
+	case TypeJSONSchema:
+		if _, err := ParseSchema(a.Value); err != nil {
+			return err
+		}
+	case TypeMaxLength:
+		if a.MaxLength <= 0 {
+			return fmt.Errorf("max_length assertions need a positive max_length")
+		}
+	default:
+		return fmt.Errorf("unknown assertion type %q", a.Type)
+	}
+	return nil
+}
+
+// judgeInstructions tell the judge model how to answer.
+const judgeInstructions = "You grade the output of a language model against criteria. " +
+	"Answer PASS if the output meets all the criteria, else FAIL, followed by a short reason."
+
+// Checker evaluates assertions. Judge assertions run on Model through Provider.
+type Checker struct {
+	Provider llm.Provider
+	Model    string
+}
+
+// Check evaluates an assertion for output. An error is returned only when the
+// judge could not be asked.
+func (c *Checker) Check(ctx context.Context, a Assertion, output string) (Result, error) {
+	result := Result{Assertion: a}
+	switch a.Type {
+	case TypeContains:
+		result.Passed = strings.Contains(output, a.Value)
+		if !result.Passed {
+			result.Message = fmt.Sprintf("output does not contain %q", a.Value)
+		}
+	case TypeRegex:
+		re, err := regexp.Compile(a.Value)
+		if err != nil {
+			result.Message = fmt.Sprintf("invalid regular expression: %v", err)
+			break
+		}
+		result.Passed = re.MatchString(output)
+		if !result.Passed {
+			result.Message = fmt.Sprintf("output does not match %s", a.Value)
+		}
+	case TypeJSONSchema:
+		schema, err := ParseSchema(a.Value)
+		if err != nil {
+			result.Message = err.Error()
+			break
+		}
+		var doc interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(output)), &doc); err != nil {
+			result.Message = fmt.Sprintf("output is not JSON: %v", err)
+			break
+		}
+		if err := schema.Validate(doc); err != nil {
+			result.Message = err.Error()
+			break
+		}
+		result.Passed = true
+	case TypeMaxLength:
+		n := utf8.RuneCountInString(output)
+		result.Passed = n <= int(a.MaxLength)
+		if !result.Passed {
+			result.Message = fmt.Sprintf("output has %d characters, more than %d", n, a.MaxLength)
+		}
+	case TypeJudge:
+		return c.judge(ctx, a, output)
+	default:
+		result.Message = fmt.Sprintf("unknown assertion type %q", a.Type)
+	}
+	return result, nil
+}
+
+// judge asks the judge model whether output meets the criteria of a. The
+// assertion passes when the answer starts with PASS.
+func (c *Checker) judge(ctx context.Context, a Assertion, output string) (Result, error) {
+	if c.Provider == nil {
+		return Result{}, fmt.Errorf("no model provider is configured for judge assertions")
+	}
+	resp, err := c.Provider.Complete(ctx, &llm.Request{
+		Model: c.Model,
+		Messages: []llm.Message{
+			{Role: llm.RoleSystem, Content: judgeInstructions},
+			{Role: llm.RoleUser, Content: "Criteria:\n" + a.Value + "\n\nOutput:\n" + output},
+		},
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	answer := strings.TrimSpace(resp.Text)
+	verdict := ""
+	if fields := strings.Fields(answer); len(fields) > 0 {
+		verdict = strings.ToUpper(strings.TrimRightFunc(fields[0], unicode.IsPunct))
+	}
+	result := Result{Assertion: a, Passed: verdict == "PASS", Message: answer}
+	if verdict != "PASS" && verdict != "FAIL" {
+		result.Message = "the judge answered neither PASS nor FAIL: " + answer
+	}
+	return result, nil
+}
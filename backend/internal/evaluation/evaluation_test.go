@@ -0,0 +1,154 @@
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"awsome-prompt/backend/internal/llm"
+)
+
+// judgeProvider answers every request with a fixed text.
+type judgeProvider struct {
+	llm.FakeProvider
+	answer string
+	got    *llm.Request
+}
+
+func (p *judgeProvider) Complete(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+	p.got = req
+	return &llm.Response{Text: p.answer}, nil
+}
+
+func TestCheck(t *testing.T) {
+	c := &Checker{}
+	tests := []struct {
+		name      string
+		assertion Assertion
+		output    string
+		passed    bool
+		message   string
+	}{
+		{"Contains", Assertion{Type: TypeContains, Value: "memo"}, "a short memo", true, ""},
+		{"DoesNotContain", Assertion{Type: TypeContains, Value: "Memo"}, "a short memo", false, `output does not contain "Memo"`},
+		{"Regex", Assertion{Type: TypeRegex, Value: `^\d{3}$`}, "123", true, ""},
+		{"RegexMismatch", Assertion{Type: TypeRegex, Value: `^\d{3}$`}, "1234", false, `output does not match ^\d{3}$`},
+		{"MaxLength", Assertion{Type: TypeMaxLength, MaxLength: 3}, "héé", true, ""},
+		{"TooLong", Assertion{Type: TypeMaxLength, MaxLength: 3}, "four", false, "output has 4 characters, more than 3"},
+		{"Schema", Assertion{Type: TypeJSONSchema, Value: `{"type":"object","required":["name"]}`}, ` {"name":"x"}`, true, ""},
+		{"SchemaViolation", Assertion{Type: TypeJSONSchema, Value: `{"type":"object","required":["name"]}`}, `{}`, false, `$: missing required property "name"`},
+		{"NotJSON", Assertion{Type: TypeJSONSchema, Value: `{}`}, "Sure! {}", false, "output is not JSON: invalid character 'S' looking for beginning of value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := c.Check(context.Background(), tt.assertion, tt.output)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.passed, result.Passed)
+			assert.Equal(t, tt.message, result.Message)
+		})
+	}
+}
+
+func TestCheckJudge(t *testing.T) {
+	provider := &judgeProvider{answer: "PASS. It is polite."}
+	c := &Checker{Provider: provider, Model: "judge"}
+	a := Assertion{Type: TypeJudge, Value: "Is polite"}
+
+	result, err := c.Check(context.Background(), a, "Dear team")
+	assert.NoError(t, err)
+	assert.True(t, result.Passed)
+	assert.Equal(t, "PASS. It is polite.", result.Message)
+	assert.Equal(t, "judge", provider.got.Model)
+	assert.Equal(t, "Criteria:\nIs polite\n\nOutput:\nDear team", provider.got.Messages[1].Content)
+
+	provider.answer = "fail: rude"
+	result, _ = c.Check(context.Background(), a, "Oi")
+	assert.False(t, result.Passed)
+	assert.Equal(t, "fail: rude", result.Message)
+
+	provider.answer = "Maybe"
+	result, _ = c.Check(context.Background(), a, "Oi")
+	assert.False(t, result.Passed)
+	assert.Equal(t, "the judge answered neither PASS nor FAIL: Maybe", result.Message)
+
+	_, err = (&Checker{}).Check(context.Background(), a, "Oi")
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Assertion{Type: TypeContains, Value: "x"}.Validate())
+	assert.EqualError(t, Assertion{Type: TypeJudge, Value: " "}.Validate(), "judge assertions need a value")
+	assert.Error(t, Assertion{Type: TypeRegex, Value: "("}.Validate())
+	assert.Error(t, Assertion{Type: TypeJSONSchema, Value: `{"type":"text"}`}.Validate())
+	assert.Error(t, Assertion{Type: TypeMaxLength}.Validate())
+	assert.EqualError(t, Assertion{Type: "equals"}.Validate(), `unknown assertion type "equals"`)
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := ParseSchema(`{
+		"type": "object",
+		"required": ["title", "tags"],
+		"additionalProperties": false,
+		"properties": {
+			"title": {"type": "string", "minLength": 1, "maxLength": 10, "pattern": "^[A-Z]"},
+			"score": {"type": "integer", "minimum": 0, "exclusiveMaximum": 10},
+			"ratio": {"type": ["number", "null"]},
+			"tags": {"type": "array", "minItems": 1, "items": {"enum": ["a", "b"]}},
+			"kind": {"const": "memo"},
+			"ref": {"oneOf": [{"type": "string"}, {"type": "integer"}]}
+		}
+	}`)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{"Valid", `{"title":"Memo","score":9,"ratio":null,"tags":["a"],"kind":"memo","ref":3}`, ""},
+		{"Missing", `{"title":"Memo"}`, `$: missing required property "tags"`},
+		{"Additional", `{"title":"Memo","tags":["a"],"extra":1}`, "$.extra: no value is allowed"},
+		{"Type", `{"title":1,"tags":["a"]}`, "$.title: expected string, got integer"},
+		{"Pattern", `{"title":"memo","tags":["a"]}`, "$.title: value does not match ^[A-Z]"},
+		{"MaxLength", `{"title":"Memorandums","tags":["a"]}`, "$.title: expected at most 10 characters"},
+		{"Integer", `{"title":"Memo","score":1.5,"tags":["a"]}`, "$.score: expected integer, got number"},
+		{"ExclusiveMaximum", `{"title":"Memo","score":10,"tags":["a"]}`, "$.score: expected less than 10"},
+		{"Enum", `{"title":"Memo","tags":["c"]}`, "$.tags[0]: value is not one of the allowed values"},
+		{"MinItems", `{"title":"Memo","tags":[]}`, "$.tags: expected at least 1 items, got 0"},
+		{"Const", `{"title":"Memo","tags":["a"],"kind":"note"}`, "$.kind: value is not the expected constant"},
+		{"OneOf", `{"title":"Memo","tags":["a"],"ref":true}`, "$.ref: value matches 0 of oneOf, expected exactly one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.doc), &doc))
+			err := schema.Validate(doc)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for _, src := range []string{`[`, `"object"`, `{"type":"text"}`, `{"properties":{"a":1}}`, `{"pattern":"("}`, `{"anyOf":[]}`, `{"minLength":"1"}`} {
+		_, err := ParseSchema(src)
+		assert.Error(t, err, src)
+	}
+}
+
+func TestParseSchemaUnsupportedKeywords(t *testing.T) {
+	_, err := ParseSchema(`{"title":"Memo","description":"A memo","type":"object"}`)
+	assert.NoError(t, err)
+
+	_, err = ParseSchema(`{"$ref":"#/definitions/memo","definitions":{"memo":{}}}`)
+	assert.EqualError(t, err, "invalid JSON schema: $: unsupported keywords: $ref, definitions")
+	_, err = ParseSchema(`{"properties":{"date":{"type":"string","format":"date"}}}`)
+	assert.EqualError(t, err, "invalid JSON schema: $.date: unsupported keywords: format")
+	_, err = ParseSchema(`{"items":{"patternProperties":{"^a":{}}}}`)
+	assert.EqualError(t, err, "invalid JSON schema: $[]: unsupported keywords: patternProperties")
+}
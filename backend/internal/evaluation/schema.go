@@ -0,0 +1,357 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Schema is a compiled JSON schema. It supports the validation keywords of
+// JSON Schema that describe the shape of a document: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, allOf, anyOf and oneOf, plus the annotations that do not
+// constrain a document. Schemas using other keywords are rejected, as
+// ignoring them would pass documents the author meant to fail.
+type Schema struct {
+	// reject is set for the false schema, which no document is valid against.
+	reject bool
+
+	types            []string
+	enum             []interface{}
+	hasConst         bool
+	constValue       interface{}
+	properties       map[string]*Schema
+	required         []string
+	additional       *Schema
+	items            *Schema
+	minItems         *float64
+	maxItems         *float64
+	minLength        *float64
+	maxLength        *float64
+	pattern          *regexp.Regexp
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+	allOf            []*Schema
+	anyOf            []*Schema
+	oneOf            []*Schema
+}
+
+// schemaTypes are the values of the type keyword.
+var schemaTypes = map[string]bool{
+	"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+}
+
+// schemaKeywords are the keywords a schema may use.
+var schemaKeywords = map[string]bool{
+	"type": true, "enum": true, "const": true,
+	"properties": true, "required": true, "additionalProperties": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"minLength": true, "maxLength": true, "pattern": true,
+	"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true,
+	"allOf": true, "anyOf": true, "oneOf": true,
+	// Annotations
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true,
+}
+
+// ParseSchema compiles a JSON schema. Unsupported keywords are an error.
+func ParseSchema(src string) (*Schema, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(src), &v); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	s, err := compileSchema(v, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	return s, nil
+}
+
+func compileSchema(v interface{}, path string) (*Schema, error) {
+	switch v := v.(type) {
+	case bool:
+		return &Schema{reject: !v}, nil
+	case map[string]interface{}:
+		s := &Schema{}
+		if err := s.compile(v, path); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("%s: a schema must be an object or a boolean", schemaPath(path))
+}
+
+func (s *Schema) compile(m map[string]interface{}, path string) error {
+	var unsupported []string
+	for keyword := range m {
+		if !schemaKeywords[keyword] {
+			unsupported = append(unsupported, keyword)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("%s: unsupported keywords: %s", schemaPath(path), strings.Join(unsupported, ", "))
+	}
+
+	var err error
+	switch t := m["type"].(type) {
+	case nil:
+	case string:
+		s.types = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			name, _ := v.(string)
+			s.types = append(s.types, name)
+		}
+	default:
+		return fmt.Errorf("%s: type must be a string or an array", schemaPath(path))
+	}
+	for _, t := range s.types {
+		if !schemaTypes[t] {
+			return fmt.Errorf("%s: unknown type %q", schemaPath(path), t)
+		}
+	}
+
+	if v, ok := m["enum"]; ok {
+		if s.enum, ok = v.([]interface{}); !ok {
+			return fmt.Errorf("%s: enum must be an array", schemaPath(path))
+		}
+	}
+	s.constValue, s.hasConst = m["const"]
+
+	if v, ok := m["properties"]; ok {
+		props, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: properties must be an object", schemaPath(path))
+		}
+		s.properties = make(map[string]*Schema, len(props))
+		for name, prop := range props {
+			if s.properties[name], err = compileSchema(prop, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := m["required"]; ok {
+		names, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: required must be an array", schemaPath(path))
+		}
+		for _, name := range names {
+			n, ok := name.(string)
+			if !ok {
+				return fmt.Errorf("%s: required must list property names", schemaPath(path))
+			}
+			s.required = append(s.required, n)
+		}
+	}
+	if v, ok := m["additionalProperties"]; ok {
+		if s.additional, err = compileSchema(v, path+".*"); err != nil {
+			return err
+		}
+	}
+	if v, ok := m["items"]; ok {
+		if s.items, err = compileSchema(v, path+"[]"); err != nil {
+			return err
+		}
+	}
+
+	for keyword, dst := range map[string]**float64{
+		"minItems": &s.minItems, "maxItems": &s.maxItems,
+		"minLength": &s.minLength, "maxLength": &s.maxLength,
+		"minimum": &s.minimum, "maximum": &s.maximum,
+		"exclusiveMinimum": &s.exclusiveMinimum, "exclusiveMaximum": &s.exclusiveMaximum,
+	} {
+		if v, ok := m[keyword]; ok {
+			n, ok := v.(float64)
+			if !ok {
+				return fmt.Errorf("%s: %s must be a number", schemaPath(path), keyword)
+			}
+			*dst = &n
+		}
+	}
+	if v, ok := m["pattern"]; ok {
+		p, _ := v.(string)
+		if s.pattern, err = regexp.Compile(p); err != nil {
+			return fmt.Errorf("%s: invalid pattern: %v", schemaPath(path), err)
+		}
+	}
+
+	for keyword, dst := range map[string]*[]*Schema{"allOf": &s.allOf, "anyOf": &s.anyOf, "oneOf": &s.oneOf} {
+		v, ok := m[keyword]
+		if !ok {
+			continue
+		}
+		schemas, ok := v.([]interface{})
+		if !ok || len(schemas) == 0 {
+			return fmt.Errorf("%s: %s must be a non-empty array", schemaPath(path), keyword)
+		}
+		for i, sub := range schemas {
+			compiled, err := compileSchema(sub, fmt.Sprintf("%s.%s[%d]", path, keyword, i))
+			if err != nil {
+				return err
+			}
+			*dst = append(*dst, compiled)
+		}
+	}
+	return nil
+}
+
+// Validate checks a document decoded by encoding/json against the schema, and
+// returns the first violation found.
+func (s *Schema) Validate(doc interface{}) error {
+	return s.validate(doc, "")
+}
+
+func (s *Schema) validate(v interface{}, path string) error {
+	if s.reject {
+		return fmt.Errorf("%s: no value is allowed", schemaPath(path))
+	}
+	if len(s.types) > 0 && !s.hasType(v) {
+		return fmt.Errorf("%s: expected %s, got %s", schemaPath(path), strings.Join(s.types, " or "), jsonType(v))
+	}
+	if s.enum != nil && !containsValue(s.enum, v) {
+		return fmt.Errorf("%s: value is not one of the allowed values", schemaPath(path))
+	}
+	if s.hasConst && !reflect.DeepEqual(s.constValue, v) {
+		return fmt.Errorf("%s: value is not the expected constant", schemaPath(path))
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", schemaPath(path), name)
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			prop, ok := s.properties[name]
+			if !ok {
+				prop = s.additional
+			}
+			if prop != nil {
+				if err := prop.validate(v[name], path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if s.minItems != nil && float64(len(v)) < *s.minItems {
+			return fmt.Errorf("%s: expected at least %g items, got %d", schemaPath(path), *s.minItems, len(v))
+		}
+		if s.maxItems != nil && float64(len(v)) > *s.maxItems {
+			return fmt.Errorf("%s: expected at most %g items, got %d", schemaPath(path), *s.maxItems, len(v))
+		}
+		if s.items != nil {
+			for i, item := range v {
+				if err := s.items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		n := float64(utf8.RuneCountInString(v))
+		if s.minLength != nil && n < *s.minLength {
+			return fmt.Errorf("%s: expected at least %g characters", schemaPath(path), *s.minLength)
+		}
+		if s.maxLength != nil && n > *s.maxLength {
+			return fmt.Errorf("%s: expected at most %g characters", schemaPath(path), *s.maxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fmt.Errorf("%s: value does not match %s", schemaPath(path), s.pattern)
+		}
+	case float64:
+		switch {
+		case s.minimum != nil && v < *s.minimum:
+			return fmt.Errorf("%s: expected at least %g", schemaPath(path), *s.minimum)
+		case s.maximum != nil && v > *s.maximum:
+			return fmt.Errorf("%s: expected at most %g", schemaPath(path), *s.maximum)
+		case s.exclusiveMinimum != nil && v <= *s.exclusiveMinimum:
+			return fmt.Errorf("%s: expected more than %g", schemaPath(path), *s.exclusiveMinimum)
+		case s.exclusiveMaximum != nil && v >= *s.exclusiveMaximum:
+			return fmt.Errorf("%s: expected less than %g", schemaPath(path), *s.exclusiveMaximum)
+		}
+	}
+
+	for _, sub := range s.allOf {
+		if err := sub.validate(v, path); err != nil {
+			return err
+		}
+	}
+	if s.anyOf != nil && countValid(s.anyOf, v, path) == 0 {
+		return fmt.Errorf("%s: value matches none of anyOf", schemaPath(path))
+	}
+	if s.oneOf != nil {
+		if n := countValid(s.oneOf, v, path); n != 1 {
+			return fmt.Errorf("%s: value matches %d of oneOf, expected exactly one", schemaPath(path), n)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) hasType(v interface{}) bool {
+	actual := jsonType(v)
+	for _, t := range s.types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonType returns the JSON type of a decoded value; numbers without a
+// fractional part are integers.
+func jsonType(v interface{}) string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, allowed := range values {
+		if reflect.DeepEqual(allowed, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func countValid(schemas []*Schema, v interface{}, path string) int {
+	n := 0
+	for _, s := range schemas {
+		if s.validate(v, path) == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// schemaPath names the location of a value of the document, $ being the root.
+func schemaPath(path string) string {
+	return "$" + path
+}
@@ -0,0 +1,89 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// FakeProvider is a deterministic provider for tests and local development.
+// It answers with the last message of the request, prefixed by the model,
+// and counts one token per word.
+type FakeProvider struct {
+	// ChunkDelay is the time Stream waits before each word.
+	ChunkDelay time.Duration
+}
+
+// NewFakeProvider creates a fake provider.
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+// Name implements Provider.
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+// Complete implements Provider. The answer is cut to MaxTokens words when it
+// is set, with the "length" finish reason.
+func (p *FakeProvider) Complete(ctx context.Context, req *Request) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var prompt []string
+	for _, m := range req.Messages {
+		prompt = append(prompt, strings.Fields(m.Content)...)
+	}
+	words := fakeAnswer(req)
+	finishReason := "stop"
+	if req.MaxTokens > 0 && len(words) > int(req.MaxTokens) {
+		words = words[:req.MaxTokens]
+		finishReason = "length"
+	}
+	return &Response{
+		Model:        req.Model,
+		Text:         strings.Join(words, " "),
+		FinishReason: finishReason,
+		Usage: Usage{
+			PromptTokens:     int32(len(prompt)),
+			CompletionTokens: int32(len(words)),
+			TotalTokens:      int32(len(prompt) + len(words)),
+		},
+	}, nil
+}
+
+// fakeAnswer returns the words of the answer to req.
+func fakeAnswer(req *Request) []string {
+	last := ""
+	if len(req.Messages) > 0 {
+		last = req.Messages[len(req.Messages)-1].Content
+	}
+	return append([]string{"[" + req.Model + "]"}, strings.Fields(last)...)
+}
+
+// Stream implements Provider, streaming the answer of Complete word by word.
+func (p *FakeProvider) Stream(ctx context.Context, req *Request, onDelta func(delta string) error) (*Response, error) {
+	resp, err := p.Complete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	words := strings.Fields(resp.Text)
+	partial := &Response{Model: resp.Model, Usage: Usage{PromptTokens: resp.Usage.PromptTokens, TotalTokens: resp.Usage.PromptTokens}}
+	for i, word := range words {
+		if i > 0 {
+			word = " " + word
+		}
+		select {
+		case <-ctx.Done():
+			return partial, ctx.Err()
+		case <-time.After(p.ChunkDelay):
+		}
+		if err := onDelta(word); err != nil {
+			return partial, err
+		}
+		partial.Text += word
+		partial.Usage.CompletionTokens++
+		partial.Usage.TotalTokens++
+	}
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package llm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeProvider(t *testing.T) {
+	p := NewFakeProvider()
+	req := &Request{Model: "m", Messages: []Message{{Role: RoleSystem, Content: "Be brief"}, {Role: RoleUser, Content: "one two three"}}}
+	resp, err := p.Complete(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "[m] one two three", resp.Text)
+	assert.Equal(t, Usage{PromptTokens: 5, CompletionTokens: 4, TotalTokens: 9}, resp.Usage)
+
+	req.MaxTokens = 2
+	resp, err = p.Complete(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "[m] one", resp.Text)
+	assert.Equal(t, "length", resp.FinishReason)
+}
+
+func TestFakeProviderStream(t *testing.T) {
+	p := NewFakeProvider()
+	req := &Request{Model: "m", Messages: []Message{{Role: RoleUser, Content: "one two three"}}}
+	var deltas []string
+	resp, err := p.Stream(context.Background(), req, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[m]", " one", " two", " three"}, deltas)
+	assert.Equal(t, "[m] one two three", resp.Text)
+
+	// Cancelling stops the stream with what was generated so far.
+	p.ChunkDelay = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err = p.Stream(ctx, req, func(delta string) error {
+		if delta == " one" {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "[m] one", resp.Text)
+	assert.Equal(t, int32(2), resp.Usage.CompletionTokens)
+}
@@ -0,0 +1,214 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxErrorBodySize bounds how much of an error response is read for its message.
+const maxErrorBodySize = 64 << 10
+
+// OpenAIProvider completes requests with the chat completions API of OpenAI,
+// or of any server compatible with it.
+type OpenAIProvider struct {
+	baseURL string
+	apiKey  string
+	timeout time.Duration
+	client  *http.Client
+}
+
+// NewOpenAIProvider creates a provider for the API at baseURL, such as
+// https://api.openai.com/v1. When timeout is positive, completions time out
+// after it, and streams when they do not start within it.
+func NewOpenAIProvider(baseURL, apiKey string, timeout time.Duration) *OpenAIProvider {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = timeout
+	return &OpenAIProvider{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		timeout: timeout,
+		client:  &http.Client{Transport: transport},
+	}
+}
+
+// Name implements Provider.
+func (p *OpenAIProvider) Name() string {
+	return "openai"
+}
+
+// chatCompletion is the part of a chat completion response the provider reads.
+type chatCompletion struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message      Message `json:"message"`
+		FinishReason string  `json:"finish_reason"`
+	} `json:"choices"`
+	Usage Usage `json:"usage"`
+}
+
+// Complete implements Provider.
+func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response, error) {
+	zap.S().Infof("OpenAIProvider.Complete: model=%s messages=%d", req.Model, len(req.Messages))
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	resp, err := p.post(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var completion chatCompletion
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return nil, fmt.Errorf("failed to decode completion: %w", err)
+	}
+	if len(completion.Choices) == 0 {
+		return nil, fmt.Errorf("completion has no choices")
+	}
+	return &Response{
+		Model:        completion.Model,
+		Text:         completion.Choices[0].Message.Content,
+		FinishReason: completion.Choices[0].FinishReason,
+		Usage:        completion.Usage,
+	}, nil
+}
+
+// streamRequest is a chat completion request answered with server-sent events.
+type streamRequest struct {
+	*Request
+	Stream        bool `json:"stream"`
+	StreamOptions struct {
+		IncludeUsage bool `json:"include_usage"`
+	} `json:"stream_options"`
+}
+
+// chatCompletionChunk is the part of a streamed chat completion event the
+// provider reads. The last event carries the usage and no choices.
+type chatCompletionChunk struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Delta        Message `json:"delta"`
+		FinishReason string  `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *Usage `json:"usage"`
+}
+
+// Stream implements Provider. The usage is only known when the server sends
+// it, as OpenAI does when asked with stream_options.
+func (p *OpenAIProvider) Stream(ctx context.Context, req *Request, onDelta func(delta string) error) (*Response, error) {
+	zap.S().Infof("OpenAIProvider.Stream: model=%s messages=%d", req.Model, len(req.Messages))
+	body := streamRequest{Request: req, Stream: true}
+	body.StreamOptions.IncludeUsage = true
+	resp, err := p.post(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	result := &Response{Model: req.Model}
+	var text strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+		var chunk chatCompletionChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			result.Text = text.String()
+			return result, fmt.Errorf("failed to decode completion chunk: %w", err)
+		}
+		if chunk.Model != "" {
+			result.Model = chunk.Model
+		}
+		if chunk.Usage != nil {
+			result.Usage = *chunk.Usage
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		if reason := chunk.Choices[0].FinishReason; reason != "" {
+			result.FinishReason = reason
+		}
+		if delta := chunk.Choices[0].Delta.Content; delta != "" {
+			if err := onDelta(delta); err != nil {
+				result.Text = text.String()
+				return result, err
+			}
+			text.WriteString(delta)
+		}
+	}
+	result.Text = text.String()
+	if err := scanner.Err(); err != nil {
+		// A cancelled context surfaces as a read error of the body.
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		return result, fmt.Errorf("failed to read completion stream: %w", err)
+	}
+	return result, nil
+}
+
+// post sends body to the chat completions endpoint and returns the response
+// when it is successful, else an APIError.
+func (p *OpenAIProvider) post(ctx context.Context, body interface{}) (*http.Response, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call provider: %w", err)
+	}
+	if resp.StatusCode/100 != 2 {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: errorMessage(resp.Body)}
+	}
+	return resp, nil
+}
+
+// errorMessage returns the message of an error response: the error.message
+// field of an OpenAI error, else the body itself.
+func errorMessage(body io.Reader) string {
+	b, _ := io.ReadAll(io.LimitReader(body, maxErrorBodySize))
+	var apiErr struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(b, &apiErr); err == nil && apiErr.Error.Message != "" {
+		return apiErr.Error.Message
+	}
+	return strings.TrimSpace(string(b))
+}
@@ -0,0 +1,113 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAIProviderComplete(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{
+			"model": "gpt-test-0613",
+			"choices": [{"index": 0, "message": {"role": "assistant", "content": "Hello!"}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 5, "completion_tokens": 2, "total_tokens": 7}
+		}`))
+	}))
+	defer server.Close()
+
+	temperature := 0.0
+	p := NewOpenAIProvider(server.URL+"/v1/", "sk-test", time.Second)
+	resp, err := p.Complete(context.Background(), &Request{
+		Model:       "gpt-test",
+		Messages:    []Message{{Role: RoleUser, Content: "Say hello"}},
+		Temperature: &temperature,
+		MaxTokens:   16,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Response{Model: "gpt-test-0613", Text: "Hello!", FinishReason: "stop", Usage: Usage{5, 2, 7}}, resp)
+
+	// A zero temperature is sent; unset parameters are not.
+	assert.Equal(t, 0.0, got["temperature"])
+	assert.Equal(t, 16.0, got["max_tokens"])
+	assert.NotContains(t, got, "top_p")
+}
+
+func TestOpenAIProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"message": "The model does not exist", "type": "invalid_request_error"}}`))
+	}))
+	defer server.Close()
+
+	_, err := NewOpenAIProvider(server.URL, "", time.Second).Complete(context.Background(), &Request{Model: "nope"})
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "The model does not exist", apiErr.Message)
+}
+
+func TestOpenAIProviderStream(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range []string{
+			`{"model":"gpt-test-0613","choices":[{"delta":{"role":"assistant","content":""}}]}`,
+			`{"model":"gpt-test-0613","choices":[{"delta":{"content":"Hel"}}]}`,
+			`{"model":"gpt-test-0613","choices":[{"delta":{"content":"lo!"},"finish_reason":"stop"}]}`,
+			`{"model":"gpt-test-0613","choices":[],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`,
+			`[DONE]`,
+		} {
+			_, _ = w.Write([]byte("data: " + event + "\n\n"))
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer server.Close()
+
+	var deltas []string
+	resp, err := NewOpenAIProvider(server.URL, "", time.Second).Stream(context.Background(), &Request{Model: "gpt-test"}, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Hel", "lo!"}, deltas)
+	assert.Equal(t, &Response{Model: "gpt-test-0613", Text: "Hello!", FinishReason: "stop", Usage: Usage{5, 2, 7}}, resp)
+	assert.Equal(t, true, got["stream"])
+	assert.Equal(t, map[string]interface{}{"include_usage": true}, got["stream_options"])
+}
+
+func TestOpenAIProviderStreamCancel(t *testing.T) {
+	upstreamDone := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`data: {"choices":[{"delta":{"content":"Once"}}]}` + "\n\n"))
+		w.(http.Flusher).Flush()
+		// Generate until the client goes away.
+		<-r.Context().Done()
+		close(upstreamDone)
+	}))
+	defer server.Close()
+
+	stop := errors.New("client gone")
+	resp, err := NewOpenAIProvider(server.URL, "", time.Second).Stream(context.Background(), &Request{Model: "gpt-test"}, func(delta string) error {
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, "", resp.Text)
+
+	select {
+	case <-upstreamDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the upstream request was not cancelled")
+	}
+}
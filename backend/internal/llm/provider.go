@@ -0,0 +1,71 @@
+// Package llm runs prompts on large language models through pluggable providers.
+package llm
+
+import (
+	"context"
+	"fmt"
+)
+
+// Chat roles of the messages of a request.
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// Message is a message of the conversation sent to a model.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Request is a chat completion request. Unset parameters are left to the
+// defaults of the provider.
+type Request struct {
+	Model       string    `json:"model"`
+	Messages    []Message `json:"messages"`
+	Temperature *float64  `json:"temperature,omitempty"`
+	TopP        *float64  `json:"top_p,omitempty"`
+	MaxTokens   int32     `json:"max_tokens,omitempty"`
+	Stop        []string  `json:"stop,omitempty"`
+}
+
+// Usage counts the tokens of a completion.
+type Usage struct {
+	PromptTokens     int32 `json:"prompt_tokens"`
+	CompletionTokens int32 `json:"completion_tokens"`
+	TotalTokens      int32 `json:"total_tokens"`
+}
+
+// Response is the completion of a request.
+type Response struct {
+	// Model is the model that answered, which may be more specific than the
+	// one requested.
+	Model        string `json:"model"`
+	Text         string `json:"text"`
+	FinishReason string `json:"finish_reason"`
+	Usage        Usage  `json:"usage"`
+}
+
+// Provider completes requests with the models of an LLM vendor.
+type Provider interface {
+	// Name identifies the provider in stored executions.
+	Name() string
+	// Complete runs req and returns the completion of the first choice.
+	Complete(ctx context.Context, req *Request) (*Response, error)
+	// Stream runs req like Complete, passing the text of the first choice to
+	// onDelta as it is generated. An error of onDelta stops the generation and
+	// is returned. On error, the response, when not nil, holds what was
+	// generated before it.
+	Stream(ctx context.Context, req *Request, onDelta func(delta string) error) (*Response, error)
+}
+
+// APIError is an error answered by the API of a provider.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("provider returned %d: %s", e.StatusCode, e.Message)
+}
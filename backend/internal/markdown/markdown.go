@@ -0,0 +1,137 @@
+// Package markdown renders the Markdown subset allowed in user content to HTML
+// that is safe to embed in a page.
+//
+// The source is escaped before any formatting is applied, so the only markup in
+// the output is the fixed set of tags produced here: paragraphs, line breaks,
+// emphasis, inline and fenced code, block quotes, bullet lists and links to
+// http, https and mailto URLs. Raw HTML in the source is shown as text.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	linkPattern   = regexp.MustCompile(`\[([^\]\n]+)\]\(([^)\s]+)\)`)
+	strongPattern = regexp.MustCompile(`\*\*([^*\n]+)\*\*`)
+	emPattern     = regexp.MustCompile(`\*([^*\n]+)\*`)
+	// placeholderPattern matches the markers standing in for links and code spans
+	// while emphasis is applied, so that URLs and code are left untouched.
+	placeholderPattern = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// Render converts Markdown source to sanitized HTML.
+func Render(src string) string {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	var b strings.Builder
+	var paragraph, list, quote []string
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			b.WriteString("<p>" + strings.Join(paragraph, "<br>") + "</p>")
+			paragraph = nil
+		}
+		if len(list) > 0 {
+			b.WriteString("<ul>")
+			for _, item := range list {
+				b.WriteString("<li>" + item + "</li>")
+			}
+			b.WriteString("</ul>")
+			list = nil
+		}
+		if len(quote) > 0 {
+			b.WriteString("<blockquote><p>" + strings.Join(quote, "<br>") + "</p></blockquote>")
+			quote = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>")
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			if len(list) == 0 {
+				flush()
+			}
+			list = append(list, inline(trimmed[2:]))
+		case strings.HasPrefix(trimmed, ">"):
+			if len(quote) == 0 {
+				flush()
+			}
+			quote = append(quote, inline(strings.TrimSpace(trimmed[1:])))
+		default:
+			if len(paragraph) == 0 {
+				flush()
+			}
+			paragraph = append(paragraph, inline(trimmed))
+		}
+	}
+	flush()
+	return b.String()
+}
+
+// inline renders the inline formatting of a single line.
+func inline(s string) string {
+	var held []string
+	hold := func(fragment string) string {
+		held = append(held, fragment)
+		return fmt.Sprintf("\x00%d\x00", len(held)-1)
+	}
+
+	// Code spans are taken out first: nothing inside them is formatted.
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(s, '`')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(s[start+1:], '`')
+		if end < 0 {
+			break
+		}
+		b.WriteString(strings.ReplaceAll(s[:start], "\x00", ""))
+		b.WriteString(hold("<code>" + html.EscapeString(s[start+1:start+1+end]) + "</code>"))
+		s = s[start+1+end+1:]
+	}
+	b.WriteString(strings.ReplaceAll(s, "\x00", ""))
+
+	out := html.EscapeString(b.String())
+	out = linkPattern.ReplaceAllStringFunc(out, func(m string) string {
+		parts := linkPattern.FindStringSubmatch(m)
+		text, url := parts[1], html.UnescapeString(parts[2])
+		if !allowedURL(url) {
+			return text
+		}
+		return hold(fmt.Sprintf(`<a href="%s" rel="nofollow noopener noreferrer">`, html.EscapeString(url))) + text + hold("</a>")
+	})
+	out = strongPattern.ReplaceAllString(out, "<strong>$1</strong>")
+	out = emPattern.ReplaceAllString(out, "<em>$1</em>")
+	return placeholderPattern.ReplaceAllStringFunc(out, func(m string) string {
+		var i int
+		_, _ = fmt.Sscanf(strings.Trim(m, "\x00"), "%d", &i)
+		return held[i]
+	})
+}
+
+// allowedURL reports whether a link target uses a scheme that cannot run script.
+func allowedURL(url string) bool {
+	lower := strings.ToLower(url)
+	for _, scheme := range []string{"http://", "https://", "mailto:"} {
+		if strings.HasPrefix(lower, scheme) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"Paragraphs", "one\ntwo\n\nthree", "<p>one<br>two</p><p>three</p>"},
+		{"Emphasis", "**bold** and *italic*", "<p><strong>bold</strong> and <em>italic</em></p>"},
+		{"InlineCode", "use `{{name}} <b>` here", "<p>use <code>{{name}} &lt;b&gt;</code> here</p>"},
+		{"CodeIsNotFormatted", "`**x**`", "<p><code>**x**</code></p>"},
+		{"FencedCode", "```\n<script>\n**x**\n```", "<pre><code>&lt;script&gt;\n**x**</code></pre>"},
+		{"List", "intro\n- a\n* **b**", "<p>intro</p><ul><li>a</li><li><strong>b</strong></li></ul>"},
+		{"Quote", "> quoted\n> text", "<blockquote><p>quoted<br>text</p></blockquote>"},
+		{"Link", "[docs](https://example.com/a?b=1&c=*d*)", `<p><a href="https://example.com/a?b=1&amp;c=*d*" rel="nofollow noopener noreferrer">docs</a></p>`},
+		{"RawHTML", `<img src=x onerror="alert(1)">`, "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>"},
+		{"ScriptLink", "[click](JavaScript:alert`1`)", "<p>click</p>"},
+		{"AttributeBreakout", `[x](https://a"onmouseover="alert(1))`, `<p><a href="https://a&#34;onmouseover=&#34;alert(1" rel="nofollow noopener noreferrer">x</a>)</p>`},
+		{"Placeholders", "a\x000\x00b", "<p>a0b</p>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Render(tt.src))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditEvent is an entry of the audit log: a mutating operation performed by a user.
+// It maps to the "audit_events" table.
+type AuditEvent struct {
+	ID           int64           `json:"id"`
+	ActorID      string          `json:"actor_id"`
+	ActorRole    string          `json:"actor_role"`
+	Action       string          `json:"action"` // e.g. "template.update"
+	ResourceType string          `json:"resource_type"`
+	ResourceID   string          `json:"resource_id"`
+	OwnerID      string          `json:"owner_id"`
+	Before       json.RawMessage `json:"before"` // Stored as JSONB in DB, null on creation
+	After        json.RawMessage `json:"after"`  // Stored as JSONB in DB, null on deletion
+	ClientIP     string          `json:"client_ip"`
+	RequestID    string          `json:"request_id"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Collection represents a user-owned, ordered list of templates.
+// It maps to the "collections" table.
+type Collection struct {
+	ID            string         `json:"id"`
+	OwnerID       string         `json:"owner_id"`
+	Name          string         `json:"name"`
+	Description   sql.NullString `json:"description"`
+	Visibility    string         `json:"visibility"`
+	FollowerCount int32          `json:"follower_count"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// Transient fields (not in collections table)
+	ItemCount   int32 `json:"item_count"`
+	IsFollowing bool  `json:"is_following"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Comment is a comment on a template, possibly replying to another comment.
+// It maps to the "template_comments" table.
+type Comment struct {
+	ID         string         `json:"id"`
+	TemplateID string         `json:"template_id"`
+	ParentID   sql.NullString `json:"parent_id"`
+	Version    sql.NullInt32  `json:"version"` // template version the comment is about
+	AuthorID   string         `json:"author_id"`
+	Body       string         `json:"body"`      // Markdown source
+	BodyHTML   string         `json:"body_html"` // sanitized rendering of Body
+	EditedAt   sql.NullTime   `json:"edited_at"`
+	DeletedAt  sql.NullTime   `json:"deleted_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+
+	// Transient fields (not in template_comments table)
+	AuthorName string `json:"author_name"`
+	ReplyCount int32  `json:"reply_count"`
+}
+
+// Deleted reports whether the comment was deleted and only stays as a placeholder in its thread.
+func (c *Comment) Deleted() bool {
+	return c.DeletedAt.Valid
+}
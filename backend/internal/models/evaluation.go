@@ -0,0 +1,39 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// EvaluationCase is a test case of a template: the values filling its
+// placeholders and the assertions its outputs must pass.
+// It maps to the "evaluation_cases" table.
+type EvaluationCase struct {
+	ID             string          `json:"id"`
+	TemplateID     string          `json:"template_id"`
+	Name           string          `json:"name"`
+	Variables      pq.StringArray  `json:"variables"`       // Values of the $$ placeholders, in order
+	NamedVariables json.RawMessage `json:"named_variables"` // Values of the named placeholders, stored as JSONB
+	Assertions     json.RawMessage `json:"assertions"`      // []evaluation.Assertion, stored as JSONB
+	CreatedBy      string          `json:"created_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// EvaluationRun is a run of the test cases of a template on one of its versions.
+// It maps to the "evaluation_runs" table.
+type EvaluationRun struct {
+	ID         string          `json:"id"`
+	TemplateID string          `json:"template_id"`
+	VersionID  int32           `json:"version_id"`
+	Version    int32           `json:"version"`
+	CreatedBy  string          `json:"created_by"`
+	Provider   string          `json:"provider"`
+	Model      string          `json:"model"`
+	Passed     int32           `json:"passed"`
+	Failed     int32           `json:"failed"`
+	Results    json.RawMessage `json:"results"` // Results of the cases, stored as JSONB
+	CreatedAt  time.Time       `json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// PromptExecution is a run of a prompt on a language model.
+// It maps to the "prompt_executions" table.
+type PromptExecution struct {
+	ID               string          `json:"id"`
+	OwnerID          string          `json:"owner_id"`
+	PromptID         sql.NullString  `json:"prompt_id"` // NULL when the text was given directly
+	Provider         string          `json:"provider"`
+	Model            string          `json:"model"`
+	Request          json.RawMessage `json:"request"` // llm.Request sent to the provider, stored as JSONB
+	ResponseText     string          `json:"response_text"`
+	FinishReason     string          `json:"finish_reason"`
+	Status           string          `json:"status"` // "succeeded", "failed" or "cancelled"
+	Error            string          `json:"error"`
+	LatencyMs        int32           `json:"latency_ms"`
+	PromptTokens     int32           `json:"prompt_tokens"`
+	CompletionTokens int32           `json:"completion_tokens"`
+	TotalTokens      int32           `json:"total_tokens"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// FeedItem is an event of a followed author shown in a user's feed: a new
+// public template, a new version of one, or a public fork.
+type FeedItem struct {
+	ID         string    `json:"id"`   // unique per event, used for pagination
+	Type       string    `json:"type"` // "template", "version" or "fork"
+	Template   Template  `json:"template"`
+	Version    int32     `json:"version"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// Transient fields (not in any table)
+	ActorName string `json:"actor_name"`
+}
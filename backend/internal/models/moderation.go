@@ -0,0 +1,28 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TemplateReport is a user's report of an abusive or spammy template.
+// It maps to the "template_reports" table.
+type TemplateReport struct {
+	ID         string         `json:"id"`
+	TemplateID string         `json:"template_id"`
+	ReporterID string         `json:"reporter_id"`
+	Reason     string         `json:"reason"` // "spam", "abuse", "inappropriate", "copyright" or "other"
+	Details    string         `json:"details"`
+	Status     string         `json:"status"` // "open", "dismissed" or "actioned"
+	ResolvedBy sql.NullString `json:"resolved_by"`
+	ResolvedAt sql.NullTime   `json:"resolved_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// ModerationQueueItem is a template waiting for an administrator's decision
+// together with its open reports.
+type ModerationQueueItem struct {
+	Template        *Template         `json:"template"`
+	Reports         []*TemplateReport `json:"reports"`
+	FirstReportedAt time.Time         `json:"first_reported_at"`
+}
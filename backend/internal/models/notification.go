@@ -0,0 +1,29 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Notification tells a user about activity on their templates or comments.
+// Repeated unread events of the same type on the same template are collapsed
+// into a single notification. It maps to the "notifications" table.
+type Notification struct {
+	ID         string         `json:"id"`
+	UserID     string         `json:"user_id"` // recipient
+	Type       string         `json:"type"`    // "like", "favorite", "fork", "comment", "reply" or "review"
+	ActorID    string         `json:"actor_id"`
+	ActorIDs   pq.StringArray `json:"actor_ids"`
+	EventCount int32          `json:"event_count"`
+	TemplateID sql.NullString `json:"template_id"`
+	CommentID  sql.NullString `json:"comment_id"`
+	ReadAt     sql.NullTime   `json:"read_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+
+	// Transient fields (not in notifications table)
+	ActorName     string `json:"actor_name"`
+	TemplateTitle string `json:"template_title"`
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Organization is a team sharing a library of templates.
+// It maps to the "organizations" table.
+type Organization struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Transient fields (not in organizations table)
+	MemberCount int32  `json:"member_count"`
+	Role        string `json:"role"` // Role of the current user, empty if not a member
+}
+
+// OrganizationMember is a user's membership of an organization.
+// It maps to the "organization_members" table.
+type OrganizationMember struct {
+	OrgID     string    `json:"org_id"`
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"` // "owner", "admin" or "member"
+	CreatedAt time.Time `json:"created_at"`
+
+	// Transient fields (not in organization_members table)
+	DisplayName string `json:"display_name"`
+}
+
+// OrganizationInvitation invites an email address to join an organization.
+// It maps to the "organization_invitations" table.
+type OrganizationInvitation struct {
+	ID         string       `json:"id"`
+	OrgID      string       `json:"org_id"`
+	Email      string       `json:"email"`
+	Role       string       `json:"role"` // "admin" or "member"
+	TokenHash  string       `json:"-"`
+	InvitedBy  string       `json:"invited_by"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+	AcceptedAt sql.NullTime `json:"accepted_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// Pending reports whether the invitation can still be accepted at the given time.
+func (i *OrganizationInvitation) Pending(now time.Time) bool {
+	return !i.AcceptedAt.Valid && now.Before(i.ExpiresAt)
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// VariablePreset is a named set of placeholder values of a user or an organization.
+// It maps to the "variable_presets" table.
+type VariablePreset struct {
+	ID        string          `json:"id"`
+	OwnerID   string          `json:"owner_id"`
+	OrgID     sql.NullString  `json:"org_id"` // NULL for personal presets
+	Name      string          `json:"name"`
+	Values    json.RawMessage `json:"values"` // Placeholder values by name, stored as JSONB
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
@@ -3,17 +3,30 @@ package models
 import (
 	"encoding/json"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 // Prompt represents the prompt model in the database.
 // It maps to the "prompts" table.
 type Prompt struct {
-	ID         string          `json:"id"`
-	TemplateID string          `json:"template_id"`
-	VersionID  int32           `json:"version_id"`
-	OwnerID    string          `json:"owner_id"`
-	Variables  json.RawMessage `json:"variables"` // Stored as JSONB in DB
-	CreatedAt  time.Time       `json:"created_at"`
+	ID             string          `json:"id"`
+	TemplateID     string          `json:"template_id"`
+	VersionID      int32           `json:"version_id"`
+	OwnerID        string          `json:"owner_id"`
+	Variables      json.RawMessage `json:"variables"`       // Stored as JSONB in DB
+	NamedVariables json.RawMessage `json:"named_variables"` // Values of the named placeholders, by name
+	Title          string          `json:"title"`
+	Notes          string          `json:"notes"`
+	Tags           pq.StringArray  `json:"tags"`
+	Pinned         bool            `json:"pinned"`
+	RenderedText   string          `json:"rendered_text"` // Version content with the variables substituted
+	CreatedAt      time.Time       `json:"created_at"`
+	LastUsedAt     time.Time       `json:"last_used_at"`
+
+	// Joined from the template and its version when the prompt is read.
+	TemplateTitle string `json:"template_title"`
+	Version       int32  `json:"version"`
 }
 
 // PromptVariables is a helper struct to parse the Variables JSON.
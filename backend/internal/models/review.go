@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Review is a user's star rating of a template, with an optional written review.
+// It maps to the "template_reviews" table.
+type Review struct {
+	ID         string    `json:"id"`
+	TemplateID string    `json:"template_id"`
+	UserID     string    `json:"user_id"`
+	Rating     int32     `json:"rating"` // 1 to 5 stars
+	Body       string    `json:"body"`
+	Version    int32     `json:"version"` // template version the review was written against
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// Transient fields (not in template_reviews table)
+	UserName string `json:"user_name"`
+	// Stale reports whether a major version of the template came out after the reviewed one.
+	Stale bool `json:"stale"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TemplateGrant gives a user access to a template.
+// It maps to the "template_grants" table.
+type TemplateGrant struct {
+	TemplateID string    `json:"template_id"`
+	UserID     string    `json:"user_id"`
+	Role       string    `json:"role"` // "viewer" or "editor"
+	GrantedBy  string    `json:"granted_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ShareLink is a secret link giving read access to a template.
+// It maps to the "template_share_links" table.
+type ShareLink struct {
+	ID         string       `json:"id"`
+	TemplateID string       `json:"template_id"`
+	TokenHash  string       `json:"-"`
+	CreatedBy  string       `json:"created_by"`
+	ExpiresAt  sql.NullTime `json:"expires_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// Active reports whether the link still grants access at the given time.
+func (l *ShareLink) Active(now time.Time) bool {
+	return !l.RevokedAt.Valid && (!l.ExpiresAt.Valid || now.Before(l.ExpiresAt.Time))
+}
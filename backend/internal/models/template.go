@@ -10,31 +10,45 @@ import (
 // Template represents the template model in the database.
 // It maps to the "templates" table.
 type Template struct {
-	ID            string         `json:"id"`
-	OwnerID       string         `json:"owner_id"`
-	Title         string         `json:"title"`
-	Description   sql.NullString `json:"description"`
-	Visibility    string         `json:"visibility"`
-	Type          string         `json:"type"`
-	Tags          pq.StringArray `json:"tags"`
-	Category      sql.NullString `json:"category"`
-	Language      string         `json:"language"`
-	LikeCount     int32          `json:"like_count"`
-	FavoriteCount int32          `json:"favorite_count"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
+	ID              string         `json:"id"`
+	OwnerID         string         `json:"owner_id"`
+	Title           string         `json:"title"`
+	Description     sql.NullString `json:"description"`
+	Visibility      string         `json:"visibility"`
+	Type            string         `json:"type"`
+	Tags            pq.StringArray `json:"tags"`
+	Category        sql.NullString `json:"category"`
+	Language        string         `json:"language"`
+	LikeCount       int32          `json:"like_count"`
+	FavoriteCount   int32          `json:"favorite_count"`
+	TrendingScore   float64        `json:"trending_score"`
+	ForkedFrom      sql.NullString `json:"forked_from"`
+	OrgID           sql.NullString `json:"org_id"`
+	FeaturedAt      sql.NullTime   `json:"featured_at"`
+	ModerationState string         `json:"moderation_state"` // "visible", "hidden" or "removed"
+	CommentCount    int32          `json:"comment_count"`
+	RatingCount     int32          `json:"rating_count"`
+	RatingAverage   float64        `json:"rating_average"`
+	RatingScore     float64        `json:"rating_score"` // Bayesian average, used for sorting
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
 
 	// Transient fields (not in templates table)
 	IsLiked     bool `json:"is_liked"`
 	IsFavorited bool `json:"is_favorited"`
+	// Position within the collection, only set when listing a collection.
+	CollectionPosition int32 `json:"collection_position,omitempty"`
 }
 
 // TemplateVersion represents a version of a template.
 // It maps to the "template_versions" table.
 type TemplateVersion struct {
-	ID         int32     `json:"id"`
-	TemplateID string    `json:"template_id"`
-	Version    int32     `json:"version"`
-	Content    string    `json:"content"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID         int32  `json:"id"`
+	TemplateID string `json:"template_id"`
+	Version    int32  `json:"version"`
+	Content    string `json:"content"`
+	Major      bool   `json:"major"` // reviews of earlier versions are stale
+	// OverridableVariables are the reserved sys.* variables prompts may set.
+	OverridableVariables pq.StringArray `json:"overridable_variables"`
+	CreatedAt            time.Time      `json:"created_at"`
 }
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// UsageEvent is something done with a template that its usage statistics count.
+type UsageEvent struct {
+	TemplateID string
+	// Version is the version number the event is about, 0 when unknown.
+	Version int32
+	// VersionID identifies the version by row instead, when its number is not at hand.
+	VersionID int32
+	Event     string // "view", "render", "copy" or "instantiate"
+	At        time.Time
+}
+
+// UsageBucket is the number of events of a kind on a template during a UTC day.
+// It maps to the "template_usage_daily" table, summed over versions unless one is selected.
+type UsageBucket struct {
+	Day   time.Time `json:"day"`
+	Event string    `json:"event"`
+	Count int64     `json:"count"`
+}
@@ -6,12 +6,29 @@ import (
 )
 
 type User struct {
-	ID           string         `json:"id"`
-	Email        string         `json:"email"`
-	Mobile       sql.NullString `json:"mobile"`
-	PasswordHash string         `json:"-"`
-	DisplayName  string         `json:"display_name"`
-	Avatar       string         `json:"avatar"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
+	ID             string         `json:"id"`
+	Email          string         `json:"email"`
+	Mobile         sql.NullString `json:"mobile"`
+	PasswordHash   string         `json:"-"`
+	DisplayName    string         `json:"display_name"`
+	Avatar         string         `json:"avatar"`
+	Bio            string         `json:"bio"`
+	Role           string         `json:"role"` // "user" or "admin"
+	SuspendedAt    sql.NullTime   `json:"suspended_at"`
+	FollowerCount  int32          `json:"follower_count"`
+	FollowingCount int32          `json:"following_count"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// Suspended reports whether the user is barred from signing in.
+func (u *User) Suspended() bool {
+	return u.SuspendedAt.Valid
+}
+
+// AuthorStats summarizes the public templates of a user for their profile page.
+type AuthorStats struct {
+	PublicTemplateCount int32 `json:"public_template_count"`
+	TotalLikes          int64 `json:"total_likes"`
+	TotalFavorites      int64 `json:"total_favorites"`
 }
@@ -0,0 +1,344 @@
+// Package policy decides which actions an identity may perform on a resource.
+//
+// Decisions are pure: callers resolve the facts a decision depends on (the
+// resource itself and, for templates, the caller's grants, organization role
+// and share link) and the policy only compares them. This keeps every
+// authorization rule of the service in one place and testable without storage.
+package policy
+
+import "awsome-prompt/backend/internal/models"
+
+// Action is something a principal wants to do with a resource.
+type Action string
+
+const (
+	// Read views a resource.
+	Read Action = "read"
+	// Update changes a resource.
+	Update Action = "update"
+	// Delete removes a resource.
+	Delete Action = "delete"
+	// Fork copies a template into the principal's own library.
+	Fork Action = "fork"
+	// Instantiate saves a prompt filled in from a template.
+	Instantiate Action = "instantiate"
+	// Share manages who can access a template: grants, share links and visibility.
+	Share Action = "share"
+	// Create adds a new template.
+	Create Action = "create"
+	// Comment posts a comment or a reply on a template.
+	Comment Action = "comment"
+	// Review rates and reviews a template.
+	Review Action = "review"
+	// ViewStats reads the usage statistics of a template.
+	ViewStats Action = "view_stats"
+	// Invite invites someone to join an organization.
+	Invite Action = "invite"
+)
+
+// Roles of user accounts.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// Principal is the identity a request is made on behalf of.
+// The zero value is an anonymous caller.
+type Principal struct {
+	UserID string
+	Role   string
+}
+
+// Anonymous reports whether the principal is not signed in.
+func (p Principal) Anonymous() bool {
+	return p.UserID == ""
+}
+
+// Admin reports whether the principal is a signed-in administrator.
+func (p Principal) Admin() bool {
+	return !p.Anonymous() && p.Role == RoleAdmin
+}
+
+// Decision is the outcome of a policy check.
+type Decision int
+
+const (
+	// Allow permits the action.
+	Allow Decision = iota
+	// Unauthenticated denies the action until the caller signs in.
+	Unauthenticated
+	// NotFound denies the action without revealing that the resource exists.
+	NotFound
+	// Forbidden denies the action on a resource the principal can see.
+	Forbidden
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Allow:
+		return "allow"
+	case Unauthenticated:
+		return "unauthenticated"
+	case NotFound:
+		return "not_found"
+	case Forbidden:
+		return "forbidden"
+	}
+	return "unknown"
+}
+
+// TemplateRelation is how a principal relates to a template besides ownership
+// and visibility. It is resolved by the caller from storage.
+type TemplateRelation struct {
+	// Grant is the role the template was shared with: "viewer", "editor" or empty.
+	Grant string
+	// OrgRole is the role in the organization owning the template: "member", "admin", "owner" or empty.
+	OrgRole string
+	// ShareLink reports whether the request carries an active share link of the template.
+	ShareLink bool
+}
+
+// templateLevel is how much a principal may do with a template. Higher levels include the lower ones.
+type templateLevel int
+
+const (
+	levelNone templateLevel = iota
+	levelViewer
+	levelEditor
+	levelOwner
+)
+
+// templateLevels is the level each action requires.
+var templateLevels = map[Action]templateLevel{
+	Read:        levelViewer,
+	Fork:        levelViewer,
+	Instantiate: levelViewer,
+	Comment:     levelViewer,
+	Review:      levelViewer,
+	Update:      levelEditor,
+	Delete:      levelOwner,
+	Share:       levelOwner,
+	Create:      levelOwner,
+	ViewStats:   levelOwner,
+}
+
+// Template decides an action on a template.
+//
+// The owner and administrators may do anything. Public templates, viewer
+// grants, share links and membership of the organization owning a non-private
+// template allow reading, forking and instantiating; editor grants and
+// organization admins and owners also allow updating. Only reading is open to
+// anonymous callers. Templates the principal cannot read are reported as not
+// found. System templates can only be created and changed by administrators.
+// Templates hidden by moderation are only visible to their owner and
+// administrators, removed ones to administrators alone.
+func Template(p Principal, action Action, t *models.Template, rel TemplateRelation) Decision {
+	required, ok := templateLevels[action]
+	if !ok {
+		return Forbidden
+	}
+	if p.Anonymous() && action != Read {
+		return Unauthenticated
+	}
+	level := templateLevelOf(p, t, rel)
+	switch {
+	case level == levelNone || moderatedAway(p, t):
+		return NotFound
+	case level < required:
+		return Forbidden
+	case t.Type == "system" && required > levelViewer && !p.Admin():
+		return Forbidden
+	}
+	return Allow
+}
+
+// moderatedAway reports whether moderation keeps a template out of the principal's sight.
+func moderatedAway(p Principal, t *models.Template) bool {
+	switch t.ModerationState {
+	case "hidden":
+		return !p.Admin() && (p.Anonymous() || t.OwnerID != p.UserID)
+	case "removed":
+		return !p.Admin()
+	}
+	return false
+}
+
+func templateLevelOf(p Principal, t *models.Template, rel TemplateRelation) templateLevel {
+	if p.Admin() || (!p.Anonymous() && t.OwnerID == p.UserID) {
+		return levelOwner
+	}
+	level := levelNone
+	if t.Visibility == "public" || rel.ShareLink {
+		level = levelViewer
+	}
+	if !p.Anonymous() {
+		switch rel.Grant {
+		case "editor":
+			level = levelEditor
+		case "viewer":
+			level = max(level, levelViewer)
+		}
+		if t.OrgID.Valid && t.Visibility != "private" {
+			switch rel.OrgRole {
+			case "admin", "owner":
+				level = levelEditor
+			case "member":
+				level = max(level, levelViewer)
+			}
+		}
+	}
+	return level
+}
+
+// Prompt decides an action on a saved prompt. Prompts are only ever visible to their owner.
+func Prompt(p Principal, action Action, prompt *models.Prompt) Decision {
+	if p.Anonymous() {
+		return Unauthenticated
+	}
+	if prompt.OwnerID != p.UserID {
+		return NotFound
+	}
+	switch action {
+	case Read, Update, Delete:
+		return Allow
+	}
+	return Forbidden
+}
+
+// CommentOn decides an action on a comment of template t, which the caller has
+// already checked the principal can read. Authors may edit and delete their own
+// comments; the owner of the template and administrators may delete any of them.
+func CommentOn(p Principal, action Action, c *models.Comment, t *models.Template) Decision {
+	if p.Anonymous() {
+		return Unauthenticated
+	}
+	author := c.AuthorID == p.UserID
+	switch action {
+	case Update:
+		if author {
+			return Allow
+		}
+	case Delete:
+		if author || t.OwnerID == p.UserID || p.Admin() {
+			return Allow
+		}
+	}
+	return Forbidden
+}
+
+// Collection decides an action on a collection.
+// Public collections can be read by anyone; only the owner may change them.
+func Collection(p Principal, action Action, c *models.Collection) Decision {
+	owner := !p.Anonymous() && c.OwnerID == p.UserID
+	if !owner && c.Visibility != "public" {
+		if p.Anonymous() && action != Read {
+			return Unauthenticated
+		}
+		return NotFound
+	}
+	switch {
+	case action == Read || owner:
+		return Allow
+	case p.Anonymous():
+		return Unauthenticated
+	}
+	return Forbidden
+}
+
+// Preset decides an action on a variable preset. Personal presets are only
+// visible to their owner. Organization presets can be read by the members of
+// the organization and created, changed and deleted by its admins and owners;
+// orgRole is the principal's role in the organization, empty if not a member.
+func Preset(p Principal, action Action, preset *models.VariablePreset, orgRole string) Decision {
+	switch {
+	case p.Anonymous():
+		return Unauthenticated
+	case !preset.OrgID.Valid && preset.OwnerID != p.UserID:
+		return NotFound
+	case !preset.OrgID.Valid:
+		if action == Read || action == Create || action == Update || action == Delete {
+			return Allow
+		}
+		return Forbidden
+	case orgRole == "":
+		return NotFound
+	case action == Read:
+		return Allow
+	case orgRole != "admin" && orgRole != "owner":
+		return Forbidden
+	case action == Create || action == Update || action == Delete:
+		return Allow
+	}
+	return Forbidden
+}
+
+// User decides an action on the account of a user, such as their profile or
+// their saved prompts. Users may only act on their own account; administrators
+// manage other accounts through the administration RPCs instead.
+func User(p Principal, action Action, userID string) Decision {
+	switch {
+	case p.Anonymous():
+		return Unauthenticated
+	case userID != p.UserID:
+		return Forbidden
+	case action == Read || action == Update:
+		return Allow
+	}
+	return Forbidden
+}
+
+// Organization decides an action on an organization, where Read views the
+// organization, its members and its library and Create adds a template to the
+// library. orgRole is the principal's role in the organization, empty if not a
+// member; organizations are only visible to their members.
+func Organization(p Principal, action Action, orgRole string) Decision {
+	switch {
+	case p.Anonymous():
+		return Unauthenticated
+	case orgRole == "":
+		return NotFound
+	case action == Read || action == Create:
+		return Allow
+	}
+	return Forbidden
+}
+
+// OrganizationMember decides an action on a membership of an organization the
+// principal belongs to with role orgRole: Invite invites a new member with the
+// role of m, Update changes the role of m and Delete removes m. Admins may
+// invite and remove members; owners may also invite and remove admins, remove
+// other owners and change roles. Any member may leave.
+func OrganizationMember(p Principal, action Action, orgRole string, m *models.OrganizationMember) Decision {
+	if d := Organization(p, Read, orgRole); d != Allow {
+		return d
+	}
+	switch action {
+	case Invite:
+		if m.Role == "owner" {
+			return Forbidden
+		}
+		if orgRole == "owner" || (orgRole == "admin" && m.Role == "member") {
+			return Allow
+		}
+	case Update:
+		if orgRole == "owner" {
+			return Allow
+		}
+	case Delete:
+		if m.UserID == p.UserID || orgRole == "owner" || (orgRole == "admin" && m.Role == "member") {
+			return Allow
+		}
+	}
+	return Forbidden
+}
+
+// Administer decides whether a principal may use the administration RPCs.
+func Administer(p Principal) Decision {
+	switch {
+	case p.Anonymous():
+		return Unauthenticated
+	case !p.Admin():
+		return Forbidden
+	}
+	return Allow
+}
@@ -0,0 +1,312 @@
+package policy
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+var (
+	anonymous = Principal{}
+	alice     = Principal{UserID: "alice"} // owns every resource below
+	bob       = Principal{UserID: "bob"}
+	root      = Principal{UserID: "root", Role: RoleAdmin}
+)
+
+func template(visibility string, org bool) *models.Template {
+	t := &models.Template{ID: "t1", OwnerID: "alice", Visibility: visibility}
+	if org {
+		t.OrgID = sql.NullString{String: "o1", Valid: true}
+	}
+	return t
+}
+
+func system() *models.Template {
+	t := template("public", false)
+	t.Type = "system"
+	return t
+}
+
+func moderated(state string) *models.Template {
+	t := template("public", false)
+	t.ModerationState = state
+	return t
+}
+
+// decisions lists the expected decision for read, fork, instantiate, update, delete and share.
+type decisions [6]Decision
+
+var templateActions = [6]Action{Read, Fork, Instantiate, Update, Delete, Share}
+
+const (
+	A = Allow
+	U = Unauthenticated
+	N = NotFound
+	F = Forbidden
+)
+
+func TestTemplate(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		template  *models.Template
+		rel       TemplateRelation
+		want      decisions
+	}{
+		{"owner of private", alice, template("private", false), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"owner of public", alice, template("public", false), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"anonymous on public", anonymous, template("public", false), TemplateRelation{}, decisions{A, U, U, U, U, U}},
+		{"anonymous on private", anonymous, template("private", false), TemplateRelation{}, decisions{N, U, U, U, U, U}},
+		{"anonymous with share link", anonymous, template("private", false), TemplateRelation{ShareLink: true}, decisions{A, U, U, U, U, U}},
+		{"stranger on public", bob, template("public", false), TemplateRelation{}, decisions{A, A, A, F, F, F}},
+		{"stranger on private", bob, template("private", false), TemplateRelation{}, decisions{N, N, N, N, N, N}},
+		{"share link holder", bob, template("private", false), TemplateRelation{ShareLink: true}, decisions{A, A, A, F, F, F}},
+		{"viewer grant", bob, template("private", false), TemplateRelation{Grant: "viewer"}, decisions{A, A, A, F, F, F}},
+		{"editor grant", bob, template("private", false), TemplateRelation{Grant: "editor"}, decisions{A, A, A, A, F, F}},
+		{"editor grant on public", bob, template("public", false), TemplateRelation{Grant: "editor"}, decisions{A, A, A, A, F, F}},
+		{"org member on org template", bob, template("org", true), TemplateRelation{OrgRole: "member"}, decisions{A, A, A, F, F, F}},
+		{"org admin on org template", bob, template("org", true), TemplateRelation{OrgRole: "admin"}, decisions{A, A, A, A, F, F}},
+		{"org owner on org template", bob, template("org", true), TemplateRelation{OrgRole: "owner"}, decisions{A, A, A, A, F, F}},
+		{"org admin on private org template", bob, template("private", true), TemplateRelation{OrgRole: "admin"}, decisions{N, N, N, N, N, N}},
+		{"org member with viewer grant on private org template", bob, template("private", true), TemplateRelation{OrgRole: "member", Grant: "viewer"}, decisions{A, A, A, F, F, F}},
+		{"outsider on org template", bob, template("org", true), TemplateRelation{}, decisions{N, N, N, N, N, N}},
+		{"org role without organization", bob, template("org", false), TemplateRelation{OrgRole: "admin"}, decisions{N, N, N, N, N, N}},
+		{"admin on private", root, template("private", false), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"owner of system template", alice, system(), TemplateRelation{}, decisions{A, A, A, F, F, F}},
+		{"editor grant on system template", bob, system(), TemplateRelation{Grant: "editor"}, decisions{A, A, A, F, F, F}},
+		{"admin on system template", root, system(), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"owner of hidden template", alice, moderated("hidden"), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"stranger on hidden template", bob, moderated("hidden"), TemplateRelation{Grant: "editor"}, decisions{N, N, N, N, N, N}},
+		{"anonymous on hidden template", anonymous, moderated("hidden"), TemplateRelation{ShareLink: true}, decisions{N, U, U, U, U, U}},
+		{"admin on hidden template", root, moderated("hidden"), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+		{"owner of removed template", alice, moderated("removed"), TemplateRelation{}, decisions{N, N, N, N, N, N}},
+		{"admin on removed template", root, moderated("removed"), TemplateRelation{}, decisions{A, A, A, A, A, A}},
+	}
+	for _, tt := range tests {
+		for i, action := range templateActions {
+			t.Run(fmt.Sprintf("%s/%s", tt.name, action), func(t *testing.T) {
+				assert.Equal(t, tt.want[i], Template(tt.principal, action, tt.template, tt.rel))
+			})
+		}
+	}
+}
+
+func TestTemplateUnknownAction(t *testing.T) {
+	assert.Equal(t, Forbidden, Template(alice, Action("publish"), template("private", false), TemplateRelation{}))
+}
+
+func TestTemplateCreate(t *testing.T) {
+	user := &models.Template{OwnerID: "alice", Visibility: "private", Type: "user"}
+	assert.Equal(t, Allow, Template(alice, Create, user, TemplateRelation{}))
+	assert.Equal(t, Forbidden, Template(alice, Create, system(), TemplateRelation{}))
+	assert.Equal(t, Allow, Template(Principal{UserID: "alice", Role: RoleAdmin}, Create, system(), TemplateRelation{}))
+	assert.Equal(t, Unauthenticated, Template(anonymous, Create, user, TemplateRelation{}))
+}
+
+func TestTemplateViewStats(t *testing.T) {
+	public := template("public", false)
+	assert.Equal(t, Allow, Template(alice, ViewStats, public, TemplateRelation{}))
+	assert.Equal(t, Forbidden, Template(bob, ViewStats, public, TemplateRelation{Grant: "editor"}))
+	assert.Equal(t, Allow, Template(Principal{UserID: "root", Role: RoleAdmin}, ViewStats, public, TemplateRelation{}))
+	assert.Equal(t, Unauthenticated, Template(anonymous, ViewStats, public, TemplateRelation{}))
+}
+
+func TestPrompt(t *testing.T) {
+	prompt := &models.Prompt{ID: "p1", OwnerID: "alice"}
+	tests := []struct {
+		principal Principal
+		action    Action
+		want      Decision
+	}{
+		{alice, Read, Allow},
+		{alice, Update, Allow},
+		{alice, Delete, Allow},
+		{alice, Share, Forbidden},
+		{bob, Read, NotFound},
+		{bob, Update, NotFound},
+		{bob, Delete, NotFound},
+		{anonymous, Read, Unauthenticated},
+		{anonymous, Delete, Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.principal.UserID, tt.action), func(t *testing.T) {
+			assert.Equal(t, tt.want, Prompt(tt.principal, tt.action, prompt))
+		})
+	}
+}
+
+func TestCommentOn(t *testing.T) {
+	template := template("public", false)
+	comment := &models.Comment{ID: "c1", TemplateID: "t1", AuthorID: "bob"}
+	carol := Principal{UserID: "carol"}
+	tests := []struct {
+		principal Principal
+		action    Action
+		want      Decision
+	}{
+		{bob, Update, Allow},
+		{bob, Delete, Allow},
+		{alice, Update, Forbidden},
+		{alice, Delete, Allow},
+		{root, Update, Forbidden},
+		{root, Delete, Allow},
+		{carol, Update, Forbidden},
+		{carol, Delete, Forbidden},
+		{anonymous, Delete, Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.principal.UserID, tt.action), func(t *testing.T) {
+			assert.Equal(t, tt.want, CommentOn(tt.principal, tt.action, comment, template))
+		})
+	}
+}
+
+func TestCollection(t *testing.T) {
+	tests := []struct {
+		principal  Principal
+		visibility string
+		action     Action
+		want       Decision
+	}{
+		{alice, "private", Read, Allow},
+		{alice, "private", Update, Allow},
+		{alice, "private", Delete, Allow},
+		{bob, "public", Read, Allow},
+		{bob, "public", Update, Forbidden},
+		{bob, "public", Delete, Forbidden},
+		{bob, "private", Read, NotFound},
+		{bob, "private", Update, NotFound},
+		{anonymous, "public", Read, Allow},
+		{anonymous, "public", Update, Unauthenticated},
+		{anonymous, "private", Read, NotFound},
+		{anonymous, "private", Delete, Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s", tt.principal.UserID, tt.visibility, tt.action), func(t *testing.T) {
+			c := &models.Collection{ID: "c1", OwnerID: "alice", Visibility: tt.visibility}
+			assert.Equal(t, tt.want, Collection(tt.principal, tt.action, c))
+		})
+	}
+}
+
+func TestPreset(t *testing.T) {
+	personal := &models.VariablePreset{ID: "v1", OwnerID: "alice"}
+	org := &models.VariablePreset{ID: "v2", OwnerID: "alice", OrgID: sql.NullString{String: "o1", Valid: true}}
+	tests := []struct {
+		principal Principal
+		preset    *models.VariablePreset
+		orgRole   string
+		action    Action
+		want      Decision
+	}{
+		{alice, personal, "", Read, Allow},
+		{alice, personal, "", Update, Allow},
+		{alice, personal, "", Delete, Allow},
+		{alice, personal, "", Share, Forbidden},
+		{bob, personal, "", Read, NotFound},
+		{bob, personal, "member", Update, NotFound},
+		{root, personal, "", Read, NotFound},
+		{bob, org, "member", Read, Allow},
+		{bob, org, "member", Create, Forbidden},
+		{bob, org, "member", Update, Forbidden},
+		{bob, org, "admin", Create, Allow},
+		{bob, org, "admin", Update, Allow},
+		{bob, org, "owner", Delete, Allow},
+		{bob, org, "owner", Share, Forbidden},
+		{bob, org, "", Read, NotFound},
+		{alice, org, "", Update, NotFound},
+		{anonymous, personal, "", Read, Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s/%s", tt.principal.UserID, tt.preset.ID, tt.orgRole, tt.action), func(t *testing.T) {
+			assert.Equal(t, tt.want, Preset(tt.principal, tt.action, tt.preset, tt.orgRole))
+		})
+	}
+}
+
+func TestUser(t *testing.T) {
+	tests := []struct {
+		principal Principal
+		action    Action
+		userID    string
+		want      Decision
+	}{
+		{alice, Read, "alice", Allow},
+		{alice, Update, "alice", Allow},
+		{alice, Delete, "alice", Forbidden},
+		{bob, Read, "alice", Forbidden},
+		{bob, Update, "alice", Forbidden},
+		{anonymous, Read, "alice", Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s", tt.principal.UserID, tt.action, tt.userID), func(t *testing.T) {
+			assert.Equal(t, tt.want, User(tt.principal, tt.action, tt.userID))
+		})
+	}
+}
+
+func TestOrganization(t *testing.T) {
+	tests := []struct {
+		principal Principal
+		orgRole   string
+		action    Action
+		want      Decision
+	}{
+		{bob, "member", Read, Allow},
+		{bob, "member", Create, Allow},
+		{bob, "owner", Delete, Forbidden},
+		{bob, "", Read, NotFound},
+		{root, "", Create, NotFound},
+		{anonymous, "", Read, Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s", tt.principal.UserID, tt.orgRole, tt.action), func(t *testing.T) {
+			assert.Equal(t, tt.want, Organization(tt.principal, tt.action, tt.orgRole))
+		})
+	}
+}
+
+func TestOrganizationMember(t *testing.T) {
+	membership := func(userID, role string) *models.OrganizationMember {
+		return &models.OrganizationMember{OrgID: "o1", UserID: userID, Role: role}
+	}
+	tests := []struct {
+		principal Principal
+		orgRole   string
+		action    Action
+		member    *models.OrganizationMember
+		want      Decision
+	}{
+		{bob, "member", Invite, membership("", "member"), Forbidden},
+		{bob, "admin", Invite, membership("", "member"), Allow},
+		{bob, "admin", Invite, membership("", "admin"), Forbidden},
+		{bob, "owner", Invite, membership("", "admin"), Allow},
+		{bob, "owner", Invite, membership("", "owner"), Forbidden},
+		{bob, "admin", Update, membership("alice", "member"), Forbidden},
+		{bob, "owner", Update, membership("alice", "admin"), Allow},
+		{bob, "member", Delete, membership("bob", "member"), Allow},
+		{bob, "member", Delete, membership("alice", "member"), Forbidden},
+		{bob, "admin", Delete, membership("alice", "member"), Allow},
+		{bob, "admin", Delete, membership("alice", "admin"), Forbidden},
+		{bob, "owner", Delete, membership("alice", "owner"), Allow},
+		{bob, "", Delete, membership("bob", "member"), NotFound},
+		{root, "", Update, membership("alice", "member"), NotFound},
+		{anonymous, "", Invite, membership("", "member"), Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s/%s/%s", tt.principal.UserID, tt.orgRole, tt.action, tt.member.UserID, tt.member.Role), func(t *testing.T) {
+			assert.Equal(t, tt.want, OrganizationMember(tt.principal, tt.action, tt.orgRole, tt.member))
+		})
+	}
+}
+
+func TestAdminister(t *testing.T) {
+	assert.Equal(t, Allow, Administer(root))
+	assert.Equal(t, Forbidden, Administer(bob))
+	assert.Equal(t, Unauthenticated, Administer(anonymous))
+	assert.Equal(t, Unauthenticated, Administer(Principal{Role: RoleAdmin}))
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// auditPurgeBatchSize bounds the number of audit events deleted per statement,
+// so that purging a large backlog does not hold locks for long.
+const auditPurgeBatchSize = 10000
+
+// AuditRepository defines the interface for audit log data access.
+// The audit log is append-only: events are never updated.
+type AuditRepository interface {
+	Record(ctx context.Context, e *models.AuditEvent) error
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.AuditEvent, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// auditRepository implements AuditRepository.
+type auditRepository struct {
+	db *sql.DB
+}
+
+// NewAuditRepository creates a new instance of AuditRepository.
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// Record appends an event to the audit log. e is updated with its ID and creation time.
+func (r *auditRepository) Record(ctx context.Context, e *models.AuditEvent) error {
+	query := `
+		INSERT INTO audit_events (actor_id, actor_role, action, resource_type, resource_id, owner_id, before, after, client_ip, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7::jsonb, $8::jsonb, $9, $10)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		e.ActorID, e.ActorRole, e.Action, e.ResourceType, e.ResourceID, e.OwnerID,
+		nullJSON(e.Before), nullJSON(e.After), e.ClientIP, e.RequestID,
+	).Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// List retrieves a page of audit events, latest first, starting strictly after
+// the given cursor when it is not nil. The "visible_to" filter restricts the
+// events to the ones about resources owned by a user or performed by them.
+func (r *auditRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.AuditEvent, error) {
+	query := `
+		SELECT id, actor_id, actor_role, action, resource_type, resource_id, owner_id,
+			COALESCE(before, 'null'::jsonb), COALESCE(after, 'null'::jsonb), client_ip, request_id, created_at
+		FROM audit_events
+		WHERE 1=1`
+	var args []interface{}
+	argID := 1
+	if val, ok := filters["visible_to"]; ok && val != "" {
+		query += fmt.Sprintf(" AND (owner_id = $%[1]d OR actor_id = $%[1]d)", argID)
+		args = append(args, val)
+		argID++
+	}
+	for _, column := range []string{"actor_id", "action", "resource_type", "resource_id"} {
+		if val, ok := filters[column]; ok && val != "" {
+			query += fmt.Sprintf(" AND %s = $%d", column, argID)
+			args = append(args, val)
+			argID++
+		}
+	}
+	if val, ok := filters["start_time"]; ok {
+		query += fmt.Sprintf(" AND created_at >= $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["end_time"]; ok {
+		query += fmt.Sprintf(" AND created_at < $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if after != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d::timestamptz, $%d::bigint)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit events: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var events []*models.AuditEvent
+	for rows.Next() {
+		var e models.AuditEvent
+		if err := rows.Scan(
+			&e.ID, &e.ActorID, &e.ActorRole, &e.Action, &e.ResourceType, &e.ResourceID, &e.OwnerID,
+			&e.Before, &e.After, &e.ClientIP, &e.RequestID, &e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %w", err)
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return events, nil
+}
+
+// Purge deletes the audit events created before the given time, in batches,
+// and returns how many were deleted.
+func (r *auditRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	var total int64
+	for {
+		result, err := r.db.ExecContext(ctx, `
+			DELETE FROM audit_events
+			WHERE id IN (SELECT id FROM audit_events WHERE created_at < $1 ORDER BY id LIMIT $2)
+		`, before, auditPurgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to purge audit events: %w", err)
+		}
+		n, _ := result.RowsAffected()
+		total += n
+		if n < auditPurgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// nullJSON maps an empty or null JSON document to SQL NULL.
+func nullJSON(b []byte) interface{} {
+	if len(b) == 0 || string(b) == "null" {
+		return nil
+	}
+	return string(b)
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"awsome-prompt/backend/internal/cache"
+	"awsome-prompt/backend/internal/models"
+)
+
+// Cache namespaces. Every key is built on the generation of its namespace so
+// that a write can invalidate all listings and stats with a single Bump, and
+// EvictAll can invalidate everything without deleting keys.
+const (
+	cacheNSTemplate     = "template"
+	cacheNSTemplateList = "template_list"
+	cacheNSStats        = "template_stats"
+	cacheNSLatest       = "template_latest"
+)
+
+// cachedTemplateRepository is a read-through cache in front of a TemplateRepository.
+// Only anonymous reads are cached: per-user fields such as is_liked make
+// authenticated results unshareable.
+type cachedTemplateRepository struct {
+	TemplateRepository
+	cache *cache.Cache
+}
+
+// NewCachedTemplateRepository wraps inner with a read-through cache.
+func NewCachedTemplateRepository(inner TemplateRepository, c *cache.Cache) TemplateRepository {
+	return &cachedTemplateRepository{TemplateRepository: inner, cache: c}
+}
+
+func templateKey(ctx context.Context, c *cache.Cache, id string) string {
+	return cacheNSTemplate + ":" + c.Generation(ctx, cacheNSTemplate) + ":" + id
+}
+
+// Get returns the template, from the cache for anonymous callers.
+func (r *cachedTemplateRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Template, error) {
+	if currentUserID != "" {
+		return r.TemplateRepository.Get(ctx, id, currentUserID)
+	}
+	var t *models.Template
+	err := r.cache.GetOrLoad(ctx, cacheNSTemplate, templateKey(ctx, r.cache, id), &t, func() (interface{}, error) {
+		return r.TemplateRepository.Get(ctx, id, currentUserID)
+	})
+	return t, err
+}
+
+// List returns a page of templates, from the cache for anonymous callers.
+func (r *cachedTemplateRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Template, error) {
+	if bypassesCache(filters) {
+		return r.TemplateRepository.List(ctx, limit, after, filters)
+	}
+	key := cache.Key(cacheNSTemplateList, r.cache.Generation(ctx, cacheNSTemplateList), limit, after, filters)
+	var templates []*models.Template
+	err := r.cache.GetOrLoad(ctx, cacheNSTemplateList, key, &templates, func() (interface{}, error) {
+		return r.TemplateRepository.List(ctx, limit, after, filters)
+	})
+	return templates, err
+}
+
+// Count returns the number of matching templates, from the cache for anonymous callers.
+func (r *cachedTemplateRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	if bypassesCache(filters) {
+		return r.TemplateRepository.Count(ctx, filters)
+	}
+	key := cache.Key(cacheNSTemplateList+"_count", r.cache.Generation(ctx, cacheNSTemplateList), filters)
+	var n int64
+	err := r.cache.GetOrLoad(ctx, cacheNSTemplateList, key, &n, func() (interface{}, error) {
+		return r.TemplateRepository.Count(ctx, filters)
+	})
+	return n, err
+}
+
+// ListCategories returns category stats, from the cache unless they are personalized.
+func (r *cachedTemplateRepository) ListCategories(ctx context.Context, filters map[string]interface{}) ([]*models.CategoryStat, error) {
+	if bypassesCache(filters) {
+		return r.TemplateRepository.ListCategories(ctx, filters)
+	}
+	key := cache.Key("categories", r.cache.Generation(ctx, cacheNSStats), filters)
+	var stats []*models.CategoryStat
+	err := r.cache.GetOrLoad(ctx, cacheNSStats, key, &stats, func() (interface{}, error) {
+		return r.TemplateRepository.ListCategories(ctx, filters)
+	})
+	return stats, err
+}
+
+// ListTags returns tag stats, from the cache unless they are personalized.
+func (r *cachedTemplateRepository) ListTags(ctx context.Context, filters map[string]interface{}) ([]*models.TagStat, error) {
+	if bypassesCache(filters) {
+		return r.TemplateRepository.ListTags(ctx, filters)
+	}
+	key := cache.Key("tags", r.cache.Generation(ctx, cacheNSStats), filters)
+	var stats []*models.TagStat
+	err := r.cache.GetOrLoad(ctx, cacheNSStats, key, &stats, func() (interface{}, error) {
+		return r.TemplateRepository.ListTags(ctx, filters)
+	})
+	return stats, err
+}
+
+// Create inserts the template and invalidates listings and stats.
+func (r *cachedTemplateRepository) Create(ctx context.Context, t *models.Template) error {
+	if err := r.TemplateRepository.Create(ctx, t); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: t.ID})
+	return nil
+}
+
+// Update updates the template and invalidates it along with listings and stats.
+func (r *cachedTemplateRepository) Update(ctx context.Context, t *models.Template) error {
+	if err := r.TemplateRepository.Update(ctx, t); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: t.ID})
+	return nil
+}
+
+// Delete deletes the template and invalidates it along with listings and stats.
+func (r *cachedTemplateRepository) Delete(ctx context.Context, id string) error {
+	if err := r.TemplateRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: id})
+	return nil
+}
+
+// TransferOwnership transfers the template and invalidates it along with listings and stats.
+func (r *cachedTemplateRepository) TransferOwnership(ctx context.Context, id, newOwnerID string) error {
+	if err := r.TemplateRepository.TransferOwnership(ctx, id, newOwnerID); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: id})
+	return nil
+}
+
+// SetFeatured features the template or stops featuring it, and invalidates it along with listings.
+func (r *cachedTemplateRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	if err := r.TemplateRepository.SetFeatured(ctx, id, featured); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: id})
+	return nil
+}
+
+// SetModerationState changes the moderation state of the template, and invalidates it along with listings.
+func (r *cachedTemplateRepository) SetModerationState(ctx context.Context, id, state string) error {
+	if err := r.TemplateRepository.SetModerationState(ctx, id, state); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTemplate, TemplateID: id})
+	return nil
+}
+
+// ToggleLike toggles the like and invalidates the template's counters.
+func (r *cachedTemplateRepository) ToggleLike(ctx context.Context, userID, templateID string) (bool, int32, error) {
+	liked, count, err := r.TemplateRepository.ToggleLike(ctx, userID, templateID)
+	if err != nil {
+		return liked, count, err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeReaction, TemplateID: templateID})
+	return liked, count, nil
+}
+
+// ToggleFavorite toggles the favorite and invalidates the template's counters.
+func (r *cachedTemplateRepository) ToggleFavorite(ctx context.Context, userID, templateID string) (bool, int32, error) {
+	favorited, count, err := r.TemplateRepository.ToggleFavorite(ctx, userID, templateID)
+	if err != nil {
+		return favorited, count, err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeReaction, TemplateID: templateID})
+	return favorited, count, nil
+}
+
+// RefreshTrendingScores recomputes the scores and invalidates listings sorted by them.
+func (r *cachedTemplateRepository) RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) (int64, error) {
+	n, err := r.TemplateRepository.RefreshTrendingScores(ctx, halfLife, window)
+	if err != nil {
+		return n, err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeTrending})
+	return n, nil
+}
+
+// EvictChange evicts the cache entries affected by a change.
+// Writers call it directly so that their own reads are fresh; for changes
+// made elsewhere, the instance that claims the change's notification calls it.
+// Category and tag stats only change when a template's metadata does.
+func EvictChange(ctx context.Context, c *cache.Cache, ch Change) {
+	switch ch.Kind {
+	case ChangeTemplate:
+		c.Delete(ctx, templateKey(ctx, c, ch.TemplateID), latestVersionKey(ctx, c, ch.TemplateID))
+		c.Bump(ctx, cacheNSTemplateList)
+		c.Bump(ctx, cacheNSStats)
+	case ChangeVersion:
+		c.Delete(ctx, latestVersionKey(ctx, c, ch.TemplateID))
+	case ChangeReaction:
+		c.Delete(ctx, templateKey(ctx, c, ch.TemplateID))
+		c.Bump(ctx, cacheNSTemplateList)
+	case ChangeTrending:
+		c.Bump(ctx, cacheNSTemplateList)
+	}
+}
+
+// EvictAll invalidates every cache entry by bumping the generation of each
+// namespace. It is the fallback when changes may have been missed.
+func EvictAll(ctx context.Context, c *cache.Cache) {
+	for _, ns := range []string{cacheNSTemplate, cacheNSTemplateList, cacheNSStats, cacheNSLatest} {
+		c.Bump(ctx, ns)
+	}
+}
+
+// bypassesCache reports whether a listing must not be cached: personalized
+// listings depend on the current user, and collection and access-filtered
+// listings change without any template change (items, grants, memberships).
+func bypassesCache(filters map[string]interface{}) bool {
+	for _, key := range []string{"collection_id", "current_user_id", "visible_to"} {
+		if val, ok := filters[key]; ok && val != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// cachedTemplateVersionRepository caches the latest version of each template.
+type cachedTemplateVersionRepository struct {
+	TemplateVersionRepository
+	cache *cache.Cache
+}
+
+// NewCachedTemplateVersionRepository wraps inner with a read-through cache.
+func NewCachedTemplateVersionRepository(inner TemplateVersionRepository, c *cache.Cache) TemplateVersionRepository {
+	return &cachedTemplateVersionRepository{TemplateVersionRepository: inner, cache: c}
+}
+
+func latestVersionKey(ctx context.Context, c *cache.Cache, templateID string) string {
+	return cacheNSLatest + ":" + c.Generation(ctx, cacheNSLatest) + ":" + templateID
+}
+
+// GetLatest returns the latest version of a template from the cache.
+func (r *cachedTemplateVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
+	var v *models.TemplateVersion
+	err := r.cache.GetOrLoad(ctx, cacheNSLatest, latestVersionKey(ctx, r.cache, templateID), &v, func() (interface{}, error) {
+		return r.TemplateVersionRepository.GetLatest(ctx, templateID)
+	})
+	return v, err
+}
+
+// Create inserts the version and evicts the cached latest version.
+func (r *cachedTemplateVersionRepository) Create(ctx context.Context, v *models.TemplateVersion) error {
+	if err := r.TemplateVersionRepository.Create(ctx, v); err != nil {
+		return err
+	}
+	EvictChange(ctx, r.cache, Change{Kind: ChangeVersion, TemplateID: v.TemplateID})
+	return nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ChangeChannel is the Postgres NOTIFY channel on which template changes are published.
+const ChangeChannel = "template_changes"
+
+// ChangeKind identifies what changed about a template.
+type ChangeKind string
+
+const (
+	// ChangeTemplate is a template being created, updated or deleted.
+	ChangeTemplate ChangeKind = "template"
+	// ChangeVersion is a new version of a template.
+	ChangeVersion ChangeKind = "version"
+	// ChangeReaction is a like or favorite being toggled, a comment being added or
+	// deleted, or a review changing the ratings of a template.
+	ChangeReaction ChangeKind = "reaction"
+	// ChangeTrending is a refresh of all trending scores; it has no template ID.
+	ChangeTrending ChangeKind = "trending"
+)
+
+// Change is the payload of a notification on ChangeChannel.
+type Change struct {
+	// ID identifies the notification, so that a single instance handles it.
+	ID         string     `json:"id,omitempty"`
+	Kind       ChangeKind `json:"kind"`
+	TemplateID string     `json:"template_id,omitempty"`
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// notifyChange publishes a change on ChangeChannel.
+// Within a transaction the notification is only delivered on commit.
+func notifyChange(ctx context.Context, db execer, kind ChangeKind, templateID string) error {
+	payload, err := json.Marshal(Change{ID: rand.Text(), Kind: kind, TemplateID: templateID})
+	if err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, "SELECT pg_notify($1, $2)", ChangeChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to notify %s change: %w", kind, err)
+	}
+	return nil
+}
+
+// notifyCommitted publishes a change for a write that has already been committed.
+// The write stands even if the notification fails, so the error is only logged;
+// caches catch up when their entries expire.
+func notifyCommitted(ctx context.Context, db execer, kind ChangeKind, templateID string) {
+	if err := notifyChange(ctx, db, kind, templateID); err != nil {
+		zap.S().Warnf("Repository: %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ErrCollectionOrderMismatch is returned by Reorder when the given templates are
+// not exactly the templates of the collection.
+var ErrCollectionOrderMismatch = errors.New("template ids do not match the collection items")
+
+// CollectionRepository defines the interface for collection data access.
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *models.Collection) error
+	Update(ctx context.Context, collection *models.Collection) error
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string, currentUserID string) (*models.Collection, error)
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Collection, error)
+	AddItem(ctx context.Context, collectionID, templateID string) error
+	RemoveItem(ctx context.Context, collectionID, templateID string) error
+	Reorder(ctx context.Context, collectionID string, templateIDs []string) error
+	ToggleFollow(ctx context.Context, userID, collectionID string) (bool, int32, error)
+}
+
+// collectionRepository implements CollectionRepository.
+type collectionRepository struct {
+	db *sql.DB
+}
+
+// NewCollectionRepository creates a new instance of CollectionRepository.
+func NewCollectionRepository(db *sql.DB) CollectionRepository {
+	return &collectionRepository{db: db}
+}
+
+// collectionColumns selects a collection with its item count and whether the user in $1 follows it.
+const collectionColumns = `
+	c.id, c.owner_id, c.name, c.description, c.visibility, c.follower_count, c.created_at, c.updated_at,
+	(SELECT COUNT(*) FROM collection_items ci WHERE ci.collection_id = c.id) AS item_count,
+	CASE WHEN cf.user_id IS NOT NULL THEN true ELSE false END AS is_following
+`
+
+func scanCollection(row interface{ Scan(...interface{}) error }) (*models.Collection, error) {
+	var c models.Collection
+	err := row.Scan(
+		&c.ID, &c.OwnerID, &c.Name, &c.Description, &c.Visibility, &c.FollowerCount, &c.CreatedAt, &c.UpdatedAt,
+		&c.ItemCount, &c.IsFollowing,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Create inserts a new collection into the database.
+func (r *collectionRepository) Create(ctx context.Context, c *models.Collection) error {
+	query := `
+		INSERT INTO collections (owner_id, name, description, visibility, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		c.OwnerID, c.Name, c.Description, c.Visibility, c.CreatedAt, c.UpdatedAt,
+	).Scan(&c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	return nil
+}
+
+// Update updates the metadata of an existing collection.
+func (r *collectionRepository) Update(ctx context.Context, c *models.Collection) error {
+	query := `
+		UPDATE collections
+		SET name = $1, description = $2, visibility = $3, updated_at = $4
+		WHERE id = $5
+	`
+	_, err := r.db.ExecContext(ctx, query, c.Name, c.Description, c.Visibility, c.UpdatedAt, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a collection, its items and its followers.
+func (r *collectionRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM collections WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a collection by ID.
+func (r *collectionRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Collection, error) {
+	query := `SELECT ` + collectionColumns + `
+		FROM collections c
+		LEFT JOIN collection_follows cf ON c.id = cf.collection_id AND cf.user_id = $1
+		WHERE c.id = $2
+	`
+	c, err := scanCollection(r.db.QueryRowContext(ctx, query, currentUserID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("collection not found")
+		}
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	return c, nil
+}
+
+// List retrieves a page of collections, most recently updated first.
+// Supported filters are owner_id, visibility and followed_by (a user ID).
+func (r *collectionRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Collection, error) {
+	currentUserID := ""
+	if val, ok := filters["current_user_id"]; ok {
+		currentUserID = val.(string)
+	}
+
+	query := `SELECT ` + collectionColumns + `
+		FROM collections c
+		LEFT JOIN collection_follows cf ON c.id = cf.collection_id AND cf.user_id = $1
+		WHERE 1=1
+	`
+	args := []interface{}{currentUserID}
+	argID := 2
+	if val, ok := filters["owner_id"]; ok && val != "" {
+		query += fmt.Sprintf(" AND c.owner_id = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["visibility"]; ok && val != "" {
+		query += fmt.Sprintf(" AND c.visibility = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["followed_by"]; ok && val != "" {
+		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM collection_follows f WHERE f.collection_id = c.id AND f.user_id = $%d)", argID)
+		args = append(args, val)
+		argID++
+	}
+	if after != nil {
+		query += fmt.Sprintf(" AND (c.updated_at, c.id) < ($%d::timestamptz, $%d::uuid)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY c.updated_at DESC, c.id DESC LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query collections: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var collections []*models.Collection
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan collection: %w", err)
+		}
+		collections = append(collections, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return collections, nil
+}
+
+// AddItem appends a template to the end of a collection.
+// Adding a template that is already in the collection is a no-op.
+func (r *collectionRepository) AddItem(ctx context.Context, collectionID, templateID string) error {
+	query := `
+		INSERT INTO collection_items (collection_id, template_id, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1 FROM collection_items WHERE collection_id = $1
+		ON CONFLICT (collection_id, template_id) DO NOTHING
+	`
+	result, err := r.db.ExecContext(ctx, query, collectionID, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to add template to collection: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n > 0 {
+		return r.touch(ctx, r.db, collectionID)
+	}
+	return nil
+}
+
+// RemoveItem removes a template from a collection.
+func (r *collectionRepository) RemoveItem(ctx context.Context, collectionID, templateID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM collection_items WHERE collection_id = $1 AND template_id = $2`, collectionID, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to remove template from collection: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n > 0 {
+		return r.touch(ctx, r.db, collectionID)
+	}
+	return nil
+}
+
+// Reorder renumbers the items of a collection following the order of templateIDs,
+// which must hold every template of the collection exactly once.
+func (r *collectionRepository) Reorder(ctx context.Context, collectionID string, templateIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var count int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM collection_items WHERE collection_id = $1`, collectionID).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to count collection items: %w", err)
+	}
+	if count != len(templateIDs) {
+		return ErrCollectionOrderMismatch
+	}
+
+	query := `
+		UPDATE collection_items ci
+		SET position = o.ord
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(template_id, ord)
+		WHERE ci.collection_id = $1 AND ci.template_id = o.template_id
+	`
+	result, err := tx.ExecContext(ctx, query, collectionID, pq.Array(templateIDs))
+	if err != nil {
+		return fmt.Errorf("failed to reorder collection: %w", err)
+	}
+	// Duplicates or unknown IDs leave some items untouched.
+	if n, _ := result.RowsAffected(); int(n) != count {
+		return ErrCollectionOrderMismatch
+	}
+	if err := r.touch(ctx, tx, collectionID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ToggleFollow toggles whether a user follows a collection.
+func (r *collectionRepository) ToggleFollow(ctx context.Context, userID, collectionID string) (bool, int32, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM collection_follows WHERE user_id=$1 AND collection_id=$2)", userID, collectionID).Scan(&exists)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if exists {
+		_, err = tx.ExecContext(ctx, "DELETE FROM collection_follows WHERE user_id=$1 AND collection_id=$2", userID, collectionID)
+	} else {
+		_, err = tx.ExecContext(ctx, "INSERT INTO collection_follows (user_id, collection_id) VALUES ($1, $2)", userID, collectionID)
+	}
+	if err != nil {
+		return false, 0, err
+	}
+
+	var count int32
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM collection_follows WHERE collection_id=$1", collectionID).Scan(&count)
+	if err != nil {
+		return false, 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE collections SET follower_count=$1 WHERE id=$2", count, collectionID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, 0, err
+	}
+
+	return !exists, count, nil
+}
+
+// touch bumps the updated_at of a collection after its items changed.
+func (r *collectionRepository) touch(ctx context.Context, db execer, collectionID string) error {
+	if _, err := db.ExecContext(ctx, `UPDATE collections SET updated_at = NOW() WHERE id = $1`, collectionID); err != nil {
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+	return nil
+}
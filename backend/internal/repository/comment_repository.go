@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ErrCommentNotFound is returned when a comment does not exist.
+var ErrCommentNotFound = errors.New("comment not found")
+
+// CommentRepository defines the interface for template comment data access.
+type CommentRepository interface {
+	Create(ctx context.Context, comment *models.Comment) error
+	Get(ctx context.Context, id string) (*models.Comment, error)
+	List(ctx context.Context, templateID, parentID string, limit int, after *Cursor) ([]*models.Comment, error)
+	Update(ctx context.Context, comment *models.Comment) error
+	Delete(ctx context.Context, id string) error
+}
+
+// commentRepository implements CommentRepository.
+type commentRepository struct {
+	db *sql.DB
+}
+
+// NewCommentRepository creates a new instance of CommentRepository.
+func NewCommentRepository(db *sql.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+// Create inserts a comment and counts it on its template.
+func (r *commentRepository) Create(ctx context.Context, c *models.Comment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		INSERT INTO template_comments (template_id, parent_id, version, author_id, body, body_html)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+	if err := tx.QueryRowContext(ctx, query, c.TemplateID, c.ParentID, c.Version, c.AuthorID, c.Body, c.BodyHTML).Scan(&c.ID, &c.CreatedAt); err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE templates SET comment_count = comment_count + 1 WHERE id = $1`, c.TemplateID); err != nil {
+		return fmt.Errorf("failed to update comment count: %w", err)
+	}
+	if err := notifyChange(ctx, tx, ChangeReaction, c.TemplateID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// commentColumns are the columns scanned by scanComment.
+const commentColumns = `
+	c.id, c.template_id, c.parent_id, c.version, c.author_id, c.body, c.body_html, c.edited_at, c.deleted_at, c.created_at,
+	COALESCE(u.display_name, ''),
+	(SELECT COUNT(*) FROM template_comments r WHERE r.parent_id = c.id AND r.deleted_at IS NULL)
+`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanComment(row scanner) (*models.Comment, error) {
+	var c models.Comment
+	err := row.Scan(
+		&c.ID, &c.TemplateID, &c.ParentID, &c.Version, &c.AuthorID, &c.Body, &c.BodyHTML, &c.EditedAt, &c.DeletedAt, &c.CreatedAt,
+		&c.AuthorName, &c.ReplyCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Get retrieves a comment by ID, deleted or not.
+func (r *commentRepository) Get(ctx context.Context, id string) (*models.Comment, error) {
+	query := `SELECT ` + commentColumns + `
+		FROM template_comments c
+		LEFT JOIN users u ON u.id = c.author_id
+		WHERE c.id = $1
+	`
+	c, err := scanComment(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	return c, nil
+}
+
+// List retrieves a page of the top-level comments of a template, or of the replies
+// to a comment when parentID is set, oldest first and starting strictly after the
+// given cursor when it is not nil. Deleted comments are only listed while they
+// have replies, so that their thread stays reachable.
+func (r *commentRepository) List(ctx context.Context, templateID, parentID string, limit int, after *Cursor) ([]*models.Comment, error) {
+	query := `SELECT ` + commentColumns + `
+		FROM template_comments c
+		LEFT JOIN users u ON u.id = c.author_id
+		WHERE c.template_id = $1
+		AND (c.deleted_at IS NULL OR EXISTS (SELECT 1 FROM template_comments r WHERE r.parent_id = c.id AND r.deleted_at IS NULL))
+	`
+	args := []interface{}{templateID}
+	if parentID == "" {
+		query += " AND c.parent_id IS NULL"
+	} else {
+		args = append(args, parentID)
+		query += fmt.Sprintf(" AND c.parent_id = $%d", len(args))
+	}
+	if after != nil {
+		args = append(args, after.Key, after.ID)
+		query += fmt.Sprintf(" AND (c.created_at, c.id) > ($%d::timestamptz, $%d::uuid)", len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY c.created_at, c.id LIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var comments []*models.Comment
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return comments, nil
+}
+
+// Update replaces the body of a comment and marks it as edited.
+func (r *commentRepository) Update(ctx context.Context, c *models.Comment) error {
+	query := `
+		UPDATE template_comments SET body = $2, body_html = $3, edited_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING edited_at
+	`
+	if err := r.db.QueryRowContext(ctx, query, c.ID, c.Body, c.BodyHTML).Scan(&c.EditedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrCommentNotFound
+		}
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a comment, keeping it as an empty placeholder for its replies,
+// and no longer counts it on its template. Deleting a deleted comment does nothing.
+func (r *commentRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var templateID string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE template_comments SET body = '', body_html = '', deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING template_id
+	`, id).Scan(&templateID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE templates SET comment_count = GREATEST(comment_count - 1, 0) WHERE id = $1`, templateID); err != nil {
+		return fmt.Errorf("failed to update comment count: %w", err)
+	}
+	if err := notifyChange(ctx, tx, ChangeReaction, templateID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
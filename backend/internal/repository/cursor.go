@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"strconv"
+	"time"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// Cursor marks a position in a keyset-paginated listing.
+// It holds the sort key and ID of the last row of the previous page; the next
+// page starts strictly after that row.
+type Cursor struct {
+	Key string `json:"k"`
+	ID  string `json:"i"`
+}
+
+// TemplateCursor returns the cursor positioned after t for a listing with the given filters.
+func TemplateCursor(t *models.Template, filters map[string]interface{}) *Cursor {
+	switch filters["sort"] {
+	case "trending":
+		return &Cursor{Key: strconv.FormatFloat(t.TrendingScore, 'g', -1, 64), ID: t.ID}
+	case "rating":
+		return &Cursor{Key: strconv.FormatFloat(t.RatingScore, 'g', -1, 64), ID: t.ID}
+	case "likes":
+		return &Cursor{Key: strconv.Itoa(int(t.LikeCount)), ID: t.ID}
+	case "position":
+		return &Cursor{Key: strconv.Itoa(int(t.CollectionPosition)), ID: t.ID}
+	case "featured":
+		return &Cursor{Key: t.FeaturedAt.Time.Format(time.RFC3339Nano), ID: t.ID}
+	}
+	return &Cursor{Key: t.CreatedAt.Format(time.RFC3339Nano), ID: t.ID}
+}
+
+// TemplateVersionCursor returns the cursor positioned after v.
+func TemplateVersionCursor(v *models.TemplateVersion) *Cursor {
+	return &Cursor{Key: strconv.Itoa(int(v.Version)), ID: strconv.Itoa(int(v.ID))}
+}
+
+// PromptCursor returns the cursor positioned after p for a listing with the given filters.
+func PromptCursor(p *models.Prompt, filters map[string]interface{}) *Cursor {
+	if filters["sort"] == "last_used" {
+		return &Cursor{Key: p.LastUsedAt.Format(time.RFC3339Nano), ID: p.ID}
+	}
+	return &Cursor{Key: p.CreatedAt.Format(time.RFC3339Nano), ID: p.ID}
+}
+
+// CollectionCursor returns the cursor positioned after c.
+func CollectionCursor(c *models.Collection) *Cursor {
+	return &Cursor{Key: c.UpdatedAt.Format(time.RFC3339Nano), ID: c.ID}
+}
+
+// UserCursor returns the cursor positioned after u.
+func UserCursor(u *models.User) *Cursor {
+	return &Cursor{Key: u.CreatedAt.Format(time.RFC3339Nano), ID: u.ID}
+}
+
+// ModerationQueueCursor returns the cursor positioned after item.
+func ModerationQueueCursor(item *models.ModerationQueueItem) *Cursor {
+	return &Cursor{Key: item.FirstReportedAt.Format(time.RFC3339Nano), ID: item.Template.ID}
+}
+
+// CommentCursor returns the cursor positioned after c.
+func CommentCursor(c *models.Comment) *Cursor {
+	return &Cursor{Key: c.CreatedAt.Format(time.RFC3339Nano), ID: c.ID}
+}
+
+// ReviewCursor returns the cursor positioned after r.
+func ReviewCursor(r *models.Review) *Cursor {
+	return &Cursor{Key: r.UpdatedAt.Format(time.RFC3339Nano), ID: r.ID}
+}
+
+// NotificationCursor returns the cursor positioned after n.
+func NotificationCursor(n *models.Notification) *Cursor {
+	return &Cursor{Key: n.UpdatedAt.Format(time.RFC3339Nano), ID: n.ID}
+}
+
+// FeedCursor returns the cursor positioned after item.
+func FeedCursor(item *models.FeedItem) *Cursor {
+	return &Cursor{Key: item.OccurredAt.Format(time.RFC3339Nano), ID: item.ID}
+}
+
+// AuditEventCursor returns the cursor positioned after e.
+func AuditEventCursor(e *models.AuditEvent) *Cursor {
+	return &Cursor{Key: e.CreatedAt.Format(time.RFC3339Nano), ID: strconv.FormatInt(e.ID, 10)}
+}
+
+// ExecutionCursor returns the cursor positioned after e.
+func ExecutionCursor(e *models.PromptExecution) *Cursor {
+	return &Cursor{Key: e.CreatedAt.Format(time.RFC3339Nano), ID: e.ID}
+}
+
+// EvaluationRunCursor returns the cursor positioned after run.
+func EvaluationRunCursor(run *models.EvaluationRun) *Cursor {
+	return &Cursor{Key: run.CreatedAt.Format(time.RFC3339Nano), ID: run.ID}
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// EvaluationRepository defines the interface for evaluation case and run data access.
+type EvaluationRepository interface {
+	CreateCase(ctx context.Context, c *models.EvaluationCase) error
+	GetCase(ctx context.Context, id string) (*models.EvaluationCase, error)
+	UpdateCase(ctx context.Context, c *models.EvaluationCase) error
+	DeleteCase(ctx context.Context, id string) error
+	ListCases(ctx context.Context, templateID string) ([]*models.EvaluationCase, error)
+	CreateRun(ctx context.Context, run *models.EvaluationRun) error
+	GetLatestRun(ctx context.Context, templateID string, versionID int32) (*models.EvaluationRun, error)
+	ListRuns(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.EvaluationRun, error)
+}
+
+// evaluationRepository implements EvaluationRepository.
+type evaluationRepository struct {
+	db *sql.DB
+}
+
+// NewEvaluationRepository creates a new instance of EvaluationRepository.
+func NewEvaluationRepository(db *sql.DB) EvaluationRepository {
+	return &evaluationRepository{db: db}
+}
+
+const evaluationCaseColumns = `id, template_id, name, variables, named_variables, assertions, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanEvaluationCase(row interface{ Scan(...interface{}) error }) (*models.EvaluationCase, error) {
+	var c models.EvaluationCase
+	if err := row.Scan(&c.ID, &c.TemplateID, &c.Name, &c.Variables, &c.NamedVariables, &c.Assertions,
+		&c.CreatedBy, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+const evaluationRunColumns = `id, template_id, version_id, version, COALESCE(created_by, ''), provider, model, passed, failed, results, created_at`
+
+func scanEvaluationRun(row interface{ Scan(...interface{}) error }) (*models.EvaluationRun, error) {
+	var run models.EvaluationRun
+	if err := row.Scan(&run.ID, &run.TemplateID, &run.VersionID, &run.Version, &run.CreatedBy, &run.Provider, &run.Model,
+		&run.Passed, &run.Failed, &run.Results, &run.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// CreateCase inserts a new test case. c is updated with its ID and timestamps.
+func (r *evaluationRepository) CreateCase(ctx context.Context, c *models.EvaluationCase) error {
+	query := `
+		INSERT INTO evaluation_cases (template_id, name, variables, named_variables, assertions, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRowContext(ctx, query, c.TemplateID, c.Name, c.Variables, c.NamedVariables, c.Assertions, c.CreatedBy).
+		Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create evaluation case: %w", err)
+	}
+	return nil
+}
+
+// GetCase retrieves a test case by ID.
+func (r *evaluationRepository) GetCase(ctx context.Context, id string) (*models.EvaluationCase, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+evaluationCaseColumns+` FROM evaluation_cases WHERE id = $1`, id)
+	c, err := scanEvaluationCase(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("evaluation case not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get evaluation case: %w", err)
+	}
+	return c, nil
+}
+
+// UpdateCase replaces the name, values and assertions of a test case.
+func (r *evaluationRepository) UpdateCase(ctx context.Context, c *models.EvaluationCase) error {
+	query := `
+		UPDATE evaluation_cases
+		SET name = $1, variables = $2, named_variables = $3, assertions = $4, updated_at = $5
+		WHERE id = $6
+	`
+	if _, err := r.db.ExecContext(ctx, query, c.Name, c.Variables, c.NamedVariables, c.Assertions, c.UpdatedAt, c.ID); err != nil {
+		return fmt.Errorf("failed to update evaluation case: %w", err)
+	}
+	return nil
+}
+
+// DeleteCase removes a test case. The results of past runs are kept.
+func (r *evaluationRepository) DeleteCase(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM evaluation_cases WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete evaluation case: %w", err)
+	}
+	return nil
+}
+
+// ListCases lists the test cases of a template, oldest first.
+func (r *evaluationRepository) ListCases(ctx context.Context, templateID string) ([]*models.EvaluationCase, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+evaluationCaseColumns+` FROM evaluation_cases WHERE template_id = $1 ORDER BY created_at, id`, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list evaluation cases: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var cases []*models.EvaluationCase
+	for rows.Next() {
+		c, err := scanEvaluationCase(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan evaluation case: %w", err)
+		}
+		cases = append(cases, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return cases, nil
+}
+
+// CreateRun inserts a new run. run is updated with its ID and creation time.
+func (r *evaluationRepository) CreateRun(ctx context.Context, run *models.EvaluationRun) error {
+	query := `
+		INSERT INTO evaluation_runs (template_id, version_id, version, created_by, provider, model, passed, failed, results)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		run.TemplateID, run.VersionID, run.Version, run.CreatedBy, run.Provider, run.Model, run.Passed, run.Failed, run.Results,
+	).Scan(&run.ID, &run.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create evaluation run: %w", err)
+	}
+	return nil
+}
+
+// GetLatestRun retrieves the latest run of a version of a template, or nil
+// when the version was never evaluated.
+func (r *evaluationRepository) GetLatestRun(ctx context.Context, templateID string, versionID int32) (*models.EvaluationRun, error) {
+	query := `SELECT ` + evaluationRunColumns + ` FROM evaluation_runs
+		WHERE template_id = $1 AND version_id = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1`
+	run, err := scanEvaluationRun(r.db.QueryRowContext(ctx, query, templateID, versionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest evaluation run: %w", err)
+	}
+	return run, nil
+}
+
+// ListRuns retrieves a page of runs, latest first, starting strictly after the
+// given cursor when it is not nil.
+func (r *evaluationRepository) ListRuns(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.EvaluationRun, error) {
+	query := `SELECT ` + evaluationRunColumns + ` FROM evaluation_runs WHERE 1=1`
+	var args []interface{}
+	argID := 1
+	for _, column := range []string{"template_id", "version_id"} {
+		if val, ok := filters[column]; ok {
+			query += fmt.Sprintf(" AND %s = $%d", column, argID)
+			args = append(args, val)
+			argID++
+		}
+	}
+	if after != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d::timestamptz, $%d::uuid)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query evaluation runs: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var runs []*models.EvaluationRun
+	for rows.Next() {
+		run, err := scanEvaluationRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan evaluation run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return runs, nil
+}
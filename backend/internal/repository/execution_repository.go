@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ExecutionRepository defines the interface for prompt execution data access.
+type ExecutionRepository interface {
+	Create(ctx context.Context, e *models.PromptExecution) error
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.PromptExecution, error)
+}
+
+// executionRepository implements ExecutionRepository.
+type executionRepository struct {
+	db *sql.DB
+}
+
+// NewExecutionRepository creates a new instance of ExecutionRepository.
+func NewExecutionRepository(db *sql.DB) ExecutionRepository {
+	return &executionRepository{db: db}
+}
+
+// Create inserts a new execution. e is updated with its ID and creation time.
+func (r *executionRepository) Create(ctx context.Context, e *models.PromptExecution) error {
+	query := `
+		INSERT INTO prompt_executions (owner_id, prompt_id, provider, model, request, response_text, finish_reason,
+			status, error, latency_ms, prompt_tokens, completion_tokens, total_tokens)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		e.OwnerID, e.PromptID, e.Provider, e.Model, e.Request, e.ResponseText, e.FinishReason,
+		e.Status, e.Error, e.LatencyMs, e.PromptTokens, e.CompletionTokens, e.TotalTokens,
+	).Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create execution: %w", err)
+	}
+	return nil
+}
+
+// List retrieves a page of executions, latest first, starting strictly after
+// the given cursor when it is not nil.
+func (r *executionRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.PromptExecution, error) {
+	query := `
+		SELECT id, owner_id, prompt_id, provider, model, request, response_text, finish_reason,
+			status, error, latency_ms, prompt_tokens, completion_tokens, total_tokens, created_at
+		FROM prompt_executions
+		WHERE 1=1`
+	var args []interface{}
+	argID := 1
+	for _, column := range []string{"owner_id", "prompt_id"} {
+		if val, ok := filters[column]; ok && val != "" {
+			query += fmt.Sprintf(" AND %s = $%d", column, argID)
+			args = append(args, val)
+			argID++
+		}
+	}
+	if after != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d::timestamptz, $%d::uuid)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query executions: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var executions []*models.PromptExecution
+	for rows.Next() {
+		var e models.PromptExecution
+		if err := rows.Scan(
+			&e.ID, &e.OwnerID, &e.PromptID, &e.Provider, &e.Model, &e.Request, &e.ResponseText, &e.FinishReason,
+			&e.Status, &e.Error, &e.LatencyMs, &e.PromptTokens, &e.CompletionTokens, &e.TotalTokens, &e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan execution: %w", err)
+		}
+		executions = append(executions, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return executions, nil
+}
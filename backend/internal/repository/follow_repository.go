@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// FollowRepository defines the interface for follow relationships between users
+// and the feeds built from them.
+type FollowRepository interface {
+	Follow(ctx context.Context, followerID, followeeID string) (int32, error)
+	Unfollow(ctx context.Context, followerID, followeeID string) (int32, error)
+	IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error)
+	Feed(ctx context.Context, userID string, limit int, after *Cursor) ([]*models.FeedItem, error)
+}
+
+// followRepository implements FollowRepository.
+type followRepository struct {
+	db *sql.DB
+}
+
+// NewFollowRepository creates a new instance of FollowRepository.
+func NewFollowRepository(db *sql.DB) FollowRepository {
+	return &followRepository{db: db}
+}
+
+// Follow makes followerID follow followeeID and returns the follower count of
+// followeeID. Following someone twice is a no-op. It returns ErrUnknownUser when
+// followeeID does not exist.
+func (r *followRepository) Follow(ctx context.Context, followerID, followeeID string) (int32, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO user_follows (follower_id, followee_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, followerID, followeeID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "user_follows_followee_id_fkey" {
+			return 0, ErrUnknownUser
+		}
+		return 0, fmt.Errorf("failed to follow user: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n > 0 {
+		if err := r.adjustCounts(ctx, tx, followerID, followeeID, 1); err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := r.followerCount(ctx, tx, followeeID)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return count, nil
+}
+
+// Unfollow makes followerID stop following followeeID and returns the follower
+// count of followeeID. Unfollowing someone not followed is a no-op.
+func (r *followRepository) Unfollow(ctx context.Context, followerID, followeeID string) (int32, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2`, followerID, followeeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unfollow user: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n > 0 {
+		if err := r.adjustCounts(ctx, tx, followerID, followeeID, -1); err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := r.followerCount(ctx, tx, followeeID)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return count, nil
+}
+
+// IsFollowing reports whether followerID follows followeeID.
+func (r *followRepository) IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM user_follows WHERE follower_id = $1 AND followee_id = $2)`, followerID, followeeID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check follow: %w", err)
+	}
+	return exists, nil
+}
+
+// adjustCounts moves the follower count of followeeID and the following count
+// of followerID by delta.
+func (r *followRepository) adjustCounts(ctx context.Context, tx *sql.Tx, followerID, followeeID string, delta int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE users SET
+			follower_count = follower_count + CASE WHEN id = $2 THEN $3::int ELSE 0 END,
+			following_count = following_count + CASE WHEN id = $1 THEN $3::int ELSE 0 END
+		WHERE id IN ($1, $2)
+	`, followerID, followeeID, delta)
+	if err != nil {
+		return fmt.Errorf("failed to update follow counts: %w", err)
+	}
+	return nil
+}
+
+func (r *followRepository) followerCount(ctx context.Context, tx *sql.Tx, userID string) (int32, error) {
+	var count int32
+	if err := tx.QueryRowContext(ctx, `SELECT follower_count FROM users WHERE id = $1`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to get follower count: %w", err)
+	}
+	return count, nil
+}
+
+// Feed retrieves a page of the feed of a user, latest first, starting strictly
+// after the given cursor when it is not nil. The feed is assembled on read from
+// the public templates, public forks and new versions of public templates of
+// the authors the user follows; each branch walks an (owner, created_at) or
+// (template, created_at) index and stops at the page size.
+func (r *followRepository) Feed(ctx context.Context, userID string, limit int, after *Cursor) ([]*models.FeedItem, error) {
+	args := []interface{}{userID, limit}
+	templateAfter, versionAfter := "", ""
+	if after != nil {
+		args = append(args, after.Key, after.ID)
+		templateAfter = " AND (t.created_at, t.id::text) < ($3::timestamptz, $4)"
+		versionAfter = " AND (v.created_at, t.id::text || ':' || v.version) < ($3::timestamptz, $4)"
+	}
+
+	query := `
+		WITH followed AS (
+			SELECT followee_id FROM user_follows WHERE follower_id = $1
+		), events AS (
+			(SELECT t.id::text AS event_id, CASE WHEN t.forked_from IS NULL THEN 'template' ELSE 'fork' END AS type,
+				t.id AS template_id, 1 AS version, t.created_at AS occurred_at
+			FROM templates t
+			WHERE t.owner_id IN (SELECT followee_id FROM followed)
+			AND t.visibility = 'public' AND t.moderation_state = 'visible'` + templateAfter + `
+			ORDER BY t.created_at DESC, t.id::text DESC
+			LIMIT $2)
+			UNION ALL
+			(SELECT t.id::text || ':' || v.version, 'version', t.id, v.version, v.created_at
+			FROM template_versions v
+			JOIN templates t ON t.id = v.template_id
+			WHERE t.owner_id IN (SELECT followee_id FROM followed)
+			AND t.visibility = 'public' AND t.moderation_state = 'visible' AND v.version > 1` + versionAfter + `
+			ORDER BY v.created_at DESC, t.id::text || ':' || v.version DESC
+			LIMIT $2)
+		)
+		SELECT e.event_id, e.type, e.version, e.occurred_at,
+			t.id, t.owner_id, t.title, t.description, t.visibility, t.type, t.tags, t.category, t.language,
+			t.like_count, t.favorite_count, t.forked_from, t.comment_count, t.rating_count, t.rating_average,
+			t.created_at, t.updated_at, COALESCE(u.display_name, '')
+		FROM events e
+		JOIN templates t ON t.id = e.template_id
+		LEFT JOIN users u ON u.id = t.owner_id
+		ORDER BY e.occurred_at DESC, e.event_id DESC
+		LIMIT $2
+	`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var items []*models.FeedItem
+	for rows.Next() {
+		var item models.FeedItem
+		t := &item.Template
+		if err := rows.Scan(
+			&item.ID, &item.Type, &item.Version, &item.OccurredAt,
+			&t.ID, &t.OwnerID, &t.Title, &t.Description, &t.Visibility, &t.Type, &t.Tags, &t.Category, &t.Language,
+			&t.LikeCount, &t.FavoriteCount, &t.ForkedFrom, &t.CommentCount, &t.RatingCount, &t.RatingAverage,
+			&t.CreatedAt, &t.UpdatedAt, &item.ActorName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan feed item: %w", err)
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return items, nil
+}
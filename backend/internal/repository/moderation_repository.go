@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ErrDuplicateReport is returned when a user reports a template they already reported.
+var ErrDuplicateReport = errors.New("template already reported")
+
+// ModerationRepository defines the interface for template report data access.
+type ModerationRepository interface {
+	CreateReport(ctx context.Context, report *models.TemplateReport) (int, error)
+	ListQueue(ctx context.Context, limit int, after *Cursor) ([]*models.ModerationQueueItem, error)
+	ResolveReports(ctx context.Context, templateID, resolvedBy, status string) (int64, error)
+}
+
+// moderationRepository implements ModerationRepository.
+type moderationRepository struct {
+	db *sql.DB
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository.
+func NewModerationRepository(db *sql.DB) ModerationRepository {
+	return &moderationRepository{db: db}
+}
+
+// CreateReport stores a report and returns the number of open reports on the template,
+// the new one included. Since each user can report a template once, that is the
+// number of distinct users waiting for a decision.
+func (r *moderationRepository) CreateReport(ctx context.Context, report *models.TemplateReport) (int, error) {
+	query := `
+		INSERT INTO template_reports (template_id, reporter_id, reason, details)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, status, created_at
+	`
+	err := r.db.QueryRowContext(ctx, query, report.TemplateID, report.ReporterID, report.Reason, report.Details).
+		Scan(&report.ID, &report.Status, &report.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return 0, ErrDuplicateReport
+		}
+		return 0, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	var open int
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM template_reports WHERE template_id = $1 AND status = 'open'`, report.TemplateID).Scan(&open)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+	return open, nil
+}
+
+// ListQueue retrieves a page of templates with open reports, longest waiting first,
+// starting strictly after the given cursor when it is not nil.
+func (r *moderationRepository) ListQueue(ctx context.Context, limit int, after *Cursor) ([]*models.ModerationQueueItem, error) {
+	query := `
+		SELECT
+			t.id, t.owner_id, t.title, t.description, t.visibility, t.type, t.tags, t.category, t.language,
+			t.like_count, t.favorite_count, t.trending_score, t.forked_from, t.org_id, t.featured_at, t.moderation_state, t.comment_count, t.rating_count, t.rating_average, t.rating_score, t.created_at, t.updated_at,
+			q.first_reported_at
+		FROM (
+			SELECT template_id, MIN(created_at) AS first_reported_at
+			FROM template_reports
+			WHERE status = 'open'
+			GROUP BY template_id
+		) q
+		JOIN templates t ON t.id = q.template_id
+		WHERE 1=1
+	`
+	var args []interface{}
+	argID := 1
+	if after != nil {
+		query += fmt.Sprintf(" AND (q.first_reported_at, t.id) > ($%d::timestamptz, $%d::uuid)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY q.first_reported_at, t.id LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query moderation queue: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var items []*models.ModerationQueueItem
+	byTemplate := make(map[string]*models.ModerationQueueItem)
+	var ids []string
+	for rows.Next() {
+		var t models.Template
+		item := &models.ModerationQueueItem{Template: &t}
+		if err := rows.Scan(
+			&t.ID, &t.OwnerID, &t.Title, &t.Description, &t.Visibility, &t.Type,
+			&t.Tags, &t.Category, &t.Language, &t.LikeCount, &t.FavoriteCount, &t.TrendingScore, &t.ForkedFrom, &t.OrgID, &t.FeaturedAt, &t.ModerationState, &t.CommentCount, &t.RatingCount, &t.RatingAverage, &t.RatingScore, &t.CreatedAt, &t.UpdatedAt,
+			&item.FirstReportedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		items = append(items, item)
+		byTemplate[t.ID] = item
+		ids = append(ids, t.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	reportRows, err := r.db.QueryContext(ctx, `
+		SELECT id, template_id, reporter_id, reason, details, status, resolved_by, resolved_at, created_at
+		FROM template_reports
+		WHERE status = 'open' AND template_id = ANY($1::uuid[])
+		ORDER BY created_at, id
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reports: %w", err)
+	}
+	defer func() {
+		_ = reportRows.Close()
+	}()
+	for reportRows.Next() {
+		var rep models.TemplateReport
+		if err := reportRows.Scan(&rep.ID, &rep.TemplateID, &rep.ReporterID, &rep.Reason, &rep.Details, &rep.Status, &rep.ResolvedBy, &rep.ResolvedAt, &rep.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan report: %w", err)
+		}
+		item := byTemplate[rep.TemplateID]
+		item.Reports = append(item.Reports, &rep)
+	}
+	if err := reportRows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return items, nil
+}
+
+// ResolveReports closes the open reports of a template with the given status
+// ("dismissed" or "actioned") and returns how many were closed.
+func (r *moderationRepository) ResolveReports(ctx context.Context, templateID, resolvedBy, status string) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE template_reports SET status = $3, resolved_by = $2, resolved_at = NOW()
+		WHERE template_id = $1 AND status = 'open'
+	`, templateID, resolvedBy, status)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve reports: %w", err)
+	}
+	n, _ := result.RowsAffected()
+	return n, nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// NotificationRepository defines the interface for notification data access.
+type NotificationRepository interface {
+	Record(ctx context.Context, n *models.Notification, window time.Duration) error
+	List(ctx context.Context, userID string, unreadOnly bool, limit int, after *Cursor) ([]*models.Notification, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	MarkRead(ctx context.Context, userID string, ids []string) (int64, error)
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+}
+
+// notificationRepository implements NotificationRepository.
+type notificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository.
+func NewNotificationRepository(db *sql.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Record stores an event as a notification for n.UserID. When the user has an
+// unread notification of the same type on the same template created less than
+// window ago, the event is collapsed into it instead: its actor becomes the
+// latest one and its event count grows. n is updated with the stored notification.
+func (r *notificationRepository) Record(ctx context.Context, n *models.Notification, window time.Duration) error {
+	query := `
+		WITH collapsed AS (
+			UPDATE notifications
+			SET actor_id = $3,
+				actor_ids = CASE WHEN $3 = ANY(actor_ids) THEN actor_ids ELSE array_append(actor_ids, $3::text) END,
+				event_count = event_count + 1,
+				comment_id = COALESCE($5::uuid, comment_id),
+				updated_at = NOW()
+			WHERE id = (
+				SELECT id FROM notifications
+				WHERE user_id = $1 AND type = $2 AND template_id IS NOT DISTINCT FROM $4::uuid
+				AND read_at IS NULL AND created_at > NOW() - make_interval(secs => $6)
+				ORDER BY created_at DESC
+				LIMIT 1
+			)
+			RETURNING id, actor_ids, event_count, created_at, updated_at
+		), inserted AS (
+			INSERT INTO notifications (user_id, type, actor_id, actor_ids, template_id, comment_id)
+			SELECT $1, $2, $3, ARRAY[$3::text], $4::uuid, $5::uuid
+			WHERE NOT EXISTS (SELECT 1 FROM collapsed)
+			RETURNING id, actor_ids, event_count, created_at, updated_at
+		)
+		SELECT * FROM collapsed UNION ALL SELECT * FROM inserted
+	`
+	err := r.db.QueryRowContext(ctx, query, n.UserID, n.Type, n.ActorID, n.TemplateID, n.CommentID, window.Seconds()).
+		Scan(&n.ID, &n.ActorIDs, &n.EventCount, &n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record notification: %w", err)
+	}
+	return nil
+}
+
+// List retrieves a page of the notifications of a user, latest activity first,
+// starting strictly after the given cursor when it is not nil.
+func (r *notificationRepository) List(ctx context.Context, userID string, unreadOnly bool, limit int, after *Cursor) ([]*models.Notification, error) {
+	query := `
+		SELECT n.id, n.user_id, n.type, n.actor_id, n.actor_ids, n.event_count, n.template_id, n.comment_id,
+			n.read_at, n.created_at, n.updated_at, COALESCE(u.display_name, ''), COALESCE(t.title, '')
+		FROM notifications n
+		LEFT JOIN users u ON u.id = n.actor_id
+		LEFT JOIN templates t ON t.id = n.template_id
+		WHERE n.user_id = $1
+	`
+	args := []interface{}{userID}
+	if unreadOnly {
+		query += " AND n.read_at IS NULL"
+	}
+	if after != nil {
+		args = append(args, after.Key, after.ID)
+		query += fmt.Sprintf(" AND (n.updated_at, n.id) < ($%d::timestamptz, $%d::uuid)", len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY n.updated_at DESC, n.id DESC LIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notifications: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		var n models.Notification
+		if err := rows.Scan(
+			&n.ID, &n.UserID, &n.Type, &n.ActorID, &n.ActorIDs, &n.EventCount, &n.TemplateID, &n.CommentID,
+			&n.ReadAt, &n.CreatedAt, &n.UpdatedAt, &n.ActorName, &n.TemplateTitle,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, &n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return notifications, nil
+}
+
+// CountUnread returns the number of unread notifications of a user.
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks the given notifications of a user as read and returns how many
+// were unread. IDs of other users' notifications are ignored.
+func (r *notificationRepository) MarkRead(ctx context.Context, userID string, ids []string) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE notifications SET read_at = NOW()
+		WHERE user_id = $1 AND id = ANY($2::uuid[]) AND read_at IS NULL
+	`, userID, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	n, _ := result.RowsAffected()
+	return n, nil
+}
+
+// MarkAllRead marks every notification of a user as read and returns how many were unread.
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE notifications SET read_at = NOW() WHERE user_id = $1 AND read_at IS NULL`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	n, _ := result.RowsAffected()
+	return n, nil
+}
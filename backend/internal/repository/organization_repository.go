@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+var (
+	// ErrNotMember is returned when changing or removing a user who is not a member of the organization.
+	ErrNotMember = errors.New("user is not a member of the organization")
+	// ErrLastOwner is returned when a change would leave an organization without an owner.
+	ErrLastOwner = errors.New("organization must keep at least one owner")
+	// ErrInvitationUsed is returned when accepting an invitation that was already accepted.
+	ErrInvitationUsed = errors.New("invitation was already accepted")
+)
+
+// OrganizationRepository defines the interface for organization, membership and invitation data access.
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *models.Organization, ownerID string) error
+	Get(ctx context.Context, id string, currentUserID string) (*models.Organization, error)
+	ListForUser(ctx context.Context, userID string) ([]*models.Organization, error)
+	GetMember(ctx context.Context, orgID, userID string) (*models.OrganizationMember, error)
+	ListMembers(ctx context.Context, orgID string) ([]*models.OrganizationMember, error)
+	UpdateMemberRole(ctx context.Context, orgID, userID, role string) error
+	RemoveMember(ctx context.Context, orgID, userID string) error
+	CreateInvitation(ctx context.Context, inv *models.OrganizationInvitation) error
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.OrganizationInvitation, error)
+	AcceptInvitation(ctx context.Context, inv *models.OrganizationInvitation, userID string) error
+}
+
+// organizationRepository implements OrganizationRepository.
+type organizationRepository struct {
+	db *sql.DB
+}
+
+// NewOrganizationRepository creates a new instance of OrganizationRepository.
+func NewOrganizationRepository(db *sql.DB) OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+// organizationColumns selects an organization with its member count and the role of the user in $1.
+const organizationColumns = `
+	o.id, o.name, o.created_by, o.created_at, o.updated_at,
+	(SELECT COUNT(*) FROM organization_members mc WHERE mc.org_id = o.id) AS member_count,
+	COALESCE(m.role, '') AS role
+`
+
+func scanOrganization(row interface{ Scan(...interface{}) error }) (*models.Organization, error) {
+	var o models.Organization
+	if err := row.Scan(&o.ID, &o.Name, &o.CreatedBy, &o.CreatedAt, &o.UpdatedAt, &o.MemberCount, &o.Role); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// Create inserts a new organization with ownerID as its first owner.
+func (r *organizationRepository) Create(ctx context.Context, o *models.Organization, ownerID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO organizations (name, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, o.Name, o.CreatedBy, o.CreatedAt, o.UpdatedAt).Scan(&o.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (org_id, user_id, role, created_at) VALUES ($1, $2, 'owner', $3)
+	`, o.ID, ownerID, o.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add organization owner: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	o.MemberCount = 1
+	o.Role = "owner"
+	return nil
+}
+
+// Get retrieves an organization by ID.
+func (r *organizationRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Organization, error) {
+	query := `SELECT ` + organizationColumns + `
+		FROM organizations o
+		LEFT JOIN organization_members m ON m.org_id = o.id AND m.user_id = $1
+		WHERE o.id = $2
+	`
+	o, err := scanOrganization(r.db.QueryRowContext(ctx, query, currentUserID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("organization not found")
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return o, nil
+}
+
+// ListForUser lists the organizations a user is a member of, by name.
+func (r *organizationRepository) ListForUser(ctx context.Context, userID string) ([]*models.Organization, error) {
+	query := `SELECT ` + organizationColumns + `
+		FROM organizations o
+		JOIN organization_members m ON m.org_id = o.id AND m.user_id = $1
+		ORDER BY o.name, o.id
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organizations: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var orgs []*models.Organization
+	for rows.Next() {
+		o, err := scanOrganization(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		orgs = append(orgs, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return orgs, nil
+}
+
+// GetMember retrieves a user's membership of an organization.
+// It returns nil without error when the user is not a member.
+func (r *organizationRepository) GetMember(ctx context.Context, orgID, userID string) (*models.OrganizationMember, error) {
+	query := `
+		SELECT m.org_id, m.user_id, m.role, m.created_at, u.display_name
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id = $1 AND m.user_id = $2
+	`
+	var m models.OrganizationMember
+	err := r.db.QueryRowContext(ctx, query, orgID, userID).Scan(&m.OrgID, &m.UserID, &m.Role, &m.CreatedAt, &m.DisplayName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return &m, nil
+}
+
+// ListMembers lists the members of an organization, oldest first.
+func (r *organizationRepository) ListMembers(ctx context.Context, orgID string) ([]*models.OrganizationMember, error) {
+	query := `
+		SELECT m.org_id, m.user_id, m.role, m.created_at, u.display_name
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id = $1
+		ORDER BY m.created_at, m.user_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organization members: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var members []*models.OrganizationMember
+	for rows.Next() {
+		var m models.OrganizationMember
+		if err := rows.Scan(&m.OrgID, &m.UserID, &m.Role, &m.CreatedAt, &m.DisplayName); err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+		members = append(members, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return members, nil
+}
+
+// UpdateMemberRole changes the role of a member.
+// It fails with ErrLastOwner when demoting the only owner.
+func (r *organizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID, role string) error {
+	return r.changeMember(ctx, orgID, userID, role != "owner", func(tx *sql.Tx) (sql.Result, error) {
+		return tx.ExecContext(ctx, `UPDATE organization_members SET role = $3 WHERE org_id = $1 AND user_id = $2`, orgID, userID, role)
+	})
+}
+
+// RemoveMember removes a member from an organization.
+// It fails with ErrLastOwner when removing the only owner.
+func (r *organizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	return r.changeMember(ctx, orgID, userID, true, func(tx *sql.Tx) (sql.Result, error) {
+		return tx.ExecContext(ctx, `DELETE FROM organization_members WHERE org_id = $1 AND user_id = $2`, orgID, userID)
+	})
+}
+
+// changeMember applies a change to a membership. When dropsOwner is set and the
+// member is an owner, the change is refused if no other owner remains.
+// The owners are locked so that two owners cannot leave concurrently.
+func (r *organizationRepository) changeMember(ctx context.Context, orgID, userID string, dropsOwner bool, change func(*sql.Tx) (sql.Result, error)) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if dropsOwner {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT user_id FROM organization_members WHERE org_id = $1 AND role = 'owner' FOR UPDATE`, orgID)
+		if err != nil {
+			return fmt.Errorf("failed to lock organization owners: %w", err)
+		}
+		var owners []string
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return fmt.Errorf("failed to scan organization owner: %w", err)
+			}
+			owners = append(owners, id)
+		}
+		_ = rows.Close()
+		if len(owners) == 1 && owners[0] == userID {
+			return ErrLastOwner
+		}
+	}
+
+	result, err := change(tx)
+	if err != nil {
+		return fmt.Errorf("failed to update organization member: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotMember
+	}
+	return tx.Commit()
+}
+
+// CreateInvitation inserts a new invitation.
+func (r *organizationRepository) CreateInvitation(ctx context.Context, inv *models.OrganizationInvitation) error {
+	query := `
+		INSERT INTO organization_invitations (org_id, email, role, token_hash, invited_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query,
+		inv.OrgID, inv.Email, inv.Role, inv.TokenHash, inv.InvitedBy, inv.ExpiresAt, inv.CreatedAt,
+	).Scan(&inv.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+	return nil
+}
+
+// GetInvitationByTokenHash retrieves the invitation with the given token hash.
+func (r *organizationRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.OrganizationInvitation, error) {
+	query := `
+		SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+		FROM organization_invitations
+		WHERE token_hash = $1
+	`
+	var inv models.OrganizationInvitation
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&inv.ID, &inv.OrgID, &inv.Email, &inv.Role, &inv.TokenHash, &inv.InvitedBy, &inv.ExpiresAt, &inv.AcceptedAt, &inv.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("invitation not found")
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	return &inv, nil
+}
+
+// AcceptInvitation marks an invitation as accepted and adds the user to its organization.
+// A user who is already a member keeps their current role.
+func (r *organizationRepository) AcceptInvitation(ctx context.Context, inv *models.OrganizationInvitation, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE organization_invitations SET accepted_at = NOW() WHERE id = $1 AND accepted_at IS NULL`, inv.ID)
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrInvitationUsed
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (org_id, user_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (org_id, user_id) DO NOTHING
+	`, inv.OrgID, userID, inv.Role)
+	if err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// PresetRepository defines the interface for variable preset data access.
+type PresetRepository interface {
+	Create(ctx context.Context, preset *models.VariablePreset) error
+	Get(ctx context.Context, id string) (*models.VariablePreset, error)
+	Update(ctx context.Context, preset *models.VariablePreset) error
+	Delete(ctx context.Context, id string) error
+	ListForOwner(ctx context.Context, ownerID string) ([]*models.VariablePreset, error)
+	ListForOrg(ctx context.Context, orgID string) ([]*models.VariablePreset, error)
+}
+
+// presetRepository implements PresetRepository.
+type presetRepository struct {
+	db *sql.DB
+}
+
+// NewPresetRepository creates a new instance of PresetRepository.
+func NewPresetRepository(db *sql.DB) PresetRepository {
+	return &presetRepository{db: db}
+}
+
+const presetColumns = `id, owner_id, org_id, name, "values", created_at, updated_at`
+
+func scanPreset(row interface{ Scan(...interface{}) error }) (*models.VariablePreset, error) {
+	var p models.VariablePreset
+	if err := row.Scan(&p.ID, &p.OwnerID, &p.OrgID, &p.Name, &p.Values, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Create inserts a new preset. preset is updated with its ID and timestamps.
+func (r *presetRepository) Create(ctx context.Context, preset *models.VariablePreset) error {
+	query := `
+		INSERT INTO variable_presets (owner_id, org_id, name, "values")
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRowContext(ctx, query, preset.OwnerID, preset.OrgID, preset.Name, preset.Values).
+		Scan(&preset.ID, &preset.CreatedAt, &preset.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create preset: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a preset by ID.
+func (r *presetRepository) Get(ctx context.Context, id string) (*models.VariablePreset, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+presetColumns+` FROM variable_presets WHERE id = $1`, id)
+	preset, err := scanPreset(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("preset not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get preset: %w", err)
+	}
+	return preset, nil
+}
+
+// Update replaces the name and values of a preset.
+func (r *presetRepository) Update(ctx context.Context, preset *models.VariablePreset) error {
+	query := `
+		UPDATE variable_presets
+		SET name = $1, "values" = $2, updated_at = $3
+		WHERE id = $4
+	`
+	if _, err := r.db.ExecContext(ctx, query, preset.Name, preset.Values, preset.UpdatedAt, preset.ID); err != nil {
+		return fmt.Errorf("failed to update preset: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a preset.
+func (r *presetRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM variable_presets WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete preset: %w", err)
+	}
+	return nil
+}
+
+// ListForOwner lists the personal presets of a user, by name.
+func (r *presetRepository) ListForOwner(ctx context.Context, ownerID string) ([]*models.VariablePreset, error) {
+	return r.list(ctx, `SELECT `+presetColumns+` FROM variable_presets WHERE owner_id = $1 AND org_id IS NULL ORDER BY name, id`, ownerID)
+}
+
+// ListForOrg lists the presets of an organization, by name.
+func (r *presetRepository) ListForOrg(ctx context.Context, orgID string) ([]*models.VariablePreset, error) {
+	return r.list(ctx, `SELECT `+presetColumns+` FROM variable_presets WHERE org_id = $1 ORDER BY name, id`, orgID)
+}
+
+func (r *presetRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.VariablePreset, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presets: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var presets []*models.VariablePreset
+	for rows.Next() {
+		preset, err := scanPreset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan preset: %w", err)
+		}
+		presets = append(presets, preset)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return presets, nil
+}
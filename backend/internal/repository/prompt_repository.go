@@ -10,13 +10,17 @@ import (
 	"go.uber.org/zap"
 
 	"awsome-prompt/backend/internal/models"
+
+	"github.com/lib/pq"
 )
 
 // PromptRepository defines the interface for prompt data access.
 type PromptRepository interface {
 	Create(ctx context.Context, prompt *models.Prompt) error
 	Get(ctx context.Context, id string) (*models.Prompt, error)
-	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*models.Prompt, error)
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Prompt, error)
+	Count(ctx context.Context, filters map[string]interface{}) (int64, error)
+	Update(ctx context.Context, prompt *models.Prompt) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -33,21 +37,30 @@ func NewPromptRepository(db *sql.DB) PromptRepository {
 func (r *promptRepository) Create(ctx context.Context, prompt *models.Prompt) error {
 	zap.S().Infof("PromptRepository.Create: ownerID=%s templateID=%s", prompt.OwnerID, prompt.TemplateID)
 	query := `
-		INSERT INTO prompts (template_id, version_id, owner_id, variables)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, created_at`
+		INSERT INTO prompts (template_id, version_id, owner_id, variables, named_variables, title, notes, tags, pinned, rendered_text)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, last_used_at`
 
 	// Ensure variables is valid JSON
 	if prompt.Variables == nil {
 		prompt.Variables = json.RawMessage("[]")
 	}
+	if prompt.NamedVariables == nil {
+		prompt.NamedVariables = json.RawMessage("{}")
+	}
 
 	err := r.db.QueryRowContext(ctx, query,
 		prompt.TemplateID,
 		prompt.VersionID,
 		prompt.OwnerID,
 		prompt.Variables,
-	).Scan(&prompt.ID, &prompt.CreatedAt)
+		prompt.NamedVariables,
+		prompt.Title,
+		prompt.Notes,
+		pq.Array(prompt.Tags),
+		prompt.Pinned,
+		prompt.RenderedText,
+	).Scan(&prompt.ID, &prompt.CreatedAt, &prompt.LastUsedAt)
 
 	if err != nil {
 		return fmt.Errorf("failed to insert prompt: %w", err)
@@ -56,13 +69,19 @@ func (r *promptRepository) Create(ctx context.Context, prompt *models.Prompt) er
 	return nil
 }
 
+// promptColumns selects a prompt joined with its template title and version number.
+const promptColumns = `
+		SELECT p.id, p.template_id, p.version_id, p.owner_id, p.variables, p.named_variables, p.title, p.notes, p.tags, p.pinned,
+			p.rendered_text, p.created_at, p.last_used_at, t.title, v.version
+		FROM prompts p
+		JOIN templates t ON t.id = p.template_id
+		JOIN template_versions v ON v.id = p.version_id`
+
 // Get retrieves a prompt by ID.
 func (r *promptRepository) Get(ctx context.Context, id string) (*models.Prompt, error) {
 	zap.S().Infof("PromptRepository.Get: id=%s", id)
-	query := `
-		SELECT id, template_id, version_id, owner_id, variables, created_at
-		FROM prompts
-		WHERE id = $1`
+	query := promptColumns + `
+		WHERE p.id = $1`
 
 	var prompt models.Prompt
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
@@ -71,7 +90,16 @@ func (r *promptRepository) Get(ctx context.Context, id string) (*models.Prompt,
 		&prompt.VersionID,
 		&prompt.OwnerID,
 		&prompt.Variables,
+		&prompt.NamedVariables,
+		&prompt.Title,
+		&prompt.Notes,
+		&prompt.Tags,
+		&prompt.Pinned,
+		&prompt.RenderedText,
 		&prompt.CreatedAt,
+		&prompt.LastUsedAt,
+		&prompt.TemplateTitle,
+		&prompt.Version,
 	)
 
 	if err != nil {
@@ -84,31 +112,28 @@ func (r *promptRepository) Get(ctx context.Context, id string) (*models.Prompt,
 	return &prompt, nil
 }
 
-// List retrieves a list of prompts based on filters.
-func (r *promptRepository) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*models.Prompt, error) {
-	zap.S().Infof("PromptRepository.List: filters=%v limit=%d offset=%d", filters, limit, offset)
-	query := `
-		SELECT id, template_id, version_id, owner_id, variables, created_at
-		FROM prompts
-		WHERE 1=1
-	`
-	var args []interface{}
-	argID := 1
+// List retrieves a page of prompts based on filters, newest (or most recently
+// used) first, starting strictly after the given cursor when it is not nil.
+func (r *promptRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Prompt, error) {
+	zap.S().Infof("PromptRepository.List: filters=%v limit=%d after=%v", filters, limit, after)
+	query := promptColumns + `
+		WHERE 1=1`
+	where, args, argID := promptListConditions(filters)
+	query += where
 
-	if val, ok := filters["owner_id"]; ok && val != "" {
-		query += fmt.Sprintf(" AND owner_id = $%d", argID)
-		args = append(args, val)
-		argID++
+	sortKey := "p.created_at"
+	if filters["sort"] == "last_used" {
+		sortKey = "p.last_used_at"
 	}
 
-	if val, ok := filters["template_id"]; ok && val != "" {
-		query += fmt.Sprintf(" AND template_id = $%d", argID)
-		args = append(args, val)
-		argID++
+	if after != nil {
+		query += fmt.Sprintf(" AND (%s, p.id) < ($%d::timestamptz, $%d::uuid)", sortKey, argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
 	}
 
-	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argID, argID+1)
-	args = append(args, limit, offset)
+	query += fmt.Sprintf(" ORDER BY %[1]s DESC, p.id DESC LIMIT $%[2]d", sortKey, argID)
+	args = append(args, limit)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -125,7 +150,16 @@ func (r *promptRepository) List(ctx context.Context, limit, offset int, filters
 			&p.VersionID,
 			&p.OwnerID,
 			&p.Variables,
+			&p.NamedVariables,
+			&p.Title,
+			&p.Notes,
+			&p.Tags,
+			&p.Pinned,
+			&p.RenderedText,
 			&p.CreatedAt,
+			&p.LastUsedAt,
+			&p.TemplateTitle,
+			&p.Version,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan prompt: %w", err)
 		}
@@ -139,6 +173,85 @@ func (r *promptRepository) List(ctx context.Context, limit, offset int, filters
 	return prompts, nil
 }
 
+// Count returns the number of prompts matching the filters.
+func (r *promptRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	where, args, _ := promptListConditions(filters)
+	var count int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM prompts p WHERE 1=1"+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count prompts: %w", err)
+	}
+	return count, nil
+}
+
+// promptListConditions builds the WHERE conditions shared by List and Count,
+// on the prompts table aliased as p. The "query" filter matches the title, the
+// notes or any of the variable values, case-insensitively. It returns the conditions, their arguments and the next free placeholder index.
+func promptListConditions(filters map[string]interface{}) (string, []interface{}, int) {
+	query := ""
+	var args []interface{}
+	argID := 1
+
+	if val, ok := filters["owner_id"]; ok && val != "" {
+		query += fmt.Sprintf(" AND p.owner_id = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+
+	if val, ok := filters["template_id"]; ok && val != "" {
+		query += fmt.Sprintf(" AND p.template_id = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+
+	if val, ok := filters["start_time"]; ok {
+		query += fmt.Sprintf(" AND p.created_at >= $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+
+	if val, ok := filters["end_time"]; ok {
+		query += fmt.Sprintf(" AND p.created_at < $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+
+	if val, ok := filters["pinned"]; ok && val == true {
+		query += " AND p.pinned"
+	}
+
+	if val, ok := filters["tag"]; ok && val != "" {
+		query += fmt.Sprintf(" AND $%d = ANY(p.tags)", argID)
+		args = append(args, val)
+		argID++
+	}
+
+	if val, ok := filters["query"]; ok && val != "" {
+		query += fmt.Sprintf(" AND (p.title ILIKE $%[1]d OR p.notes ILIKE $%[1]d"+
+			" OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(p.variables) AS value WHERE value ILIKE $%[1]d)"+
+			" OR EXISTS (SELECT 1 FROM jsonb_each_text(p.named_variables) AS named WHERE named.value ILIKE $%[1]d))", argID)
+		args = append(args, "%"+escapeLike(val.(string))+"%")
+		argID++
+	}
+
+	return query, args, argID
+}
+
+// Update updates the title, notes, tags, pinned state and last use time of a prompt.
+func (r *promptRepository) Update(ctx context.Context, prompt *models.Prompt) error {
+	zap.S().Infof("PromptRepository.Update: id=%s", prompt.ID)
+	query := `
+		UPDATE prompts
+		SET title = $1, notes = $2, tags = $3, pinned = $4, last_used_at = $5
+		WHERE id = $6`
+	_, err := r.db.ExecContext(ctx, query,
+		prompt.Title, prompt.Notes, pq.Array(prompt.Tags), prompt.Pinned, prompt.LastUsedAt, prompt.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update prompt: %w", err)
+	}
+	return nil
+}
+
 // Delete removes a prompt from the database.
 func (r *promptRepository) Delete(ctx context.Context, id string) error {
 	zap.S().Infof("PromptRepository.Delete: id=%s", id)
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ErrReviewNotFound is returned when a user has not reviewed a template.
+var ErrReviewNotFound = errors.New("review not found")
+
+// The rating score of a template is the Bayesian average of its ratings: the
+// mean of its reviews and of ratingPriorWeight imaginary reviews rating it
+// ratingPriorMean. A handful of enthusiastic reviews thus ranks below many good
+// ones. Templates without reviews score 0 so that they sort last.
+const (
+	ratingPriorMean   = 3.0
+	ratingPriorWeight = 5
+)
+
+// ReviewRepository defines the interface for template review data access.
+type ReviewRepository interface {
+	Upsert(ctx context.Context, review *models.Review) error
+	Delete(ctx context.Context, templateID, userID string) error
+	List(ctx context.Context, templateID string, excludeStale bool, limit int, after *Cursor) ([]*models.Review, error)
+}
+
+// reviewRepository implements ReviewRepository.
+type reviewRepository struct {
+	db *sql.DB
+}
+
+// NewReviewRepository creates a new instance of ReviewRepository.
+func NewReviewRepository(db *sql.DB) ReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+// Upsert stores a user's review of a template, replacing their previous one,
+// and refreshes the ratings of the template.
+func (r *reviewRepository) Upsert(ctx context.Context, review *models.Review) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		INSERT INTO template_reviews (template_id, user_id, rating, body, version)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (template_id, user_id) DO UPDATE
+		SET rating = EXCLUDED.rating, body = EXCLUDED.body, version = EXCLUDED.version, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRowContext(ctx, query, review.TemplateID, review.UserID, review.Rating, review.Body, review.Version).
+		Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save review: %w", err)
+	}
+	if err := refreshRatings(ctx, tx, review.TemplateID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a user's review of a template and refreshes the ratings of the template.
+func (r *reviewRepository) Delete(ctx context.Context, templateID, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM template_reviews WHERE template_id = $1 AND user_id = $2`, templateID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrReviewNotFound
+	}
+	if err := refreshRatings(ctx, tx, templateID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// refreshRatings recomputes the rating count, average and score of a template from its reviews.
+func refreshRatings(ctx context.Context, tx *sql.Tx, templateID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE templates t
+		SET rating_count = s.n,
+			rating_average = s.average,
+			rating_score = CASE WHEN s.n = 0 THEN 0 ELSE ($2::float8 * $3::float8 + s.total) / ($3::float8 + s.n) END
+		FROM (
+			SELECT COUNT(*) AS n, COALESCE(AVG(rating), 0) AS average, COALESCE(SUM(rating), 0) AS total
+			FROM template_reviews
+			WHERE template_id = $1
+		) s
+		WHERE t.id = $1
+	`, templateID, ratingPriorMean, ratingPriorWeight)
+	if err != nil {
+		return fmt.Errorf("failed to refresh ratings: %w", err)
+	}
+	return notifyChange(ctx, tx, ChangeReaction, templateID)
+}
+
+// List retrieves a page of the reviews of a template, most recently updated first,
+// starting strictly after the given cursor when it is not nil. A review is stale
+// when a major version of the template came out after the version it was written against.
+func (r *reviewRepository) List(ctx context.Context, templateID string, excludeStale bool, limit int, after *Cursor) ([]*models.Review, error) {
+	query := `
+		SELECT r.id, r.template_id, r.user_id, r.rating, r.body, r.version, r.created_at, r.updated_at,
+			COALESCE(u.display_name, ''), s.stale
+		FROM template_reviews r
+		LEFT JOIN users u ON u.id = r.user_id
+		CROSS JOIN LATERAL (
+			SELECT EXISTS (
+				SELECT 1 FROM template_versions v
+				WHERE v.template_id = r.template_id AND v.major AND v.version > r.version
+			) AS stale
+		) s
+		WHERE r.template_id = $1
+	`
+	args := []interface{}{templateID}
+	if excludeStale {
+		query += " AND NOT s.stale"
+	}
+	if after != nil {
+		args = append(args, after.Key, after.ID)
+		query += fmt.Sprintf(" AND (r.updated_at, r.id) < ($%d::timestamptz, $%d::uuid)", len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY r.updated_at DESC, r.id DESC LIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var reviews []*models.Review
+	for rows.Next() {
+		var rev models.Review
+		if err := rows.Scan(
+			&rev.ID, &rev.TemplateID, &rev.UserID, &rev.Rating, &rev.Body, &rev.Version, &rev.CreatedAt, &rev.UpdatedAt,
+			&rev.UserName, &rev.Stale,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, &rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return reviews, nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// ErrUnknownUser is returned when granting access to a user that does not exist.
+var ErrUnknownUser = errors.New("user does not exist")
+
+// ShareRepository defines the interface for template grant and share link data access.
+type ShareRepository interface {
+	UpsertGrant(ctx context.Context, grant *models.TemplateGrant) error
+	DeleteGrant(ctx context.Context, templateID, userID string) error
+	GetGrant(ctx context.Context, templateID, userID string) (*models.TemplateGrant, error)
+	ListGrants(ctx context.Context, templateID string) ([]*models.TemplateGrant, error)
+	CreateLink(ctx context.Context, link *models.ShareLink) error
+	GetLinkByTokenHash(ctx context.Context, tokenHash string) (*models.ShareLink, error)
+	ListLinks(ctx context.Context, templateID string) ([]*models.ShareLink, error)
+	RevokeLink(ctx context.Context, templateID, linkID string) error
+}
+
+// shareRepository implements ShareRepository.
+type shareRepository struct {
+	db *sql.DB
+}
+
+// NewShareRepository creates a new instance of ShareRepository.
+func NewShareRepository(db *sql.DB) ShareRepository {
+	return &shareRepository{db: db}
+}
+
+// UpsertGrant creates a grant or changes the role of an existing one.
+func (r *shareRepository) UpsertGrant(ctx context.Context, g *models.TemplateGrant) error {
+	query := `
+		INSERT INTO template_grants (template_id, user_id, role, granted_by, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (template_id, user_id) DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by
+		RETURNING created_at
+	`
+	err := r.db.QueryRowContext(ctx, query, g.TemplateID, g.UserID, g.Role, g.GrantedBy, g.CreatedAt).Scan(&g.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "template_grants_user_id_fkey" {
+			return ErrUnknownUser
+		}
+		return fmt.Errorf("failed to grant access: %w", err)
+	}
+	return nil
+}
+
+// DeleteGrant removes a user's grant on a template.
+func (r *shareRepository) DeleteGrant(ctx context.Context, templateID, userID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM template_grants WHERE template_id = $1 AND user_id = $2`, templateID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke access: %w", err)
+	}
+	return nil
+}
+
+// GetGrant retrieves a user's grant on a template.
+// It returns nil without error when the user has no grant.
+func (r *shareRepository) GetGrant(ctx context.Context, templateID, userID string) (*models.TemplateGrant, error) {
+	query := `
+		SELECT template_id, user_id, role, granted_by, created_at
+		FROM template_grants
+		WHERE template_id = $1 AND user_id = $2
+	`
+	var g models.TemplateGrant
+	err := r.db.QueryRowContext(ctx, query, templateID, userID).Scan(&g.TemplateID, &g.UserID, &g.Role, &g.GrantedBy, &g.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get grant: %w", err)
+	}
+	return &g, nil
+}
+
+// ListGrants lists the grants of a template, oldest first.
+func (r *shareRepository) ListGrants(ctx context.Context, templateID string) ([]*models.TemplateGrant, error) {
+	query := `
+		SELECT template_id, user_id, role, granted_by, created_at
+		FROM template_grants
+		WHERE template_id = $1
+		ORDER BY created_at, user_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query grants: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var grants []*models.TemplateGrant
+	for rows.Next() {
+		var g models.TemplateGrant
+		if err := rows.Scan(&g.TemplateID, &g.UserID, &g.Role, &g.GrantedBy, &g.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan grant: %w", err)
+		}
+		grants = append(grants, &g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return grants, nil
+}
+
+// CreateLink inserts a new share link.
+func (r *shareRepository) CreateLink(ctx context.Context, l *models.ShareLink) error {
+	query := `
+		INSERT INTO template_share_links (template_id, token_hash, created_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	err := r.db.QueryRowContext(ctx, query, l.TemplateID, l.TokenHash, l.CreatedBy, l.ExpiresAt, l.CreatedAt).Scan(&l.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+	return nil
+}
+
+const shareLinkColumns = `id, template_id, token_hash, created_by, expires_at, revoked_at, created_at`
+
+func scanShareLink(row interface{ Scan(...interface{}) error }) (*models.ShareLink, error) {
+	var l models.ShareLink
+	if err := row.Scan(&l.ID, &l.TemplateID, &l.TokenHash, &l.CreatedBy, &l.ExpiresAt, &l.RevokedAt, &l.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// GetLinkByTokenHash retrieves the share link with the given token hash.
+func (r *shareRepository) GetLinkByTokenHash(ctx context.Context, tokenHash string) (*models.ShareLink, error) {
+	query := `SELECT ` + shareLinkColumns + ` FROM template_share_links WHERE token_hash = $1`
+	l, err := scanShareLink(r.db.QueryRowContext(ctx, query, tokenHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("share link not found")
+		}
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+	return l, nil
+}
+
+// ListLinks lists the share links of a template, newest first.
+func (r *shareRepository) ListLinks(ctx context.Context, templateID string) ([]*models.ShareLink, error) {
+	query := `SELECT ` + shareLinkColumns + ` FROM template_share_links WHERE template_id = $1 ORDER BY created_at DESC, id DESC`
+	rows, err := r.db.QueryContext(ctx, query, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query share links: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var links []*models.ShareLink
+	for rows.Next() {
+		l, err := scanShareLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return links, nil
+}
+
+// RevokeLink revokes a share link of a template. Revoking twice keeps the first revocation time.
+func (r *shareRepository) RevokeLink(ctx context.Context, templateID, linkID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE template_share_links SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1 AND template_id = $2
+	`, linkID, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("share link not found")
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"awsome-prompt/backend/internal/models"
 
@@ -12,7 +14,8 @@ import (
 
 // TemplateRepository defines the interface for template data access.
 type TemplateRepository interface {
-	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*models.Template, error)
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Template, error)
+	Count(ctx context.Context, filters map[string]interface{}) (int64, error)
 	Create(ctx context.Context, template *models.Template) error
 	Update(ctx context.Context, template *models.Template) error
 	Delete(ctx context.Context, id string) error
@@ -21,6 +24,10 @@ type TemplateRepository interface {
 	ListTags(ctx context.Context, filters map[string]interface{}) ([]*models.TagStat, error)
 	ToggleLike(ctx context.Context, userID, templateID string) (bool, int32, error)
 	ToggleFavorite(ctx context.Context, userID, templateID string) (bool, int32, error)
+	RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) (int64, error)
+	TransferOwnership(ctx context.Context, id, newOwnerID string) error
+	SetFeatured(ctx context.Context, id string, featured bool) error
+	SetModerationState(ctx context.Context, id, state string) error
 }
 
 // templateRepository implements TemplateRepository.
@@ -37,17 +44,18 @@ func NewTemplateRepository(db *sql.DB) TemplateRepository {
 func (r *templateRepository) Create(ctx context.Context, t *models.Template) error {
 	query := `
 		INSERT INTO templates (
-			owner_id, title, description, visibility, type, tags, category, language, created_at, updated_at
+			owner_id, title, description, visibility, type, tags, category, language, forked_from, org_id, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
-		) RETURNING id
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		) RETURNING id, moderation_state
 	`
 	err := r.db.QueryRowContext(ctx, query,
-		t.OwnerID, t.Title, t.Description, t.Visibility, t.Type, pq.Array(t.Tags), t.Category, t.Language, t.CreatedAt, t.UpdatedAt,
-	).Scan(&t.ID)
+		t.OwnerID, t.Title, t.Description, t.Visibility, t.Type, pq.Array(t.Tags), t.Category, t.Language, t.ForkedFrom, t.OrgID, t.CreatedAt, t.UpdatedAt,
+	).Scan(&t.ID, &t.ModerationState)
 	if err != nil {
 		return fmt.Errorf("failed to create template: %w", err)
 	}
+	notifyCommitted(ctx, r.db, ChangeTemplate, t.ID)
 	return nil
 }
 
@@ -64,6 +72,7 @@ func (r *templateRepository) Update(ctx context.Context, t *models.Template) err
 	if err != nil {
 		return fmt.Errorf("failed to update template: %w", err)
 	}
+	notifyCommitted(ctx, r.db, ChangeTemplate, t.ID)
 	return nil
 }
 
@@ -74,6 +83,70 @@ func (r *templateRepository) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return fmt.Errorf("failed to delete template: %w", err)
 	}
+	notifyCommitted(ctx, r.db, ChangeTemplate, id)
+	return nil
+}
+
+// TransferOwnership gives a template to another user, dropping that user's now redundant grant.
+func (r *templateRepository) TransferOwnership(ctx context.Context, id, newOwnerID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, `UPDATE templates SET owner_id = $2, updated_at = NOW() WHERE id = $1`, id, newOwnerID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return ErrUnknownUser
+		}
+		return fmt.Errorf("failed to transfer template: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("template not found")
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM template_grants WHERE template_id = $1 AND user_id = $2`, id, newOwnerID); err != nil {
+		return fmt.Errorf("failed to drop grant: %w", err)
+	}
+	if err := notifyChange(ctx, tx, ChangeTemplate, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// SetFeatured features a template or stops featuring it.
+// Featuring an already featured template keeps its original featured time.
+func (r *templateRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE templates SET featured_at = CASE WHEN $2 THEN COALESCE(featured_at, NOW()) END
+		WHERE id = $1
+	`, id, featured)
+	if err != nil {
+		return fmt.Errorf("failed to set featured: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("template not found")
+	}
+	notifyCommitted(ctx, r.db, ChangeTemplate, id)
+	return nil
+}
+
+// SetModerationState changes whether moderation hides or removes a template.
+func (r *templateRepository) SetModerationState(ctx context.Context, id, state string) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE templates SET moderation_state = $2 WHERE id = $1`, id, state)
+	if err != nil {
+		return fmt.Errorf("failed to set moderation state: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("template not found")
+	}
+	notifyCommitted(ctx, r.db, ChangeTemplate, id)
 	return nil
 }
 
@@ -82,7 +155,7 @@ func (r *templateRepository) Get(ctx context.Context, id string, currentUserID s
 	query := `
 		SELECT
 			t.id, t.owner_id, t.title, t.description, t.visibility, t.type, t.tags, t.category, t.language,
-			t.like_count, t.favorite_count, t.created_at, t.updated_at,
+			t.like_count, t.favorite_count, t.trending_score, t.forked_from, t.org_id, t.featured_at, t.moderation_state, t.comment_count, t.rating_count, t.rating_average, t.rating_score, t.created_at, t.updated_at,
 			CASE WHEN tl.user_id IS NOT NULL THEN true ELSE false END as is_liked,
 			CASE WHEN tf.user_id IS NOT NULL THEN true ELSE false END as is_favorited
 		FROM templates t
@@ -93,7 +166,7 @@ func (r *templateRepository) Get(ctx context.Context, id string, currentUserID s
 	var t models.Template
 	err := r.db.QueryRowContext(ctx, query, id, currentUserID).Scan(
 		&t.ID, &t.OwnerID, &t.Title, &t.Description, &t.Visibility, &t.Type,
-		&t.Tags, &t.Category, &t.Language, &t.LikeCount, &t.FavoriteCount, &t.CreatedAt, &t.UpdatedAt,
+		&t.Tags, &t.Category, &t.Language, &t.LikeCount, &t.FavoriteCount, &t.TrendingScore, &t.ForkedFrom, &t.OrgID, &t.FeaturedAt, &t.ModerationState, &t.CommentCount, &t.RatingCount, &t.RatingAverage, &t.RatingScore, &t.CreatedAt, &t.UpdatedAt,
 		&t.IsLiked, &t.IsFavorited,
 	)
 	if err != nil {
@@ -105,27 +178,125 @@ func (r *templateRepository) Get(ctx context.Context, id string, currentUserID s
 	return &t, nil
 }
 
-// List retrieves a list of templates based on filters and pagination.
-func (r *templateRepository) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*models.Template, error) {
-	currentUserID := ""
-	if val, ok := filters["current_user_id"]; ok {
-		currentUserID = val.(string)
-	}
-
+// List retrieves a page of templates based on filters.
+// Results are ordered by the sort filter (newest first by default) with the ID
+// as tie-breaker, and start strictly after the given cursor when it is not nil.
+func (r *templateRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.Template, error) {
 	query := `
 		SELECT
 			t.id, t.owner_id, t.title, t.description, t.visibility, t.type, t.tags, t.category, t.language,
-			t.like_count, t.favorite_count, t.created_at, t.updated_at,
+			t.like_count, t.favorite_count, t.trending_score, t.forked_from, t.org_id, t.featured_at, t.moderation_state, t.comment_count, t.rating_count, t.rating_average, t.rating_score, t.created_at, t.updated_at,
 			CASE WHEN tl.user_id IS NOT NULL THEN true ELSE false END as is_liked,
-			CASE WHEN tf.user_id IS NOT NULL THEN true ELSE false END as is_favorited
+			CASE WHEN tf.user_id IS NOT NULL THEN true ELSE false END as is_favorited,
+			COALESCE(ci.position, 0)
 		FROM templates t
 		LEFT JOIN template_likes tl ON t.id = tl.template_id AND tl.user_id = $1
 		LEFT JOIN template_favorites tf ON t.id = tf.template_id AND tf.user_id = $1
+		LEFT JOIN collection_items ci ON t.id = ci.template_id AND ci.collection_id = $2::uuid
 		WHERE 1=1
 	`
-	args := []interface{}{currentUserID}
-	argID := 2
+	where, args, argID := templateListConditions(filters, templateListJoinArgs(filters), 3)
+	query += where
+
+	// Collections are listed in their own ascending order, everything else newest
+	// (or most trending, best rated or most liked) first.
+	sortKey := "t.created_at"
+	keyType := "timestamptz"
+	direction, cmp := "DESC", "<"
+	switch filters["sort"] {
+	case "trending":
+		sortKey = "t.trending_score"
+		keyType = "float8"
+	case "rating":
+		sortKey = "t.rating_score"
+		keyType = "float8"
+	case "likes":
+		sortKey = "t.like_count"
+		keyType = "int"
+	case "featured":
+		sortKey = "t.featured_at"
+	case "position":
+		sortKey = "ci.position"
+		keyType = "int"
+		direction, cmp = "ASC", ">"
+	}
+
+	if after != nil {
+		query += fmt.Sprintf(" AND (%s, t.id) %s ($%d::%s, $%d::uuid)", sortKey, cmp, argID, keyType, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+
+	query += fmt.Sprintf(" ORDER BY %[1]s %[2]s, t.id %[2]s LIMIT $%[3]d", sortKey, direction, argID)
+	args = append(args, limit)
 
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query templates: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var templates []*models.Template
+	for rows.Next() {
+		var t models.Template
+		if err := rows.Scan(
+			&t.ID, &t.OwnerID, &t.Title, &t.Description, &t.Visibility, &t.Type,
+			&t.Tags, &t.Category, &t.Language, &t.LikeCount, &t.FavoriteCount, &t.TrendingScore, &t.ForkedFrom, &t.OrgID, &t.FeaturedAt, &t.ModerationState, &t.CommentCount, &t.RatingCount, &t.RatingAverage, &t.RatingScore, &t.CreatedAt, &t.UpdatedAt,
+			&t.IsLiked, &t.IsFavorited, &t.CollectionPosition,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return templates, nil
+}
+
+// Count returns the number of templates matching the filters.
+func (r *templateRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM templates t
+		LEFT JOIN template_likes tl ON t.id = tl.template_id AND tl.user_id = $1
+		LEFT JOIN template_favorites tf ON t.id = tf.template_id AND tf.user_id = $1
+		LEFT JOIN collection_items ci ON t.id = ci.template_id AND ci.collection_id = $2::uuid
+		WHERE 1=1
+	`
+	where, args, _ := templateListConditions(filters, templateListJoinArgs(filters), 3)
+	query += where
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count templates: %w", err)
+	}
+	return count, nil
+}
+
+// templateListJoinArgs returns the arguments of the joins shared by List and Count:
+// the current user ($1) and the listed collection ($2, NULL when not listing one).
+func templateListJoinArgs(filters map[string]interface{}) []interface{} {
+	currentUserID := ""
+	if val, ok := filters["current_user_id"]; ok {
+		currentUserID = val.(string)
+	}
+	var collectionID interface{}
+	if val, ok := filters["collection_id"]; ok && val != "" {
+		collectionID = val
+	}
+	return []interface{}{currentUserID, collectionID}
+}
+
+// templateListConditions builds the WHERE conditions shared by List and Count.
+// It appends to args and returns the next free placeholder index.
+// Templates under moderation are left out, except hidden ones for their owner (the current user, $1).
+func templateListConditions(filters map[string]interface{}, args []interface{}, argID int) (string, []interface{}, int) {
+	query := " AND (t.moderation_state = 'visible' OR (t.moderation_state = 'hidden' AND t.owner_id = $1))"
 	if val, ok := filters["visibility"]; ok && val != "" {
 		query += fmt.Sprintf(" AND t.visibility = $%d", argID)
 		args = append(args, val)
@@ -160,67 +331,101 @@ func (r *templateRepository) List(ctx context.Context, limit, offset int, filter
 	if val, ok := filters["my_favorites"]; ok && val.(bool) {
 		query += " AND tf.user_id IS NOT NULL"
 	}
-
-	query += fmt.Sprintf(" ORDER BY t.created_at DESC LIMIT $%d OFFSET $%d", argID, argID+1)
-	args = append(args, limit, offset)
-
-	// fmt.Printf("List Query: %s, Args: %v\n", query, args)
-
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query templates: %w", err)
+	if val, ok := filters["min_rating"]; ok {
+		query += fmt.Sprintf(" AND t.rating_count > 0 AND t.rating_average >= $%d", argID)
+		args = append(args, val)
+		argID++
 	}
-	defer func() {
-		_ = rows.Close()
-	}()
-
-	var templates []*models.Template
-	for rows.Next() {
-		var t models.Template
-		if err := rows.Scan(
-			&t.ID, &t.OwnerID, &t.Title, &t.Description, &t.Visibility, &t.Type,
-			&t.Tags, &t.Category, &t.Language, &t.LikeCount, &t.FavoriteCount, &t.CreatedAt, &t.UpdatedAt,
-			&t.IsLiked, &t.IsFavorited,
-		); err != nil {
-			return nil, fmt.Errorf("failed to scan template: %w", err)
-		}
-		templates = append(templates, &t)
+	if val, ok := filters["trending_only"]; ok && val.(bool) {
+		query += " AND t.trending_score > 0"
 	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %w", err)
+	if val, ok := filters["featured"]; ok && val.(bool) {
+		query += " AND t.featured_at IS NOT NULL"
+	}
+	if val, ok := filters["collection_id"]; ok && val != "" {
+		query += " AND ci.collection_id IS NOT NULL"
 	}
+	if val, ok := filters["org_id"]; ok && val != "" {
+		query += fmt.Sprintf(" AND t.org_id = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	// member_of restricts the results to the templates of the organizations of the given user.
+	if val, ok := filters["member_of"]; ok && val != "" {
+		query += fmt.Sprintf(" AND t.org_id IN (SELECT m.org_id FROM organization_members m WHERE m.user_id = $%d)", argID)
+		args = append(args, val)
+		argID++
+	}
+	// visible_to restricts the results to the templates the given user can see.
+	if val, ok := filters["visible_to"]; ok {
+		query += visibleToCondition(argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["shared_with"]; ok && val != "" {
+		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM template_grants g WHERE g.template_id = t.id AND g.user_id = $%d)", argID)
+		args = append(args, val)
+		argID++
+	}
+	return query, args, argID
+}
 
-	return templates, nil
+// visibleToCondition returns the condition matching the templates the user in
+// placeholder argID can see: public ones, their own, those shared with them and
+// those visible to the members of their organizations.
+func visibleToCondition(argID int) string {
+	return fmt.Sprintf(` AND (t.visibility = 'public' OR t.owner_id = $%[1]d
+		OR EXISTS (SELECT 1 FROM template_grants g WHERE g.template_id = t.id AND g.user_id = $%[1]d)
+		OR (t.visibility = 'org' AND EXISTS (
+			SELECT 1 FROM organization_members m WHERE m.org_id = t.org_id AND m.user_id = $%[1]d)))`, argID)
 }
 
-// ListCategories retrieves all categories and their template counts.
-func (r *templateRepository) ListCategories(ctx context.Context, filters map[string]interface{}) ([]*models.CategoryStat, error) {
-	query := `
-		SELECT category, COUNT(*) as count
-		FROM templates
-		WHERE category IS NOT NULL AND category != ''
-	`
+// templateStatsConditions builds the WHERE conditions shared by ListCategories and ListTags.
+// Only visible templates are counted.
+func templateStatsConditions(filters map[string]interface{}) (string, []interface{}) {
+	query := " AND t.moderation_state = 'visible'"
 	var args []interface{}
 	argID := 1
 
 	if val, ok := filters["visibility"]; ok && val != "" {
-		query += fmt.Sprintf(" AND visibility = $%d", argID)
+		query += fmt.Sprintf(" AND t.visibility = $%d", argID)
 		args = append(args, val)
 		argID++
 	}
 	if val, ok := filters["owner_id"]; ok && val != "" {
-		query += fmt.Sprintf(" AND owner_id = $%d", argID)
+		query += fmt.Sprintf(" AND t.owner_id = $%d", argID)
 		args = append(args, val)
 		argID++
 	}
 	if val, ok := filters["language"]; ok && val != "" {
-		query += fmt.Sprintf(" AND language = $%d", argID)
+		query += fmt.Sprintf(" AND t.language = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["org_id"]; ok && val != "" {
+		query += fmt.Sprintf(" AND t.org_id = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["visible_to"]; ok {
+		query += visibleToCondition(argID)
 		args = append(args, val)
 	}
+	return query, args
+}
+
+// ListCategories retrieves all categories and their template counts.
+func (r *templateRepository) ListCategories(ctx context.Context, filters map[string]interface{}) ([]*models.CategoryStat, error) {
+	query := `
+		SELECT t.category, COUNT(*) as count
+		FROM templates t
+		WHERE t.category IS NOT NULL AND t.category != ''
+	`
+	where, args := templateStatsConditions(filters)
+	query += where
 
 	query += `
-		GROUP BY category
+		GROUP BY t.category
 		ORDER BY count DESC
 	`
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -242,38 +447,16 @@ func (r *templateRepository) ListCategories(ctx context.Context, filters map[str
 
 // ListTags retrieves all tags and their template counts.
 func (r *templateRepository) ListTags(ctx context.Context, filters map[string]interface{}) ([]*models.TagStat, error) {
-	var args []interface{}
-	argID := 1
-
-	// Note: unnesting happens in select, filtering needs to happen on the row before or after?
-	// If we filter templates first, then unnest, we count tags of visible templates.
-	// Correct approach:
-	// SELECT t.tag, COUNT(*) FROM (SELECT unnest(tags) as tag FROM templates WHERE ...) t GROUP BY t.tag
-
-	// Re-writing query for safety with filters
-	whereClause := ""
-	if val, ok := filters["visibility"]; ok && val != "" {
-		whereClause += fmt.Sprintf(" AND visibility = $%d", argID)
-		args = append(args, val)
-		argID++
-	}
-	if val, ok := filters["owner_id"]; ok && val != "" {
-		whereClause += fmt.Sprintf(" AND owner_id = $%d", argID)
-		args = append(args, val)
-		argID++ // Ensure argID is incremented
-	}
-	if val, ok := filters["language"]; ok && val != "" {
-		whereClause += fmt.Sprintf(" AND language = $%d", argID)
-		args = append(args, val)
-	}
+	// Filter the templates first, then unnest, so that only tags of matching templates are counted.
+	whereClause, args := templateStatsConditions(filters)
 
 	query := fmt.Sprintf(`
 		SELECT tag, COUNT(*) as count
 		FROM (
-			SELECT unnest(tags) as tag
-			FROM templates
+			SELECT unnest(t.tags) as tag
+			FROM templates t
 			WHERE 1=1 %s
-		) as t
+		) as tt
 		GROUP BY tag
 		ORDER BY count DESC
 	`, whereClause)
@@ -329,6 +512,10 @@ func (r *templateRepository) ToggleLike(ctx context.Context, userID, templateID
 		return false, 0, err
 	}
 
+	if err := notifyChange(ctx, tx, ChangeReaction, templateID); err != nil {
+		return false, 0, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return false, 0, err
 	}
@@ -370,9 +557,95 @@ func (r *templateRepository) ToggleFavorite(ctx context.Context, userID, templat
 		return false, 0, err
 	}
 
+	if err := notifyChange(ctx, tx, ChangeReaction, templateID); err != nil {
+		return false, 0, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return false, 0, err
 	}
 
 	return !exists, count, nil
 }
+
+// Weights of the events that feed the trending score.
+const (
+	trendingWeightLike     = 1.0
+	trendingWeightFavorite = 2.0
+	trendingWeightFork     = 3.0
+	trendingWeightPrompt   = 0.5
+)
+
+// trendingLockKey is the advisory lock held while trending scores are
+// refreshed, so that a single instance refreshes them at a time.
+const trendingLockKey = 0x7472656e64 // "trend"
+
+// ErrTrendingRefreshInProgress is returned by RefreshTrendingScores when
+// another instance holds the lock.
+var ErrTrendingRefreshInProgress = errors.New("trending scores are being refreshed by another instance")
+
+// RefreshTrendingScores recomputes the trending score of every template.
+// Each like, favorite, fork and prompt created within the window contributes its
+// weight, halved for every halfLife that has elapsed since the event.
+// It returns the number of templates whose score changed, or
+// ErrTrendingRefreshInProgress when another instance is refreshing them.
+func (r *templateRepository) RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) (int64, error) {
+	query := fmt.Sprintf(`
+		WITH events AS (
+			SELECT template_id, created_at, %[1]f AS weight
+			FROM template_likes WHERE created_at > NOW() - $2::float8 * INTERVAL '1 second'
+			UNION ALL
+			SELECT template_id, created_at, %[2]f
+			FROM template_favorites WHERE created_at > NOW() - $2::float8 * INTERVAL '1 second'
+			UNION ALL
+			SELECT forked_from, created_at, %[3]f
+			FROM templates WHERE forked_from IS NOT NULL AND created_at > NOW() - $2::float8 * INTERVAL '1 second'
+			UNION ALL
+			SELECT template_id, created_at, %[4]f
+			FROM prompts WHERE created_at > NOW() - $2::float8 * INTERVAL '1 second'
+		),
+		scores AS (
+			SELECT template_id, SUM(weight * POWER(0.5, EXTRACT(EPOCH FROM NOW() - created_at) / $1::float8)) AS score
+			FROM events
+			GROUP BY template_id
+		)
+		UPDATE templates t
+		SET trending_score = COALESCE(s.score, 0)
+		FROM templates t2
+		LEFT JOIN scores s ON s.template_id = t2.id
+		WHERE t.id = t2.id AND t.trending_score IS DISTINCT FROM COALESCE(s.score, 0)
+	`, trendingWeightLike, trendingWeightFavorite, trendingWeightFork, trendingWeightPrompt)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The lock is released with the transaction, even if the instance dies.
+	var locked bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", trendingLockKey).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("failed to lock trending scores: %w", err)
+	}
+	if !locked {
+		return 0, ErrTrendingRefreshInProgress
+	}
+
+	result, err := tx.ExecContext(ctx, query, halfLife.Seconds(), window.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh trending scores: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if n > 0 {
+		if err := notifyChange(ctx, tx, ChangeTrending, ""); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit trending scores: %w", err)
+	}
+	return n, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 
 	"awsome-prompt/backend/internal/models"
@@ -13,8 +14,11 @@ import (
 // TemplateVersionRepository defines the interface for template version data access.
 type TemplateVersionRepository interface {
 	Create(ctx context.Context, version *models.TemplateVersion) error
+	Get(ctx context.Context, templateID string, id int32) (*models.TemplateVersion, error)
 	GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error)
-	List(ctx context.Context, limit, offset int, templateID string) ([]*models.TemplateVersion, error)
+	GetLatestForTemplates(ctx context.Context, templateIDs []string) (map[string]*models.TemplateVersion, error)
+	List(ctx context.Context, limit int, after *Cursor, templateID string) ([]*models.TemplateVersion, error)
+	Count(ctx context.Context, templateID string) (int64, error)
 }
 
 type templateVersionRepository struct {
@@ -29,26 +33,48 @@ func NewTemplateVersionRepository(db *sql.DB) TemplateVersionRepository {
 // Create inserts a new template version into the database.
 func (r *templateVersionRepository) Create(ctx context.Context, v *models.TemplateVersion) error {
 	zap.S().Infof("TemplateVersionRepository.Create: templateID=%s version=%s", v.TemplateID, v.Version)
+	if v.OverridableVariables == nil {
+		v.OverridableVariables = pq.StringArray{}
+	}
 	query := `
-		INSERT INTO template_versions (template_id, version, content, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO template_versions (template_id, version, content, major, overridable_variables, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id
 	`
 	err := r.db.QueryRowContext(ctx, query,
-		v.TemplateID, v.Version, v.Content, v.CreatedAt,
+		v.TemplateID, v.Version, v.Content, v.Major, v.OverridableVariables, v.CreatedAt,
 	).Scan(&v.ID)
 
 	if err != nil {
 		return fmt.Errorf("failed to create template version: %w", err)
 	}
+	notifyCommitted(ctx, r.db, ChangeVersion, v.TemplateID)
 	return nil
 }
 
+// Get retrieves a version of a template by its ID.
+func (r *templateVersionRepository) Get(ctx context.Context, templateID string, id int32) (*models.TemplateVersion, error) {
+	zap.S().Infof("TemplateVersionRepository.Get: templateID=%s id=%d", templateID, id)
+	query := `
+		SELECT id, template_id, version, content, major, overridable_variables, created_at
+		FROM template_versions
+		WHERE template_id = $1 AND id = $2
+	`
+	var v models.TemplateVersion
+	err := r.db.QueryRowContext(ctx, query, templateID, id).Scan(
+		&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.Major, &v.OverridableVariables, &v.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version: %w", err)
+	}
+	return &v, nil
+}
+
 // GetLatest retrieves the latest version of a template.
 func (r *templateVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
 	zap.S().Infof("TemplateVersionRepository.GetLatest: templateID=%s", templateID)
 	query := `
-		SELECT id, template_id, version, content, created_at
+		SELECT id, template_id, version, content, major, overridable_variables, created_at
 		FROM template_versions
 		WHERE template_id = $1
 		ORDER BY version DESC
@@ -56,7 +82,7 @@ func (r *templateVersionRepository) GetLatest(ctx context.Context, templateID st
 	`
 	var v models.TemplateVersion
 	err := r.db.QueryRowContext(ctx, query, templateID).Scan(
-		&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.CreatedAt,
+		&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.Major, &v.OverridableVariables, &v.CreatedAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latest version: %w", err)
@@ -64,17 +90,63 @@ func (r *templateVersionRepository) GetLatest(ctx context.Context, templateID st
 	return &v, nil
 }
 
-// List retrieves all versions for a template.
-func (r *templateVersionRepository) List(ctx context.Context, limit, offset int, templateID string) ([]*models.TemplateVersion, error) {
-	zap.S().Infof("TemplateVersionRepository.List: templateID=%s limit=%d offset=%d", templateID, limit, offset)
+// GetLatestForTemplates retrieves the latest version of each of the given templates
+// in a single query. Templates without versions are absent from the result.
+func (r *templateVersionRepository) GetLatestForTemplates(ctx context.Context, templateIDs []string) (map[string]*models.TemplateVersion, error) {
+	zap.S().Infof("TemplateVersionRepository.GetLatestForTemplates: count=%d", len(templateIDs))
+	versions := make(map[string]*models.TemplateVersion, len(templateIDs))
+	if len(templateIDs) == 0 {
+		return versions, nil
+	}
+
 	query := `
-		SELECT id, template_id, version, content, created_at
+		SELECT DISTINCT ON (template_id) id, template_id, version, content, major, overridable_variables, created_at
+		FROM template_versions
+		WHERE template_id = ANY($1::uuid[])
+		ORDER BY template_id, version DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(templateIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest versions: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var v models.TemplateVersion
+		if err := rows.Scan(
+			&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.Major, &v.OverridableVariables, &v.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan template version: %w", err)
+		}
+		versions[v.TemplateID] = &v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return versions, nil
+}
+
+// List retrieves a page of versions for a template, newest version first,
+// starting strictly after the given cursor when it is not nil.
+func (r *templateVersionRepository) List(ctx context.Context, limit int, after *Cursor, templateID string) ([]*models.TemplateVersion, error) {
+	zap.S().Infof("TemplateVersionRepository.List: templateID=%s limit=%d after=%v", templateID, limit, after)
+	query := `
+		SELECT id, template_id, version, content, major, overridable_variables, created_at
 		FROM template_versions
 		WHERE template_id = $1
-		ORDER BY version DESC
-		LIMIT $2 OFFSET $3
 	`
-	rows, err := r.db.QueryContext(ctx, query, templateID, limit, offset)
+	args := []interface{}{templateID}
+	if after != nil {
+		query += " AND (version, id) < ($2::int, $3::int)"
+		args = append(args, after.Key, after.ID)
+	}
+	query += fmt.Sprintf(" ORDER BY version DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list template versions: %w", err)
 	}
@@ -86,7 +158,7 @@ func (r *templateVersionRepository) List(ctx context.Context, limit, offset int,
 	for rows.Next() {
 		var v models.TemplateVersion
 		if err := rows.Scan(
-			&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.CreatedAt,
+			&v.ID, &v.TemplateID, &v.Version, &v.Content, &v.Major, &v.OverridableVariables, &v.CreatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan template version: %w", err)
 		}
@@ -98,3 +170,13 @@ func (r *templateVersionRepository) List(ctx context.Context, limit, offset int,
 
 	return versions, nil
 }
+
+// Count returns the number of versions of a template.
+func (r *templateVersionRepository) Count(ctx context.Context, templateID string) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM template_versions WHERE template_id = $1`, templateID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count template versions: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// UsageRepository defines the interface for template usage statistics.
+type UsageRepository interface {
+	Record(ctx context.Context, e *models.UsageEvent) error
+	Series(ctx context.Context, templateID string, version int32, from, to time.Time) ([]*models.UsageBucket, error)
+}
+
+// usageRepository implements UsageRepository.
+type usageRepository struct {
+	db *sql.DB
+}
+
+// NewUsageRepository creates a new instance of UsageRepository.
+func NewUsageRepository(db *sql.DB) UsageRepository {
+	return &usageRepository{db: db}
+}
+
+// Record counts an event in the bucket of its template, version, UTC day and kind.
+// When the event only carries a version row ID, the version number is looked up.
+func (r *usageRepository) Record(ctx context.Context, e *models.UsageEvent) error {
+	query := `
+		INSERT INTO template_usage_daily (template_id, version, day, event, count)
+		VALUES ($1, COALESCE(NULLIF($2::int, 0), (SELECT version FROM template_versions WHERE id = $3 AND template_id = $1), 0),
+			($4::timestamptz AT TIME ZONE 'UTC')::date, $5, 1)
+		ON CONFLICT (template_id, day, version, event) DO UPDATE SET count = template_usage_daily.count + 1
+	`
+	if _, err := r.db.ExecContext(ctx, query, e.TemplateID, e.Version, e.VersionID, e.At, e.Event); err != nil {
+		return fmt.Errorf("failed to record usage: %w", err)
+	}
+	return nil
+}
+
+// Series returns the non-empty daily buckets of a template between two UTC days
+// included, oldest first. Versions are summed up unless version is not 0.
+func (r *usageRepository) Series(ctx context.Context, templateID string, version int32, from, to time.Time) ([]*models.UsageBucket, error) {
+	query := `
+		SELECT day, event, SUM(count)
+		FROM template_usage_daily
+		WHERE template_id = $1 AND day BETWEEN $2::date AND $3::date AND ($4::int = 0 OR version = $4)
+		GROUP BY day, event
+		ORDER BY day, event
+	`
+	rows, err := r.db.QueryContext(ctx, query, templateID, from.Format(time.DateOnly), to.Format(time.DateOnly), version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usage: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var buckets []*models.UsageBucket
+	for rows.Next() {
+		var b models.UsageBucket
+		if err := rows.Scan(&b.Day, &b.Event, &b.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan usage: %w", err)
+		}
+		buckets = append(buckets, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return buckets, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -20,6 +22,9 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
 	GetByID(ctx context.Context, id string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
+	List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.User, error)
+	SetSuspended(ctx context.Context, id string, suspended bool) (*models.User, error)
+	GetAuthorStats(ctx context.Context, id string) (*models.AuthorStats, error)
 }
 
 type userRepository struct {
@@ -54,7 +59,7 @@ RETURNING created_at, updated_at`
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	zap.S().Infof("UserRepository.GetByEmail: email=%s", email)
 	query := `
-SELECT id, email, mobile, password_hash, display_name, COALESCE(avatar, ''), created_at, updated_at
+SELECT id, email, mobile, password_hash, display_name, COALESCE(avatar, ''), role, suspended_at, created_at, updated_at
 FROM users
 WHERE email = $1`
 
@@ -66,6 +71,8 @@ WHERE email = $1`
 		&user.PasswordHash,
 		&user.DisplayName,
 		&user.Avatar,
+		&user.Role,
+		&user.SuspendedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -83,7 +90,7 @@ WHERE email = $1`
 func (r *userRepository) GetByID(ctx context.Context, id string) (*models.User, error) {
 	zap.S().Infof("UserRepository.GetByID: id=%s", id)
 	query := `
-SELECT id, email, mobile, password_hash, display_name, COALESCE(avatar, ''), created_at, updated_at
+SELECT id, email, mobile, password_hash, display_name, COALESCE(avatar, ''), bio, role, suspended_at, follower_count, following_count, created_at, updated_at
 FROM users
 WHERE id = $1`
 
@@ -95,6 +102,11 @@ WHERE id = $1`
 		&user.PasswordHash,
 		&user.DisplayName,
 		&user.Avatar,
+		&user.Bio,
+		&user.Role,
+		&user.SuspendedAt,
+		&user.FollowerCount,
+		&user.FollowingCount,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -113,7 +125,7 @@ func (r *userRepository) Update(ctx context.Context, user *models.User) error {
 	zap.S().Infof("UserRepository.Update: id=%s", user.ID)
 	query := `
 UPDATE users
-SET email = $2, mobile = $3, password_hash = $4, display_name = $5, avatar = $6, updated_at = $7
+SET email = $2, mobile = $3, password_hash = $4, display_name = $5, avatar = $6, bio = $7, updated_at = $8
 WHERE id = $1`
 
 	args := []interface{}{
@@ -123,6 +135,7 @@ WHERE id = $1`
 		user.PasswordHash,
 		user.DisplayName,
 		user.Avatar,
+		user.Bio,
 		time.Now(),
 	}
 
@@ -142,3 +155,105 @@ WHERE id = $1`
 
 	return nil
 }
+
+// List retrieves a page of users, newest first, starting strictly after the given cursor when it is not nil.
+// The "query" filter matches the ID, email or display name; "role" and "suspended" narrow the results.
+func (r *userRepository) List(ctx context.Context, limit int, after *Cursor, filters map[string]interface{}) ([]*models.User, error) {
+	zap.S().Infof("UserRepository.List: limit=%d filters=%v", limit, filters)
+	query := `
+SELECT id, email, mobile, password_hash, display_name, COALESCE(avatar, ''), role, suspended_at, created_at, updated_at
+FROM users
+WHERE 1=1`
+	var args []interface{}
+	argID := 1
+	if val, ok := filters["query"]; ok && val != "" {
+		query += fmt.Sprintf(" AND (id ILIKE $%[1]d OR email ILIKE $%[1]d OR display_name ILIKE $%[1]d)", argID)
+		args = append(args, "%"+escapeLike(val.(string))+"%")
+		argID++
+	}
+	if val, ok := filters["role"]; ok && val != "" {
+		query += fmt.Sprintf(" AND role = $%d", argID)
+		args = append(args, val)
+		argID++
+	}
+	if val, ok := filters["suspended"]; ok && val.(bool) {
+		query += " AND suspended_at IS NOT NULL"
+	}
+	if after != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d::timestamptz, $%d)", argID, argID+1)
+		args = append(args, after.Key, after.ID)
+		argID += 2
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", argID)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.Mobile,
+			&user.PasswordHash,
+			&user.DisplayName,
+			&user.Avatar,
+			&user.Role,
+			&user.SuspendedAt,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return users, nil
+}
+
+// SetSuspended suspends or reinstates a user and returns the updated user.
+// Suspending an already suspended user keeps the original suspension time.
+func (r *userRepository) SetSuspended(ctx context.Context, id string, suspended bool) (*models.User, error) {
+	zap.S().Infof("UserRepository.SetSuspended: id=%s suspended=%t", id, suspended)
+	query := `
+UPDATE users
+SET suspended_at = CASE WHEN $2 THEN COALESCE(suspended_at, NOW()) END, updated_at = NOW()
+WHERE id = $1`
+	result, err := r.db.ExecContext(ctx, query, id, suspended)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update suspension: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil, ErrUserNotFound
+	}
+	return r.GetByID(ctx, id)
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// GetAuthorStats counts the public templates of a user that are not under
+// moderation, and the likes and favorites they received.
+func (r *userRepository) GetAuthorStats(ctx context.Context, id string) (*models.AuthorStats, error) {
+	query := `
+SELECT COUNT(*), COALESCE(SUM(like_count), 0), COALESCE(SUM(favorite_count), 0)
+FROM templates
+WHERE owner_id = $1 AND visibility = 'public' AND moderation_state = 'visible'`
+
+	var stats models.AuthorStats
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&stats.PublicTemplateCount, &stats.TotalLikes, &stats.TotalFavorites); err != nil {
+		return nil, fmt.Errorf("failed to get author stats: %w", err)
+	}
+	return &stats, nil
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// AdminService implements the administration RPCs. Every RPC requires the admin role.
+type AdminService struct {
+	pb.UnimplementedAdminServiceServer
+	UserRepo       repository.UserRepository
+	TemplateRepo   repository.TemplateRepository
+	ModerationRepo repository.ModerationRepository
+	EmailSvc       EmailService
+	PageTokens     *PageTokenCodec
+	Audit          *Auditor
+}
+
+func NewAdminService(
+	userRepo repository.UserRepository,
+	templateRepo repository.TemplateRepository,
+	moderationRepo repository.ModerationRepository,
+	emailSvc EmailService,
+	pageTokenSecret string,
+) *AdminService {
+	return &AdminService{
+		UserRepo:       userRepo,
+		TemplateRepo:   templateRepo,
+		ModerationRepo: moderationRepo,
+		EmailSvc:       emailSvc,
+		PageTokens:     NewPageTokenCodec(pageTokenSecret),
+	}
+}
+
+// ListUsers lists user accounts, newest first.
+func (s *AdminService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	zap.S().Infof("AdminService.ListUsers: page_size=%d page_token=%s query=%s role=%s suspended_only=%t", req.PageSize, req.PageToken, req.Query, req.Role, req.SuspendedOnly)
+	if err := authorize(policy.Administer(principal(ctx)), "user"); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+	filters := map[string]interface{}{}
+	if req.Query != "" {
+		filters["query"] = req.Query
+	}
+	if role := userRoleFromProto(req.Role); role != "" {
+		filters["role"] = role
+	}
+	if req.SuspendedOnly {
+		filters["suspended"] = true
+	}
+
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Fetch one extra row to find out whether there is a next page.
+	users, err := s.UserRepo.List(ctx, limit+1, after, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	nextToken := ""
+	if len(users) > limit {
+		users = users[:limit]
+		nextToken = s.PageTokens.Encode(repository.UserCursor(users[limit-1]), filters)
+	}
+
+	pbUsers := make([]*pb.User, len(users))
+	for i, u := range users {
+		pbUsers[i] = userModelToProto(u)
+	}
+	return &pb.ListUsersResponse{Users: pbUsers, NextPageToken: nextToken}, nil
+}
+
+// SuspendUser suspends a user, who can no longer sign in or call the API.
+func (s *AdminService) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	zap.S().Infof("AdminService.SuspendUser: user_id=%s", req.UserId)
+	p := principal(ctx)
+	if err := authorize(policy.Administer(p), "user"); err != nil {
+		return nil, err
+	}
+	if req.UserId == p.UserID {
+		return nil, status.Error(codes.InvalidArgument, "cannot suspend yourself")
+	}
+
+	user, err := s.UserRepo.SetSuspended(ctx, req.UserId, true)
+	if err != nil {
+		return nil, suspensionError(err)
+	}
+	s.Audit.Record(ctx, "user.suspend", user.ID, user.ID, nil, userAuditSummary(user))
+	return &pb.SuspendUserResponse{User: userModelToProto(user)}, nil
+}
+
+// ReinstateUser lifts the suspension of a user.
+func (s *AdminService) ReinstateUser(ctx context.Context, req *pb.ReinstateUserRequest) (*pb.ReinstateUserResponse, error) {
+	zap.S().Infof("AdminService.ReinstateUser: user_id=%s", req.UserId)
+	if err := authorize(policy.Administer(principal(ctx)), "user"); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.SetSuspended(ctx, req.UserId, false)
+	if err != nil {
+		return nil, suspensionError(err)
+	}
+	s.Audit.Record(ctx, "user.reinstate", user.ID, user.ID, nil, userAuditSummary(user))
+	return &pb.ReinstateUserResponse{User: userModelToProto(user)}, nil
+}
+
+func suspensionError(err error) error {
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	return status.Errorf(codes.Internal, "failed to update user: %v", err)
+}
+
+// TransferTemplateOwnership makes another user the owner of a template.
+// A grant the new owner had on the template is dropped.
+func (s *AdminService) TransferTemplateOwnership(ctx context.Context, req *pb.TransferTemplateOwnershipRequest) (*pb.TransferTemplateOwnershipResponse, error) {
+	zap.S().Infof("AdminService.TransferTemplateOwnership: template_id=%s new_owner_id=%s", req.TemplateId, req.NewOwnerId)
+	if err := authorize(policy.Administer(principal(ctx)), "template"); err != nil {
+		return nil, err
+	}
+	if req.NewOwnerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_owner_id is required")
+	}
+	previous, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+
+	if err := s.TemplateRepo.TransferOwnership(ctx, req.TemplateId, req.NewOwnerId); err != nil {
+		if errors.Is(err, repository.ErrUnknownUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer template: %v", err)
+	}
+
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reload template: %v", err)
+	}
+	// The event belongs to the previous owner, who loses sight of the template.
+	s.Audit.Record(ctx, "template.transfer", template.ID, previous.OwnerID, templateAuditSummary(previous), templateAuditSummary(template))
+	return &pb.TransferTemplateOwnershipResponse{Template: templateModelToProto(template)}, nil
+}
+
+// SetTemplateFeatured features a public template or stops featuring it.
+// Templates hidden or removed by moderation cannot be featured.
+func (s *AdminService) SetTemplateFeatured(ctx context.Context, req *pb.SetTemplateFeaturedRequest) (*pb.SetTemplateFeaturedResponse, error) {
+	zap.S().Infof("AdminService.SetTemplateFeatured: template_id=%s featured=%t", req.TemplateId, req.Featured)
+	if err := authorize(policy.Administer(principal(ctx)), "template"); err != nil {
+		return nil, err
+	}
+
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if req.Featured && template.Visibility != "public" {
+		return nil, status.Error(codes.FailedPrecondition, "only public templates can be featured")
+	}
+	if req.Featured && !moderationVisible(template) {
+		return nil, status.Error(codes.FailedPrecondition, "templates under moderation cannot be featured")
+	}
+
+	if err := s.TemplateRepo.SetFeatured(ctx, req.TemplateId, req.Featured); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set featured: %v", err)
+	}
+	before := templateAuditSummary(template)
+	template, err = s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reload template: %v", err)
+	}
+	s.Audit.Record(ctx, "template.set_featured", template.ID, template.OwnerID, before, templateAuditSummary(template))
+	return &pb.SetTemplateFeaturedResponse{Template: templateModelToProto(template)}, nil
+}
+
+// ListModerationQueue lists the templates with open reports, longest waiting first.
+func (s *AdminService) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueResponse, error) {
+	zap.S().Infof("AdminService.ListModerationQueue: page_size=%d page_token=%s", req.PageSize, req.PageToken)
+	if err := authorize(policy.Administer(principal(ctx)), "template"); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+	filters := map[string]interface{}{"queue": "moderation"}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Fetch one extra row to find out whether there is a next page.
+	items, err := s.ModerationRepo.ListQueue(ctx, limit+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list moderation queue: %v", err)
+	}
+	nextToken := ""
+	if len(items) > limit {
+		items = items[:limit]
+		nextToken = s.PageTokens.Encode(repository.ModerationQueueCursor(items[limit-1]), filters)
+	}
+
+	pbItems := make([]*pb.ModerationQueueItem, len(items))
+	for i, item := range items {
+		reports := make([]*pb.TemplateReport, len(item.Reports))
+		for j, r := range item.Reports {
+			reports[j] = reportModelToProto(r)
+		}
+		pbItems[i] = &pb.ModerationQueueItem{
+			Template:        templateModelToProto(item.Template),
+			Reports:         reports,
+			FirstReportedAt: timestamppb.New(item.FirstReportedAt),
+		}
+	}
+	return &pb.ListModerationQueueResponse{Items: pbItems, NextPageToken: nextToken}, nil
+}
+
+// ModerateTemplate resolves the open reports of a template. Dismissing makes the
+// template visible again, hiding leaves it to its owner only and removing takes
+// it away from its owner too, who is warned by email.
+func (s *AdminService) ModerateTemplate(ctx context.Context, req *pb.ModerateTemplateRequest) (*pb.ModerateTemplateResponse, error) {
+	zap.S().Infof("AdminService.ModerateTemplate: template_id=%s action=%s", req.TemplateId, req.Action)
+	p := principal(ctx)
+	if err := authorize(policy.Administer(p), "template"); err != nil {
+		return nil, err
+	}
+
+	var state, reportStatus string
+	switch req.Action {
+	case pb.ModerationAction_MODERATION_ACTION_DISMISS:
+		state, reportStatus = "visible", "dismissed"
+	case pb.ModerationAction_MODERATION_ACTION_HIDE:
+		state, reportStatus = "hidden", "actioned"
+	case pb.ModerationAction_MODERATION_ACTION_REMOVE:
+		state, reportStatus = "removed", "actioned"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "action is required")
+	}
+
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if err := s.TemplateRepo.SetModerationState(ctx, template.ID, state); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to moderate template: %v", err)
+	}
+	resolved, err := s.ModerationRepo.ResolveReports(ctx, template.ID, p.UserID, reportStatus)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve reports: %v", err)
+	}
+
+	// The template is removed either way; a warning that cannot be sent is only logged.
+	if state == "removed" && template.ModerationState != "removed" {
+		owner, err := s.UserRepo.GetByID(ctx, template.OwnerID)
+		if err == nil {
+			err = s.EmailSvc.SendModerationWarning(owner.Email, template.Title, req.Note, template.Language)
+		}
+		if err != nil {
+			zap.S().Warnf("AdminService.ModerateTemplate: failed to warn owner %s: %v", template.OwnerID, err)
+		}
+	}
+
+	before := templateAuditSummary(template)
+	template.ModerationState = state
+	after := templateAuditSummary(template)
+	after["note"] = req.Note
+	after["resolved_reports"] = resolved
+	s.Audit.Record(ctx, "template.moderate", template.ID, template.OwnerID, before, after)
+	return &pb.ModerateTemplateResponse{
+		Template:        templateModelToProto(template),
+		ResolvedReports: int32(resolved),
+	}, nil
+}
+
+func userRoleFromProto(r pb.UserRole) string {
+	switch r {
+	case pb.UserRole_USER_ROLE_USER:
+		return policy.RoleUser
+	case pb.UserRole_USER_ROLE_ADMIN:
+		return policy.RoleAdmin
+	}
+	return ""
+}
+
+func userModelToProto(m *models.User) *pb.User {
+	u := &pb.User{
+		Id:          m.ID,
+		Email:       m.Email,
+		DisplayName: m.DisplayName,
+		Role:        userRoleToProto(m.Role),
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+	if m.SuspendedAt.Valid {
+		u.SuspendedAt = timestamppb.New(m.SuspendedAt.Time)
+	}
+	return u
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func adminContext(userID string) context.Context {
+	return ContextWithPrincipal(context.Background(), policy.Principal{UserID: userID, Role: policy.RoleAdmin})
+}
+
+func TestAdminRequiresAdminRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{"Anonymous", context.Background(), codes.Unauthenticated},
+		{"User", ContextWithUserID(context.Background(), "bob"), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(MockUserRepository)
+			mockTemplateRepo := new(MockTemplateRepository)
+			svc := NewAdminService(mockUserRepo, mockTemplateRepo, nil, nil, "secret")
+
+			_, err := svc.ListUsers(tt.ctx, &pb.ListUsersRequest{})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			_, err = svc.SuspendUser(tt.ctx, &pb.SuspendUserRequest{UserId: "carol"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			_, err = svc.TransferTemplateOwnership(tt.ctx, &pb.TransferTemplateOwnershipRequest{TemplateId: "t1", NewOwnerId: "bob"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			_, err = svc.SetTemplateFeatured(tt.ctx, &pb.SetTemplateFeaturedRequest{TemplateId: "t1", Featured: true})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			mockUserRepo.AssertNotCalled(t, "SetSuspended", mock.Anything, mock.Anything, mock.Anything)
+			mockTemplateRepo.AssertNotCalled(t, "TransferOwnership", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	svc := NewAdminService(mockUserRepo, new(MockTemplateRepository), nil, nil, "secret")
+	ctx := adminContext("root")
+
+	now := time.Now()
+	filters := map[string]interface{}{"query": "ali", "role": "admin", "suspended": true}
+	users := []*models.User{
+		{ID: "u3", Email: "alice3@example.com", Role: "admin", CreatedAt: now},
+		{ID: "u2", Email: "alice2@example.com", Role: "admin", CreatedAt: now.Add(-time.Minute)},
+		{ID: "u1", Email: "alice1@example.com", Role: "admin", CreatedAt: now.Add(-2 * time.Minute)},
+	}
+	mockUserRepo.On("List", ctx, 3, (*repository.Cursor)(nil), filters).Return(users, nil)
+
+	resp, err := svc.ListUsers(ctx, &pb.ListUsersRequest{PageSize: 2, Query: "ali", Role: pb.UserRole_USER_ROLE_ADMIN, SuspendedOnly: true})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Users, 2)
+	assert.Equal(t, pb.UserRole_USER_ROLE_ADMIN, resp.Users[0].Role)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	cursor, err := svc.PageTokens.Decode(resp.NextPageToken, filters)
+	assert.NoError(t, err)
+	assert.Equal(t, "u2", cursor.ID)
+}
+
+func TestSuspendUser(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		svc := NewAdminService(mockUserRepo, new(MockTemplateRepository), nil, nil, "secret")
+		ctx := adminContext("root")
+		suspended := &models.User{ID: "bob", SuspendedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+		mockUserRepo.On("SetSuspended", ctx, "bob", true).Return(suspended, nil)
+
+		resp, err := svc.SuspendUser(ctx, &pb.SuspendUserRequest{UserId: "bob"})
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.User.SuspendedAt)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		svc := NewAdminService(mockUserRepo, new(MockTemplateRepository), nil, nil, "secret")
+
+		_, err := svc.SuspendUser(adminContext("root"), &pb.SuspendUserRequest{UserId: "root"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "SetSuspended", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		svc := NewAdminService(mockUserRepo, new(MockTemplateRepository), nil, nil, "secret")
+		ctx := adminContext("root")
+		mockUserRepo.On("SetSuspended", ctx, "ghost", false).Return(nil, repository.ErrUserNotFound)
+
+		_, err := svc.ReinstateUser(ctx, &pb.ReinstateUserRequest{UserId: "ghost"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestTransferTemplateOwnership(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := NewAdminService(new(MockUserRepository), mockTemplateRepo, nil, nil, "secret")
+		ctx := adminContext("root")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice"}, nil).Once()
+		mockTemplateRepo.On("TransferOwnership", ctx, "t1", "bob").Return(nil)
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "bob"}, nil).Once()
+
+		resp, err := svc.TransferTemplateOwnership(ctx, &pb.TransferTemplateOwnershipRequest{TemplateId: "t1", NewOwnerId: "bob"})
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", resp.Template.OwnerId)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := NewAdminService(new(MockUserRepository), mockTemplateRepo, nil, nil, "secret")
+		ctx := adminContext("root")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice"}, nil)
+		mockTemplateRepo.On("TransferOwnership", ctx, "t1", "ghost").Return(repository.ErrUnknownUser)
+
+		_, err := svc.TransferTemplateOwnership(ctx, &pb.TransferTemplateOwnershipRequest{TemplateId: "t1", NewOwnerId: "ghost"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSetTemplateFeatured(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		featured   bool
+		wantCode   codes.Code
+	}{
+		{"FeaturePublic", "public", true, codes.OK},
+		{"FeaturePrivate", "private", true, codes.FailedPrecondition},
+		{"UnfeaturePrivate", "private", false, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplateRepo := new(MockTemplateRepository)
+			svc := NewAdminService(new(MockUserRepository), mockTemplateRepo, nil, nil, "secret")
+			ctx := adminContext("root")
+			template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: tt.visibility}
+			mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+			mockTemplateRepo.On("SetFeatured", ctx, "t1", tt.featured).Return(nil)
+
+			_, err := svc.SetTemplateFeatured(ctx, &pb.SetTemplateFeaturedRequest{TemplateId: "t1", Featured: tt.featured})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				mockTemplateRepo.AssertNotCalled(t, "SetFeatured", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
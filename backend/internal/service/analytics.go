@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+)
+
+const (
+	// viewDedupeTTL is how long the set of a day's viewers of a template is kept.
+	// It outlives the day so that late requests around midnight still dedupe.
+	viewDedupeTTL = 48 * time.Hour
+	// eventDedupeWindow is the period during which a caller's renders or
+	// copies of a template are counted once.
+	eventDedupeWindow = time.Hour
+	// defaultStatsDays is the length of the period GetTemplateStats covers by default.
+	defaultStatsDays = 30
+	// maxStatsDays caps the length of the period of GetTemplateStats.
+	maxStatsDays = 366
+)
+
+// SeenMarker marks keys as seen until they expire. SetNX reports whether the
+// key was not marked yet. data.RedisClient implements it.
+type SeenMarker interface {
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+}
+
+// recordUsage counts a usage event of a template. Statistics are best effort:
+// a failure is logged and never fails the action being counted.
+func (s *PromptService) recordUsage(ctx context.Context, e *models.UsageEvent) {
+	if s.UsageRepo == nil {
+		return
+	}
+	if e.At.IsZero() {
+		e.At = time.Now()
+	}
+	if err := s.UsageRepo.Record(ctx, e); err != nil {
+		zap.S().Warnf("PromptService.recordUsage: failed to record %s of template %s: %v", e.Event, e.TemplateID, err)
+	}
+}
+
+// firstSeen reports whether the caller is seen under key for the first time
+// before ttl. Callers are identified by user ID, else by client address; when
+// neither is known, without a SeenMarker or when it fails, every call is
+// reported as the first.
+func (s *PromptService) firstSeen(ctx context.Context, key string, ttl time.Duration) bool {
+	caller := ""
+	if userID, _ := GetUserIDFromContext(ctx); userID != "" {
+		caller = "u:" + userID
+	} else if ip := clientIP(ctx); ip != "" {
+		caller = "ip:" + ip
+	}
+	if s.Viewers == nil || caller == "" {
+		return true
+	}
+	added, err := s.Viewers.SetNX(ctx, key+":"+caller, 1, ttl)
+	if err != nil {
+		zap.S().Warnf("PromptService.firstSeen: failed to dedupe %s: %v", key, err)
+		return true
+	}
+	return added
+}
+
+// recordView counts a view of a template, once a day per viewer.
+func (s *PromptService) recordView(ctx context.Context, templateID string, version int32) {
+	if s.UsageRepo == nil {
+		return
+	}
+	now := time.Now().UTC()
+	if !s.firstSeen(ctx, fmt.Sprintf("template_views:%s:%s", templateID, now.Format("20060102")), viewDedupeTTL) {
+		return
+	}
+	s.recordUsage(ctx, &models.UsageEvent{TemplateID: templateID, Version: version, Event: "view", At: now})
+}
+
+// RecordTemplateEvent records a render or a copy of a template by anyone who can
+// read it. Repeated events of a caller are counted once per eventDedupeWindow.
+func (s *PromptService) RecordTemplateEvent(ctx context.Context, req *pb.RecordTemplateEventRequest) (*pb.RecordTemplateEventResponse, error) {
+	zap.S().Infof("PromptService.RecordTemplateEvent: template_id=%s version=%d event=%s", req.TemplateId, req.Version, req.Event)
+	var event string
+	switch req.Event {
+	case pb.TemplateEvent_TEMPLATE_EVENT_RENDER:
+		event = "render"
+	case pb.TemplateEvent_TEMPLATE_EVENT_COPY:
+		event = "copy"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "event must be TEMPLATE_EVENT_RENDER or TEMPLATE_EVENT_COPY")
+	}
+	if req.Version < 0 {
+		return nil, status.Error(codes.InvalidArgument, "version must not be negative")
+	}
+
+	userID, _ := GetUserIDFromContext(ctx)
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if err := s.authorizeTemplate(ctx, principal(ctx), policy.Read, template, req.ShareToken); err != nil {
+		return nil, err
+	}
+	if req.Version != 0 {
+		latest, err := s.TemplateVersionRepo.GetLatest(ctx, template.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get latest version: %v", err)
+		}
+		if latest == nil || req.Version > latest.Version {
+			return nil, status.Errorf(codes.InvalidArgument, "version %d does not exist", req.Version)
+		}
+	}
+
+	now := time.Now().UTC()
+	window := now.Truncate(eventDedupeWindow).Unix()
+	if s.firstSeen(ctx, fmt.Sprintf("template_%ss:%s:%d", event, template.ID, window), 2*eventDedupeWindow) {
+		s.recordUsage(ctx, &models.UsageEvent{TemplateID: template.ID, Version: req.Version, Event: event, At: now})
+	}
+	return &pb.RecordTemplateEventResponse{}, nil
+}
+
+// GetTemplateStats returns the daily usage of a template over a period of UTC
+// days, with a row for every day of the period.
+func (s *PromptService) GetTemplateStats(ctx context.Context, req *pb.GetTemplateStatsRequest) (*pb.GetTemplateStatsResponse, error) {
+	zap.S().Infof("PromptService.GetTemplateStats: template_id=%s version=%d start_date=%s end_date=%s", req.TemplateId, req.Version, req.StartDate, req.EndDate)
+	if req.Version < 0 {
+		return nil, status.Error(codes.InvalidArgument, "version must not be negative")
+	}
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.EndDate != "" {
+		d, err := time.Parse(time.DateOnly, req.EndDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "end_date must be formatted as YYYY-MM-DD")
+		}
+		end = d
+	}
+	start := end.AddDate(0, 0, -(defaultStatsDays - 1))
+	if req.StartDate != "" {
+		d, err := time.Parse(time.DateOnly, req.StartDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start_date must be formatted as YYYY-MM-DD")
+		}
+		start = d
+	}
+	if start.After(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_date must not be after end_date")
+	}
+	if end.Sub(start) >= maxStatsDays*24*time.Hour {
+		return nil, status.Errorf(codes.InvalidArgument, "the period must not exceed %d days", maxStatsDays)
+	}
+
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.ViewStats)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := s.UsageRepo.Series(ctx, template.ID, req.Version, start, end)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+	}
+
+	resp := &pb.GetTemplateStatsResponse{Totals: &pb.TemplateUsage{}}
+	byDay := make(map[string]*pb.TemplateUsage)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		day := &pb.TemplateUsage{Date: d.Format(time.DateOnly)}
+		resp.Days = append(resp.Days, day)
+		byDay[day.Date] = day
+	}
+	for _, b := range buckets {
+		day, ok := byDay[b.Day.Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+		addUsage(day, b.Event, b.Count)
+		addUsage(resp.Totals, b.Event, b.Count)
+	}
+	return resp, nil
+}
+
+// addUsage adds count events of a kind to u.
+func addUsage(u *pb.TemplateUsage, event string, count int64) {
+	switch event {
+	case "view":
+		u.Views += count
+	case "render":
+		u.Renders += count
+	case "copy":
+		u.Copies += count
+	case "instantiate":
+		u.Instantiations += count
+	}
+}
+
+// WriteTemplateStatsCSV writes the daily rows of a GetTemplateStats response as
+// CSV, with a header line.
+func WriteTemplateStatsCSV(w io.Writer, resp *pb.GetTemplateStatsResponse) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "views", "renders", "copies", "instantiations"}); err != nil {
+		return err
+	}
+	for _, d := range resp.Days {
+		if err := cw.Write([]string{
+			d.Date,
+			strconv.FormatInt(d.Views, 10),
+			strconv.FormatInt(d.Renders, 10),
+			strconv.FormatInt(d.Copies, 10),
+			strconv.FormatInt(d.Instantiations, 10),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
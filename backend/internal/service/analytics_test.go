@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockUsageRepository is a mock implementation of repository.UsageRepository
+type MockUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockUsageRepository) Record(ctx context.Context, e *models.UsageEvent) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+func (m *MockUsageRepository) Series(ctx context.Context, templateID string, version int32, from, to time.Time) ([]*models.UsageBucket, error) {
+	args := m.Called(ctx, templateID, version, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.UsageBucket), args.Error(1)
+}
+
+// fakeSeenMarker remembers the keys it marked.
+type fakeSeenMarker struct {
+	seen map[string]bool
+}
+
+func (f *fakeSeenMarker) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	if f.seen == nil {
+		f.seen = make(map[string]bool)
+	}
+	if f.seen[key] {
+		return false, nil
+	}
+	f.seen[key] = true
+	return true, nil
+}
+
+func TestGetTemplateRecordsViewOncePerViewer(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockUsageRepo := new(MockUsageRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: mockUsageRepo, Viewers: &fakeSeenMarker{}})
+
+	mockTemplateRepo.On("Get", mock.Anything, "t1", mock.Anything).Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+	mockUsageRepo.On("Record", mock.Anything, mock.MatchedBy(func(e *models.UsageEvent) bool {
+		return e.TemplateID == "t1" && e.Version == 3 && e.Event == "view"
+	})).Return(nil)
+
+	bob := ContextWithUserID(context.Background(), "bob")
+	anonymous := ContextWithClientIP(context.Background(), "10.0.0.1")
+	for _, ctx := range []context.Context{bob, bob, anonymous, anonymous} {
+		_, err := svc.GetTemplate(ctx, &pb.GetTemplateRequest{Id: "t1"})
+		assert.NoError(t, err)
+	}
+	mockUsageRepo.AssertNumberOfCalls(t, "Record", 2)
+}
+
+func TestRecordTemplateEvent(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockUsageRepo := new(MockUsageRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: mockUsageRepo})
+	ctx := context.Background()
+	mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+	mockTemplateRepo.On("Get", ctx, "t2", "").Return(&models.Template{ID: "t2", OwnerID: "alice", Visibility: "private"}, nil)
+	mockUsageRepo.On("Record", ctx, mock.MatchedBy(func(e *models.UsageEvent) bool {
+		return e.TemplateID == "t1" && e.Version == 2 && e.Event == "copy"
+	})).Return(nil)
+
+	_, err := svc.RecordTemplateEvent(ctx, &pb.RecordTemplateEventRequest{TemplateId: "t1", Version: 2, Event: pb.TemplateEvent_TEMPLATE_EVENT_COPY})
+	assert.NoError(t, err)
+	mockUsageRepo.AssertExpectations(t)
+
+	// Views and instantiations are only recorded by the server.
+	_, err = svc.RecordTemplateEvent(ctx, &pb.RecordTemplateEventRequest{TemplateId: "t1", Event: pb.TemplateEvent_TEMPLATE_EVENT_VIEW})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.RecordTemplateEvent(ctx, &pb.RecordTemplateEventRequest{TemplateId: "t2", Event: pb.TemplateEvent_TEMPLATE_EVENT_RENDER})
+	assert.Error(t, err)
+
+	_, err = svc.RecordTemplateEvent(ctx, &pb.RecordTemplateEventRequest{TemplateId: "t1", Version: 4, Event: pb.TemplateEvent_TEMPLATE_EVENT_COPY})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUsageRepo.AssertNumberOfCalls(t, "Record", 1)
+}
+
+func TestRecordTemplateEventOncePerWindow(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockUsageRepo := new(MockUsageRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: mockUsageRepo, Viewers: &fakeSeenMarker{}})
+	mockTemplateRepo.On("Get", mock.Anything, "t1", mock.Anything).Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+	mockUsageRepo.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	bob := ContextWithUserID(context.Background(), "bob")
+	anonymous := ContextWithClientIP(context.Background(), "10.0.0.1")
+	for _, ctx := range []context.Context{bob, bob, anonymous, anonymous} {
+		_, err := svc.RecordTemplateEvent(ctx, &pb.RecordTemplateEventRequest{TemplateId: "t1", Version: 3, Event: pb.TemplateEvent_TEMPLATE_EVENT_RENDER})
+		assert.NoError(t, err)
+	}
+	_, err := svc.RecordTemplateEvent(bob, &pb.RecordTemplateEventRequest{TemplateId: "t1", Version: 3, Event: pb.TemplateEvent_TEMPLATE_EVENT_COPY})
+	assert.NoError(t, err)
+
+	mockUsageRepo.AssertNumberOfCalls(t, "Record", 3)
+}
+
+func TestGetTemplateStats(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}
+
+	t.Run("Owner", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockUsageRepo := new(MockUsageRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: mockUsageRepo})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockUsageRepo.On("Series", ctx, "t1", int32(0), day(1), day(3)).Return([]*models.UsageBucket{
+			{Day: day(1), Event: "view", Count: 4},
+			{Day: day(1), Event: "copy", Count: 1},
+			{Day: day(3), Event: "view", Count: 2},
+			{Day: day(3), Event: "instantiate", Count: 1},
+		}, nil)
+
+		resp, err := svc.GetTemplateStats(ctx, &pb.GetTemplateStatsRequest{TemplateId: "t1", StartDate: "2026-03-01", EndDate: "2026-03-03"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Days, 3)
+		assert.Equal(t, &pb.TemplateUsage{Date: "2026-03-01", Views: 4, Copies: 1}, resp.Days[0])
+		assert.Equal(t, &pb.TemplateUsage{Date: "2026-03-02"}, resp.Days[1])
+		assert.Equal(t, &pb.TemplateUsage{Views: 6, Copies: 1, Instantiations: 1}, resp.Totals)
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteTemplateStatsCSV(&buf, resp))
+		assert.Equal(t, "date,views,renders,copies,instantiations\n"+
+			"2026-03-01,4,0,1,0\n2026-03-02,0,0,0,0\n2026-03-03,2,0,0,1\n", buf.String())
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockUsageRepo := new(MockUsageRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: mockUsageRepo})
+		mockShareRepo := new(MockShareRepository)
+		svc.ShareRepo = mockShareRepo
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockShareRepo.On("GetGrant", ctx, "t1", "bob").Return(nil, nil)
+
+		_, err := svc.GetTemplateStats(ctx, &pb.GetTemplateStatsRequest{TemplateId: "t1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockUsageRepo.AssertNotCalled(t, "Series", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidPeriod", func(t *testing.T) {
+		svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 3}, UsageRepo: new(MockUsageRepository)})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		for _, req := range []*pb.GetTemplateStatsRequest{
+			{TemplateId: "t1", StartDate: "2026-03-04", EndDate: "2026-03-03"},
+			{TemplateId: "t1", StartDate: "2024-01-01", EndDate: "2026-03-03"},
+			{TemplateId: "t1", EndDate: "03/03/2026"},
+		} {
+			_, err := svc.GetTemplateStats(ctx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
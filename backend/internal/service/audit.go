@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// Auditor appends the mutating operations of the services to the audit log.
+// Reads, notification read markers and usage statistics are not audited.
+// A nil Auditor records nothing.
+type Auditor struct {
+	Repo repository.AuditRepository
+}
+
+// NewAuditor creates a new Auditor.
+func NewAuditor(repo repository.AuditRepository) *Auditor {
+	return &Auditor{Repo: repo}
+}
+
+// Record appends an operation performed by the caller to the audit log.
+// action is <resource_type>.<verb>; before and after summarize the resource
+// and are nil when it did not exist. The audit log is best effort: a failure
+// is logged and never fails the operation.
+func (a *Auditor) Record(ctx context.Context, action, resourceID, ownerID string, before, after interface{}) {
+	if a == nil || a.Repo == nil {
+		return
+	}
+	p := principal(ctx)
+	resourceType, _, _ := strings.Cut(action, ".")
+	e := &models.AuditEvent{
+		ActorID:      p.UserID,
+		ActorRole:    p.Role,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		OwnerID:      ownerID,
+		ClientIP:     clientIP(ctx),
+		RequestID:    RequestID(ctx),
+	}
+	var err error
+	if before != nil {
+		if e.Before, err = json.Marshal(before); err != nil {
+			zap.S().Warnf("Auditor.Record: failed to marshal %s summary: %v", action, err)
+		}
+	}
+	if after != nil {
+		if e.After, err = json.Marshal(after); err != nil {
+			zap.S().Warnf("Auditor.Record: failed to marshal %s summary: %v", action, err)
+		}
+	}
+	if err := a.Repo.Record(ctx, e); err != nil {
+		zap.S().Warnf("Auditor.Record: failed to record %s of %s: %v", action, resourceID, err)
+	}
+}
+
+// asUser returns ctx acting as the given user, for operations such as signing in
+// that are performed before the request is authenticated.
+func asUser(ctx context.Context, u *models.User) context.Context {
+	return ContextWithPrincipal(ctx, policy.Principal{UserID: u.ID, Role: u.Role})
+}
+
+// templateAuditSummary summarizes the audited fields of a template.
+func templateAuditSummary(t *models.Template) map[string]interface{} {
+	return map[string]interface{}{
+		"owner_id":         t.OwnerID,
+		"title":            t.Title,
+		"description":      t.Description.String,
+		"visibility":       t.Visibility,
+		"category":         t.Category.String,
+		"tags":             []string(t.Tags),
+		"language":         t.Language,
+		"org_id":           t.OrgID.String,
+		"moderation_state": t.ModerationState,
+		"featured":         t.FeaturedAt.Valid,
+	}
+}
+
+// promptAuditSummary summarizes the audited fields of a saved prompt.
+func promptAuditSummary(p *models.Prompt) map[string]interface{} {
+	return map[string]interface{}{
+		"template_id":     p.TemplateID,
+		"version_id":      p.VersionID,
+		"variables":       p.Variables,
+		"named_variables": p.NamedVariables,
+		"title":           p.Title,
+		"notes":           p.Notes,
+		"tags":            []string(p.Tags),
+		"pinned":          p.Pinned,
+	}
+}
+
+// collectionAuditSummary summarizes the audited fields of a collection.
+func collectionAuditSummary(c *models.Collection) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        c.Name,
+		"description": c.Description.String,
+		"visibility":  c.Visibility,
+	}
+}
+
+// commentAuditSummary summarizes the audited fields of a comment.
+func commentAuditSummary(c *models.Comment) map[string]interface{} {
+	return map[string]interface{}{
+		"template_id": c.TemplateID,
+		"parent_id":   c.ParentID.String,
+		"body":        c.Body,
+	}
+}
+
+// presetAuditSummary summarizes the audited fields of a variable preset.
+func presetAuditSummary(p *models.VariablePreset) map[string]interface{} {
+	return map[string]interface{}{
+		"name":   p.Name,
+		"org_id": p.OrgID.String,
+		"values": p.Values,
+	}
+}
+
+// userAuditSummary summarizes the audited fields of a user. Contact details
+// and credentials are left out.
+func userAuditSummary(u *models.User) map[string]interface{} {
+	return map[string]interface{}{
+		"display_name": u.DisplayName,
+		"avatar":       u.Avatar,
+		"bio":          u.Bio,
+		"role":         u.Role,
+		"suspended":    u.Suspended(),
+	}
+}
+
+// AuditService implements the RPCs reading the audit log.
+type AuditService struct {
+	pb.UnimplementedAuditServiceServer
+	AuditRepo  repository.AuditRepository
+	PageTokens *PageTokenCodec
+}
+
+func NewAuditService(auditRepo repository.AuditRepository, pageTokenSecret string) *AuditService {
+	return &AuditService{
+		AuditRepo:  auditRepo,
+		PageTokens: NewPageTokenCodec(pageTokenSecret),
+	}
+}
+
+// ListAuditEvents lists audit events, latest first. Administrators see every
+// event; other users only see the events about resources they own and the
+// ones they performed, and only see where the events they performed come from.
+func (s *AuditService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "audit event")
+	}
+	zap.S().Infof("AuditService.ListAuditEvents: user_id=%s page_size=%d actor_id=%s action=%s resource_type=%s resource_id=%s", p.UserID, req.PageSize, req.ActorId, req.Action, req.ResourceType, req.ResourceId)
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+	admin := policy.Administer(p) == policy.Allow
+	filters := map[string]interface{}{}
+	if !admin {
+		filters["visible_to"] = p.UserID
+	}
+	if req.ActorId != "" {
+		filters["actor_id"] = req.ActorId
+	}
+	if req.Action != "" {
+		filters["action"] = req.Action
+	}
+	if req.ResourceType != "" {
+		filters["resource_type"] = req.ResourceType
+	}
+	if req.ResourceId != "" {
+		filters["resource_id"] = req.ResourceId
+	}
+	if req.StartTime != nil {
+		filters["start_time"] = req.StartTime.AsTime().Format(time.RFC3339Nano)
+	}
+	if req.EndTime != nil {
+		filters["end_time"] = req.EndTime.AsTime().Format(time.RFC3339Nano)
+	}
+
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Fetch one extra row to find out whether there is a next page.
+	events, err := s.AuditRepo.List(ctx, limit+1, after, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+	nextToken := ""
+	if len(events) > limit {
+		events = events[:limit]
+		nextToken = s.PageTokens.Encode(repository.AuditEventCursor(events[limit-1]), filters)
+	}
+
+	pbEvents := make([]*pb.AuditEvent, len(events))
+	for i, e := range events {
+		pbEvents[i] = auditEventModelToProto(e)
+		// The client address and request of someone else, such as a user
+		// liking the caller's template, are not the caller's to see.
+		if !admin && e.ActorID != p.UserID {
+			pbEvents[i].ClientIp = ""
+			pbEvents[i].RequestId = ""
+		}
+	}
+	return &pb.ListAuditEventsResponse{Events: pbEvents, NextPageToken: nextToken}, nil
+}
+
+func auditEventModelToProto(e *models.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:           strconv.FormatInt(e.ID, 10),
+		ActorId:      e.ActorID,
+		ActorRole:    e.ActorRole,
+		Action:       e.Action,
+		ResourceType: e.ResourceType,
+		ResourceId:   e.ResourceID,
+		OwnerId:      e.OwnerID,
+		Before:       auditSummaryToProto(e.Before),
+		After:        auditSummaryToProto(e.After),
+		ClientIp:     e.ClientIP,
+		RequestId:    e.RequestID,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+}
+
+// auditSummaryToProto converts a stored summary to a Struct, nil when there is none.
+func auditSummaryToProto(raw json.RawMessage) *structpb.Struct {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		return nil
+	}
+	summary, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil
+	}
+	return summary
+}
+
+// AuditRetentionWorker periodically deletes the audit events older than the
+// retention period.
+type AuditRetentionWorker struct {
+	Repo      repository.AuditRepository
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// NewAuditRetentionWorker creates a new AuditRetentionWorker.
+func NewAuditRetentionWorker(repo repository.AuditRepository, interval, retention time.Duration) *AuditRetentionWorker {
+	return &AuditRetentionWorker{
+		Repo:      repo,
+		Interval:  interval,
+		Retention: retention,
+	}
+}
+
+// Run purges expired events immediately and then on every interval until ctx is cancelled.
+func (w *AuditRetentionWorker) Run(ctx context.Context) {
+	zap.S().Infof("AuditRetentionWorker: interval=%s retention=%s", w.Interval, w.Retention)
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil {
+			zap.S().Errorf("AuditRetentionWorker: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce deletes the events older than the retention period.
+func (w *AuditRetentionWorker) RunOnce(ctx context.Context) error {
+	n, err := w.Repo.Purge(ctx, time.Now().Add(-w.Retention))
+	if err != nil {
+		return err
+	}
+	zap.S().Infof("AuditRetentionWorker: purged %d audit events", n)
+	return nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockAuditRepository is a mock implementation of repository.AuditRepository
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Record(ctx context.Context, e *models.AuditEvent) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+func (m *MockAuditRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.AuditEvent, error) {
+	args := m.Called(ctx, limit, after, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.AuditEvent), args.Error(1)
+}
+func (m *MockAuditRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestAuditorRecord(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	auditor := NewAuditor(mockRepo)
+	ctx := ContextWithUserID(context.Background(), "alice")
+	ctx = ContextWithClientIP(ctx, "10.0.0.1")
+	ctx = ContextWithRequestID(ctx, "req-1")
+
+	var got *models.AuditEvent
+	mockRepo.On("Record", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		got = args.Get(1).(*models.AuditEvent)
+	}).Return(errors.New("db down"))
+
+	auditor.Record(ctx, "template.update", "t1", "bob", map[string]interface{}{"title": "Old"}, map[string]interface{}{"title": "New"})
+	assert.Equal(t, "alice", got.ActorID)
+	assert.Equal(t, "template.update", got.Action)
+	assert.Equal(t, "template", got.ResourceType)
+	assert.Equal(t, "t1", got.ResourceID)
+	assert.Equal(t, "bob", got.OwnerID)
+	assert.Equal(t, "10.0.0.1", got.ClientIP)
+	assert.Equal(t, "req-1", got.RequestID)
+	assert.JSONEq(t, `{"title":"Old"}`, string(got.Before))
+	assert.JSONEq(t, `{"title":"New"}`, string(got.After))
+
+	// A nil Auditor records nothing.
+	var none *Auditor
+	none.Record(ctx, "template.delete", "t1", "bob", nil, nil)
+	mockRepo.AssertNumberOfCalls(t, "Record", 1)
+}
+
+func TestContextWithRequestID(t *testing.T) {
+	assert.Equal(t, "abc", RequestID(ContextWithRequestID(context.Background(), "abc")))
+	generated := RequestID(ContextWithRequestID(context.Background(), ""))
+	assert.Len(t, generated, 32)
+	assert.NotEqual(t, generated, RequestID(ContextWithRequestID(context.Background(), "")))
+}
+
+func TestUpdateProfileIsAudited(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	mockAuditRepo := new(MockAuditRepository)
+	svc := NewUserService(mockRepo, nil, nil, nil, nil, "secret")
+	svc.Audit = NewAuditor(mockAuditRepo)
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockRepo.On("GetByID", ctx, "bob").Return(&models.User{ID: "bob", DisplayName: "Bob", Email: "bob@example.com"}, nil)
+	mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+	var got *models.AuditEvent
+	mockAuditRepo.On("Record", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		got = args.Get(1).(*models.AuditEvent)
+	}).Return(nil)
+
+	_, err := svc.UpdateProfile(ctx, &pb.UpdateProfileRequest{DisplayName: "Robert", Password: "hunter22"})
+	assert.NoError(t, err)
+	assert.Equal(t, "user.update_profile", got.Action)
+	assert.Equal(t, "bob", got.OwnerID)
+
+	var before, after map[string]interface{}
+	assert.NoError(t, json.Unmarshal(got.Before, &before))
+	assert.NoError(t, json.Unmarshal(got.After, &after))
+	assert.Equal(t, "Bob", before["display_name"])
+	assert.Equal(t, "Robert", after["display_name"])
+	assert.Equal(t, true, after["password_changed"])
+	// Credentials and contact details never reach the audit log.
+	assert.NotContains(t, string(got.After), "hunter22")
+	assert.NotContains(t, string(got.After), "bob@example.com")
+}
+
+func TestListAuditEvents(t *testing.T) {
+	events := []*models.AuditEvent{
+		{ID: 7, ActorID: "alice", Action: "template.update", ResourceType: "template", ResourceID: "t1", OwnerID: "alice",
+			Before: json.RawMessage(`{"title":"Old"}`), After: json.RawMessage(`{"title":"New"}`), CreatedAt: time.Now()},
+		{ID: 6, ActorID: "alice", Action: "template.delete", ResourceType: "template", ResourceID: "t2", OwnerID: "alice",
+			Before: json.RawMessage(`{"title":"Gone"}`), After: json.RawMessage(`null`), CreatedAt: time.Now().Add(-time.Minute)},
+	}
+
+	t.Run("Owner", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+		svc := NewAuditService(mockRepo, "secret")
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockRepo.On("List", ctx, 2, (*repository.Cursor)(nil), map[string]interface{}{
+			"visible_to": "alice", "resource_type": "template",
+		}).Return(events, nil)
+
+		resp, err := svc.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{PageSize: 1, ResourceType: "template"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Events, 1)
+		assert.Equal(t, "7", resp.Events[0].Id)
+		assert.Equal(t, "Old", resp.Events[0].Before.Fields["title"].GetStringValue())
+		assert.Equal(t, "New", resp.Events[0].After.Fields["title"].GetStringValue())
+		assert.NotEmpty(t, resp.NextPageToken)
+	})
+
+	t.Run("OwnerSeesOthersWithoutTheirAddress", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+		svc := NewAuditService(mockRepo, "secret")
+		ctx := ContextWithUserID(context.Background(), "alice")
+		like := &models.AuditEvent{ID: 8, ActorID: "bob", Action: "template.like", ResourceType: "template", ResourceID: "t1", OwnerID: "alice",
+			ClientIP: "10.0.0.2", RequestID: "req-bob", CreatedAt: time.Now()}
+		own := &models.AuditEvent{ID: 7, ActorID: "alice", Action: "template.update", ResourceType: "template", ResourceID: "t1", OwnerID: "alice",
+			ClientIP: "10.0.0.1", RequestID: "req-alice", CreatedAt: time.Now().Add(-time.Minute)}
+		mockRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{"visible_to": "alice"}).Return([]*models.AuditEvent{like, own}, nil)
+
+		resp, err := svc.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Events, 2)
+		assert.Equal(t, "bob", resp.Events[0].ActorId)
+		assert.Empty(t, resp.Events[0].ClientIp)
+		assert.Empty(t, resp.Events[0].RequestId)
+		assert.Equal(t, "10.0.0.1", resp.Events[1].ClientIp)
+		assert.Equal(t, "req-alice", resp.Events[1].RequestId)
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		mockRepo := new(MockAuditRepository)
+		svc := NewAuditService(mockRepo, "secret")
+		ctx := adminContext("root")
+		mockRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{"actor_id": "alice"}).Return(events[1:], nil)
+
+		resp, err := svc.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{ActorId: "alice"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Events, 1)
+		assert.Nil(t, resp.Events[0].After)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		svc := NewAuditService(new(MockAuditRepository), "secret")
+		_, err := svc.ListAuditEvents(context.Background(), &pb.ListAuditEventsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestAuditRetentionWorker(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	worker := NewAuditRetentionWorker(mockRepo, time.Hour, 30*24*time.Hour)
+	cutoff := time.Now().Add(-30 * 24 * time.Hour)
+	mockRepo.On("Purge", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return before.Sub(cutoff).Abs() < time.Minute
+	})).Return(int64(3), nil)
+
+	assert.NoError(t, worker.RunOnce(context.Background()))
+	mockRepo.AssertExpectations(t)
+}
@@ -2,53 +2,95 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
 )
 
+// principalCacheTTL is how long the role and suspension of a user loaded by
+// the interceptor are reused before they are loaded again.
+const principalCacheTTL = 30 * time.Second
+
 // AuthInterceptor is a server interceptor that authenticates the user.
 type AuthInterceptor struct {
 	jwtSecret []byte
 	// publicRpcMethods is a map of methods that do not require authentication
 	publicRpcMethods map[string]bool
+	// Users reloads the role and suspension of the callers, so that a
+	// suspension or a role change applies to tokens issued before it. When
+	// nil, the claims of the token are trusted.
+	Users repository.UserRepository
+
+	mu    sync.Mutex
+	users map[string]cachedUser
+	swept time.Time
+}
+
+// cachedUser is a user loaded by the interceptor, reused until expires.
+type cachedUser struct {
+	user    *models.User
+	expires time.Time
 }
 
 type contextKey string
 
-const userIDKey contextKey = "user_id"
+const (
+	userIDKey contextKey = "user_id"
+	roleKey   contextKey = "role"
+)
 
 // ContextWithUserID adds the user ID to the context.
 func ContextWithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
+// ContextWithPrincipal adds the user ID and role of an authenticated principal to the context.
+func ContextWithPrincipal(ctx context.Context, p policy.Principal) context.Context {
+	return context.WithValue(ContextWithUserID(ctx, p.UserID), roleKey, p.Role)
+}
+
 // NewAuthInterceptor creates a new AuthInterceptor.
 func NewAuthInterceptor(jwtSecret string) *AuthInterceptor {
 	return &AuthInterceptor{
 		jwtSecret: []byte(jwtSecret),
+		users:     make(map[string]cachedUser),
 		publicRpcMethods: map[string]bool{
-			"/v1.UserService/Register":         true,
-			"/v1.UserService/Login":            true,
-			"/v1.UserService/LoginWithOAuth":   true,
-			"/v1.PromptService/ListTemplates":  true, // Allow public viewing? Maybe make it conditional essentially
-			"/v1.PromptService/GetTemplate":    true,
-			"/v1.PromptService/ListCategories": true,
-			"/v1.PromptService/ListTags":       true,
+			"/v1.UserService/Register":                true,
+			"/v1.UserService/Login":                   true,
+			"/v1.UserService/LoginWithOAuth":          true,
+			"/v1.PromptService/ListTemplates":         true, // Allow public viewing? Maybe make it conditional essentially
+			"/v1.PromptService/GetTemplate":           true,
+			"/v1.PromptService/ListTemplateVersions":  true,
+			"/v1.PromptService/ListCategories":        true,
+			"/v1.PromptService/ListTags":              true,
+			"/v1.PromptService/ListTrendingTemplates": true,
+			"/v1.PromptService/GetCollection":         true,
+			"/v1.PromptService/ListCollections":       true,
+			"/v1.PromptService/ListComments":          true,
+			"/v1.PromptService/ListReviews":           true,
+			"/v1.UserService/GetPublicProfile":        true,
+			"/v1.PromptService/RecordTemplateEvent":   true,
 			// For testing reflection
 			"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
 		},
 	}
 }
 
-// VerifyToken validates the token string and returns the user ID.
-func (i *AuthInterceptor) VerifyToken(tokenString string) (string, error) {
+// VerifyToken validates the token string and returns the user it was issued to.
+// Tokens issued before roles were introduced carry no role and are plain users.
+func (i *AuthInterceptor) VerifyToken(tokenString string) (policy.Principal, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -57,57 +99,149 @@ func (i *AuthInterceptor) VerifyToken(tokenString string) (string, error) {
 	})
 
 	if err != nil {
-		return "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return policy.Principal{}, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
 		userID, ok := claims["sub"].(string)
 		if !ok {
-			return "", status.Error(codes.Unauthenticated, "invalid token payload: missing sub")
+			return policy.Principal{}, status.Error(codes.Unauthenticated, "invalid token payload: missing sub")
+		}
+		role, _ := claims["role"].(string)
+		if role == "" {
+			role = policy.RoleUser
 		}
-		return userID, nil
+		return policy.Principal{UserID: userID, Role: role}, nil
 	}
 
-	return "", status.Error(codes.Unauthenticated, "invalid token")
+	return policy.Principal{}, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+// Authenticate verifies the token and returns the user it was issued to, with
+// the role the user has now. Suspended users are denied.
+func (i *AuthInterceptor) Authenticate(ctx context.Context, tokenString string) (policy.Principal, error) {
+	p, err := i.VerifyToken(tokenString)
+	if err != nil || i.Users == nil {
+		return p, err
+	}
+	user, err := i.loadUser(ctx, p.UserID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return policy.Principal{}, status.Error(codes.Unauthenticated, "user no longer exists")
+	} else if err != nil {
+		return policy.Principal{}, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user.Suspended() {
+		return policy.Principal{}, status.Error(codes.PermissionDenied, "account suspended")
+	}
+	p.Role = user.Role
+	if p.Role == "" {
+		p.Role = policy.RoleUser
+	}
+	return p, nil
+}
+
+// loadUser returns the user with the given ID, from the cache when it was
+// loaded less than principalCacheTTL ago.
+func (i *AuthInterceptor) loadUser(ctx context.Context, id string) (*models.User, error) {
+	now := time.Now()
+	i.mu.Lock()
+	cached, ok := i.users[id]
+	i.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.user, nil
+	}
+
+	user, err := i.Users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	i.mu.Lock()
+	if now.Sub(i.swept) > principalCacheTTL {
+		for k, v := range i.users {
+			if !now.Before(v.expires) {
+				delete(i.users, k)
+			}
+		}
+		i.swept = now
+	}
+	i.users[id] = cachedUser{user: user, expires: now.Add(principalCacheTTL)}
+	i.mu.Unlock()
+	return user, nil
 }
 
 // Unary returns a server interceptor function to authenticate unary RPCs.
 func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// 1. Attempt to extract and verify token if present
-		var tokenString string
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			zap.S().Infof("AuthInterceptor: md=%v", md)
-			values := md["authorization"]
-			if len(values) > 0 {
-				authHeader := values[0]
-				parts := strings.Split(authHeader, " ")
-				if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
-					tokenString = parts[1]
-				}
-			}
+		// Tag the request with an ID, echoed to the client, that the audit log refers to
+		ctx = ContextWithRequestID(ctx, RequestID(ctx))
+		_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", RequestID(ctx)))
+
+		newCtx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(newCtx, req)
+	}
+}
+
+// Stream returns a server interceptor function to authenticate streaming RPCs.
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ContextWithRequestID(ss.Context(), RequestID(ss.Context()))
+		_ = ss.SetHeader(metadata.Pairs("x-request-id", RequestID(ctx)))
 
-		if tokenString != "" {
-			// Token is present, verify it
-			userID, err := i.VerifyToken(tokenString)
-			if err != nil {
-				return nil, err // Fail if token provided but invalid
+		newCtx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: newCtx})
+	}
+}
+
+// authServerStream is a server stream whose context carries the caller.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate returns ctx with the caller of method, from the bearer token of
+// the request metadata. Only public methods may be called without a token.
+func (i *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	// 1. Attempt to extract and verify token if present
+	var tokenString string
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		values := md["authorization"]
+		if len(values) > 0 {
+			authHeader := values[0]
+			parts := strings.Split(authHeader, " ")
+			if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+				tokenString = parts[1]
 			}
-			// Inject User ID into Context
-			newCtx := context.WithValue(ctx, userIDKey, userID)
-			return handler(newCtx, req)
 		}
+	}
 
-		// 2. If no token, check if the method is public
-		if i.publicRpcMethods[info.FullMethod] {
-			return handler(ctx, req)
+	if tokenString != "" {
+		// Token is present, verify it
+		p, err := i.Authenticate(ctx, tokenString)
+		if err != nil {
+			return nil, err // Fail if token provided but invalid, or the user is suspended
 		}
+		// Inject User ID and role into Context
+		return ContextWithPrincipal(ctx, p), nil
+	}
 
-		// 3. Not public and no token -> Fail
-		return nil, status.Error(codes.Unauthenticated, "missing authorization token")
+	// 2. If no token, check if the method is public
+	if i.publicRpcMethods[method] {
+		return ctx, nil
 	}
+
+	// 3. Not public and no token -> Fail
+	return nil, status.Error(codes.Unauthenticated, "missing authorization token")
 }
 
 // GetUserIDFromContext retrieves the user ID from the context.
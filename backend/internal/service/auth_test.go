@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// fakeServerStream is a server stream that only has a context.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	interceptor := NewAuthInterceptor("secret")
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "alice"}).SignedString([]byte("secret"))
+	assert.NoError(t, err)
+	info := &grpc.StreamServerInfo{FullMethod: "/v1.PromptService/StreamExecutePrompt", IsServerStream: true}
+
+	var userID string
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		userID, err = GetUserIDFromContext(ss.Context())
+		return err
+	}
+
+	// The caller of the token reaches the handler.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	assert.NoError(t, interceptor.Stream()(nil, &fakeServerStream{ctx: ctx}, info, handler))
+	assert.Equal(t, "alice", userID)
+
+	// Without a valid token, non-public methods are rejected.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer nope"))
+	err = interceptor.Stream()(nil, &fakeServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = interceptor.Stream()(nil, &fakeServerStream{ctx: context.Background()}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthenticateReloadsUser(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	interceptor := NewAuthInterceptor("secret")
+	interceptor.Users = mockUserRepo
+	sign := func(sub, role string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": sub, "role": role}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		return token
+	}
+	ctx := context.Background()
+	mockUserRepo.On("GetByID", ctx, "alice").Return(&models.User{ID: "alice", Role: policy.RoleUser}, nil).Once()
+	mockUserRepo.On("GetByID", ctx, "bob").Return(&models.User{ID: "bob", SuspendedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil).Once()
+	mockUserRepo.On("GetByID", ctx, "ghost").Return(nil, repository.ErrUserNotFound).Once()
+
+	// The role comes from the user, not from the claims of the token.
+	p, err := interceptor.Authenticate(ctx, sign("alice", policy.RoleAdmin))
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Principal{UserID: "alice", Role: policy.RoleUser}, p)
+
+	// The user is loaded once per cache period.
+	_, err = interceptor.Authenticate(ctx, sign("alice", policy.RoleAdmin))
+	assert.NoError(t, err)
+
+	_, err = interceptor.Authenticate(ctx, sign("bob", policy.RoleUser))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = interceptor.Authenticate(ctx, sign("ghost", policy.RoleUser))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	mockUserRepo.AssertExpectations(t)
+
+	// Suspended users are rejected by the interceptor too.
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.PromptService/ListTemplates"}
+	md := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+sign("bob", policy.RoleUser)))
+	_, err = interceptor.Unary()(md, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
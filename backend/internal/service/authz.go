@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+)
+
+// principal returns the identity authenticated for the request, anonymous when there is none.
+func principal(ctx context.Context) policy.Principal {
+	userID, _ := GetUserIDFromContext(ctx)
+	role, _ := ctx.Value(roleKey).(string)
+	return policy.Principal{UserID: userID, Role: role}
+}
+
+// authorize turns a policy decision about a resource into the error returned to the caller.
+func authorize(d policy.Decision, resource string) error {
+	switch d {
+	case policy.Allow:
+		return nil
+	case policy.Unauthenticated:
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	case policy.NotFound:
+		return status.Errorf(codes.NotFound, "%s not found", resource)
+	}
+	return status.Error(codes.PermissionDenied, "not authorized")
+}
+
+// authorizeSelf checks that a user ID sent by the client, if any, is the caller's own.
+func authorizeSelf(ctx context.Context, action policy.Action, userID string) error {
+	p := principal(ctx)
+	if userID == "" {
+		userID = p.UserID
+	}
+	return authorize(policy.User(p, action, userID), "user")
+}
+
+// templateRelation resolves how a principal relates to a template from its grants,
+// its organization membership and, when given, a share link token.
+func (s *PromptService) templateRelation(ctx context.Context, p policy.Principal, t *models.Template, shareToken string) (policy.TemplateRelation, error) {
+	var rel policy.TemplateRelation
+	if !p.Anonymous() && t.OwnerID == p.UserID {
+		return rel, nil
+	}
+	if !p.Anonymous() {
+		grant, err := s.ShareRepo.GetGrant(ctx, t.ID, p.UserID)
+		if err != nil {
+			return rel, status.Errorf(codes.Internal, "failed to get grant: %v", err)
+		}
+		if grant != nil {
+			rel.Grant = grant.Role
+		}
+		if t.OrgID.Valid && t.Visibility != "private" {
+			member, err := s.OrgRepo.GetMember(ctx, t.OrgID.String, p.UserID)
+			if err != nil {
+				return rel, status.Errorf(codes.Internal, "failed to get membership: %v", err)
+			}
+			if member != nil {
+				rel.OrgRole = member.Role
+			}
+		}
+	}
+	if shareToken != "" {
+		link, err := s.ShareRepo.GetLinkByTokenHash(ctx, hashSecretToken(shareToken))
+		rel.ShareLink = err == nil && link.TemplateID == t.ID && link.Active(time.Now())
+	}
+	return rel, nil
+}
+
+// authorizeTemplate checks that a principal may perform an action on a template.
+func (s *PromptService) authorizeTemplate(ctx context.Context, p policy.Principal, action policy.Action, t *models.Template, shareToken string) error {
+	// Decide first without looking anything up: owners and anonymous callers need no relation.
+	d := policy.Template(p, action, t, policy.TemplateRelation{})
+	if d == policy.Allow || d == policy.Unauthenticated {
+		return authorize(d, "template")
+	}
+	rel, err := s.templateRelation(ctx, p, t, shareToken)
+	if err != nil {
+		return err
+	}
+	return authorize(policy.Template(p, action, t, rel), "template")
+}
+
+// getTemplateFor returns a template if the caller may perform the action on it.
+func (s *PromptService) getTemplateFor(ctx context.Context, id string, action policy.Action) (*models.Template, error) {
+	p := principal(ctx)
+	if p.Anonymous() && action != policy.Read {
+		return nil, authorize(policy.Unauthenticated, "template")
+	}
+	template, err := s.TemplateRepo.Get(ctx, id, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if err := s.authorizeTemplate(ctx, p, action, template, ""); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// getPromptFor returns a saved prompt if the caller may perform the action on it.
+func (s *PromptService) getPromptFor(ctx context.Context, id string, action policy.Action) (*models.Prompt, error) {
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "prompt")
+	}
+	prompt, err := s.PromptRepo.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "prompt not found")
+	}
+	if err := authorize(policy.Prompt(p, action, prompt), "prompt"); err != nil {
+		return nil, err
+	}
+	return prompt, nil
+}
+
+// getCollectionFor returns a collection if the caller may perform the action on it.
+func (s *PromptService) getCollectionFor(ctx context.Context, id string, action policy.Action) (*models.Collection, error) {
+	p := principal(ctx)
+	if p.Anonymous() && action != policy.Read {
+		return nil, authorize(policy.Unauthenticated, "collection")
+	}
+	collection, err := s.CollectionRepo.Get(ctx, id, p.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if err := authorize(policy.Collection(p, action, collection), "collection"); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// getPresetFor returns a variable preset if the caller may perform the action on it.
+func (s *PromptService) getPresetFor(ctx context.Context, id string, action policy.Action) (*models.VariablePreset, error) {
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "preset")
+	}
+	preset, err := s.PresetRepo.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "preset not found")
+	}
+	if err := s.authorizePreset(ctx, p, action, preset); err != nil {
+		return nil, err
+	}
+	return preset, nil
+}
+
+// authorizePreset checks that a principal may perform an action on a preset,
+// looking up their role in the organization of an organization preset.
+func (s *PromptService) authorizePreset(ctx context.Context, p policy.Principal, action policy.Action, preset *models.VariablePreset) error {
+	orgRole := ""
+	if preset.OrgID.Valid && !p.Anonymous() {
+		member, err := s.OrgRepo.GetMember(ctx, preset.OrgID.String, p.UserID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get membership: %v", err)
+		}
+		if member != nil {
+			orgRole = member.Role
+		}
+	}
+	return authorize(policy.Preset(p, action, preset, orgRole), "preset")
+}
+
+// getEvaluationCaseFor returns a test case if the caller may perform the action
+// on its template.
+func (s *PromptService) getEvaluationCaseFor(ctx context.Context, id string, action policy.Action) (*models.EvaluationCase, error) {
+	if principal(ctx).Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "evaluation case")
+	}
+	c, err := s.EvaluationRepo.GetCase(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "evaluation case not found")
+	}
+	if _, err := s.getTemplateFor(ctx, c.TemplateID, action); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "evaluation case not found")
+		}
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"awsome-prompt/backend/internal/cache"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// changeClaimTTL is how long the claim of a change by an instance is kept,
+// well beyond the delivery of its notification to the other instances.
+const changeClaimTTL = 10 * time.Minute
+
+// CacheInvalidator keeps the cache consistent across replicas.
+// It listens for the change notifications published by the repositories and
+// evicts the affected entries.
+type CacheInvalidator struct {
+	DSN   string
+	Cache *cache.Cache
+	// PingInterval is how often an idle connection is checked, so that a
+	// silently dropped connection is noticed and re-established.
+	PingInterval time.Duration
+}
+
+// NewCacheInvalidator creates a new CacheInvalidator.
+func NewCacheInvalidator(dsn string, c *cache.Cache) *CacheInvalidator {
+	return &CacheInvalidator{
+		DSN:          dsn,
+		Cache:        c,
+		PingInterval: 90 * time.Second,
+	}
+}
+
+// Run listens for changes until ctx is cancelled.
+func (i *CacheInvalidator) Run(ctx context.Context) {
+	listener := pq.NewListener(i.DSN, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			zap.S().Warnf("CacheInvalidator: listener event %d: %v", ev, err)
+		}
+	})
+	defer func() { _ = listener.Close() }()
+
+	if err := listener.Listen(repository.ChangeChannel); err != nil {
+		zap.S().Errorf("CacheInvalidator: failed to listen on %s: %v", repository.ChangeChannel, err)
+		return
+	}
+	zap.S().Infof("CacheInvalidator: listening on %s", repository.ChangeChannel)
+
+	ticker := time.NewTicker(i.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-listener.Notify:
+			i.Handle(ctx, n)
+		case <-ticker.C:
+			go func() { _ = listener.Ping() }()
+		}
+	}
+}
+
+// Handle applies a single notification. The cache is shared by the instances,
+// so the first one to claim a change evicts its entries for all of them.
+// A nil notification means the connection was re-established: anything sent
+// while it was down is lost, so every entry is invalidated.
+func (i *CacheInvalidator) Handle(ctx context.Context, n *pq.Notification) {
+	if n == nil {
+		zap.S().Warn("CacheInvalidator: reconnected, invalidating cache")
+		repository.EvictAll(ctx, i.Cache)
+		return
+	}
+
+	var ch repository.Change
+	if err := json.Unmarshal([]byte(n.Extra), &ch); err != nil {
+		zap.S().Warnf("CacheInvalidator: invalid payload %q: %v", n.Extra, err)
+		return
+	}
+	if ch.ID != "" && !i.Cache.Claim(ctx, "change:"+ch.ID, changeClaimTTL) {
+		return
+	}
+	repository.EvictChange(ctx, i.Cache, ch)
+}
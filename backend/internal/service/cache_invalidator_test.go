@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"awsome-prompt/backend/internal/cache"
+	"awsome-prompt/backend/internal/data"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// memoryCacheStore is an in-memory cache.Store.
+type memoryCacheStore struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func (s *memoryCacheStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	val, ok := s.data[key]
+	if !ok {
+		return "", data.ErrNotFound
+	}
+	return val, nil
+}
+
+func (s *memoryCacheStore) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = string(value.([]byte))
+	return nil
+}
+
+func (s *memoryCacheStore) Del(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data, key)
+	return nil
+}
+
+func (s *memoryCacheStore) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] += "1"
+	return int64(len(s.data[key])), nil
+}
+
+func (s *memoryCacheStore) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data[key]; ok {
+		return false, nil
+	}
+	s.data[key] = string(value.([]byte))
+	return true, nil
+}
+
+func TestCacheInvalidatorHandle(t *testing.T) {
+	ctx := context.Background()
+	filters := map[string]interface{}{"visibility": "public"}
+
+	setup := func() (*CacheInvalidator, *MockTemplateRepository, repository.TemplateRepository, *memoryCacheStore) {
+		store := &memoryCacheStore{data: make(map[string]string)}
+		c := cache.New(store, time.Minute)
+		mockRepo := new(MockTemplateRepository)
+		mockRepo.On("List", ctx, 10, (*repository.Cursor)(nil), filters).Return([]*models.Template{{ID: "t1", Title: "Cached"}}, nil)
+		return NewCacheInvalidator("", c), mockRepo, repository.NewCachedTemplateRepository(mockRepo, c), store
+	}
+
+	t.Run("TemplateChangeEvicts", func(t *testing.T) {
+		inv, mockRepo, repo, _ := setup()
+		_, _ = repo.List(ctx, 10, nil, filters)
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 1)
+
+		inv.Handle(ctx, &pq.Notification{Channel: repository.ChangeChannel, Extra: `{"kind":"template","template_id":"t1"}`})
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 2)
+	})
+
+	t.Run("VersionChangeKeepsListings", func(t *testing.T) {
+		inv, mockRepo, repo, _ := setup()
+		_, _ = repo.List(ctx, 10, nil, filters)
+
+		inv.Handle(ctx, &pq.Notification{Channel: repository.ChangeChannel, Extra: `{"kind":"version","template_id":"t2"}`})
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 1)
+	})
+
+	t.Run("ReconnectInvalidatesWithoutDeleting", func(t *testing.T) {
+		inv, mockRepo, repo, store := setup()
+		mockRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", Title: "Cached"}, nil)
+		_, _ = repo.List(ctx, 10, nil, filters)
+		_, _ = repo.Get(ctx, "t1", "")
+		entries := len(store.data)
+
+		inv.Handle(ctx, nil)
+		// Entries are orphaned by the new generations, not deleted.
+		assert.GreaterOrEqual(t, len(store.data), entries)
+		_, _ = repo.List(ctx, 10, nil, filters)
+		_, _ = repo.Get(ctx, "t1", "")
+		mockRepo.AssertNumberOfCalls(t, "List", 2)
+		mockRepo.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("ChangeHandledOnce", func(t *testing.T) {
+		inv, mockRepo, repo, _ := setup()
+		other := NewCacheInvalidator("", inv.Cache)
+		n := &pq.Notification{Channel: repository.ChangeChannel, Extra: `{"id":"n1","kind":"template","template_id":"t1"}`}
+
+		_, _ = repo.List(ctx, 10, nil, filters)
+		inv.Handle(ctx, n)
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 2)
+
+		// The other instance received the same change and leaves the cache alone.
+		other.Handle(ctx, n)
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 2)
+	})
+
+	t.Run("InvalidPayloadIgnored", func(t *testing.T) {
+		inv, mockRepo, repo, _ := setup()
+		_, _ = repo.List(ctx, 10, nil, filters)
+
+		inv.Handle(ctx, &pq.Notification{Channel: repository.ChangeChannel, Extra: "not json"})
+		_, _ = repo.List(ctx, 10, nil, filters)
+		mockRepo.AssertNumberOfCalls(t, "List", 1)
+	})
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// --- Collection RPCs ---
+
+// CreateCollection creates a new collection owned by the current user.
+func (s *PromptService) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.CreateCollection: user_id=%s name=%s", userID, req.Name)
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	visibility := "private"
+	if req.Visibility == pb.Visibility_VISIBILITY_PUBLIC {
+		visibility = "public"
+	}
+
+	collection := &models.Collection{
+		OwnerID:     userID,
+		Name:        req.Name,
+		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		Visibility:  visibility,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := s.CollectionRepo.Create(ctx, collection); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.create", collection.ID, collection.OwnerID, nil, collectionAuditSummary(collection))
+
+	return &pb.CreateCollectionResponse{Collection: s.collectionModelToProto(collection)}, nil
+}
+
+// GetCollection retrieves a collection visible to the current user.
+func (s *PromptService) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.GetCollectionResponse, error) {
+	zap.S().Infof("PromptService.GetCollection: id=%s", req.Id)
+	collection, err := s.getCollectionFor(ctx, req.Id, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCollectionResponse{Collection: s.collectionModelToProto(collection)}, nil
+}
+
+// UpdateCollection updates the name, description and visibility of a collection.
+func (s *PromptService) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.UpdateCollectionResponse, error) {
+	zap.S().Infof("PromptService.UpdateCollection: id=%s", req.Id)
+	collection, err := s.getCollectionFor(ctx, req.Id, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	before := collectionAuditSummary(collection)
+
+	if req.Name != "" {
+		collection.Name = req.Name
+	}
+	// Allow clearing Description by sending empty string
+	collection.Description = sql.NullString{String: req.Description, Valid: req.Description != ""}
+	switch req.Visibility {
+	case pb.Visibility_VISIBILITY_PUBLIC:
+		collection.Visibility = "public"
+	case pb.Visibility_VISIBILITY_PRIVATE:
+		collection.Visibility = "private"
+	}
+	collection.UpdatedAt = time.Now()
+
+	if err := s.CollectionRepo.Update(ctx, collection); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.update", collection.ID, collection.OwnerID, before, collectionAuditSummary(collection))
+	return &pb.UpdateCollectionResponse{Collection: s.collectionModelToProto(collection)}, nil
+}
+
+// DeleteCollection deletes a collection owned by the current user.
+func (s *PromptService) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	zap.S().Infof("PromptService.DeleteCollection: id=%s", req.Id)
+	collection, err := s.getCollectionFor(ctx, req.Id, policy.Delete)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CollectionRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.delete", collection.ID, collection.OwnerID, collectionAuditSummary(collection), nil)
+	return &pb.DeleteCollectionResponse{Success: true}, nil
+}
+
+// ListCollections lists the collections of an owner, the collections followed by
+// the current user, or all public collections.
+func (s *PromptService) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	userID, _ := GetUserIDFromContext(ctx)
+	zap.S().Infof("PromptService.ListCollections: owner_id=%s followed=%t user_id=%s", req.OwnerId, req.Followed, userID)
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := make(map[string]interface{})
+	switch {
+	case req.Followed:
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+		filters["followed_by"] = userID
+		filters["visibility"] = "public"
+	case req.OwnerId != "":
+		filters["owner_id"] = req.OwnerId
+		if req.OwnerId != userID {
+			filters["visibility"] = "public"
+		}
+	default:
+		filters["visibility"] = "public"
+	}
+
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The current user only affects is_following, not which collections match.
+	filters["current_user_id"] = userID
+
+	collections, err := s.CollectionRepo.List(ctx, limit+1, after, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections: %v", err)
+	}
+	delete(filters, "current_user_id")
+
+	nextPageToken := ""
+	if len(collections) > limit {
+		collections = collections[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.CollectionCursor(collections[limit-1]), filters)
+	}
+
+	var pbCollections []*pb.Collection
+	for _, c := range collections {
+		pbCollections = append(pbCollections, s.collectionModelToProto(c))
+	}
+	return &pb.ListCollectionsResponse{Collections: pbCollections, NextPageToken: nextPageToken}, nil
+}
+
+// AddTemplateToCollection appends a template the current user can see to one of their collections.
+func (s *PromptService) AddTemplateToCollection(ctx context.Context, req *pb.AddTemplateToCollectionRequest) (*pb.AddTemplateToCollectionResponse, error) {
+	zap.S().Infof("PromptService.AddTemplateToCollection: collection_id=%s template_id=%s", req.CollectionId, req.TemplateId)
+	collection, err := s.getCollectionFor(ctx, req.CollectionId, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.CollectionRepo.AddItem(ctx, collection.ID, template.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add template to collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.add_template", collection.ID, collection.OwnerID, nil, map[string]interface{}{"template_id": template.ID})
+	updated, err := s.reloadCollection(ctx, collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddTemplateToCollectionResponse{Collection: updated}, nil
+}
+
+// RemoveTemplateFromCollection removes a template from one of the current user's collections.
+func (s *PromptService) RemoveTemplateFromCollection(ctx context.Context, req *pb.RemoveTemplateFromCollectionRequest) (*pb.RemoveTemplateFromCollectionResponse, error) {
+	zap.S().Infof("PromptService.RemoveTemplateFromCollection: collection_id=%s template_id=%s", req.CollectionId, req.TemplateId)
+	collection, err := s.getCollectionFor(ctx, req.CollectionId, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CollectionRepo.RemoveItem(ctx, req.CollectionId, req.TemplateId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove template from collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.remove_template", collection.ID, collection.OwnerID, map[string]interface{}{"template_id": req.TemplateId}, nil)
+	updated, err := s.reloadCollection(ctx, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveTemplateFromCollectionResponse{Collection: updated}, nil
+}
+
+// ReorderCollection sets the order of the templates of one of the current user's collections.
+func (s *PromptService) ReorderCollection(ctx context.Context, req *pb.ReorderCollectionRequest) (*pb.ReorderCollectionResponse, error) {
+	zap.S().Infof("PromptService.ReorderCollection: collection_id=%s count=%d", req.CollectionId, len(req.TemplateIds))
+	collection, err := s.getCollectionFor(ctx, req.CollectionId, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CollectionRepo.Reorder(ctx, req.CollectionId, req.TemplateIds); err != nil {
+		if errors.Is(err, repository.ErrCollectionOrderMismatch) {
+			return nil, status.Error(codes.InvalidArgument, "template_ids must list every template of the collection exactly once")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reorder collection: %v", err)
+	}
+	s.Audit.Record(ctx, "collection.reorder", collection.ID, collection.OwnerID, nil, map[string]interface{}{"template_ids": req.TemplateIds})
+	return &pb.ReorderCollectionResponse{Success: true}, nil
+}
+
+// ToggleFollowCollection toggles whether the current user follows a public collection.
+func (s *PromptService) ToggleFollowCollection(ctx context.Context, req *pb.ToggleFollowCollectionRequest) (*pb.ToggleFollowCollectionResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.ToggleFollowCollection: user_id=%s collection_id=%s", userID, req.CollectionId)
+
+	collection, err := s.getCollectionFor(ctx, req.CollectionId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Visibility != "public" {
+		return nil, status.Error(codes.FailedPrecondition, "only public collections can be followed")
+	}
+
+	isFollowing, count, err := s.CollectionRepo.ToggleFollow(ctx, userID, req.CollectionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to toggle follow: %v", err)
+	}
+	action := "collection.unfollow"
+	if isFollowing {
+		action = "collection.follow"
+	}
+	s.Audit.Record(ctx, action, collection.ID, collection.OwnerID, nil, map[string]interface{}{"follower_count": count})
+	return &pb.ToggleFollowCollectionResponse{IsFollowing: isFollowing, FollowerCount: count}, nil
+}
+
+// --- Collection Helpers ---
+
+// reloadCollection reloads a collection after its items changed so that its item count is current.
+func (s *PromptService) reloadCollection(ctx context.Context, id string) (*pb.Collection, error) {
+	userID, _ := GetUserIDFromContext(ctx)
+	collection, err := s.CollectionRepo.Get(ctx, id, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+	}
+	return s.collectionModelToProto(collection), nil
+}
+
+func (s *PromptService) collectionModelToProto(m *models.Collection) *pb.Collection {
+	if m == nil {
+		return nil
+	}
+	vis := pb.Visibility_VISIBILITY_PRIVATE
+	if m.Visibility == "public" {
+		vis = pb.Visibility_VISIBILITY_PUBLIC
+	}
+	return &pb.Collection{
+		Id:            m.ID,
+		OwnerId:       m.OwnerID,
+		Name:          m.Name,
+		Description:   m.Description.String,
+		Visibility:    vis,
+		ItemCount:     m.ItemCount,
+		FollowerCount: m.FollowerCount,
+		IsFollowing:   m.IsFollowing,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+		UpdatedAt:     timestamppb.New(m.UpdatedAt),
+	}
+}
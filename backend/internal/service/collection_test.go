@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockCollectionRepository is a mock implementation of repository.CollectionRepository
+type MockCollectionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCollectionRepository) Create(ctx context.Context, c *models.Collection) error {
+	args := m.Called(ctx, c)
+	c.ID = "c_new"
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) Update(ctx context.Context, c *models.Collection) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Collection, error) {
+	args := m.Called(ctx, id, currentUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Collection), args.Error(1)
+}
+func (m *MockCollectionRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.Collection, error) {
+	args := m.Called(ctx, limit, after, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Collection), args.Error(1)
+}
+func (m *MockCollectionRepository) AddItem(ctx context.Context, collectionID, templateID string) error {
+	args := m.Called(ctx, collectionID, templateID)
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) RemoveItem(ctx context.Context, collectionID, templateID string) error {
+	args := m.Called(ctx, collectionID, templateID)
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) Reorder(ctx context.Context, collectionID string, templateIDs []string) error {
+	args := m.Called(ctx, collectionID, templateIDs)
+	return args.Error(0)
+}
+func (m *MockCollectionRepository) ToggleFollow(ctx context.Context, userID, collectionID string) (bool, int32, error) {
+	args := m.Called(ctx, userID, collectionID)
+	return args.Bool(0), int32(args.Int(1)), args.Error(2)
+}
+
+func newCollectionTestService() (*PromptService, *MockCollectionRepository, *MockTemplateRepository) {
+	mockCollectionRepo := new(MockCollectionRepository)
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockShareRepo := new(MockShareRepository)
+	mockShareRepo.On("GetGrant", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, CollectionRepo: mockCollectionRepo, ShareRepo: mockShareRepo})
+	return svc, mockCollectionRepo, mockTemplateRepo
+}
+
+func TestCreateCollection(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockCollectionRepo.On("Create", ctx, mock.MatchedBy(func(c *models.Collection) bool {
+			return c.OwnerID == "alice" && c.Name == "Writing" && c.Visibility == "public"
+		})).Return(nil)
+
+		resp, err := svc.CreateCollection(ctx, &pb.CreateCollectionRequest{Name: "Writing", Visibility: pb.Visibility_VISIBILITY_PUBLIC})
+		assert.NoError(t, err)
+		assert.Equal(t, "c_new", resp.Collection.Id)
+		assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, resp.Collection.Visibility)
+	})
+
+	t.Run("NameRequired", func(t *testing.T) {
+		svc, _, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		_, err := svc.CreateCollection(ctx, &pb.CreateCollectionRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc, _, _ := newCollectionTestService()
+		_, err := svc.CreateCollection(context.Background(), &pb.CreateCollectionRequest{Name: "Writing"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestCollectionAccess(t *testing.T) {
+	private := &models.Collection{ID: "c1", OwnerID: "alice", Name: "Mine", Visibility: "private"}
+
+	t.Run("PrivateHiddenFromOthers", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockCollectionRepo.On("Get", ctx, "c1", "bob").Return(private, nil)
+
+		_, err := svc.GetCollection(ctx, &pb.GetCollectionRequest{Id: "c1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OnlyOwnerCanModify", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		public := &models.Collection{ID: "c2", OwnerID: "alice", Visibility: "public"}
+		mockCollectionRepo.On("Get", ctx, "c2", "bob").Return(public, nil)
+
+		_, err := svc.DeleteCollection(ctx, &pb.DeleteCollectionRequest{Id: "c2"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockCollectionRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("FollowRequiresPublic", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockCollectionRepo.On("Get", ctx, "c1", "alice").Return(private, nil)
+
+		_, err := svc.ToggleFollowCollection(ctx, &pb.ToggleFollowCollectionRequest{CollectionId: "c1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestAddTemplateToCollection(t *testing.T) {
+	collection := &models.Collection{ID: "c1", OwnerID: "alice", Visibility: "private"}
+
+	t.Run("VisibleTemplate", func(t *testing.T) {
+		svc, mockCollectionRepo, mockTemplateRepo := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockCollectionRepo.On("Get", ctx, "c1", "alice").Return(collection, nil)
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "bob", Visibility: "public"}, nil)
+		mockCollectionRepo.On("AddItem", ctx, "c1", "t1").Return(nil)
+
+		_, err := svc.AddTemplateToCollection(ctx, &pb.AddTemplateToCollectionRequest{CollectionId: "c1", TemplateId: "t1"})
+		assert.NoError(t, err)
+		mockCollectionRepo.AssertExpectations(t)
+	})
+
+	t.Run("OthersPrivateTemplate", func(t *testing.T) {
+		svc, mockCollectionRepo, mockTemplateRepo := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockCollectionRepo.On("Get", ctx, "c1", "alice").Return(collection, nil)
+		mockTemplateRepo.On("Get", ctx, "t2", "").Return(&models.Template{ID: "t2", OwnerID: "bob", Visibility: "private"}, nil)
+
+		_, err := svc.AddTemplateToCollection(ctx, &pb.AddTemplateToCollectionRequest{CollectionId: "c1", TemplateId: "t2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		mockCollectionRepo.AssertNotCalled(t, "AddItem", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReorderCollection(t *testing.T) {
+	svc, mockCollectionRepo, _ := newCollectionTestService()
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockCollectionRepo.On("Get", ctx, "c1", "alice").Return(&models.Collection{ID: "c1", OwnerID: "alice", Visibility: "private"}, nil)
+	mockCollectionRepo.On("Reorder", ctx, "c1", []string{"t1"}).Return(repository.ErrCollectionOrderMismatch)
+	mockCollectionRepo.On("Reorder", ctx, "c1", []string{"t2", "t1"}).Return(nil)
+
+	_, err := svc.ReorderCollection(ctx, &pb.ReorderCollectionRequest{CollectionId: "c1", TemplateIds: []string{"t1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := svc.ReorderCollection(ctx, &pb.ReorderCollectionRequest{CollectionId: "c1", TemplateIds: []string{"t2", "t1"}})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestListCollections(t *testing.T) {
+	t.Run("OthersOnlyPublic", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockCollectionRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{
+			"owner_id":        "alice",
+			"visibility":      "public",
+			"current_user_id": "bob",
+		}).Return([]*models.Collection{{ID: "c2", OwnerID: "alice", Visibility: "public"}}, nil)
+
+		resp, err := svc.ListCollections(ctx, &pb.ListCollectionsRequest{OwnerId: "alice"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Collections, 1)
+	})
+
+	t.Run("FollowedRequiresAuth", func(t *testing.T) {
+		svc, _, _ := newCollectionTestService()
+		_, err := svc.ListCollections(context.Background(), &pb.ListCollectionsRequest{Followed: true})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestListTemplatesByCollection(t *testing.T) {
+	t.Run("OrderedAndFilteredToVisible", func(t *testing.T) {
+		svc, mockCollectionRepo, mockTemplateRepo := newCollectionTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockCollectionRepo.On("Get", ctx, "c2", "bob").Return(&models.Collection{ID: "c2", OwnerID: "alice", Visibility: "public"}, nil)
+		mockTemplateRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{
+			"collection_id":   "c2",
+			"sort":            "position",
+			"visible_to":      "bob",
+			"current_user_id": "bob",
+		}).Return([]*models.Template{{ID: "t1", Visibility: "public", CollectionPosition: 1}}, nil)
+
+		resp, err := svc.ListTemplates(ctx, &pb.ListTemplatesRequest{CollectionId: "c2"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 1)
+		assert.Empty(t, resp.PrivateTemplates)
+	})
+
+	t.Run("PrivateCollectionOfOthers", func(t *testing.T) {
+		svc, mockCollectionRepo, _ := newCollectionTestService()
+		mockCollectionRepo.On("Get", mock.Anything, "c1", "").Return(nil, errors.New("collection not found"))
+
+		_, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{CollectionId: "c1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
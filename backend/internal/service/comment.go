@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/markdown"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// maxCommentLength caps the Markdown source of a comment, in characters.
+const maxCommentLength = 10000
+
+// CreateComment posts a comment on a template the caller can read, or a reply to
+// one of its comments. Replies always refer to the version of their parent.
+func (s *PromptService) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.CreateComment: template_id=%s parent_id=%s version=%d user_id=%s", req.TemplateId, req.ParentId, req.Version, userID)
+
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		TemplateID: template.ID,
+		AuthorID:   userID,
+		Body:       body,
+		BodyHTML:   markdown.Render(body),
+	}
+	parentAuthorID := ""
+	if req.ParentId != "" {
+		parent, err := s.CommentRepo.Get(ctx, req.ParentId)
+		if err != nil || parent.TemplateID != template.ID {
+			return nil, status.Error(codes.InvalidArgument, "parent comment not found on this template")
+		}
+		if parent.Deleted() {
+			return nil, status.Error(codes.FailedPrecondition, "cannot reply to a deleted comment")
+		}
+		comment.ParentID = sql.NullString{String: parent.ID, Valid: true}
+		comment.Version = parent.Version
+		parentAuthorID = parent.AuthorID
+	} else if req.Version != 0 {
+		latest, err := s.TemplateVersionRepo.GetLatest(ctx, template.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get latest version: %v", err)
+		}
+		if latest == nil || req.Version < 1 || req.Version > latest.Version {
+			return nil, status.Errorf(codes.InvalidArgument, "version %d does not exist", req.Version)
+		}
+		comment.Version = sql.NullInt32{Int32: req.Version, Valid: true}
+	}
+
+	if err := s.CommentRepo.Create(ctx, comment); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	s.Audit.Record(ctx, "comment.create", comment.ID, comment.AuthorID, nil, commentAuditSummary(comment))
+	// The parent author is told about the reply; the template owner gets a
+	// comment notification unless they were just told about it as the parent author.
+	s.notify(ctx, parentAuthorID, "reply", userID, template.ID, comment.ID)
+	if template.OwnerID != parentAuthorID {
+		s.notify(ctx, template.OwnerID, "comment", userID, template.ID, comment.ID)
+	}
+	return &pb.CreateCommentResponse{Comment: commentModelToProto(comment)}, nil
+}
+
+// UpdateComment replaces the body of the caller's own comment.
+func (s *PromptService) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.UpdateCommentResponse, error) {
+	zap.S().Infof("PromptService.UpdateComment: id=%s", req.Id)
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.getCommentFor(ctx, req.Id, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	before := commentAuditSummary(comment)
+
+	comment.Body = body
+	comment.BodyHTML = markdown.Render(body)
+	if err := s.CommentRepo.Update(ctx, comment); err != nil {
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update comment: %v", err)
+	}
+	s.Audit.Record(ctx, "comment.update", comment.ID, comment.AuthorID, before, commentAuditSummary(comment))
+	return &pb.UpdateCommentResponse{Comment: commentModelToProto(comment)}, nil
+}
+
+// DeleteComment deletes a comment. Its replies stay in place under an empty placeholder.
+func (s *PromptService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	zap.S().Infof("PromptService.DeleteComment: id=%s", req.Id)
+	comment, err := s.getCommentFor(ctx, req.Id, policy.Delete)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CommentRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+	s.Audit.Record(ctx, "comment.delete", comment.ID, comment.AuthorID, commentAuditSummary(comment), nil)
+	return &pb.DeleteCommentResponse{Success: true}, nil
+}
+
+// ListComments lists the top-level comments of a template the caller can read,
+// or the replies to one of them, oldest first.
+func (s *PromptService) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	zap.S().Infof("PromptService.ListComments: template_id=%s parent_id=%s page_size=%d", req.TemplateId, req.ParentId, req.PageSize)
+	if req.TemplateId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template_id is required")
+	}
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if err := s.authorizeTemplate(ctx, principal(ctx), policy.Read, template, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := map[string]interface{}{"template_id": req.TemplateId, "parent_id": req.ParentId}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	comments, err := s.CommentRepo.List(ctx, template.ID, req.ParentId, limit+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list comments: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(comments) > limit {
+		comments = comments[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.CommentCursor(comments[limit-1]), filters)
+	}
+
+	var pbComments []*pb.Comment
+	for _, c := range comments {
+		pbComments = append(pbComments, commentModelToProto(c))
+	}
+	return &pb.ListCommentsResponse{Comments: pbComments, NextPageToken: nextPageToken}, nil
+}
+
+// getCommentFor returns a live comment if the caller may perform the action on it.
+// The caller must also still be able to read the template the comment is on.
+func (s *PromptService) getCommentFor(ctx context.Context, id string, action policy.Action) (*models.Comment, error) {
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "comment")
+	}
+	comment, err := s.CommentRepo.Get(ctx, id)
+	if err != nil || comment.Deleted() {
+		return nil, status.Errorf(codes.NotFound, "comment not found")
+	}
+	template, err := s.getTemplateFor(ctx, comment.TemplateID, policy.Read)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "comment not found")
+		}
+		return nil, err
+	}
+	if err := authorize(policy.CommentOn(p, action, comment, template), "comment"); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// validateCommentBody trims a comment body and checks its length.
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", status.Error(codes.InvalidArgument, "body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", status.Errorf(codes.InvalidArgument, "body must be at most %d characters", maxCommentLength)
+	}
+	return body, nil
+}
+
+func commentModelToProto(m *models.Comment) *pb.Comment {
+	c := &pb.Comment{
+		Id:                m.ID,
+		TemplateId:        m.TemplateID,
+		ParentId:          m.ParentID.String,
+		Version:           m.Version.Int32,
+		AuthorId:          m.AuthorID,
+		AuthorDisplayName: m.AuthorName,
+		Body:              m.Body,
+		BodyHtml:          m.BodyHTML,
+		ReplyCount:        m.ReplyCount,
+		Deleted:           m.Deleted(),
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+	}
+	if m.EditedAt.Valid {
+		c.EditedAt = timestamppb.New(m.EditedAt.Time)
+	}
+	return c
+}
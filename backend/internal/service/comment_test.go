@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockCommentRepository is a mock implementation of repository.CommentRepository
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, c *models.Comment) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+func (m *MockCommentRepository) Get(ctx context.Context, id string) (*models.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+func (m *MockCommentRepository) List(ctx context.Context, templateID, parentID string, limit int, after *repository.Cursor) ([]*models.Comment, error) {
+	args := m.Called(ctx, templateID, parentID, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Comment), args.Error(1)
+}
+func (m *MockCommentRepository) Update(ctx context.Context, c *models.Comment) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+func (m *MockCommentRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// latestVersionRepository reports a fixed latest version for every template.
+type latestVersionRepository struct {
+	MockTemplateVersionRepository
+	latest int32
+}
+
+func (r *latestVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
+	return &models.TemplateVersion{TemplateID: templateID, Version: r.latest}, nil
+}
+
+func TestCreateComment(t *testing.T) {
+	template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}
+
+	t.Run("RendersMarkdown", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockCommentRepo := new(MockCommentRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockCommentRepo.On("Create", ctx, mock.MatchedBy(func(c *models.Comment) bool {
+			return c.AuthorID == "bob" && c.Version.Int32 == 2 && !c.ParentID.Valid
+		})).Return(nil)
+
+		resp, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{TemplateId: "t1", Version: 2, Body: " **nice** <script> "})
+		assert.NoError(t, err)
+		assert.Equal(t, "**nice** <script>", resp.Comment.Body)
+		assert.Equal(t, "<p><strong>nice</strong> &lt;script&gt;</p>", resp.Comment.BodyHtml)
+	})
+
+	t.Run("ReplyInheritsVersion", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockCommentRepo := new(MockCommentRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockCommentRepo.On("Get", ctx, "c1").Return(&models.Comment{ID: "c1", TemplateID: "t1", Version: sql.NullInt32{Int32: 1, Valid: true}}, nil)
+		mockCommentRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+		resp, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{TemplateId: "t1", ParentId: "c1", Version: 3, Body: "agreed"})
+		assert.NoError(t, err)
+		assert.Equal(t, "c1", resp.Comment.ParentId)
+		assert.Equal(t, int32(1), resp.Comment.Version)
+	})
+
+	t.Run("ParentOnOtherTemplate", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockCommentRepo := new(MockCommentRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockCommentRepo.On("Get", ctx, "c9").Return(&models.Comment{ID: "c9", TemplateID: "t2"}, nil)
+
+		_, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{TemplateId: "t1", ParentId: "c9", Body: "hi"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockCommentRepo := new(MockCommentRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+
+		_, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{TemplateId: "t1", Version: 4, Body: "hi"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("EmptyBody", func(t *testing.T) {
+		svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: new(MockCommentRepository)})
+
+		_, err := svc.CreateComment(ContextWithUserID(context.Background(), "bob"), &pb.CreateCommentRequest{TemplateId: "t1", Body: "  "})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: new(MockCommentRepository)})
+
+		_, err := svc.CreateComment(context.Background(), &pb.CreateCommentRequest{TemplateId: "t1", Body: "hi"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestUpdateAndDeleteComment(t *testing.T) {
+	template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}
+	comment := func() *models.Comment {
+		return &models.Comment{ID: "c1", TemplateID: "t1", AuthorID: "bob", Body: "old"}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantUpdate codes.Code
+		wantDelete codes.Code
+	}{
+		{"Author", ContextWithUserID(context.Background(), "bob"), codes.OK, codes.OK},
+		{"TemplateOwner", ContextWithUserID(context.Background(), "alice"), codes.PermissionDenied, codes.OK},
+		{"Admin", adminContext("root"), codes.PermissionDenied, codes.OK},
+		{"Stranger", ContextWithUserID(context.Background(), "carol"), codes.PermissionDenied, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplateRepo := new(MockTemplateRepository)
+			mockCommentRepo := new(MockCommentRepository)
+			svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+			mockTemplateRepo.On("Get", tt.ctx, "t1", "").Return(template, nil)
+			mockCommentRepo.On("Get", tt.ctx, "c1").Return(comment(), nil)
+			mockCommentRepo.On("Update", tt.ctx, mock.Anything).Run(func(args mock.Arguments) {
+				args.Get(1).(*models.Comment).EditedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}).Return(nil)
+			mockCommentRepo.On("Delete", tt.ctx, "c1").Return(nil)
+
+			resp, err := svc.UpdateComment(tt.ctx, &pb.UpdateCommentRequest{Id: "c1", Body: "*new*"})
+			assert.Equal(t, tt.wantUpdate, status.Code(err))
+			if err == nil {
+				assert.Equal(t, "<p><em>new</em></p>", resp.Comment.BodyHtml)
+				assert.NotNil(t, resp.Comment.EditedAt)
+			}
+
+			_, err = svc.DeleteComment(tt.ctx, &pb.DeleteCommentRequest{Id: "c1"})
+			assert.Equal(t, tt.wantDelete, status.Code(err))
+		})
+	}
+
+	t.Run("AlreadyDeleted", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		deleted := comment()
+		deleted.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		mockCommentRepo.On("Get", ctx, "c1").Return(deleted, nil)
+
+		_, err := svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: "c1", Body: "again"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListComments(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockCommentRepo := new(MockCommentRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo})
+	ctx := context.Background()
+	mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+
+	now := time.Now()
+	comments := []*models.Comment{
+		{ID: "c1", TemplateID: "t1", AuthorID: "bob", Body: "first", CreatedAt: now, ReplyCount: 2},
+		{ID: "c2", TemplateID: "t1", AuthorID: "carol", DeletedAt: sql.NullTime{Time: now, Valid: true}, CreatedAt: now.Add(time.Second), ReplyCount: 1},
+		{ID: "c3", TemplateID: "t1", AuthorID: "dave", Body: "third", CreatedAt: now.Add(2 * time.Second)},
+	}
+	mockCommentRepo.On("List", ctx, "t1", "", 3, (*repository.Cursor)(nil)).Return(comments, nil)
+
+	resp, err := svc.ListComments(ctx, &pb.ListCommentsRequest{TemplateId: "t1", PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Comments, 2)
+	assert.Equal(t, int32(2), resp.Comments[0].ReplyCount)
+	assert.True(t, resp.Comments[1].Deleted)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	// The page token is bound to the thread it was issued for.
+	_, err = svc.ListComments(ctx, &pb.ListCommentsRequest{TemplateId: "t1", ParentId: "c1", PageToken: resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -10,6 +10,8 @@ import (
 
 type EmailService interface {
 	SendVerificationCode(toEmail, code, lang string) error
+	SendOrganizationInvitation(toEmail, orgName, inviterName, token, lang string) error
+	SendModerationWarning(toEmail, templateTitle, note, lang string) error
 }
 
 type emailService struct {
@@ -47,6 +49,68 @@ func (s *emailService) SendVerificationCode(toEmail, code, lang string) error {
 		body = fmt.Sprintf("Your verification code is: %s\nThis code is valid for 5 minutes.", code)
 	}
 
+	if err := s.send(toEmail, subject, body); err != nil {
+		return err
+	}
+	zap.S().Infof("Sent verification code to %s", toEmail)
+	return nil
+}
+
+func (s *emailService) SendOrganizationInvitation(toEmail, orgName, inviterName, token, lang string) error {
+	if s.smtpHost == "" || s.smtpPort == "" {
+		zap.S().Warnf("SMTP configuration missing. Skipping invitation to %s. Token: %s", toEmail, token)
+		return nil
+	}
+
+	var subject, body string
+
+	if lang == "zh" {
+		subject = fmt.Sprintf("%s 邀请您加入 Awsome Prompt 上的 %s", inviterName, orgName)
+		body = fmt.Sprintf("%s 邀请您加入组织 %s。\n登录后使用以下邀请码接受邀请: %s\n该邀请7天内有效。", inviterName, orgName, token)
+	} else {
+		subject = fmt.Sprintf("%s invited you to join %s on Awsome Prompt", inviterName, orgName)
+		body = fmt.Sprintf("%s invited you to join the organization %s.\nSign in and accept the invitation with this code: %s\nThis invitation is valid for 7 days.", inviterName, orgName, token)
+	}
+
+	if err := s.send(toEmail, subject, body); err != nil {
+		return err
+	}
+	zap.S().Infof("Sent organization invitation to %s", toEmail)
+	return nil
+}
+
+// SendModerationWarning tells the owner of a template that moderators removed it.
+func (s *emailService) SendModerationWarning(toEmail, templateTitle, note, lang string) error {
+	if s.smtpHost == "" || s.smtpPort == "" {
+		zap.S().Warnf("SMTP configuration missing. Skipping moderation warning to %s", toEmail)
+		return nil
+	}
+
+	var subject, body string
+
+	if lang == "zh" {
+		subject = "您在 Awsome Prompt 上的模板已被移除"
+		body = fmt.Sprintf("您的模板 \"%s\" 因违反社区准则已被管理员移除。", templateTitle)
+		if note != "" {
+			body += fmt.Sprintf("\n说明: %s", note)
+		}
+	} else {
+		subject = "Your template was removed from Awsome Prompt"
+		body = fmt.Sprintf("Your template \"%s\" was removed by the moderators for breaking the community guidelines.", templateTitle)
+		if note != "" {
+			body += fmt.Sprintf("\nNote: %s", note)
+		}
+	}
+
+	if err := s.send(toEmail, subject, body); err != nil {
+		return err
+	}
+	zap.S().Infof("Sent moderation warning to %s", toEmail)
+	return nil
+}
+
+// send sends a plain text email.
+func (s *emailService) send(toEmail, subject, body string) error {
 	// Simple text email
 	msg := []byte(fmt.Sprintf("From: %s\r\n"+
 		"To: %s\r\n"+
@@ -117,7 +181,5 @@ func (s *emailService) SendVerificationCode(toEmail, code, lang string) error {
 			return err
 		}
 	}
-
-	zap.S().Infof("Sent verification code to %s", toEmail)
 	return nil
 }
@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/evaluation"
+	"awsome-prompt/backend/internal/llm"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// maxEvaluationCases bounds the test cases of a template, which all run on
+// each version evaluated.
+const maxEvaluationCases = 50
+
+// maxEvaluationCaseRuns bounds the cases an evaluation runs, summed over the
+// versions evaluated, as they all run on the model within the request.
+const maxEvaluationCaseRuns = 200
+
+// assertionTypes maps the assertion types of the API to the stored ones.
+var assertionTypes = map[pb.AssertionType]string{
+	pb.AssertionType_ASSERTION_TYPE_CONTAINS:    evaluation.TypeContains,
+	pb.AssertionType_ASSERTION_TYPE_REGEX:       evaluation.TypeRegex,
+	pb.AssertionType_ASSERTION_TYPE_JSON_SCHEMA: evaluation.TypeJSONSchema,
+	pb.AssertionType_ASSERTION_TYPE_MAX_LENGTH:  evaluation.TypeMaxLength,
+	pb.AssertionType_ASSERTION_TYPE_JUDGE:       evaluation.TypeJudge,
+}
+
+// CreateEvaluationCase adds a test case to a template the caller may edit.
+func (s *PromptService) CreateEvaluationCase(ctx context.Context, req *pb.CreateEvaluationCaseRequest) (*pb.CreateEvaluationCaseResponse, error) {
+	zap.S().Infof("PromptService.CreateEvaluationCase: template_id=%s name=%s", req.TemplateId, req.Name)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	c := &models.EvaluationCase{TemplateID: template.ID, CreatedBy: principal(ctx).UserID}
+	if err := setEvaluationCase(c, req.Name, req.Variables, req.NamedVariables, req.Assertions); err != nil {
+		return nil, err
+	}
+	if c.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	cases, err := s.EvaluationRepo.ListCases(ctx, template.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list evaluation cases: %v", err)
+	}
+	if len(cases) >= maxEvaluationCases {
+		return nil, status.Errorf(codes.FailedPrecondition, "a template has at most %d test cases", maxEvaluationCases)
+	}
+
+	if err := s.EvaluationRepo.CreateCase(ctx, c); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create evaluation case: %v", err)
+	}
+	return &pb.CreateEvaluationCaseResponse{Case: evaluationCaseModelToProto(c)}, nil
+}
+
+// UpdateEvaluationCase replaces the name, values and assertions of a test case.
+func (s *PromptService) UpdateEvaluationCase(ctx context.Context, req *pb.UpdateEvaluationCaseRequest) (*pb.UpdateEvaluationCaseResponse, error) {
+	zap.S().Infof("PromptService.UpdateEvaluationCase: id=%s", req.Id)
+	c, err := s.getEvaluationCaseFor(ctx, req.Id, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	if err := setEvaluationCase(c, req.Name, req.Variables, req.NamedVariables, req.Assertions); err != nil {
+		return nil, err
+	}
+	c.UpdatedAt = time.Now()
+
+	if err := s.EvaluationRepo.UpdateCase(ctx, c); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update evaluation case: %v", err)
+	}
+	return &pb.UpdateEvaluationCaseResponse{Case: evaluationCaseModelToProto(c)}, nil
+}
+
+// DeleteEvaluationCase deletes a test case. The results of past runs are kept.
+func (s *PromptService) DeleteEvaluationCase(ctx context.Context, req *pb.DeleteEvaluationCaseRequest) (*pb.DeleteEvaluationCaseResponse, error) {
+	zap.S().Infof("PromptService.DeleteEvaluationCase: id=%s", req.Id)
+	c, err := s.getEvaluationCaseFor(ctx, req.Id, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.EvaluationRepo.DeleteCase(ctx, c.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete evaluation case: %v", err)
+	}
+	return &pb.DeleteEvaluationCaseResponse{Success: true}, nil
+}
+
+// ListEvaluationCases lists the test cases of a template the caller may edit.
+func (s *PromptService) ListEvaluationCases(ctx context.Context, req *pb.ListEvaluationCasesRequest) (*pb.ListEvaluationCasesResponse, error) {
+	zap.S().Infof("PromptService.ListEvaluationCases: template_id=%s", req.TemplateId)
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update); err != nil {
+		return nil, err
+	}
+	cases, err := s.EvaluationRepo.ListCases(ctx, req.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list evaluation cases: %v", err)
+	}
+	pbCases := make([]*pb.EvaluationCase, len(cases))
+	for i, c := range cases {
+		pbCases[i] = evaluationCaseModelToProto(c)
+	}
+	return &pb.ListEvaluationCasesResponse{Cases: pbCases}, nil
+}
+
+// RunEvaluation runs the test cases of a template on a version, the latest by
+// default, or on every version, and stores one run per version. Errors of the
+// provider fail the cases they happen in, not the evaluation.
+func (s *PromptService) RunEvaluation(ctx context.Context, req *pb.RunEvaluationRequest) (*pb.RunEvaluationResponse, error) {
+	zap.S().Infof("PromptService.RunEvaluation: template_id=%s version_id=%d all_versions=%t", req.TemplateId, req.VersionId, req.AllVersions)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	if s.Provider == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no model provider is configured")
+	}
+	if req.AllVersions && req.VersionId != 0 {
+		return nil, status.Error(codes.InvalidArgument, "version_id and all_versions are exclusive")
+	}
+	base, err := s.modelRequest(req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	cases, err := s.EvaluationRepo.ListCases(ctx, template.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list evaluation cases: %v", err)
+	}
+	if len(cases) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "the template has no test cases")
+	}
+	versions, err := s.evaluationVersions(ctx, template.ID, req.VersionId, req.AllVersions)
+	if err != nil {
+		return nil, err
+	}
+	if n := len(versions) * len(cases); n > maxEvaluationCaseRuns {
+		return nil, status.Errorf(codes.InvalidArgument, "running %d test cases on %d versions exceeds the limit of %d case runs; evaluate fewer versions", len(cases), len(versions), maxEvaluationCaseRuns)
+	}
+
+	checker := &evaluation.Checker{Provider: s.Provider, Model: base.Model}
+	runs := make([]*pb.EvaluationRun, 0, len(versions))
+	for _, version := range versions {
+		run := &models.EvaluationRun{
+			TemplateID: template.ID,
+			VersionID:  version.ID,
+			Version:    version.Version,
+			CreatedBy:  principal(ctx).UserID,
+			Provider:   s.Provider.Name(),
+			Model:      base.Model,
+		}
+		results := make([]evaluation.CaseResult, len(cases))
+		for i, c := range cases {
+			if results[i], err = s.runEvaluationCase(ctx, template, version, c, base, checker); err != nil {
+				return nil, err
+			}
+			if results[i].Passed {
+				run.Passed++
+			} else {
+				run.Failed++
+			}
+		}
+		run.Results, _ = json.Marshal(results)
+
+		if err := s.EvaluationRepo.CreateRun(ctx, run); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save evaluation run: %v", err)
+		}
+		runs = append(runs, evaluationRunModelToProto(run))
+	}
+	return &pb.RunEvaluationResponse{Runs: runs}, nil
+}
+
+// ListEvaluationRuns lists the runs of the test cases of a template the caller
+// may edit, latest first.
+func (s *PromptService) ListEvaluationRuns(ctx context.Context, req *pb.ListEvaluationRunsRequest) (*pb.ListEvaluationRunsResponse, error) {
+	zap.S().Infof("PromptService.ListEvaluationRuns: template_id=%s version_id=%d page_size=%d", req.TemplateId, req.VersionId, req.PageSize)
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update); err != nil {
+		return nil, err
+	}
+	filters := map[string]interface{}{"template_id": req.TemplateId}
+	if req.VersionId != 0 {
+		filters["version_id"] = req.VersionId
+	}
+
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	runs, err := s.EvaluationRepo.ListRuns(ctx, limit+1, after, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list evaluation runs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(runs) > limit {
+		runs = runs[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.EvaluationRunCursor(runs[limit-1]), filters)
+	}
+
+	pbRuns := make([]*pb.EvaluationRun, len(runs))
+	for i, run := range runs {
+		pbRuns[i] = evaluationRunModelToProto(run)
+	}
+	return &pb.ListEvaluationRunsResponse{Runs: pbRuns, NextPageToken: nextPageToken}, nil
+}
+
+// CompareEvaluationRuns puts the latest runs of two versions of a template side
+// by side, case by case, in the order of the base run.
+func (s *PromptService) CompareEvaluationRuns(ctx context.Context, req *pb.CompareEvaluationRunsRequest) (*pb.CompareEvaluationRunsResponse, error) {
+	zap.S().Infof("PromptService.CompareEvaluationRuns: template_id=%s base_version_id=%d head_version_id=%d", req.TemplateId, req.BaseVersionId, req.HeadVersionId)
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update); err != nil {
+		return nil, err
+	}
+	if req.BaseVersionId == 0 || req.HeadVersionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "base_version_id and head_version_id are required")
+	}
+	base, err := s.latestEvaluationRun(ctx, req.TemplateId, req.BaseVersionId)
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.latestEvaluationRun(ctx, req.TemplateId, req.HeadVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRun, headRun := evaluationRunModelToProto(base), evaluationRunModelToProto(head)
+	headResults := make(map[string]*pb.EvaluationResult, len(headRun.Results))
+	for _, r := range headRun.Results {
+		headResults[r.CaseId] = r
+	}
+	var comparisons []*pb.EvaluationComparison
+	for _, r := range baseRun.Results {
+		comparisons = append(comparisons, compareEvaluationResults(r.CaseId, r.CaseName, r, headResults[r.CaseId]))
+		delete(headResults, r.CaseId)
+	}
+	for _, r := range headRun.Results {
+		if _, ok := headResults[r.CaseId]; ok {
+			comparisons = append(comparisons, compareEvaluationResults(r.CaseId, r.CaseName, nil, r))
+		}
+	}
+
+	baseRun.Results, headRun.Results = nil, nil
+	return &pb.CompareEvaluationRunsResponse{Base: baseRun, Head: headRun, Cases: comparisons}, nil
+}
+
+// evaluationVersions returns the versions of a template an evaluation runs on:
+// all of them, oldest first, the one of versionID, or the latest.
+func (s *PromptService) evaluationVersions(ctx context.Context, templateID string, versionID int32, all bool) ([]*models.TemplateVersion, error) {
+	if !all {
+		var version *models.TemplateVersion
+		var err error
+		if versionID != 0 {
+			version, err = s.TemplateVersionRepo.Get(ctx, templateID, versionID)
+		} else {
+			version, err = s.TemplateVersionRepo.GetLatest(ctx, templateID)
+		}
+		if err != nil || version == nil {
+			return nil, status.Errorf(codes.NotFound, "version not found")
+		}
+		return []*models.TemplateVersion{version}, nil
+	}
+
+	const pageSize = 100
+	var versions []*models.TemplateVersion
+	var after *repository.Cursor
+	for {
+		page, err := s.TemplateVersionRepo.List(ctx, pageSize, after, templateID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list versions: %v", err)
+		}
+		versions = append(versions, page...)
+		if len(page) < pageSize {
+			break
+		}
+		after = repository.TemplateVersionCursor(page[len(page)-1])
+	}
+	slices.Reverse(versions)
+	return versions, nil
+}
+
+// runEvaluationCase renders a test case with version, runs it and checks its
+// assertions. An error is returned only when the caller went away.
+func (s *PromptService) runEvaluationCase(ctx context.Context, template *models.Template, version *models.TemplateVersion, c *models.EvaluationCase, base *llm.Request, checker *evaluation.Checker) (evaluation.CaseResult, error) {
+	result := evaluation.CaseResult{CaseID: c.ID, CaseName: c.Name, Assertions: []evaluation.Result{}}
+	var explicit map[string]string
+	_ = json.Unmarshal(c.NamedVariables, &explicit)
+	named, _, err := s.namedValues(ctx, template, version, "", explicit)
+	if err != nil {
+		result.Error = status.Convert(err).Message()
+		return result, nil
+	}
+	result.Input = renderContent(version.Content, c.Variables, named)
+
+	llmReq := *base
+	llmReq.Messages = append(slices.Clone(base.Messages), llm.Message{Role: llm.RoleUser, Content: result.Input})
+	start := time.Now()
+	resp, err := s.Provider.Complete(ctx, &llmReq)
+	result.LatencyMs = int32(time.Since(start).Milliseconds())
+	if err != nil {
+		if ctx.Err() != nil {
+			return result, status.FromContextError(ctx.Err()).Err()
+		}
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Output = resp.Text
+
+	var assertions []evaluation.Assertion
+	_ = json.Unmarshal(c.Assertions, &assertions)
+	result.Passed = true
+	for _, a := range assertions {
+		r, err := checker.Check(ctx, a, result.Output)
+		if err != nil {
+			r = evaluation.Result{Assertion: a, Message: "the judge failed: " + err.Error()}
+		}
+		result.Passed = result.Passed && r.Passed
+		result.Assertions = append(result.Assertions, r)
+	}
+	return result, nil
+}
+
+// latestEvaluationRun returns the latest run of a version of a template.
+func (s *PromptService) latestEvaluationRun(ctx context.Context, templateID string, versionID int32) (*models.EvaluationRun, error) {
+	run, err := s.EvaluationRepo.GetLatestRun(ctx, templateID, versionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get evaluation run: %v", err)
+	}
+	if run == nil {
+		return nil, status.Errorf(codes.NotFound, "version %d was never evaluated", versionID)
+	}
+	return run, nil
+}
+
+// compareEvaluationResults compares the results of a case on the base and
+// head versions; either may be nil when the case did not run on it.
+func compareEvaluationResults(caseID, caseName string, base, head *pb.EvaluationResult) *pb.EvaluationComparison {
+	comparison := &pb.EvaluationComparison{CaseId: caseID, CaseName: caseName, Base: base, Head: head}
+	switch {
+	case base == nil || head == nil:
+	case base.Passed == head.Passed:
+		comparison.Change = pb.EvaluationChange_EVALUATION_CHANGE_UNCHANGED
+	case head.Passed:
+		comparison.Change = pb.EvaluationChange_EVALUATION_CHANGE_FIXED
+	default:
+		comparison.Change = pb.EvaluationChange_EVALUATION_CHANGE_REGRESSED
+	}
+	return comparison
+}
+
+// setEvaluationCase validates and sets the fields of a test case. The name is
+// left unchanged when empty.
+func setEvaluationCase(c *models.EvaluationCase, name string, variables []string, named map[string]string, assertions []*pb.Assertion) error {
+	if len(assertions) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one assertion is required")
+	}
+	stored := make([]evaluation.Assertion, len(assertions))
+	for i, a := range assertions {
+		stored[i] = evaluation.Assertion{Type: assertionTypes[a.Type], Value: a.Value, MaxLength: a.MaxLength}
+		if stored[i].Type == "" {
+			return status.Errorf(codes.InvalidArgument, "assertion %d: type is required", i+1)
+		}
+		if err := stored[i].Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "assertion %d: %v", i+1, err)
+		}
+	}
+	namedJSON, err := presetValuesJSON(named)
+	if err != nil {
+		return err
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		c.Name = name
+	}
+	if variables == nil {
+		variables = []string{}
+	}
+	c.Variables = pq.StringArray(variables)
+	c.NamedVariables = namedJSON
+	c.Assertions, _ = json.Marshal(stored)
+	return nil
+}
+
+func assertionToProto(a evaluation.Assertion) *pb.Assertion {
+	pbAssertion := &pb.Assertion{Value: a.Value, MaxLength: a.MaxLength}
+	for t, name := range assertionTypes {
+		if name == a.Type {
+			pbAssertion.Type = t
+		}
+	}
+	return pbAssertion
+}
+
+func evaluationCaseModelToProto(m *models.EvaluationCase) *pb.EvaluationCase {
+	var named map[string]string
+	_ = json.Unmarshal(m.NamedVariables, &named)
+	var assertions []evaluation.Assertion
+	_ = json.Unmarshal(m.Assertions, &assertions)
+	pbAssertions := make([]*pb.Assertion, len(assertions))
+	for i, a := range assertions {
+		pbAssertions[i] = assertionToProto(a)
+	}
+	return &pb.EvaluationCase{
+		Id:             m.ID,
+		TemplateId:     m.TemplateID,
+		Name:           m.Name,
+		Variables:      m.Variables,
+		NamedVariables: named,
+		Assertions:     pbAssertions,
+		CreatedBy:      m.CreatedBy,
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+		UpdatedAt:      timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func evaluationRunModelToProto(m *models.EvaluationRun) *pb.EvaluationRun {
+	run := &pb.EvaluationRun{
+		Id:         m.ID,
+		TemplateId: m.TemplateID,
+		VersionId:  m.VersionID,
+		Version:    m.Version,
+		CreatedBy:  m.CreatedBy,
+		Provider:   m.Provider,
+		Model:      m.Model,
+		Passed:     m.Passed,
+		Failed:     m.Failed,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+	var results []evaluation.CaseResult
+	_ = json.Unmarshal(m.Results, &results)
+	for _, r := range results {
+		pbResult := &pb.EvaluationResult{
+			CaseId:    r.CaseID,
+			CaseName:  r.CaseName,
+			Input:     r.Input,
+			Output:    r.Output,
+			Passed:    r.Passed,
+			Error:     r.Error,
+			LatencyMs: r.LatencyMs,
+		}
+		for _, a := range r.Assertions {
+			pbResult.Assertions = append(pbResult.Assertions, &pb.AssertionResult{
+				Assertion: assertionToProto(a.Assertion),
+				Passed:    a.Passed,
+				Message:   a.Message,
+			})
+		}
+		run.Results = append(run.Results, pbResult)
+	}
+	return run
+}
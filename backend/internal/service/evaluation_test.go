@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/evaluation"
+	"awsome-prompt/backend/internal/llm"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockEvaluationRepository is a mock implementation of repository.EvaluationRepository
+type MockEvaluationRepository struct {
+	mock.Mock
+}
+
+func (m *MockEvaluationRepository) CreateCase(ctx context.Context, c *models.EvaluationCase) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+func (m *MockEvaluationRepository) GetCase(ctx context.Context, id string) (*models.EvaluationCase, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EvaluationCase), args.Error(1)
+}
+func (m *MockEvaluationRepository) UpdateCase(ctx context.Context, c *models.EvaluationCase) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+func (m *MockEvaluationRepository) DeleteCase(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+func (m *MockEvaluationRepository) ListCases(ctx context.Context, templateID string) ([]*models.EvaluationCase, error) {
+	args := m.Called(ctx, templateID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.EvaluationCase), args.Error(1)
+}
+func (m *MockEvaluationRepository) CreateRun(ctx context.Context, run *models.EvaluationRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+func (m *MockEvaluationRepository) GetLatestRun(ctx context.Context, templateID string, versionID int32) (*models.EvaluationRun, error) {
+	args := m.Called(ctx, templateID, versionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EvaluationRun), args.Error(1)
+}
+func (m *MockEvaluationRepository) ListRuns(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.EvaluationRun, error) {
+	args := m.Called(ctx, limit, after, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.EvaluationRun), args.Error(1)
+}
+
+func newEvaluationService() (*PromptService, *MockTemplateRepository, *MockTemplateVersionRepository, *MockEvaluationRepository) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockVersionRepo := new(MockTemplateVersionRepository)
+	mockEvaluationRepo := new(MockEvaluationRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo, ShareRepo: new(MockShareRepository), EvaluationRepo: mockEvaluationRepo, Provider: llm.NewFakeProvider(), DefaultModel: "small"})
+	return svc, mockTemplateRepo, mockVersionRepo, mockEvaluationRepo
+}
+
+func TestCreateEvaluationCase(t *testing.T) {
+	svc, mockTemplateRepo, _, mockEvaluationRepo := newEvaluationService()
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+	mockEvaluationRepo.On("ListCases", ctx, "t1").Return([]*models.EvaluationCase{}, nil)
+	mockEvaluationRepo.On("CreateCase", ctx, mock.MatchedBy(func(c *models.EvaluationCase) bool {
+		return c.TemplateID == "t1" && c.CreatedBy == "alice" && c.Name == "Budgets" &&
+			string(c.NamedVariables) == `{"tone":"formal"}` &&
+			string(c.Assertions) == `[{"type":"contains","value":"budget"},{"type":"max_length","max_length":200}]`
+	})).Return(nil)
+
+	resp, err := svc.CreateEvaluationCase(ctx, &pb.CreateEvaluationCaseRequest{
+		TemplateId:     "t1",
+		Name:           " Budgets ",
+		Variables:      []string{"budgets"},
+		NamedVariables: map[string]string{"tone": "formal"},
+		Assertions: []*pb.Assertion{
+			{Type: pb.AssertionType_ASSERTION_TYPE_CONTAINS, Value: "budget"},
+			{Type: pb.AssertionType_ASSERTION_TYPE_MAX_LENGTH, MaxLength: 200},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Budgets", resp.Case.Name)
+	assert.Equal(t, []string{"budgets"}, []string(resp.Case.Variables))
+	assert.Equal(t, pb.AssertionType_ASSERTION_TYPE_MAX_LENGTH, resp.Case.Assertions[1].Type)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *pb.CreateEvaluationCaseRequest
+		code codes.Code
+	}{
+		{"NoName", ctx, &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Assertions: []*pb.Assertion{{Type: pb.AssertionType_ASSERTION_TYPE_CONTAINS, Value: "x"}}}, codes.InvalidArgument},
+		{"NoAssertion", ctx, &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Name: "x"}, codes.InvalidArgument},
+		{"NoType", ctx, &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Name: "x", Assertions: []*pb.Assertion{{Value: "x"}}}, codes.InvalidArgument},
+		{"BadRegex", ctx, &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Name: "x", Assertions: []*pb.Assertion{{Type: pb.AssertionType_ASSERTION_TYPE_REGEX, Value: "("}}}, codes.InvalidArgument},
+		{"BadSchema", ctx, &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Name: "x", Assertions: []*pb.Assertion{{Type: pb.AssertionType_ASSERTION_TYPE_JSON_SCHEMA, Value: "{"}}}, codes.InvalidArgument},
+		{"NotEditor", ContextWithUserID(context.Background(), "bob"), &pb.CreateEvaluationCaseRequest{TemplateId: "t1", Name: "x", Assertions: []*pb.Assertion{{Type: pb.AssertionType_ASSERTION_TYPE_CONTAINS, Value: "x"}}}, codes.PermissionDenied},
+	}
+	svc.ShareRepo.(*MockShareRepository).On("GetGrant", mock.Anything, "t1", "bob").Return(nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateEvaluationCase(tt.ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestRunEvaluationAllVersions(t *testing.T) {
+	svc, mockTemplateRepo, mockVersionRepo, mockEvaluationRepo := newEvaluationService()
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice"}, nil)
+	mockEvaluationRepo.On("ListCases", ctx, "t1").Return([]*models.EvaluationCase{{
+		ID:             "c1",
+		Name:           "Budgets",
+		Variables:      []string{"budgets"},
+		NamedVariables: json.RawMessage(`{"tone":"formal"}`),
+		Assertions:     json.RawMessage(`[{"type":"contains","value":"memo"},{"type":"max_length","max_length":60}]`),
+	}}, nil)
+	// Versions are listed newest first.
+	mockVersionRepo.On("List", ctx, 100, (*repository.Cursor)(nil), "t1").Return([]*models.TemplateVersion{
+		{ID: 12, TemplateID: "t1", Version: 2, Content: "Write a {{tone}} note about $$"},
+		{ID: 11, TemplateID: "t1", Version: 1, Content: "Write a {{tone}} memo about $$"},
+	}, nil)
+	var saved []*models.EvaluationRun
+	mockEvaluationRepo.On("CreateRun", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		saved = append(saved, args.Get(1).(*models.EvaluationRun))
+	}).Return(nil)
+
+	resp, err := svc.RunEvaluation(ctx, &pb.RunEvaluationRequest{TemplateId: "t1", AllVersions: true})
+	assert.NoError(t, err)
+
+	// One run per version, oldest first; the second version regressed.
+	assert.Len(t, resp.Runs, 2)
+	assert.Equal(t, int32(1), resp.Runs[0].Version)
+	assert.Equal(t, int32(1), resp.Runs[0].Passed)
+	first := resp.Runs[0].Results[0]
+	assert.Equal(t, "Write a formal memo about budgets", first.Input)
+	assert.Equal(t, "[small] Write a formal memo about budgets", first.Output)
+	assert.True(t, first.Passed)
+
+	assert.Equal(t, int32(12), resp.Runs[1].VersionId)
+	assert.Equal(t, int32(1), resp.Runs[1].Failed)
+	second := resp.Runs[1].Results[0]
+	assert.False(t, second.Passed)
+	assert.Equal(t, `output does not contain "memo"`, second.Assertions[0].Message)
+	assert.True(t, second.Assertions[1].Passed)
+
+	assert.Len(t, saved, 2)
+	assert.Equal(t, "fake", saved[1].Provider)
+	assert.Equal(t, "small", saved[1].Model)
+	assert.Equal(t, "alice", saved[1].CreatedBy)
+	var results []evaluation.CaseResult
+	assert.NoError(t, json.Unmarshal(saved[1].Results, &results))
+	assert.Equal(t, "c1", results[0].CaseID)
+
+	// Evaluations need test cases and a provider.
+	mockTemplateRepo.On("Get", mock.Anything, "t2", "").Return(&models.Template{ID: "t2", OwnerID: "alice"}, nil)
+	mockEvaluationRepo.On("ListCases", ctx, "t2").Return([]*models.EvaluationCase{}, nil)
+	_, err = svc.RunEvaluation(ctx, &pb.RunEvaluationRequest{TemplateId: "t2"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	svc.Provider = nil
+	_, err = svc.RunEvaluation(ctx, &pb.RunEvaluationRequest{TemplateId: "t1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRunEvaluationTooManyCaseRuns(t *testing.T) {
+	svc, mockTemplateRepo, mockVersionRepo, mockEvaluationRepo := newEvaluationService()
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice"}, nil)
+	cases := make([]*models.EvaluationCase, maxEvaluationCases)
+	for i := range cases {
+		cases[i] = &models.EvaluationCase{ID: "c", Assertions: json.RawMessage(`[{"type":"contains","value":"memo"}]`)}
+	}
+	mockEvaluationRepo.On("ListCases", ctx, "t1").Return(cases, nil)
+	versions := make([]*models.TemplateVersion, maxEvaluationCaseRuns/maxEvaluationCases+1)
+	for i := range versions {
+		versions[i] = &models.TemplateVersion{ID: int32(i + 1), TemplateID: "t1", Version: int32(i + 1)}
+	}
+	mockVersionRepo.On("List", ctx, 100, (*repository.Cursor)(nil), "t1").Return(versions, nil)
+
+	_, err := svc.RunEvaluation(ctx, &pb.RunEvaluationRequest{TemplateId: "t1", AllVersions: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockEvaluationRepo.AssertNotCalled(t, "CreateRun", mock.Anything, mock.Anything)
+}
+
+func TestCompareEvaluationRuns(t *testing.T) {
+	svc, mockTemplateRepo, _, mockEvaluationRepo := newEvaluationService()
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice"}, nil)
+	mockEvaluationRepo.On("GetLatestRun", ctx, "t1", int32(11)).Return(&models.EvaluationRun{
+		ID: "r1", VersionID: 11, Version: 1, Passed: 2, Failed: 1,
+		Results: json.RawMessage(`[
+			{"case_id":"c1","case_name":"One","passed":true},
+			{"case_id":"c2","case_name":"Two","passed":false},
+			{"case_id":"c3","case_name":"Three","passed":true}
+		]`),
+	}, nil)
+	mockEvaluationRepo.On("GetLatestRun", ctx, "t1", int32(12)).Return(&models.EvaluationRun{
+		ID: "r2", VersionID: 12, Version: 2, Passed: 2, Failed: 1,
+		Results: json.RawMessage(`[
+			{"case_id":"c1","case_name":"One","passed":false},
+			{"case_id":"c2","case_name":"Two","passed":true},
+			{"case_id":"c4","case_name":"Four","passed":true}
+		]`),
+	}, nil)
+	mockEvaluationRepo.On("GetLatestRun", ctx, "t1", int32(13)).Return(nil, nil)
+
+	resp, err := svc.CompareEvaluationRuns(ctx, &pb.CompareEvaluationRunsRequest{TemplateId: "t1", BaseVersionId: 11, HeadVersionId: 12})
+	assert.NoError(t, err)
+	assert.Equal(t, "r1", resp.Base.Id)
+	assert.Equal(t, int32(2), resp.Head.Version)
+	assert.Empty(t, resp.Base.Results)
+
+	var changes []string
+	for _, c := range resp.Cases {
+		changes = append(changes, c.CaseId+":"+c.Change.String())
+	}
+	assert.Equal(t, []string{
+		"c1:EVALUATION_CHANGE_REGRESSED",
+		"c2:EVALUATION_CHANGE_FIXED",
+		"c3:EVALUATION_CHANGE_UNSPECIFIED",
+		"c4:EVALUATION_CHANGE_UNSPECIFIED",
+	}, changes)
+	assert.Nil(t, resp.Cases[2].Head)
+	assert.Nil(t, resp.Cases[3].Base)
+
+	_, err = svc.CompareEvaluationRuns(ctx, &pb.CompareEvaluationRunsRequest{TemplateId: "t1", BaseVersionId: 11, HeadVersionId: 13})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.CompareEvaluationRuns(ctx, &pb.CompareEvaluationRunsRequest{TemplateId: "t1", BaseVersionId: 11})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/llm"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// ExecutePrompt runs a rendered text, or the rendered text of a saved prompt
+// of the caller, on a language model. The run is stored whether it succeeds
+// or not; errors of the provider are returned after it is stored.
+func (s *PromptService) ExecutePrompt(ctx context.Context, req *pb.ExecutePromptRequest) (*pb.ExecutePromptResponse, error) {
+	zap.S().Infof("PromptService.ExecutePrompt: prompt_id=%s", req.PromptId)
+	llmReq, promptID, err := s.executionRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, runErr := s.Provider.Complete(ctx, llmReq)
+	execution, err := s.saveExecution(ctx, llmReq, promptID, time.Since(start), resp, runErr)
+	if err != nil {
+		return nil, err
+	}
+	if runErr != nil {
+		return nil, providerError(runErr)
+	}
+	return &pb.ExecutePromptResponse{Execution: executionModelToProto(execution)}, nil
+}
+
+// StreamExecutePrompt runs a prompt like ExecutePrompt, sending the text to
+// the client as the provider generates it. When the client goes away, the
+// generation is stopped upstream. The transcript is stored when the stream
+// ends, however it ends, and sent as the last message.
+func (s *PromptService) StreamExecutePrompt(req *pb.ExecutePromptRequest, stream pb.PromptService_StreamExecutePromptServer) error {
+	zap.S().Infof("PromptService.StreamExecutePrompt: prompt_id=%s", req.PromptId)
+	ctx := stream.Context()
+	llmReq, promptID, err := s.executionRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// A failed send means the client is gone, so the run is cancelled like it
+	// is when the client cancels the call.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	start := time.Now()
+	resp, runErr := s.Provider.Stream(ctx, llmReq, func(delta string) error {
+		if err := stream.Send(&pb.ExecutePromptChunk{Delta: delta}); err != nil {
+			cancel()
+			return err
+		}
+		return nil
+	})
+	execution, err := s.saveExecution(ctx, llmReq, promptID, time.Since(start), resp, runErr)
+	if err != nil {
+		return err
+	}
+	if runErr != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return providerError(runErr)
+	}
+	return stream.Send(&pb.ExecutePromptChunk{Execution: executionModelToProto(execution)})
+}
+
+// ListExecutions lists the runs of the caller, latest first.
+func (s *PromptService) ListExecutions(ctx context.Context, req *pb.ListExecutionsRequest) (*pb.ListExecutionsResponse, error) {
+	zap.S().Infof("PromptService.ListExecutions: prompt_id=%s page_size=%d", req.PromptId, req.PageSize)
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Runs are private, so only the caller's own are listed.
+	if err := authorizeSelf(ctx, policy.Read, ""); err != nil {
+		return nil, err
+	}
+	filters := map[string]interface{}{"owner_id": principal(ctx).UserID}
+	if req.PromptId != "" {
+		filters["prompt_id"] = req.PromptId
+	}
+
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	executions, err := s.ExecutionRepo.List(ctx, limit+1, after, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list executions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(executions) > limit {
+		executions = executions[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.ExecutionCursor(executions[limit-1]), filters)
+	}
+
+	pbExecutions := make([]*pb.Execution, len(executions))
+	for i, e := range executions {
+		pbExecutions[i] = executionModelToProto(e)
+	}
+	return &pb.ListExecutionsResponse{Executions: pbExecutions, NextPageToken: nextPageToken}, nil
+}
+
+// executionRequest validates req and returns the provider request to run, with
+// the ID of the saved prompt it runs, if any.
+func (s *PromptService) executionRequest(ctx context.Context, req *pb.ExecutePromptRequest) (*llm.Request, string, error) {
+	if principal(ctx).Anonymous() {
+		return nil, "", authorize(policy.Unauthenticated, "execution")
+	}
+	if s.Provider == nil {
+		return nil, "", status.Error(codes.FailedPrecondition, "no model provider is configured")
+	}
+	if (req.RenderedText == "") == (req.PromptId == "") {
+		return nil, "", status.Error(codes.InvalidArgument, "exactly one of rendered_text and prompt_id is required")
+	}
+	input := req.RenderedText
+	if req.PromptId != "" {
+		prompt, err := s.getPromptFor(ctx, req.PromptId, policy.Read)
+		if err != nil {
+			return nil, "", err
+		}
+		input = prompt.RenderedText
+	}
+	if strings.TrimSpace(input) == "" {
+		return nil, "", status.Error(codes.InvalidArgument, "the prompt is empty")
+	}
+
+	llmReq, err := s.modelRequest(req.Parameters)
+	if err != nil {
+		return nil, "", err
+	}
+	llmReq.Messages = append(llmReq.Messages, llm.Message{Role: llm.RoleUser, Content: input})
+	return llmReq, req.PromptId, nil
+}
+
+// modelRequest validates params and returns the provider request they make,
+// with the system prompt but without the prompt itself.
+func (s *PromptService) modelRequest(params *pb.ModelParameters) (*llm.Request, error) {
+	if params == nil {
+		params = &pb.ModelParameters{}
+	}
+	llmReq := &llm.Request{
+		Model:       params.Model,
+		Temperature: params.Temperature,
+		TopP:        params.TopP,
+		MaxTokens:   params.MaxTokens,
+		Stop:        params.Stop,
+	}
+	if llmReq.Model == "" {
+		llmReq.Model = s.DefaultModel
+	}
+	switch {
+	case llmReq.Model == "":
+		return nil, status.Error(codes.InvalidArgument, "model is required")
+	case llmReq.Temperature != nil && (*llmReq.Temperature < 0 || *llmReq.Temperature > 2):
+		return nil, status.Error(codes.InvalidArgument, "temperature must be between 0 and 2")
+	case llmReq.TopP != nil && (*llmReq.TopP <= 0 || *llmReq.TopP > 1):
+		return nil, status.Error(codes.InvalidArgument, "top_p must be greater than 0 and at most 1")
+	case llmReq.MaxTokens < 0:
+		return nil, status.Error(codes.InvalidArgument, "max_tokens must not be negative")
+	}
+	if params.SystemPrompt != "" {
+		llmReq.Messages = append(llmReq.Messages, llm.Message{Role: llm.RoleSystem, Content: params.SystemPrompt})
+	}
+	return llmReq, nil
+}
+
+// saveExecution stores the run of llmReq by the caller that took latency, with
+// the response of the provider and its error. The response may be partial or
+// nil on error. The run is stored even when the client went away, since the
+// provider may have billed it.
+func (s *PromptService) saveExecution(ctx context.Context, llmReq *llm.Request, promptID string, latency time.Duration, resp *llm.Response, runErr error) (*models.PromptExecution, error) {
+	request, _ := json.Marshal(llmReq)
+	execution := &models.PromptExecution{
+		OwnerID:   principal(ctx).UserID,
+		PromptID:  sql.NullString{String: promptID, Valid: promptID != ""},
+		Provider:  s.Provider.Name(),
+		Model:     llmReq.Model,
+		Request:   request,
+		Status:    "succeeded",
+		LatencyMs: int32(latency.Milliseconds()),
+	}
+	if resp != nil {
+		execution.ResponseText = resp.Text
+		execution.FinishReason = resp.FinishReason
+		execution.PromptTokens = resp.Usage.PromptTokens
+		execution.CompletionTokens = resp.Usage.CompletionTokens
+		execution.TotalTokens = resp.Usage.TotalTokens
+	}
+	switch {
+	case runErr == nil:
+	case ctx.Err() != nil:
+		execution.Status = "cancelled"
+	default:
+		execution.Status = "failed"
+		execution.Error = runErr.Error()
+	}
+
+	if err := s.ExecutionRepo.Create(context.WithoutCancel(ctx), execution); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save execution: %v", err)
+	}
+	return execution, nil
+}
+
+// providerError maps an error of a provider to a gRPC status.
+func providerError(err error) error {
+	var apiErr *llm.APIError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "execution cancelled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "the model provider timed out")
+	case errors.As(err, &apiErr):
+		switch apiErr.StatusCode {
+		case http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity:
+			return status.Errorf(codes.InvalidArgument, "the model provider rejected the request: %s", apiErr.Message)
+		case http.StatusTooManyRequests:
+			return status.Errorf(codes.ResourceExhausted, "the model provider is rate limited: %s", apiErr.Message)
+		}
+	}
+	zap.S().Warnf("PromptService: model provider error: %v", err)
+	return status.Error(codes.Unavailable, "the model provider is unavailable")
+}
+
+func executionModelToProto(m *models.PromptExecution) *pb.Execution {
+	var req llm.Request
+	_ = json.Unmarshal(m.Request, &req)
+	params := &pb.ModelParameters{
+		Model:       req.Model,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		MaxTokens:   req.MaxTokens,
+		Stop:        req.Stop,
+	}
+	input := ""
+	for _, msg := range req.Messages {
+		switch msg.Role {
+		case llm.RoleSystem:
+			params.SystemPrompt = msg.Content
+		case llm.RoleUser:
+			input = msg.Content
+		}
+	}
+	executionStatus := pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	switch m.Status {
+	case "failed":
+		executionStatus = pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	case "cancelled":
+		executionStatus = pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED
+	}
+	return &pb.Execution{
+		Id:               m.ID,
+		OwnerId:          m.OwnerID,
+		PromptId:         m.PromptID.String,
+		Provider:         m.Provider,
+		Parameters:       params,
+		Input:            input,
+		Output:           m.ResponseText,
+		FinishReason:     m.FinishReason,
+		Status:           executionStatus,
+		Error:            m.Error,
+		LatencyMs:        m.LatencyMs,
+		PromptTokens:     m.PromptTokens,
+		CompletionTokens: m.CompletionTokens,
+		TotalTokens:      m.TotalTokens,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/llm"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MockExecutionRepository is a mock implementation of repository.ExecutionRepository
+type MockExecutionRepository struct {
+	mock.Mock
+}
+
+func (m *MockExecutionRepository) Create(ctx context.Context, e *models.PromptExecution) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+func (m *MockExecutionRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.PromptExecution, error) {
+	args := m.Called(ctx, limit, after, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.PromptExecution), args.Error(1)
+}
+
+func newExecutionService(provider llm.Provider) (*PromptService, *MockPromptRepository, *MockExecutionRepository) {
+	mockPromptRepo := new(MockPromptRepository)
+	mockExecutionRepo := new(MockExecutionRepository)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, ExecutionRepo: mockExecutionRepo, Provider: provider, DefaultModel: "small"})
+	return svc, mockPromptRepo, mockExecutionRepo
+}
+
+func TestExecutePromptEndToEnd(t *testing.T) {
+	var got llm.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{
+			"model": "small-2024",
+			"choices": [{"message": {"role": "assistant", "content": "Dear team, ..."}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 12, "completion_tokens": 4, "total_tokens": 16}
+		}`))
+	}))
+	defer server.Close()
+
+	svc, mockPromptRepo, mockExecutionRepo := newExecutionService(llm.NewOpenAIProvider(server.URL, "sk-test", time.Second))
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockPromptRepo.On("Get", ctx, "p1").Return(&models.Prompt{ID: "p1", OwnerID: "alice", RenderedText: "Write a memo"}, nil)
+	var saved *models.PromptExecution
+	mockExecutionRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*models.PromptExecution)
+		saved.ID = "e1"
+	}).Return(nil)
+
+	resp, err := svc.ExecutePrompt(ctx, &pb.ExecutePromptRequest{
+		PromptId:   "p1",
+		Parameters: &pb.ModelParameters{Temperature: proto.Float64(0), MaxTokens: 64, SystemPrompt: "Be formal"},
+	})
+	assert.NoError(t, err)
+
+	// The provider got the saved prompt with the parameters.
+	assert.Equal(t, "small", got.Model)
+	assert.Equal(t, []llm.Message{{Role: "system", Content: "Be formal"}, {Role: "user", Content: "Write a memo"}}, got.Messages)
+	assert.Equal(t, 0.0, *got.Temperature)
+	assert.Equal(t, int32(64), got.MaxTokens)
+
+	// The run is stored and returned.
+	assert.Equal(t, "alice", saved.OwnerID)
+	assert.Equal(t, "p1", saved.PromptID.String)
+	assert.Equal(t, "openai", saved.Provider)
+	assert.Equal(t, "succeeded", saved.Status)
+	assert.JSONEq(t, `{"model":"small","temperature":0,"max_tokens":64,"messages":[{"role":"system","content":"Be formal"},{"role":"user","content":"Write a memo"}]}`, string(saved.Request))
+	execution := resp.Execution
+	assert.Equal(t, "e1", execution.Id)
+	assert.Equal(t, "Write a memo", execution.Input)
+	assert.Equal(t, "Dear team, ...", execution.Output)
+	assert.Equal(t, "Be formal", execution.Parameters.SystemPrompt)
+	assert.Equal(t, int32(12), execution.PromptTokens)
+	assert.Equal(t, int32(16), execution.TotalTokens)
+	assert.GreaterOrEqual(t, execution.LatencyMs, int32(0))
+}
+
+func TestExecutePromptProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error": {"message": "Slow down"}}`))
+	}))
+	defer server.Close()
+
+	svc, _, mockExecutionRepo := newExecutionService(llm.NewOpenAIProvider(server.URL, "", time.Second))
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockExecutionRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *models.PromptExecution) bool {
+		return e.Status == "failed" && e.Error == "provider returned 429: Slow down" && !e.PromptID.Valid
+	})).Return(nil)
+
+	_, err := svc.ExecutePrompt(ctx, &pb.ExecutePromptRequest{RenderedText: "Hi"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	mockExecutionRepo.AssertExpectations(t)
+}
+
+func TestExecutePromptValidation(t *testing.T) {
+	svc, mockPromptRepo, mockExecutionRepo := newExecutionService(llm.NewFakeProvider())
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockPromptRepo.On("Get", ctx, "p2").Return(&models.Prompt{ID: "p2", OwnerID: "bob", RenderedText: "Secret"}, nil)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *pb.ExecutePromptRequest
+		code codes.Code
+	}{
+		{"Anonymous", context.Background(), &pb.ExecutePromptRequest{RenderedText: "Hi"}, codes.Unauthenticated},
+		{"NoInput", ctx, &pb.ExecutePromptRequest{}, codes.InvalidArgument},
+		{"BothInputs", ctx, &pb.ExecutePromptRequest{RenderedText: "Hi", PromptId: "p2"}, codes.InvalidArgument},
+		{"OtherUsersPrompt", ctx, &pb.ExecutePromptRequest{PromptId: "p2"}, codes.NotFound},
+		{"Temperature", ctx, &pb.ExecutePromptRequest{RenderedText: "Hi", Parameters: &pb.ModelParameters{Temperature: proto.Float64(3)}}, codes.InvalidArgument},
+		{"TopP", ctx, &pb.ExecutePromptRequest{RenderedText: "Hi", Parameters: &pb.ModelParameters{TopP: proto.Float64(0)}}, codes.InvalidArgument},
+		{"MaxTokens", ctx, &pb.ExecutePromptRequest{RenderedText: "Hi", Parameters: &pb.ModelParameters{MaxTokens: -1}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ExecutePrompt(tt.ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+	mockExecutionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+	svc.Provider = nil
+	_, err := svc.ExecutePrompt(ctx, &pb.ExecutePromptRequest{RenderedText: "Hi"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListExecutions(t *testing.T) {
+	svc, _, mockExecutionRepo := newExecutionService(llm.NewFakeProvider())
+	ctx := ContextWithUserID(context.Background(), "alice")
+	executions := []*models.PromptExecution{
+		{ID: "e2", OwnerID: "alice", Status: "failed", Error: "boom", Request: json.RawMessage(`{"model":"small","messages":[{"role":"user","content":"Hi"}]}`), CreatedAt: time.Now()},
+		{ID: "e1", OwnerID: "alice", Status: "succeeded", Request: json.RawMessage(`{}`), CreatedAt: time.Now().Add(-time.Minute)},
+	}
+	mockExecutionRepo.On("List", ctx, 2, (*repository.Cursor)(nil), map[string]interface{}{"owner_id": "alice", "prompt_id": "p1"}).Return(executions, nil)
+
+	resp, err := svc.ListExecutions(ctx, &pb.ListExecutionsRequest{PageSize: 1, PromptId: "p1"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Executions, 1)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_FAILED, resp.Executions[0].Status)
+	assert.Equal(t, "Hi", resp.Executions[0].Input)
+	assert.Equal(t, "small", resp.Executions[0].Parameters.Model)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	_, err = svc.ListExecutions(context.Background(), &pb.ListExecutionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// fakeExecutionStream collects the chunks sent to a client.
+type fakeExecutionStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*pb.ExecutePromptChunk
+	// onSend runs after each chunk is collected.
+	onSend func()
+}
+
+func (s *fakeExecutionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeExecutionStream) Send(chunk *pb.ExecutePromptChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
+
+func TestStreamExecutePrompt(t *testing.T) {
+	svc, _, mockExecutionRepo := newExecutionService(llm.NewFakeProvider())
+	ctx := ContextWithUserID(context.Background(), "alice")
+	var saved *models.PromptExecution
+	mockExecutionRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*models.PromptExecution)
+		saved.ID = "e1"
+	}).Return(nil)
+
+	stream := &fakeExecutionStream{ctx: ctx}
+	err := svc.StreamExecutePrompt(&pb.ExecutePromptRequest{RenderedText: "Write a memo"}, stream)
+	assert.NoError(t, err)
+
+	// The text arrives word by word, then the stored run.
+	var deltas []string
+	for _, chunk := range stream.chunks[:len(stream.chunks)-1] {
+		deltas = append(deltas, chunk.Delta)
+	}
+	assert.Equal(t, []string{"[small]", " Write", " a", " memo"}, deltas)
+	last := stream.chunks[len(stream.chunks)-1].Execution
+	assert.Equal(t, "e1", last.Id)
+	assert.Equal(t, "[small] Write a memo", last.Output)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED, last.Status)
+	assert.Equal(t, "[small] Write a memo", saved.ResponseText)
+}
+
+func TestStreamExecutePromptCancelled(t *testing.T) {
+	svc, _, mockExecutionRepo := newExecutionService(&llm.FakeProvider{ChunkDelay: time.Millisecond})
+	ctx, cancel := context.WithCancel(ContextWithUserID(context.Background(), "alice"))
+	defer cancel()
+	var saved *models.PromptExecution
+	mockExecutionRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*models.PromptExecution)
+	}).Return(nil)
+
+	// The client goes away after the second word.
+	stream := &fakeExecutionStream{ctx: ctx}
+	stream.onSend = func() {
+		if len(stream.chunks) == 2 {
+			cancel()
+		}
+	}
+	err := svc.StreamExecutePrompt(&pb.ExecutePromptRequest{RenderedText: "Write a long memo"}, stream)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	// What was generated is stored, and no execution is sent.
+	assert.Len(t, stream.chunks, 2)
+	assert.Equal(t, "cancelled", saved.Status)
+	assert.Equal(t, "[small] Write", saved.ResponseText)
+	assert.Equal(t, int32(2), saved.CompletionTokens)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// FollowUser makes the current user follow another user. Following a user
+// twice is a no-op.
+func (s *UserService) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.FollowUserResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("UserService.FollowUser: user_id=%s followee_id=%s", userID, req.UserId)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.UserId == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot follow yourself")
+	}
+
+	count, err := s.FollowRepo.Follow(ctx, userID, req.UserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrUnknownUser) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to follow user: %v", err)
+	}
+	s.Audit.Record(ctx, "user.follow", req.UserId, req.UserId, nil, map[string]interface{}{"follower_count": count})
+	return &pb.FollowUserResponse{IsFollowing: true, FollowerCount: count}, nil
+}
+
+// UnfollowUser makes the current user stop following another user.
+func (s *UserService) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*pb.UnfollowUserResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("UserService.UnfollowUser: user_id=%s followee_id=%s", userID, req.UserId)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	count, err := s.FollowRepo.Unfollow(ctx, userID, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unfollow user: %v", err)
+	}
+	s.Audit.Record(ctx, "user.unfollow", req.UserId, req.UserId, nil, map[string]interface{}{"follower_count": count})
+	return &pb.UnfollowUserResponse{IsFollowing: false, FollowerCount: count}, nil
+}
+
+// GetFeed lists the new public templates, new versions and forks of the users
+// the current user follows, latest first.
+func (s *PromptService) GetFeed(ctx context.Context, req *pb.GetFeedRequest) (*pb.GetFeedResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.GetFeed: user_id=%s page_size=%d", userID, req.PageSize)
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := map[string]interface{}{"feed": userID}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items, err := s.FollowRepo.Feed(ctx, userID, limit+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get feed: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(items) > limit {
+		items = items[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.FeedCursor(items[limit-1]), filters)
+	}
+
+	var pbItems []*pb.FeedItem
+	for _, item := range items {
+		pbItems = append(pbItems, feedItemModelToProto(item))
+	}
+	return &pb.GetFeedResponse{Items: pbItems, NextPageToken: nextPageToken}, nil
+}
+
+func feedItemTypeToProto(t string) pb.FeedItemType {
+	switch t {
+	case "template":
+		return pb.FeedItemType_FEED_ITEM_TYPE_TEMPLATE
+	case "version":
+		return pb.FeedItemType_FEED_ITEM_TYPE_VERSION
+	case "fork":
+		return pb.FeedItemType_FEED_ITEM_TYPE_FORK
+	default:
+		return pb.FeedItemType_FEED_ITEM_TYPE_UNSPECIFIED
+	}
+}
+
+func feedItemModelToProto(m *models.FeedItem) *pb.FeedItem {
+	return &pb.FeedItem{
+		Id:               m.ID,
+		Type:             feedItemTypeToProto(m.Type),
+		Template:         templateModelToProto(&m.Template),
+		Version:          m.Version,
+		ActorId:          m.Template.OwnerID,
+		ActorDisplayName: m.ActorName,
+		OccurredAt:       timestamppb.New(m.OccurredAt),
+	}
+}
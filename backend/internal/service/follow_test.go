@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockFollowRepository is a mock implementation of repository.FollowRepository
+type MockFollowRepository struct {
+	mock.Mock
+}
+
+func (m *MockFollowRepository) Follow(ctx context.Context, followerID, followeeID string) (int32, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Get(0).(int32), args.Error(1)
+}
+func (m *MockFollowRepository) Unfollow(ctx context.Context, followerID, followeeID string) (int32, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Get(0).(int32), args.Error(1)
+}
+func (m *MockFollowRepository) IsFollowing(ctx context.Context, followerID, followeeID string) (bool, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Bool(0), args.Error(1)
+}
+func (m *MockFollowRepository) Feed(ctx context.Context, userID string, limit int, after *repository.Cursor) ([]*models.FeedItem, error) {
+	args := m.Called(ctx, userID, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FeedItem), args.Error(1)
+}
+
+func TestFollowUser(t *testing.T) {
+	ctx := ContextWithUserID(context.Background(), "alice")
+
+	t.Run("Success", func(t *testing.T) {
+		mockFollowRepo := new(MockFollowRepository)
+		svc := NewUserService(new(MockUserRepository), mockFollowRepo, nil, nil, nil, "secret")
+		mockFollowRepo.On("Follow", ctx, "alice", "bob").Return(int32(3), nil)
+		mockFollowRepo.On("Unfollow", ctx, "alice", "bob").Return(int32(2), nil)
+
+		resp, err := svc.FollowUser(ctx, &pb.FollowUserRequest{UserId: "bob"})
+		assert.NoError(t, err)
+		assert.True(t, resp.IsFollowing)
+		assert.Equal(t, int32(3), resp.FollowerCount)
+
+		unfollowed, err := svc.UnfollowUser(ctx, &pb.UnfollowUserRequest{UserId: "bob"})
+		assert.NoError(t, err)
+		assert.False(t, unfollowed.IsFollowing)
+		assert.Equal(t, int32(2), unfollowed.FollowerCount)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		mockFollowRepo := new(MockFollowRepository)
+		svc := NewUserService(new(MockUserRepository), mockFollowRepo, nil, nil, nil, "secret")
+
+		_, err := svc.FollowUser(ctx, &pb.FollowUserRequest{UserId: "alice"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockFollowRepo.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		mockFollowRepo := new(MockFollowRepository)
+		svc := NewUserService(new(MockUserRepository), mockFollowRepo, nil, nil, nil, "secret")
+		mockFollowRepo.On("Follow", ctx, "alice", "nobody").Return(int32(0), repository.ErrUnknownUser)
+
+		_, err := svc.FollowUser(ctx, &pb.FollowUserRequest{UserId: "nobody"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		svc := NewUserService(new(MockUserRepository), new(MockFollowRepository), nil, nil, nil, "secret")
+
+		_, err := svc.FollowUser(context.Background(), &pb.FollowUserRequest{UserId: "bob"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestGetFeed(t *testing.T) {
+	mockFollowRepo := new(MockFollowRepository)
+	svc := newTestService(PromptServiceDeps{FollowRepo: mockFollowRepo})
+	ctx := ContextWithUserID(context.Background(), "alice")
+
+	now := time.Now()
+	items := []*models.FeedItem{
+		{ID: "t2:3", Type: "version", Version: 3, OccurredAt: now, ActorName: "Bob",
+			Template: models.Template{ID: "t2", OwnerID: "bob", Title: "Summarizer", Visibility: "public"}},
+		{ID: "t3", Type: "fork", Version: 1, OccurredAt: now.Add(-time.Minute),
+			Template: models.Template{ID: "t3", OwnerID: "carol", Title: "Translator", Visibility: "public"}},
+		{ID: "t1", Type: "template", Version: 1, OccurredAt: now.Add(-time.Hour),
+			Template: models.Template{ID: "t1", OwnerID: "bob", Title: "Greeting", Visibility: "public"}},
+	}
+	mockFollowRepo.On("Feed", ctx, "alice", 3, (*repository.Cursor)(nil)).Return(items, nil)
+	mockFollowRepo.On("Feed", ctx, "alice", 3, &repository.Cursor{Key: now.Add(-time.Minute).Format(time.RFC3339Nano), ID: "t3"}).Return(items[2:], nil)
+
+	resp, err := svc.GetFeed(ctx, &pb.GetFeedRequest{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Items, 2)
+	assert.Equal(t, pb.FeedItemType_FEED_ITEM_TYPE_VERSION, resp.Items[0].Type)
+	assert.Equal(t, "bob", resp.Items[0].ActorId)
+	assert.Equal(t, "Bob", resp.Items[0].ActorDisplayName)
+	assert.Equal(t, "Summarizer", resp.Items[0].Template.Title)
+	assert.Equal(t, pb.FeedItemType_FEED_ITEM_TYPE_FORK, resp.Items[1].Type)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	next, err := svc.GetFeed(ctx, &pb.GetFeedRequest{PageSize: 2, PageToken: resp.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, next.Items, 1)
+	assert.Empty(t, next.NextPageToken)
+
+	// Feed page tokens are bound to the user they were issued for.
+	_, err = svc.GetFeed(ContextWithUserID(context.Background(), "bob"), &pb.GetFeedRequest{PageToken: resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
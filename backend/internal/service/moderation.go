@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+const (
+	// defaultReportHideThreshold is how many users must report a template before it is hidden pending review.
+	defaultReportHideThreshold = 5
+	// maxReportDetailsLength caps the explanation attached to a report, in characters.
+	maxReportDetailsLength = 1000
+)
+
+// ReportTemplate reports a template the caller can see to the administrators.
+// Once ReportHideThreshold users have open reports on a template it is hidden
+// until an administrator reviews it.
+func (s *PromptService) ReportTemplate(ctx context.Context, req *pb.ReportTemplateRequest) (*pb.ReportTemplateResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.ReportTemplate: template_id=%s reason=%s user_id=%s", req.TemplateId, req.Reason, userID)
+
+	reason := reportReasonFromProto(req.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if utf8.RuneCountInString(req.Details) > maxReportDetailsLength {
+		return nil, status.Errorf(codes.InvalidArgument, "details must be at most %d characters", maxReportDetailsLength)
+	}
+
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	if template.OwnerID == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot report your own template")
+	}
+
+	report := &models.TemplateReport{
+		TemplateID: template.ID,
+		ReporterID: userID,
+		Reason:     reason,
+		Details:    req.Details,
+	}
+	open, err := s.ModerationRepo.CreateReport(ctx, report)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicateReport) {
+			return nil, status.Error(codes.AlreadyExists, "template already reported")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to report template: %v", err)
+	}
+	// Reports stay confidential: the event belongs to the reporter, not the template owner.
+	s.Audit.Record(ctx, "report.create", template.ID, userID, nil, map[string]interface{}{"reason": reason, "details": req.Details})
+
+	// The report is stored either way, so failing to hide the template only delays it until the review.
+	if s.ReportHideThreshold > 0 && open >= s.ReportHideThreshold && moderationVisible(template) {
+		if err := s.TemplateRepo.SetModerationState(ctx, template.ID, "hidden"); err != nil {
+			zap.S().Warnf("PromptService.ReportTemplate: failed to hide template %s: %v", template.ID, err)
+		} else {
+			zap.S().Infof("PromptService.ReportTemplate: hid template %s after %d reports", template.ID, open)
+		}
+	}
+
+	return &pb.ReportTemplateResponse{Report: reportModelToProto(report)}, nil
+}
+
+// moderationVisible reports whether moderation leaves a template in sight.
+func moderationVisible(t *models.Template) bool {
+	return t.ModerationState == "" || t.ModerationState == "visible"
+}
+
+func reportReasonFromProto(r pb.ReportReason) string {
+	switch r {
+	case pb.ReportReason_REPORT_REASON_SPAM:
+		return "spam"
+	case pb.ReportReason_REPORT_REASON_ABUSE:
+		return "abuse"
+	case pb.ReportReason_REPORT_REASON_INAPPROPRIATE:
+		return "inappropriate"
+	case pb.ReportReason_REPORT_REASON_COPYRIGHT:
+		return "copyright"
+	case pb.ReportReason_REPORT_REASON_OTHER:
+		return "other"
+	}
+	return ""
+}
+
+func reportReasonToProto(reason string) pb.ReportReason {
+	switch reason {
+	case "spam":
+		return pb.ReportReason_REPORT_REASON_SPAM
+	case "abuse":
+		return pb.ReportReason_REPORT_REASON_ABUSE
+	case "inappropriate":
+		return pb.ReportReason_REPORT_REASON_INAPPROPRIATE
+	case "copyright":
+		return pb.ReportReason_REPORT_REASON_COPYRIGHT
+	case "other":
+		return pb.ReportReason_REPORT_REASON_OTHER
+	}
+	return pb.ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func reportStatusToProto(s string) pb.ReportStatus {
+	switch s {
+	case "open":
+		return pb.ReportStatus_REPORT_STATUS_OPEN
+	case "dismissed":
+		return pb.ReportStatus_REPORT_STATUS_DISMISSED
+	case "actioned":
+		return pb.ReportStatus_REPORT_STATUS_ACTIONED
+	}
+	return pb.ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func moderationStateToProto(state string) pb.ModerationState {
+	switch state {
+	case "visible":
+		return pb.ModerationState_MODERATION_STATE_VISIBLE
+	case "hidden":
+		return pb.ModerationState_MODERATION_STATE_HIDDEN
+	case "removed":
+		return pb.ModerationState_MODERATION_STATE_REMOVED
+	}
+	return pb.ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func reportModelToProto(m *models.TemplateReport) *pb.TemplateReport {
+	return &pb.TemplateReport{
+		Id:         m.ID,
+		TemplateId: m.TemplateID,
+		ReporterId: m.ReporterID,
+		Reason:     reportReasonToProto(m.Reason),
+		Details:    m.Details,
+		Status:     reportStatusToProto(m.Status),
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+}
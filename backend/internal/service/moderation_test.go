@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockModerationRepository is a mock implementation of repository.ModerationRepository
+type MockModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockModerationRepository) CreateReport(ctx context.Context, r *models.TemplateReport) (int, error) {
+	args := m.Called(ctx, r)
+	return args.Int(0), args.Error(1)
+}
+func (m *MockModerationRepository) ListQueue(ctx context.Context, limit int, after *repository.Cursor) ([]*models.ModerationQueueItem, error) {
+	args := m.Called(ctx, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ModerationQueueItem), args.Error(1)
+}
+func (m *MockModerationRepository) ResolveReports(ctx context.Context, templateID, resolvedBy, status string) (int64, error) {
+	args := m.Called(ctx, templateID, resolvedBy, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestReportTemplate(t *testing.T) {
+	publicTemplate := func(state string) *models.Template {
+		return &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public", ModerationState: state}
+	}
+
+	tests := []struct {
+		name     string
+		state    string
+		open     int
+		wantHide bool
+	}{
+		{"BelowThreshold", "visible", 2, false},
+		{"ReachesThreshold", "visible", 3, true},
+		{"AlreadyHidden", "hidden", 4, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplateRepo := new(MockTemplateRepository)
+			mockModerationRepo := new(MockModerationRepository)
+			svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ModerationRepo: mockModerationRepo})
+			svc.ReportHideThreshold = 3
+			ctx := ContextWithUserID(context.Background(), "bob")
+
+			template := publicTemplate(tt.state)
+			if tt.state == "hidden" {
+				// Only administrators still see a template hidden from the public.
+				ctx = adminContext("bob")
+			}
+			mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+			mockModerationRepo.On("CreateReport", ctx, mock.MatchedBy(func(r *models.TemplateReport) bool {
+				return r.TemplateID == "t1" && r.ReporterID == "bob" && r.Reason == "spam"
+			})).Run(func(args mock.Arguments) {
+				args.Get(1).(*models.TemplateReport).Status = "open"
+			}).Return(tt.open, nil)
+			mockTemplateRepo.On("SetModerationState", ctx, "t1", "hidden").Return(nil)
+
+			resp, err := svc.ReportTemplate(ctx, &pb.ReportTemplateRequest{TemplateId: "t1", Reason: pb.ReportReason_REPORT_REASON_SPAM})
+			assert.NoError(t, err)
+			assert.Equal(t, pb.ReportStatus_REPORT_STATUS_OPEN, resp.Report.Status)
+			if tt.wantHide {
+				mockTemplateRepo.AssertCalled(t, "SetModerationState", ctx, "t1", "hidden")
+			} else {
+				mockTemplateRepo.AssertNotCalled(t, "SetModerationState", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("OwnTemplate", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockModerationRepo := new(MockModerationRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ModerationRepo: mockModerationRepo})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(publicTemplate("visible"), nil)
+
+		_, err := svc.ReportTemplate(ctx, &pb.ReportTemplateRequest{TemplateId: "t1", Reason: pb.ReportReason_REPORT_REASON_ABUSE})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockModerationRepo.AssertNotCalled(t, "CreateReport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockModerationRepo := new(MockModerationRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ModerationRepo: mockModerationRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(publicTemplate("visible"), nil)
+		mockModerationRepo.On("CreateReport", ctx, mock.Anything).Return(0, repository.ErrDuplicateReport)
+
+		_, err := svc.ReportTemplate(ctx, &pb.ReportTemplateRequest{TemplateId: "t1", Reason: pb.ReportReason_REPORT_REASON_SPAM})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("MissingReason", func(t *testing.T) {
+		svc := newTestService(PromptServiceDeps{ModerationRepo: new(MockModerationRepository)})
+
+		_, err := svc.ReportTemplate(ContextWithUserID(context.Background(), "bob"), &pb.ReportTemplateRequest{TemplateId: "t1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestModerateTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		action       pb.ModerationAction
+		wantState    string
+		wantStatus   string
+		wantWarnings int
+	}{
+		{"Dismiss", pb.ModerationAction_MODERATION_ACTION_DISMISS, "visible", "dismissed", 0},
+		{"Hide", pb.ModerationAction_MODERATION_ACTION_HIDE, "hidden", "actioned", 0},
+		{"Remove", pb.ModerationAction_MODERATION_ACTION_REMOVE, "removed", "actioned", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(MockUserRepository)
+			mockTemplateRepo := new(MockTemplateRepository)
+			mockModerationRepo := new(MockModerationRepository)
+			mockEmail := new(MockEmailService)
+			svc := NewAdminService(mockUserRepo, mockTemplateRepo, mockModerationRepo, mockEmail, "secret")
+			ctx := adminContext("root")
+
+			template := &models.Template{ID: "t1", OwnerID: "alice", Title: "Spam", Language: "en", ModerationState: "hidden"}
+			mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+			mockTemplateRepo.On("SetModerationState", ctx, "t1", tt.wantState).Return(nil)
+			mockModerationRepo.On("ResolveReports", ctx, "t1", "root", tt.wantStatus).Return(int64(4), nil)
+			mockUserRepo.On("GetByID", ctx, "alice").Return(&models.User{ID: "alice", Email: "alice@example.com"}, nil)
+			mockEmail.On("SendModerationWarning", "alice@example.com", "Spam", "against the rules", "en").Return(nil)
+
+			resp, err := svc.ModerateTemplate(ctx, &pb.ModerateTemplateRequest{TemplateId: "t1", Action: tt.action, Note: "against the rules"})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(4), resp.ResolvedReports)
+			assert.Equal(t, moderationStateToProto(tt.wantState), resp.Template.ModerationState)
+			mockEmail.AssertNumberOfCalls(t, "SendModerationWarning", tt.wantWarnings)
+		})
+	}
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := NewAdminService(new(MockUserRepository), mockTemplateRepo, new(MockModerationRepository), new(MockEmailService), "secret")
+
+		_, err := svc.ModerateTemplate(ContextWithUserID(context.Background(), "bob"), &pb.ModerateTemplateRequest{TemplateId: "t1", Action: pb.ModerationAction_MODERATION_ACTION_REMOVE})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockTemplateRepo.AssertNotCalled(t, "SetModerationState", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// notificationCollapseWindow is how long an unread notification keeps absorbing
+// repeated events of the same type on the same template.
+const notificationCollapseWindow = time.Hour
+
+// maxMarkReadIDs caps the number of notifications marked read in one call.
+const maxMarkReadIDs = 100
+
+// NotificationService implements the notification inbox RPCs. Every RPC acts on
+// the caller's own notifications.
+type NotificationService struct {
+	pb.UnimplementedNotificationServiceServer
+	NotificationRepo repository.NotificationRepository
+	PageTokens       *PageTokenCodec
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository, pageTokenSecret string) *NotificationService {
+	return &NotificationService{
+		NotificationRepo: notificationRepo,
+		PageTokens:       NewPageTokenCodec(pageTokenSecret),
+	}
+}
+
+// ListNotifications lists the caller's notifications, latest activity first.
+func (s *NotificationService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("NotificationService.ListNotifications: user_id=%s page_size=%d unread_only=%t", userID, req.PageSize, req.UnreadOnly)
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := map[string]interface{}{"user_id": userID, "unread_only": req.UnreadOnly}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	notifications, err := s.NotificationRepo.List(ctx, userID, req.UnreadOnly, limit+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+	unread, err := s.NotificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count notifications: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.NotificationCursor(notifications[limit-1]), filters)
+	}
+
+	var pbNotifications []*pb.Notification
+	for _, n := range notifications {
+		pbNotifications = append(pbNotifications, notificationModelToProto(n))
+	}
+	return &pb.ListNotificationsResponse{
+		Notifications: pbNotifications,
+		NextPageToken: nextPageToken,
+		UnreadCount:   int32(unread),
+	}, nil
+}
+
+// MarkRead marks some of the caller's notifications as read.
+func (s *NotificationService) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("NotificationService.MarkRead: user_id=%s ids=%v", userID, req.Ids)
+
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if len(req.Ids) > maxMarkReadIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ids can be marked read at once", maxMarkReadIDs)
+	}
+
+	updated, err := s.NotificationRepo.MarkRead(ctx, userID, req.Ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications read: %v", err)
+	}
+	return &pb.MarkReadResponse{Updated: int32(updated)}, nil
+}
+
+// MarkAllRead marks all of the caller's notifications as read.
+func (s *NotificationService) MarkAllRead(ctx context.Context, req *pb.MarkAllReadRequest) (*pb.MarkAllReadResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("NotificationService.MarkAllRead: user_id=%s", userID)
+
+	updated, err := s.NotificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications read: %v", err)
+	}
+	return &pb.MarkAllReadResponse{Updated: int32(updated)}, nil
+}
+
+// notify records an event for its recipient. Users are not notified of their
+// own actions, and failures are only logged: a notification never fails the
+// action that caused it.
+func (s *PromptService) notify(ctx context.Context, recipientID, notificationType, actorID, templateID, commentID string) {
+	if s.NotificationRepo == nil || recipientID == "" || recipientID == actorID {
+		return
+	}
+	n := &models.Notification{
+		UserID:     recipientID,
+		Type:       notificationType,
+		ActorID:    actorID,
+		TemplateID: sql.NullString{String: templateID, Valid: templateID != ""},
+		CommentID:  sql.NullString{String: commentID, Valid: commentID != ""},
+	}
+	if err := s.NotificationRepo.Record(ctx, n, notificationCollapseWindow); err != nil {
+		zap.S().Warnf("Failed to notify user %s of %s on template %s: %v", recipientID, notificationType, templateID, err)
+	}
+}
+
+func notificationTypeToProto(t string) pb.NotificationType {
+	switch t {
+	case "like":
+		return pb.NotificationType_NOTIFICATION_TYPE_LIKE
+	case "favorite":
+		return pb.NotificationType_NOTIFICATION_TYPE_FAVORITE
+	case "fork":
+		return pb.NotificationType_NOTIFICATION_TYPE_FORK
+	case "comment":
+		return pb.NotificationType_NOTIFICATION_TYPE_COMMENT
+	case "reply":
+		return pb.NotificationType_NOTIFICATION_TYPE_REPLY
+	case "review":
+		return pb.NotificationType_NOTIFICATION_TYPE_REVIEW
+	default:
+		return pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
+
+func notificationModelToProto(m *models.Notification) *pb.Notification {
+	return &pb.Notification{
+		Id:               m.ID,
+		Type:             notificationTypeToProto(m.Type),
+		ActorId:          m.ActorID,
+		ActorDisplayName: m.ActorName,
+		ActorCount:       int32(len(m.ActorIDs)),
+		EventCount:       m.EventCount,
+		TemplateId:       m.TemplateID.String,
+		TemplateTitle:    m.TemplateTitle,
+		CommentId:        m.CommentID.String,
+		Read:             m.ReadAt.Valid,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+		UpdatedAt:        timestamppb.New(m.UpdatedAt),
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockNotificationRepository is a mock implementation of repository.NotificationRepository
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) Record(ctx context.Context, n *models.Notification, window time.Duration) error {
+	args := m.Called(ctx, n, window)
+	return args.Error(0)
+}
+func (m *MockNotificationRepository) List(ctx context.Context, userID string, unreadOnly bool, limit int, after *repository.Cursor) ([]*models.Notification, error) {
+	args := m.Called(ctx, userID, unreadOnly, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Notification), args.Error(1)
+}
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, userID string, ids []string) (int64, error) {
+	args := m.Called(ctx, userID, ids)
+	return args.Get(0).(int64), args.Error(1)
+}
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestListNotifications(t *testing.T) {
+	mockRepo := new(MockNotificationRepository)
+	svc := NewNotificationService(mockRepo, "secret")
+	ctx := ContextWithUserID(context.Background(), "alice")
+
+	now := time.Now()
+	notifications := []*models.Notification{
+		{ID: "n1", UserID: "alice", Type: "like", ActorID: "bob", ActorIDs: pq.StringArray{"carol", "bob"}, EventCount: 3,
+			TemplateID: sql.NullString{String: "t1", Valid: true}, ActorName: "Bob", TemplateTitle: "Greeting", UpdatedAt: now},
+		{ID: "n2", UserID: "alice", Type: "reply", ActorID: "carol", ActorIDs: pq.StringArray{"carol"}, EventCount: 1,
+			CommentID: sql.NullString{String: "c1", Valid: true}, ReadAt: sql.NullTime{Time: now, Valid: true}, UpdatedAt: now.Add(-time.Minute)},
+		{ID: "n3", UserID: "alice", Type: "fork", ActorID: "dave", ActorIDs: pq.StringArray{"dave"}, EventCount: 1, UpdatedAt: now.Add(-time.Hour)},
+	}
+	mockRepo.On("List", ctx, "alice", false, 3, (*repository.Cursor)(nil)).Return(notifications, nil)
+	mockRepo.On("CountUnread", ctx, "alice").Return(int64(2), nil)
+
+	resp, err := svc.ListNotifications(ctx, &pb.ListNotificationsRequest{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Notifications, 2)
+	assert.Equal(t, int32(2), resp.UnreadCount)
+	assert.Equal(t, pb.NotificationType_NOTIFICATION_TYPE_LIKE, resp.Notifications[0].Type)
+	assert.Equal(t, int32(2), resp.Notifications[0].ActorCount)
+	assert.Equal(t, int32(3), resp.Notifications[0].EventCount)
+	assert.False(t, resp.Notifications[0].Read)
+	assert.True(t, resp.Notifications[1].Read)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	// The page token is bound to the filters it was issued for.
+	_, err = svc.ListNotifications(ctx, &pb.ListNotificationsRequest{UnreadOnly: true, PageToken: resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.ListNotifications(context.Background(), &pb.ListNotificationsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestMarkRead(t *testing.T) {
+	mockRepo := new(MockNotificationRepository)
+	svc := NewNotificationService(mockRepo, "secret")
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockRepo.On("MarkRead", ctx, "alice", []string{"n1", "n2"}).Return(int64(1), nil)
+	mockRepo.On("MarkAllRead", ctx, "alice").Return(int64(4), nil)
+
+	resp, err := svc.MarkRead(ctx, &pb.MarkReadRequest{Ids: []string{"n1", "n2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Updated)
+
+	_, err = svc.MarkRead(ctx, &pb.MarkReadRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	all, err := svc.MarkAllRead(ctx, &pb.MarkAllReadRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), all.Updated)
+}
+
+func TestCommentNotifications(t *testing.T) {
+	template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}
+
+	tests := []struct {
+		name         string
+		actor        string
+		parentAuthor string
+		want         map[string]string // recipient -> type
+	}{
+		{"Comment", "bob", "", map[string]string{"alice": "comment"}},
+		{"ReplyToOther", "bob", "carol", map[string]string{"carol": "reply", "alice": "comment"}},
+		{"ReplyToOwner", "bob", "alice", map[string]string{"alice": "reply"}},
+		{"OwnerReplies", "alice", "bob", map[string]string{"bob": "reply"}},
+		{"OwnComment", "alice", "", map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplateRepo := new(MockTemplateRepository)
+			mockCommentRepo := new(MockCommentRepository)
+			mockNotificationRepo := new(MockNotificationRepository)
+			svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 3}, CommentRepo: mockCommentRepo, NotificationRepo: mockNotificationRepo})
+			ctx := ContextWithUserID(context.Background(), tt.actor)
+			mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+			mockCommentRepo.On("Get", ctx, "c1").Return(&models.Comment{ID: "c1", TemplateID: "t1", AuthorID: tt.parentAuthor}, nil)
+			mockCommentRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+				args.Get(1).(*models.Comment).ID = "c2"
+			}).Return(nil)
+
+			got := map[string]string{}
+			mockNotificationRepo.On("Record", ctx, mock.Anything, notificationCollapseWindow).Run(func(args mock.Arguments) {
+				n := args.Get(1).(*models.Notification)
+				assert.Equal(t, tt.actor, n.ActorID)
+				assert.Equal(t, "c2", n.CommentID.String)
+				got[n.UserID] = n.Type
+			}).Return(nil)
+
+			req := &pb.CreateCommentRequest{TemplateId: "t1", Body: "hi"}
+			if tt.parentAuthor != "" {
+				req.ParentId = "c1"
+			}
+			_, err := svc.CreateComment(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotifyFailureDoesNotFailAction(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockReviewRepo := new(MockReviewRepository)
+	mockNotificationRepo := new(MockNotificationRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo, NotificationRepo: mockNotificationRepo})
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+	mockReviewRepo.On("Upsert", ctx, mock.Anything).Return(nil)
+	mockNotificationRepo.On("Record", ctx, mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserID == "alice" && n.Type == "review" && n.TemplateID.String == "t1"
+	}), notificationCollapseWindow).Return(errors.New("db down"))
+
+	_, err := svc.RateTemplate(ctx, &pb.RateTemplateRequest{TemplateId: "t1", Rating: 5})
+	assert.NoError(t, err)
+	mockNotificationRepo.AssertExpectations(t)
+}
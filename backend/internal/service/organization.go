@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// invitationTTL is how long an organization invitation can be accepted.
+const invitationTTL = 7 * 24 * time.Hour
+
+type OrganizationService struct {
+	pb.UnimplementedOrganizationServiceServer
+	OrgRepo  repository.OrganizationRepository
+	UserRepo repository.UserRepository
+	EmailSvc EmailService
+	Audit    *Auditor
+}
+
+func NewOrganizationService(orgRepo repository.OrganizationRepository, userRepo repository.UserRepository, emailSvc EmailService) *OrganizationService {
+	return &OrganizationService{
+		OrgRepo:  orgRepo,
+		UserRepo: userRepo,
+		EmailSvc: emailSvc,
+	}
+}
+
+// CreateOrganization creates an organization with the current user as its owner.
+func (s *OrganizationService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("OrganizationService.CreateOrganization: user_id=%s name=%s", userID, req.Name)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	org := &models.Organization{
+		Name:      name,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := s.OrgRepo.Create(ctx, org, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+	}
+	s.Audit.Record(ctx, "organization.create", org.ID, userID, nil, map[string]interface{}{"name": org.Name})
+	return &pb.CreateOrganizationResponse{Organization: organizationModelToProto(org)}, nil
+}
+
+// GetOrganization retrieves an organization. Only members can see it.
+func (s *OrganizationService) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.GetOrganizationResponse, error) {
+	zap.S().Infof("OrganizationService.GetOrganization: id=%s", req.Id)
+	member, err := orgMembershipFor(ctx, s.OrgRepo, req.Id, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.OrgRepo.Get(ctx, req.Id, member.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found")
+	}
+	return &pb.GetOrganizationResponse{Organization: organizationModelToProto(org)}, nil
+}
+
+// ListOrganizations lists the organizations of the current user.
+func (s *OrganizationService) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("OrganizationService.ListOrganizations: user_id=%s", userID)
+
+	orgs, err := s.OrgRepo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+	}
+	var pbOrgs []*pb.Organization
+	for _, o := range orgs {
+		pbOrgs = append(pbOrgs, organizationModelToProto(o))
+	}
+	return &pb.ListOrganizationsResponse{Organizations: pbOrgs}, nil
+}
+
+// ListOrganizationMembers lists the members of an organization. Only members can list them.
+func (s *OrganizationService) ListOrganizationMembers(ctx context.Context, req *pb.ListOrganizationMembersRequest) (*pb.ListOrganizationMembersResponse, error) {
+	zap.S().Infof("OrganizationService.ListOrganizationMembers: org_id=%s", req.OrgId)
+	if _, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Read); err != nil {
+		return nil, err
+	}
+	members, err := s.OrgRepo.ListMembers(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list members: %v", err)
+	}
+	var pbMembers []*pb.OrganizationMember
+	for _, m := range members {
+		pbMembers = append(pbMembers, memberModelToProto(m))
+	}
+	return &pb.ListOrganizationMembersResponse{Members: pbMembers}, nil
+}
+
+// InviteOrganizationMember emails an invitation to join an organization.
+// Admins can invite members; only owners can invite admins.
+func (s *OrganizationService) InviteOrganizationMember(ctx context.Context, req *pb.InviteOrganizationMemberRequest) (*pb.InviteOrganizationMemberResponse, error) {
+	zap.S().Infof("OrganizationService.InviteOrganizationMember: org_id=%s email=%s role=%s", req.OrgId, req.Email, req.Role)
+	inviter, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	role := "member"
+	switch req.Role {
+	case pb.OrgRole_ORG_ROLE_UNSPECIFIED, pb.OrgRole_ORG_ROLE_MEMBER:
+	case pb.OrgRole_ORG_ROLE_ADMIN:
+		role = "admin"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "owners cannot be invited, promote a member instead")
+	}
+	invitee := &models.OrganizationMember{OrgID: req.OrgId, Role: role}
+	if err := authorize(policy.OrganizationMember(principal(ctx), policy.Invite, inviter.Role, invitee), "organization"); err != nil {
+		return nil, err
+	}
+
+	org, err := s.OrgRepo.Get(ctx, req.OrgId, inviter.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found")
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	now := time.Now()
+	inv := &models.OrganizationInvitation{
+		OrgID:     org.ID,
+		Email:     email,
+		Role:      role,
+		TokenHash: hashSecretToken(token),
+		InvitedBy: inviter.UserID,
+		ExpiresAt: now.Add(invitationTTL),
+		CreatedAt: now,
+	}
+	if err := s.OrgRepo.CreateInvitation(ctx, inv); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invitation: %v", err)
+	}
+	if err := s.EmailSvc.SendOrganizationInvitation(email, org.Name, inviter.DisplayName, token, req.Language); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send invitation: %v", err)
+	}
+	s.Audit.Record(ctx, "organization.invite_member", org.ID, org.CreatedBy, nil, map[string]interface{}{"email": inv.Email, "role": inv.Role})
+
+	return &pb.InviteOrganizationMemberResponse{Invitation: invitationModelToProto(inv)}, nil
+}
+
+// AcceptOrganizationInvitation adds the current user to an organization.
+// The invitation must have been sent to the user's email address.
+func (s *OrganizationService) AcceptOrganizationInvitation(ctx context.Context, req *pb.AcceptOrganizationInvitationRequest) (*pb.AcceptOrganizationInvitationResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("OrganizationService.AcceptOrganizationInvitation: user_id=%s", userID)
+
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	inv, err := s.OrgRepo.GetInvitationByTokenHash(ctx, hashSecretToken(req.Token))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "invitation not found")
+	}
+	if !inv.Pending(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "invitation has expired or was already accepted")
+	}
+	user, err := s.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if !strings.EqualFold(user.Email, inv.Email) {
+		return nil, status.Error(codes.PermissionDenied, "invitation was sent to another email address")
+	}
+
+	if err := s.OrgRepo.AcceptInvitation(ctx, inv, userID); err != nil {
+		if errors.Is(err, repository.ErrInvitationUsed) {
+			return nil, status.Error(codes.FailedPrecondition, "invitation has expired or was already accepted")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation: %v", err)
+	}
+	s.Audit.Record(ctx, "organization.join", inv.OrgID, userID, nil, map[string]interface{}{"user_id": userID, "role": inv.Role})
+
+	org, err := s.OrgRepo.Get(ctx, inv.OrgID, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	return &pb.AcceptOrganizationInvitationResponse{Organization: organizationModelToProto(org)}, nil
+}
+
+// UpdateOrganizationMember changes the role of a member. Only owners can change roles,
+// and an organization always keeps at least one owner.
+func (s *OrganizationService) UpdateOrganizationMember(ctx context.Context, req *pb.UpdateOrganizationMemberRequest) (*pb.UpdateOrganizationMemberResponse, error) {
+	zap.S().Infof("OrganizationService.UpdateOrganizationMember: org_id=%s user_id=%s role=%s", req.OrgId, req.UserId, req.Role)
+	caller, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	target := &models.OrganizationMember{OrgID: req.OrgId, UserID: req.UserId}
+	if err := authorize(policy.OrganizationMember(principal(ctx), policy.Update, caller.Role, target), "organization"); err != nil {
+		return nil, err
+	}
+	role := orgRoleFromProto(req.Role)
+	if role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	if err := s.OrgRepo.UpdateMemberRole(ctx, req.OrgId, req.UserId, role); err != nil {
+		return nil, memberChangeError(err)
+	}
+	member, err := s.OrgRepo.GetMember(ctx, req.OrgId, req.UserId)
+	if err != nil || member == nil {
+		return nil, status.Errorf(codes.Internal, "failed to get member: %v", err)
+	}
+	s.Audit.Record(ctx, "organization.update_member", req.OrgId, req.UserId, nil, map[string]interface{}{"user_id": req.UserId, "role": role})
+	return &pb.UpdateOrganizationMemberResponse{Member: memberModelToProto(member)}, nil
+}
+
+// RemoveOrganizationMember removes a member from an organization.
+// Any member can leave; admins can remove members and owners can remove anyone.
+func (s *OrganizationService) RemoveOrganizationMember(ctx context.Context, req *pb.RemoveOrganizationMemberRequest) (*pb.RemoveOrganizationMemberResponse, error) {
+	zap.S().Infof("OrganizationService.RemoveOrganizationMember: org_id=%s user_id=%s", req.OrgId, req.UserId)
+	caller, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	target := caller
+	if req.UserId != caller.UserID {
+		if target, err = s.OrgRepo.GetMember(ctx, req.OrgId, req.UserId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get member: %v", err)
+		}
+		if target == nil {
+			return nil, status.Errorf(codes.NotFound, "member not found")
+		}
+	}
+	if err := authorize(policy.OrganizationMember(principal(ctx), policy.Delete, caller.Role, target), "organization"); err != nil {
+		return nil, err
+	}
+
+	if err := s.OrgRepo.RemoveMember(ctx, req.OrgId, req.UserId); err != nil {
+		return nil, memberChangeError(err)
+	}
+	s.Audit.Record(ctx, "organization.remove_member", req.OrgId, req.UserId, map[string]interface{}{"user_id": req.UserId}, nil)
+	return &pb.RemoveOrganizationMemberResponse{Success: true}, nil
+}
+
+// --- Organization Helpers ---
+
+// orgMembershipFor returns the current user's membership of an organization
+// if they may perform the action on it. Organizations the user is not a member
+// of are reported as not found.
+func orgMembershipFor(ctx context.Context, repo repository.OrganizationRepository, orgID string, action policy.Action) (*models.OrganizationMember, error) {
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "organization")
+	}
+	member, err := repo.GetMember(ctx, orgID, p.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get membership: %v", err)
+	}
+	role := ""
+	if member != nil {
+		role = member.Role
+	}
+	if err := authorize(policy.Organization(p, action, role), "organization"); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func memberChangeError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotMember):
+		return status.Errorf(codes.NotFound, "member not found")
+	case errors.Is(err, repository.ErrLastOwner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to update member: %v", err)
+}
+
+func orgRoleFromProto(r pb.OrgRole) string {
+	switch r {
+	case pb.OrgRole_ORG_ROLE_MEMBER:
+		return "member"
+	case pb.OrgRole_ORG_ROLE_ADMIN:
+		return "admin"
+	case pb.OrgRole_ORG_ROLE_OWNER:
+		return "owner"
+	}
+	return ""
+}
+
+func orgRoleToProto(role string) pb.OrgRole {
+	switch role {
+	case "member":
+		return pb.OrgRole_ORG_ROLE_MEMBER
+	case "admin":
+		return pb.OrgRole_ORG_ROLE_ADMIN
+	case "owner":
+		return pb.OrgRole_ORG_ROLE_OWNER
+	}
+	return pb.OrgRole_ORG_ROLE_UNSPECIFIED
+}
+
+func organizationModelToProto(m *models.Organization) *pb.Organization {
+	return &pb.Organization{
+		Id:          m.ID,
+		Name:        m.Name,
+		Role:        orgRoleToProto(m.Role),
+		MemberCount: m.MemberCount,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+}
+
+func memberModelToProto(m *models.OrganizationMember) *pb.OrganizationMember {
+	return &pb.OrganizationMember{
+		OrgId:       m.OrgID,
+		UserId:      m.UserID,
+		DisplayName: m.DisplayName,
+		Role:        orgRoleToProto(m.Role),
+		JoinedAt:    timestamppb.New(m.CreatedAt),
+	}
+}
+
+func invitationModelToProto(m *models.OrganizationInvitation) *pb.OrganizationInvitation {
+	return &pb.OrganizationInvitation{
+		Id:        m.ID,
+		OrgId:     m.OrgID,
+		Email:     m.Email,
+		Role:      orgRoleToProto(m.Role),
+		InvitedBy: m.InvitedBy,
+		ExpiresAt: timestamppb.New(m.ExpiresAt),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockOrganizationRepository is a mock implementation of repository.OrganizationRepository
+type MockOrganizationRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrganizationRepository) Create(ctx context.Context, o *models.Organization, ownerID string) error {
+	args := m.Called(ctx, o, ownerID)
+	return args.Error(0)
+}
+func (m *MockOrganizationRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Organization, error) {
+	args := m.Called(ctx, id, currentUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Organization), args.Error(1)
+}
+func (m *MockOrganizationRepository) ListForUser(ctx context.Context, userID string) ([]*models.Organization, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Organization), args.Error(1)
+}
+func (m *MockOrganizationRepository) GetMember(ctx context.Context, orgID, userID string) (*models.OrganizationMember, error) {
+	args := m.Called(ctx, orgID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.OrganizationMember), args.Error(1)
+}
+func (m *MockOrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]*models.OrganizationMember, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.OrganizationMember), args.Error(1)
+}
+func (m *MockOrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID, role string) error {
+	args := m.Called(ctx, orgID, userID, role)
+	return args.Error(0)
+}
+func (m *MockOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	args := m.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
+func (m *MockOrganizationRepository) CreateInvitation(ctx context.Context, inv *models.OrganizationInvitation) error {
+	args := m.Called(ctx, inv)
+	return args.Error(0)
+}
+func (m *MockOrganizationRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.OrganizationInvitation, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.OrganizationInvitation), args.Error(1)
+}
+func (m *MockOrganizationRepository) AcceptInvitation(ctx context.Context, inv *models.OrganizationInvitation, userID string) error {
+	args := m.Called(ctx, inv, userID)
+	return args.Error(0)
+}
+
+func member(userID, role string) *models.OrganizationMember {
+	return &models.OrganizationMember{OrgID: "o1", UserID: userID, Role: role, DisplayName: userID}
+}
+
+func TestInviteOrganizationMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		inviter  string
+		role     pb.OrgRole
+		wantCode codes.Code
+	}{
+		{"AdminInvitesMember", "admin", pb.OrgRole_ORG_ROLE_MEMBER, codes.OK},
+		{"OwnerInvitesAdmin", "owner", pb.OrgRole_ORG_ROLE_ADMIN, codes.OK},
+		{"AdminCannotInviteAdmin", "admin", pb.OrgRole_ORG_ROLE_ADMIN, codes.PermissionDenied},
+		{"MemberCannotInvite", "member", pb.OrgRole_ORG_ROLE_MEMBER, codes.PermissionDenied},
+		{"NobodyInvitesOwners", "owner", pb.OrgRole_ORG_ROLE_OWNER, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgRepo := new(MockOrganizationRepository)
+			mockEmail := new(MockEmailService)
+			svc := NewOrganizationService(mockOrgRepo, new(MockUserRepository), mockEmail)
+			ctx := ContextWithUserID(context.Background(), "alice")
+
+			mockOrgRepo.On("GetMember", ctx, "o1", "alice").Return(member("alice", tt.inviter), nil)
+			mockOrgRepo.On("Get", ctx, "o1", "alice").Return(&models.Organization{ID: "o1", Name: "Acme"}, nil)
+			var stored *models.OrganizationInvitation
+			mockOrgRepo.On("CreateInvitation", ctx, mock.Anything).Run(func(args mock.Arguments) {
+				stored = args.Get(1).(*models.OrganizationInvitation)
+			}).Return(nil)
+			var token string
+			mockEmail.On("SendOrganizationInvitation", "bob@example.com", "Acme", "alice", mock.Anything, "en").Run(func(args mock.Arguments) {
+				token = args.String(3)
+			}).Return(nil)
+
+			resp, err := svc.InviteOrganizationMember(ctx, &pb.InviteOrganizationMemberRequest{
+				OrgId: "o1", Email: "bob@example.com", Role: tt.role, Language: "en",
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				mockEmail.AssertNotCalled(t, "SendOrganizationInvitation", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.Equal(t, tt.role, resp.Invitation.Role)
+			assert.Equal(t, hashSecretToken(token), stored.TokenHash)
+			assert.WithinDuration(t, time.Now().Add(invitationTTL), stored.ExpiresAt, time.Minute)
+		})
+	}
+
+	t.Run("NotAMember", func(t *testing.T) {
+		mockOrgRepo := new(MockOrganizationRepository)
+		svc := NewOrganizationService(mockOrgRepo, new(MockUserRepository), new(MockEmailService))
+		ctx := ContextWithUserID(context.Background(), "mallory")
+		mockOrgRepo.On("GetMember", ctx, "o1", "mallory").Return(nil, nil)
+
+		_, err := svc.InviteOrganizationMember(ctx, &pb.InviteOrganizationMemberRequest{OrgId: "o1", Email: "x@example.com"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestAcceptOrganizationInvitation(t *testing.T) {
+	pending := func() *models.OrganizationInvitation {
+		return &models.OrganizationInvitation{ID: "i1", OrgID: "o1", Email: "Bob@Example.com", Role: "member", ExpiresAt: time.Now().Add(time.Hour)}
+	}
+	setup := func(inv *models.OrganizationInvitation, email string) (*OrganizationService, *MockOrganizationRepository, context.Context) {
+		mockOrgRepo := new(MockOrganizationRepository)
+		mockUserRepo := new(MockUserRepository)
+		svc := NewOrganizationService(mockOrgRepo, mockUserRepo, new(MockEmailService))
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockOrgRepo.On("GetInvitationByTokenHash", ctx, hashSecretToken("tok")).Return(inv, nil)
+		mockUserRepo.On("GetByID", ctx, "bob").Return(&models.User{ID: "bob", Email: email}, nil)
+		return svc, mockOrgRepo, ctx
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inv := pending()
+		svc, mockOrgRepo, ctx := setup(inv, "bob@example.com")
+		mockOrgRepo.On("AcceptInvitation", ctx, inv, "bob").Return(nil)
+		mockOrgRepo.On("Get", ctx, "o1", "bob").Return(&models.Organization{ID: "o1", Name: "Acme", Role: "member"}, nil)
+
+		resp, err := svc.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{Token: "tok"})
+		assert.NoError(t, err)
+		assert.Equal(t, pb.OrgRole_ORG_ROLE_MEMBER, resp.Organization.Role)
+	})
+
+	t.Run("OtherEmail", func(t *testing.T) {
+		svc, mockOrgRepo, ctx := setup(pending(), "eve@example.com")
+
+		_, err := svc.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{Token: "tok"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockOrgRepo.AssertNotCalled(t, "AcceptInvitation", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		inv := pending()
+		inv.ExpiresAt = time.Now().Add(-time.Hour)
+		svc, _, ctx := setup(inv, "bob@example.com")
+
+		_, err := svc.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{Token: "tok"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("AlreadyAccepted", func(t *testing.T) {
+		inv := pending()
+		inv.AcceptedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		svc, _, ctx := setup(inv, "bob@example.com")
+
+		_, err := svc.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{Token: "tok"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		callerRole string
+		target     string
+		targetRole string
+		wantCode   codes.Code
+	}{
+		{"AdminRemovesMember", "admin", "bob", "member", codes.OK},
+		{"AdminCannotRemoveAdmin", "admin", "bob", "admin", codes.PermissionDenied},
+		{"MemberCannotRemoveOthers", "member", "bob", "member", codes.PermissionDenied},
+		{"OwnerRemovesAdmin", "owner", "bob", "admin", codes.OK},
+		{"MemberLeaves", "member", "alice", "member", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgRepo := new(MockOrganizationRepository)
+			svc := NewOrganizationService(mockOrgRepo, new(MockUserRepository), new(MockEmailService))
+			ctx := ContextWithUserID(context.Background(), "alice")
+			mockOrgRepo.On("GetMember", ctx, "o1", "alice").Return(member("alice", tt.callerRole), nil)
+			mockOrgRepo.On("GetMember", ctx, "o1", "bob").Return(member("bob", tt.targetRole), nil)
+			mockOrgRepo.On("RemoveMember", ctx, "o1", tt.target).Return(nil)
+
+			_, err := svc.RemoveOrganizationMember(ctx, &pb.RemoveOrganizationMemberRequest{OrgId: "o1", UserId: tt.target})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+
+	t.Run("LastOwnerCannotLeave", func(t *testing.T) {
+		mockOrgRepo := new(MockOrganizationRepository)
+		svc := NewOrganizationService(mockOrgRepo, new(MockUserRepository), new(MockEmailService))
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockOrgRepo.On("GetMember", ctx, "o1", "alice").Return(member("alice", "owner"), nil)
+		mockOrgRepo.On("RemoveMember", ctx, "o1", "alice").Return(repository.ErrLastOwner)
+
+		_, err := svc.RemoveOrganizationMember(ctx, &pb.RemoveOrganizationMemberRequest{OrgId: "o1", UserId: "alice"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestUpdateOrganizationMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		callerRole string
+		wantCode   codes.Code
+	}{
+		{"OwnerPromotes", "owner", codes.OK},
+		{"AdminCannotPromote", "admin", codes.PermissionDenied},
+		{"MemberCannotPromote", "member", codes.PermissionDenied},
+		{"OutsiderSeesNothing", "", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgRepo := new(MockOrganizationRepository)
+			svc := NewOrganizationService(mockOrgRepo, new(MockUserRepository), new(MockEmailService))
+			ctx := ContextWithUserID(context.Background(), "alice")
+			if tt.callerRole == "" {
+				mockOrgRepo.On("GetMember", ctx, "o1", "alice").Return(nil, nil)
+			} else {
+				mockOrgRepo.On("GetMember", ctx, "o1", "alice").Return(member("alice", tt.callerRole), nil)
+			}
+			mockOrgRepo.On("UpdateMemberRole", ctx, "o1", "bob", "admin").Return(nil)
+			mockOrgRepo.On("GetMember", ctx, "o1", "bob").Return(member("bob", "admin"), nil)
+
+			_, err := svc.UpdateOrganizationMember(ctx, &pb.UpdateOrganizationMemberRequest{OrgId: "o1", UserId: "bob", Role: pb.OrgRole_ORG_ROLE_ADMIN})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				mockOrgRepo.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestOrganizationTemplates(t *testing.T) {
+	orgTemplate := func(visibility string) *models.Template {
+		return &models.Template{ID: "t1", OwnerID: "alice", Title: "Library", Visibility: visibility, OrgID: sql.NullString{String: "o1", Valid: true}}
+	}
+	setup := func(userID, role string) (*PromptService, *MockTemplateRepository, context.Context) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockShareRepo := new(MockShareRepository)
+		mockOrgRepo := new(MockOrganizationRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ShareRepo: mockShareRepo, OrgRepo: mockOrgRepo})
+		ctx := ContextWithUserID(context.Background(), userID)
+		mockShareRepo.On("GetGrant", ctx, mock.Anything, userID).Return(nil, nil)
+		if role == "" {
+			mockOrgRepo.On("GetMember", ctx, "o1", userID).Return(nil, nil)
+		} else {
+			mockOrgRepo.On("GetMember", ctx, "o1", userID).Return(member(userID, role), nil)
+		}
+		return svc, mockTemplateRepo, ctx
+	}
+
+	t.Run("MemberReadsOrgTemplate", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "member")
+		mockTemplateRepo.On("Get", ctx, "t1", "bob").Return(orgTemplate("org"), nil)
+
+		resp, err := svc.GetTemplate(ctx, &pb.GetTemplateRequest{Id: "t1"})
+		assert.NoError(t, err)
+		assert.Equal(t, pb.Visibility_VISIBILITY_ORG, resp.Template.Visibility)
+		assert.Equal(t, "o1", resp.Template.OrgId)
+	})
+
+	t.Run("OutsiderCannotRead", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("eve", "")
+		mockTemplateRepo.On("Get", ctx, "t1", "eve").Return(orgTemplate("org"), nil)
+
+		_, err := svc.GetTemplate(ctx, &pb.GetTemplateRequest{Id: "t1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("PrivateStaysPrivate", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "admin")
+		mockTemplateRepo.On("Get", ctx, "t1", "bob").Return(orgTemplate("private"), nil)
+
+		_, err := svc.GetTemplate(ctx, &pb.GetTemplateRequest{Id: "t1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("AdminEdits", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "admin")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(orgTemplate("org"), nil)
+
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Edited"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("MemberCannotEdit", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "member")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(orgTemplate("org"), nil)
+
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Edited"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("CreateRequiresMembership", func(t *testing.T) {
+		svc, _, ctx := setup("eve", "")
+
+		_, err := svc.CreateTemplate(ctx, &pb.CreateTemplateRequest{Title: "x", OrgId: "o1", Visibility: pb.Visibility_VISIBILITY_ORG})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OrgVisibilityRequiresOrg", func(t *testing.T) {
+		svc, _, ctx := setup("bob", "member")
+
+		_, err := svc.CreateTemplate(ctx, &pb.CreateTemplateRequest{Title: "x", Visibility: pb.Visibility_VISIBILITY_ORG})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ListLibrary", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "member")
+		mockTemplateRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{
+			"org_id":          "o1",
+			"visible_to":      "bob",
+			"current_user_id": "bob",
+		}).Return([]*models.Template{orgTemplate("org")}, nil)
+
+		resp, err := svc.ListTemplates(ctx, &pb.ListTemplatesRequest{OrgId: "o1"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 1)
+	})
+
+	t.Run("ListLibraryTags", func(t *testing.T) {
+		svc, mockTemplateRepo, ctx := setup("bob", "member")
+		mockTemplateRepo.On("ListTags", ctx, map[string]interface{}{"org_id": "o1", "visible_to": "bob"}).
+			Return([]*models.TagStat{{Name: "internal", Count: 2}}, nil)
+
+		resp, err := svc.ListTags(ctx, &pb.ListTagsRequest{OrgId: "o1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "internal", resp.Tags[0].Name)
+	})
+
+	t.Run("OutsiderCannotListLibrary", func(t *testing.T) {
+		svc, _, ctx := setup("eve", "")
+
+		_, err := svc.ListCategories(ctx, &pb.ListCategoriesRequest{OrgId: "o1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"awsome-prompt/backend/internal/repository"
+)
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// PageTokenCodec turns repository cursors into opaque, tamper-proof page tokens.
+// A token is bound to the filter set of the listing that produced it, so it is
+// rejected when replayed against a listing with different filters.
+type PageTokenCodec struct {
+	secret []byte
+}
+
+// pageTokenPayload is the signed content of a page token.
+type pageTokenPayload struct {
+	Cursor  repository.Cursor `json:"c"`
+	Filters string            `json:"f"`
+}
+
+// NewPageTokenCodec creates a new PageTokenCodec signing with the given secret.
+func NewPageTokenCodec(secret string) *PageTokenCodec {
+	return &PageTokenCodec{secret: []byte(secret)}
+}
+
+// Encode returns the page token for a cursor within a listing with the given filters.
+func (c *PageTokenCodec) Encode(cursor *repository.Cursor, filters map[string]interface{}) string {
+	payload, _ := json.Marshal(pageTokenPayload{Cursor: *cursor, Filters: filtersFingerprint(filters)})
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Decode verifies a page token against the filters of the current listing and
+// returns its cursor. An empty token yields a nil cursor (the first page).
+func (c *PageTokenCodec) Decode(token string, filters map[string]interface{}) (*repository.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errInvalidPageToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, c.sign(payload)) {
+		return nil, errInvalidPageToken
+	}
+
+	var p pageTokenPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errInvalidPageToken
+	}
+	if p.Filters != filtersFingerprint(filters) {
+		return nil, errors.New("page token does not match the request filters")
+	}
+	return &p.Cursor, nil
+}
+
+func (c *PageTokenCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// filtersFingerprint returns a stable digest of a filter set.
+// encoding/json sorts map keys, so equal filter sets always marshal identically.
+func filtersFingerprint(filters map[string]interface{}) string {
+	b, _ := json.Marshal(filters)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
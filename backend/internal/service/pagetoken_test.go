@@ -0,0 +1,57 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	codec := NewPageTokenCodec("secret")
+	filters := map[string]interface{}{"visibility": "public", "tags": []string{"a", "b"}}
+	cursor := &repository.Cursor{Key: "2026-01-02T03:04:05.123456Z", ID: "tpl_1"}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		token := codec.Encode(cursor, filters)
+		assert.NotContains(t, token, ":")
+
+		decoded, err := codec.Decode(token, map[string]interface{}{"tags": []string{"a", "b"}, "visibility": "public"})
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("EmptyToken", func(t *testing.T) {
+		decoded, err := codec.Decode("", filters)
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("DifferentFilters", func(t *testing.T) {
+		token := codec.Encode(cursor, filters)
+		_, err := codec.Decode(token, map[string]interface{}{"visibility": "public"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		token := codec.Encode(cursor, filters)
+		payload, sig, _ := strings.Cut(token, ".")
+		forged := codec.Encode(&repository.Cursor{Key: "0", ID: "tpl_2"}, filters)
+		forgedPayload, _, _ := strings.Cut(forged, ".")
+
+		_, err := codec.Decode(forgedPayload+"."+sig, filters)
+		assert.Error(t, err)
+		_, err = codec.Decode(payload, filters)
+		assert.Error(t, err)
+		_, err = codec.Decode("20", filters)
+		assert.Error(t, err)
+	})
+
+	t.Run("OtherSecret", func(t *testing.T) {
+		token := NewPageTokenCodec("other").Encode(cursor, filters)
+		_, err := codec.Decode(token, filters)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+)
+
+// CreateVariablePreset creates a preset for the caller or, for its admins and owners, for an organization.
+func (s *PromptService) CreateVariablePreset(ctx context.Context, req *pb.CreateVariablePresetRequest) (*pb.CreateVariablePresetResponse, error) {
+	zap.S().Infof("PromptService.CreateVariablePreset: name=%s org_id=%s", req.Name, req.OrgId)
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	values, err := presetValuesJSON(req.Values)
+	if err != nil {
+		return nil, err
+	}
+	p := principal(ctx)
+	preset := &models.VariablePreset{
+		OwnerID: p.UserID,
+		OrgID:   sql.NullString{String: req.OrgId, Valid: req.OrgId != ""},
+		Name:    name,
+		Values:  values,
+	}
+	if err := s.authorizePreset(ctx, p, policy.Create, preset); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "organization not found")
+		}
+		return nil, err
+	}
+
+	if err := s.PresetRepo.Create(ctx, preset); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create preset: %v", err)
+	}
+	s.Audit.Record(ctx, "preset.create", preset.ID, preset.OwnerID, nil, presetAuditSummary(preset))
+	return &pb.CreateVariablePresetResponse{Preset: presetModelToProto(preset)}, nil
+}
+
+// UpdateVariablePreset replaces the name and values of a preset.
+func (s *PromptService) UpdateVariablePreset(ctx context.Context, req *pb.UpdateVariablePresetRequest) (*pb.UpdateVariablePresetResponse, error) {
+	zap.S().Infof("PromptService.UpdateVariablePreset: id=%s", req.Id)
+	preset, err := s.getPresetFor(ctx, req.Id, policy.Update)
+	if err != nil {
+		return nil, err
+	}
+	before := presetAuditSummary(preset)
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		preset.Name = name
+	}
+	if preset.Values, err = presetValuesJSON(req.Values); err != nil {
+		return nil, err
+	}
+	preset.UpdatedAt = time.Now()
+
+	if err := s.PresetRepo.Update(ctx, preset); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update preset: %v", err)
+	}
+	s.Audit.Record(ctx, "preset.update", preset.ID, preset.OwnerID, before, presetAuditSummary(preset))
+	return &pb.UpdateVariablePresetResponse{Preset: presetModelToProto(preset)}, nil
+}
+
+// DeleteVariablePreset deletes a preset.
+func (s *PromptService) DeleteVariablePreset(ctx context.Context, req *pb.DeleteVariablePresetRequest) (*pb.DeleteVariablePresetResponse, error) {
+	zap.S().Infof("PromptService.DeleteVariablePreset: id=%s", req.Id)
+	preset, err := s.getPresetFor(ctx, req.Id, policy.Delete)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PresetRepo.Delete(ctx, preset.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete preset: %v", err)
+	}
+	s.Audit.Record(ctx, "preset.delete", preset.ID, preset.OwnerID, presetAuditSummary(preset), nil)
+	return &pb.DeleteVariablePresetResponse{Success: true}, nil
+}
+
+// ListVariablePresets lists the caller's personal presets or, for its members, the presets of an organization.
+func (s *PromptService) ListVariablePresets(ctx context.Context, req *pb.ListVariablePresetsRequest) (*pb.ListVariablePresetsResponse, error) {
+	zap.S().Infof("PromptService.ListVariablePresets: org_id=%s", req.OrgId)
+	p := principal(ctx)
+	if p.Anonymous() {
+		return nil, authorize(policy.Unauthenticated, "preset")
+	}
+
+	var presets []*models.VariablePreset
+	var err error
+	if req.OrgId == "" {
+		presets, err = s.PresetRepo.ListForOwner(ctx, p.UserID)
+	} else {
+		orgPreset := &models.VariablePreset{OrgID: sql.NullString{String: req.OrgId, Valid: true}}
+		if err := s.authorizePreset(ctx, p, policy.Read, orgPreset); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "organization not found")
+			}
+			return nil, err
+		}
+		presets, err = s.PresetRepo.ListForOrg(ctx, req.OrgId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list presets: %v", err)
+	}
+
+	pbPresets := make([]*pb.VariablePreset, len(presets))
+	for i, preset := range presets {
+		pbPresets[i] = presetModelToProto(preset)
+	}
+	return &pb.ListVariablePresetsResponse{Presets: pbPresets}, nil
+}
+
+// namedValues resolves the named placeholders of version from the explicit
+// values, from a preset the caller may read when presetID is set, and from the
+// reserved variables. Explicit values may only set the reserved variables the
+// version lets prompts override; the preset values of the others are ignored.
+func (s *PromptService) namedValues(ctx context.Context, template *models.Template, version *models.TemplateVersion, presetID string, explicit map[string]string) (map[string]string, *pb.PlaceholderReport, error) {
+	if err := checkSettable(explicit, version.OverridableVariables); err != nil {
+		return nil, nil, err
+	}
+	var preset map[string]string
+	if presetID != "" {
+		m, err := s.getPresetFor(ctx, presetID, policy.Read)
+		if err != nil {
+			return nil, nil, err
+		}
+		_ = json.Unmarshal(m.Values, &preset)
+	}
+	system := s.systemValues(ctx, template, version)
+	values, report := resolveNamedValues(version.Content, system, settableValues(preset, version.OverridableVariables), explicit)
+	return values, report, nil
+}
+
+// presetValuesJSON validates and encodes the values of a preset.
+func presetValuesJSON(values map[string]string) (json.RawMessage, error) {
+	for name := range values {
+		if !placeholderName.MatchString(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid placeholder name %q", name)
+		}
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid values: %v", err)
+	}
+	return b, nil
+}
+
+func presetModelToProto(m *models.VariablePreset) *pb.VariablePreset {
+	var values map[string]string
+	_ = json.Unmarshal(m.Values, &values)
+	return &pb.VariablePreset{
+		Id:        m.ID,
+		OwnerId:   m.OwnerID,
+		OrgId:     m.OrgID.String,
+		Name:      m.Name,
+		Values:    values,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockPresetRepository is a mock implementation of repository.PresetRepository
+type MockPresetRepository struct {
+	mock.Mock
+}
+
+func (m *MockPresetRepository) Create(ctx context.Context, p *models.VariablePreset) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+func (m *MockPresetRepository) Get(ctx context.Context, id string) (*models.VariablePreset, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.VariablePreset), args.Error(1)
+}
+func (m *MockPresetRepository) Update(ctx context.Context, p *models.VariablePreset) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+func (m *MockPresetRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+func (m *MockPresetRepository) ListForOwner(ctx context.Context, ownerID string) ([]*models.VariablePreset, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.VariablePreset), args.Error(1)
+}
+func (m *MockPresetRepository) ListForOrg(ctx context.Context, orgID string) ([]*models.VariablePreset, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.VariablePreset), args.Error(1)
+}
+
+func TestCreateVariablePreset(t *testing.T) {
+	t.Run("Personal", func(t *testing.T) {
+		mockPresetRepo := new(MockPresetRepository)
+		svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: mockPresetRepo})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockPresetRepo.On("Create", ctx, mock.MatchedBy(func(p *models.VariablePreset) bool {
+			return p.OwnerID == "alice" && !p.OrgID.Valid && p.Name == "Acme" && string(p.Values) == `{"product":"Acme CRM"}`
+		})).Return(nil)
+
+		resp, err := svc.CreateVariablePreset(ctx, &pb.CreateVariablePresetRequest{Name: " Acme ", Values: map[string]string{"product": "Acme CRM"}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"product": "Acme CRM"}, resp.Preset.Values)
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: new(MockPresetRepository)})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		_, err := svc.CreateVariablePreset(ctx, &pb.CreateVariablePresetRequest{Name: "Acme", Values: map[string]string{"bad name}}": "x"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Organization", func(t *testing.T) {
+		mockPresetRepo := new(MockPresetRepository)
+		mockOrgRepo := new(MockOrganizationRepository)
+		svc := newTestService(PromptServiceDeps{OrgRepo: mockOrgRepo, PresetRepo: mockPresetRepo})
+		admin := ContextWithUserID(context.Background(), "alice")
+		member := ContextWithUserID(context.Background(), "bob")
+		outsider := ContextWithUserID(context.Background(), "carol")
+		mockOrgRepo.On("GetMember", admin, "o1", "alice").Return(&models.OrganizationMember{OrgID: "o1", UserID: "alice", Role: "admin"}, nil)
+		mockOrgRepo.On("GetMember", member, "o1", "bob").Return(&models.OrganizationMember{OrgID: "o1", UserID: "bob", Role: "member"}, nil)
+		mockOrgRepo.On("GetMember", outsider, "o1", "carol").Return(nil, nil)
+		mockPresetRepo.On("Create", admin, mock.Anything).Return(nil)
+
+		req := &pb.CreateVariablePresetRequest{Name: "Team", OrgId: "o1", Values: map[string]string{"audience": "enterprise CTOs"}}
+		resp, err := svc.CreateVariablePreset(admin, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "o1", resp.Preset.OrgId)
+
+		_, err = svc.CreateVariablePreset(member, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = svc.CreateVariablePreset(outsider, req)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		mockPresetRepo.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
+
+func TestListVariablePresets(t *testing.T) {
+	mockPresetRepo := new(MockPresetRepository)
+	mockOrgRepo := new(MockOrganizationRepository)
+	svc := newTestService(PromptServiceDeps{OrgRepo: mockOrgRepo, PresetRepo: mockPresetRepo})
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockPresetRepo.On("ListForOwner", ctx, "bob").Return([]*models.VariablePreset{{ID: "v1", OwnerID: "bob", Name: "Mine", Values: json.RawMessage(`{}`)}}, nil)
+	mockPresetRepo.On("ListForOrg", ctx, "o1").Return([]*models.VariablePreset{
+		{ID: "v2", OwnerID: "alice", OrgID: sql.NullString{String: "o1", Valid: true}, Name: "Team", Values: json.RawMessage(`{"audience":"CTOs"}`)},
+	}, nil)
+	mockOrgRepo.On("GetMember", ctx, "o1", "bob").Return(&models.OrganizationMember{OrgID: "o1", UserID: "bob", Role: "member"}, nil)
+	mockOrgRepo.On("GetMember", ctx, "o2", "bob").Return(nil, nil)
+
+	resp, err := svc.ListVariablePresets(ctx, &pb.ListVariablePresetsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", resp.Presets[0].Id)
+
+	resp, err = svc.ListVariablePresets(ctx, &pb.ListVariablePresetsRequest{OrgId: "o1"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"audience": "CTOs"}, resp.Presets[0].Values)
+
+	_, err = svc.ListVariablePresets(ctx, &pb.ListVariablePresetsRequest{OrgId: "o2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateVariablePreset(t *testing.T) {
+	mockPresetRepo := new(MockPresetRepository)
+	svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: mockPresetRepo})
+	ctx := ContextWithUserID(context.Background(), "alice")
+	mockPresetRepo.On("Get", mock.Anything, "v1").Return(&models.VariablePreset{ID: "v1", OwnerID: "alice", Name: "Acme", Values: json.RawMessage(`{"product":"Acme"}`)}, nil)
+	mockPresetRepo.On("Get", mock.Anything, "v9").Return(nil, errors.New("not found"))
+	mockPresetRepo.On("Update", ctx, mock.MatchedBy(func(p *models.VariablePreset) bool {
+		return p.Name == "Acme" && string(p.Values) == `{"product":"Acme CRM"}`
+	})).Return(nil)
+
+	resp, err := svc.UpdateVariablePreset(ctx, &pb.UpdateVariablePresetRequest{Id: "v1", Values: map[string]string{"product": "Acme CRM"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", resp.Preset.Name)
+
+	_, err = svc.UpdateVariablePreset(ContextWithUserID(context.Background(), "bob"), &pb.UpdateVariablePresetRequest{Id: "v1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = svc.UpdateVariablePreset(ctx, &pb.UpdateVariablePresetRequest{Id: "v9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreatePromptWithPreset(t *testing.T) {
+	mockPresetRepo := new(MockPresetRepository)
+	svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: mockPresetRepo})
+	mockPromptRepo := svc.PromptRepo.(*MockPromptRepository)
+	mockTemplateRepo := svc.TemplateRepo.(*MockTemplateRepository)
+	mockVersionRepo := svc.TemplateVersionRepo.(*MockTemplateVersionRepository)
+	ctx := ContextWithUserID(context.Background(), "alice")
+
+	mockTemplateRepo.On("Get", ctx, "tpl_1", "").Return(&models.Template{ID: "tpl_1", OwnerID: "alice", Visibility: "private"}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(1)).Return(&models.TemplateVersion{ID: 1, TemplateID: "tpl_1", Version: 1, Content: "Pitch {{product}} to {{audience}} in $$ words, {{tone}}."}, nil)
+	mockPresetRepo.On("Get", ctx, "v1").Return(&models.VariablePreset{ID: "v1", OwnerID: "alice", Values: json.RawMessage(`{"product":"Acme CRM","audience":"enterprise CTOs"}`)}, nil)
+	mockPromptRepo.On("Create", ctx, mock.MatchedBy(func(p *models.Prompt) bool {
+		return string(p.NamedVariables) == `{"audience":"startups","product":"Acme CRM"}`
+	})).Return(nil)
+
+	resp, err := svc.CreatePrompt(ctx, &pb.CreatePromptRequest{
+		TemplateId:     "tpl_1",
+		VersionId:      1,
+		Variables:      []string{"50"},
+		PresetId:       "v1",
+		NamedVariables: map[string]string{"audience": "startups"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Pitch Acme CRM to startups in 50 words, {{tone}}.", resp.Prompt.RenderedText)
+	assert.Equal(t, []string{"product"}, resp.Report.FilledFromPreset)
+	assert.Equal(t, []string{"tone"}, resp.Report.Missing)
+
+	// Another user's preset cannot be applied.
+	mockPresetRepo.On("Get", ctx, "v2").Return(&models.VariablePreset{ID: "v2", OwnerID: "bob", Values: json.RawMessage(`{}`)}, nil)
+	_, err = svc.CreatePrompt(ctx, &pb.CreatePromptRequest{TemplateId: "tpl_1", VersionId: 1, PresetId: "v2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRenderPrompt(t *testing.T) {
+	mockPresetRepo := new(MockPresetRepository)
+	svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: mockPresetRepo})
+	mockTemplateRepo := svc.TemplateRepo.(*MockTemplateRepository)
+	mockVersionRepo := svc.TemplateVersionRepo.(*MockTemplateVersionRepository)
+	ctx := ContextWithUserID(context.Background(), "alice")
+
+	mockTemplateRepo.On("Get", ctx, "tpl_1", "").Return(&models.Template{ID: "tpl_1", OwnerID: "bob", Visibility: "public"}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(2)).Return(&models.TemplateVersion{ID: 2, TemplateID: "tpl_1", Version: 2, Content: "Pitch {{product}} to {{audience}}"}, nil)
+	mockPresetRepo.On("Get", ctx, "v1").Return(&models.VariablePreset{ID: "v1", OwnerID: "alice", Values: json.RawMessage(`{"product":"Acme CRM"}`)}, nil)
+
+	resp, err := svc.RenderPrompt(ctx, &pb.RenderPromptRequest{TemplateId: "tpl_1", VersionId: 2, PresetId: "v1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Pitch Acme CRM to {{audience}}", resp.RenderedText)
+	assert.Equal(t, int32(2), resp.Version)
+	assert.Equal(t, []string{"audience"}, resp.Report.Missing)
+	// Rendering saves nothing.
+	svc.PromptRepo.(*MockPromptRepository).AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
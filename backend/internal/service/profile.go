@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// topTemplatesLimit is the number of templates shown on a public profile.
+const topTemplatesLimit = 5
+
+// GetPublicProfile retrieves the public profile of a user with statistics about
+// their public templates. Anyone can read it; it only carries fields the user
+// chose to make public, never their email address or mobile number. Suspended
+// users have no public profile.
+func (s *UserService) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.GetPublicProfileResponse, error) {
+	zap.S().Infof("UserService.GetPublicProfile: user_id=%s", req.UserId)
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	user, err := s.Repo.GetByID(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user.Suspended() {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	stats, err := s.Repo.GetAuthorStats(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get author stats: %v", err)
+	}
+	top, err := s.TemplateRepo.List(ctx, topTemplatesLimit, nil, map[string]interface{}{
+		"owner_id":   user.ID,
+		"visibility": "public",
+		"sort":       "likes",
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+	}
+
+	isFollowing := false
+	if p := principal(ctx); !p.Anonymous() && p.UserID != user.ID {
+		if isFollowing, err = s.FollowRepo.IsFollowing(ctx, p.UserID, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check follow: %v", err)
+		}
+	}
+
+	var pbTemplates []*pb.Template
+	for _, t := range top {
+		pbTemplates = append(pbTemplates, templateModelToProto(t))
+	}
+	return &pb.GetPublicProfileResponse{
+		Id:                  user.ID,
+		DisplayName:         user.DisplayName,
+		Avatar:              user.Avatar,
+		Bio:                 user.Bio,
+		JoinedAt:            timestamppb.New(user.CreatedAt),
+		PublicTemplateCount: stats.PublicTemplateCount,
+		TotalLikes:          stats.TotalLikes,
+		TotalFavorites:      stats.TotalFavorites,
+		FollowerCount:       user.FollowerCount,
+		FollowingCount:      user.FollowingCount,
+		IsFollowing:         isFollowing,
+		TopTemplates:        pbTemplates,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetPublicProfile(t *testing.T) {
+	joined := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	author := func() *models.User {
+		return &models.User{
+			ID: "bob", Email: "bob@example.com", Mobile: sql.NullString{String: "+100", Valid: true},
+			DisplayName: "Bob", Bio: "I write prompts", FollowerCount: 7, CreatedAt: joined,
+		}
+	}
+	topFilters := mock.MatchedBy(func(f map[string]interface{}) bool {
+		return f["owner_id"] == "bob" && f["visibility"] == "public" && f["sort"] == "likes"
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockFollowRepo := new(MockFollowRepository)
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := NewUserService(mockRepo, mockFollowRepo, mockTemplateRepo, nil, nil, "secret")
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, "bob").Return(author(), nil)
+		mockRepo.On("GetAuthorStats", ctx, "bob").Return(&models.AuthorStats{PublicTemplateCount: 3, TotalLikes: 42, TotalFavorites: 5}, nil)
+		mockTemplateRepo.On("List", ctx, topTemplatesLimit, mock.Anything, topFilters).Return([]*models.Template{
+			{ID: "t1", OwnerID: "bob", Title: "Best", Visibility: "public", LikeCount: 30},
+			{ID: "t2", OwnerID: "bob", Title: "Good", Visibility: "public", LikeCount: 12},
+		}, nil)
+
+		resp, err := svc.GetPublicProfile(ctx, &pb.GetPublicProfileRequest{UserId: "bob"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", resp.DisplayName)
+		assert.Equal(t, "I write prompts", resp.Bio)
+		assert.Equal(t, joined, resp.JoinedAt.AsTime())
+		assert.Equal(t, int32(3), resp.PublicTemplateCount)
+		assert.Equal(t, int64(42), resp.TotalLikes)
+		assert.Equal(t, int64(5), resp.TotalFavorites)
+		assert.Equal(t, int32(7), resp.FollowerCount)
+		assert.False(t, resp.IsFollowing)
+		assert.Len(t, resp.TopTemplates, 2)
+		assert.NotContains(t, resp.String(), "bob@example.com")
+		assert.NotContains(t, resp.String(), "+100")
+		mockFollowRepo.AssertNotCalled(t, "IsFollowing", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Follower", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		mockFollowRepo := new(MockFollowRepository)
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := NewUserService(mockRepo, mockFollowRepo, mockTemplateRepo, nil, nil, "secret")
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockRepo.On("GetByID", ctx, "bob").Return(author(), nil)
+		mockRepo.On("GetAuthorStats", ctx, "bob").Return(&models.AuthorStats{}, nil)
+		mockTemplateRepo.On("List", ctx, topTemplatesLimit, mock.Anything, topFilters).Return(nil, nil)
+		mockFollowRepo.On("IsFollowing", ctx, "alice", "bob").Return(true, nil)
+
+		resp, err := svc.GetPublicProfile(ctx, &pb.GetPublicProfileRequest{UserId: "bob"})
+		assert.NoError(t, err)
+		assert.True(t, resp.IsFollowing)
+	})
+
+	t.Run("Suspended", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		svc := NewUserService(mockRepo, new(MockFollowRepository), new(MockTemplateRepository), nil, nil, "secret")
+		suspended := author()
+		suspended.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		mockRepo.On("GetByID", mock.Anything, "bob").Return(suspended, nil)
+
+		_, err := svc.GetPublicProfile(context.Background(), &pb.GetPublicProfileRequest{UserId: "bob"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestUpdateProfileBio(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	svc := NewUserService(mockRepo, nil, nil, nil, nil, "secret")
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockRepo.On("GetByID", ctx, "bob").Return(&models.User{ID: "bob", DisplayName: "Bob"}, nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(u *models.User) bool { return u.Bio == "Prompt tinkerer" })).Return(nil)
+
+	resp, err := svc.UpdateProfile(ctx, &pb.UpdateProfileRequest{Bio: "  Prompt tinkerer "})
+	assert.NoError(t, err)
+	assert.Equal(t, "Prompt tinkerer", resp.Bio)
+
+	_, err = svc.UpdateProfile(ctx, &pb.UpdateProfileRequest{Bio: strings.Repeat("x", maxBioLength+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -4,14 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
-	"strconv"
+	"slices"
 	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
 	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/llm"
 	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
 	"awsome-prompt/backend/internal/repository"
 
 	"github.com/lib/pq"
@@ -25,17 +27,88 @@ type PromptService struct {
 	PromptRepo          repository.PromptRepository
 	TemplateRepo        repository.TemplateRepository
 	TemplateVersionRepo repository.TemplateVersionRepository
+	CollectionRepo      repository.CollectionRepository
+	ShareRepo           repository.ShareRepository
+	OrgRepo             repository.OrganizationRepository
+	ModerationRepo      repository.ModerationRepository
+	CommentRepo         repository.CommentRepository
+	ReviewRepo          repository.ReviewRepository
+	NotificationRepo    repository.NotificationRepository
+	FollowRepo          repository.FollowRepository
+	UsageRepo           repository.UsageRepository
+	PresetRepo          repository.PresetRepository
+	ExecutionRepo       repository.ExecutionRepository
+	EvaluationRepo      repository.EvaluationRepository
+	// Provider runs prompts on language models. When nil, ExecutePrompt and
+	// RunEvaluation fail.
+	Provider llm.Provider
+	// DefaultModel is the model prompts run on when the request names none.
+	DefaultModel string
+	// UserRepo fills {{sys.user.display_name}}. When nil, it renders empty.
+	UserRepo repository.UserRepository
+	Audit    *Auditor
+	// Viewers dedupes the views, renders and copies counted by the usage
+	// statistics. When nil, every event is counted.
+	Viewers    SeenMarker
+	PageTokens *PageTokenCodec
+	// ReportHideThreshold is the number of open reports from distinct users
+	// that hides a template until an administrator reviews it. Zero disables it.
+	ReportHideThreshold int
 }
 
-func NewPromptService(
-	promptRepo repository.PromptRepository,
-	templateRepo repository.TemplateRepository,
-	templateVersionRepo repository.TemplateVersionRepository,
-) *PromptService {
+// PromptServiceDeps are the repositories and services a PromptService is built
+// on. The prompt, template and version repositories are required; the RPCs of
+// a feature whose repository is nil are not available. The other fields are
+// optional, as documented on PromptService.
+type PromptServiceDeps struct {
+	PromptRepo          repository.PromptRepository
+	TemplateRepo        repository.TemplateRepository
+	TemplateVersionRepo repository.TemplateVersionRepository
+	CollectionRepo      repository.CollectionRepository
+	ShareRepo           repository.ShareRepository
+	OrgRepo             repository.OrganizationRepository
+	ModerationRepo      repository.ModerationRepository
+	CommentRepo         repository.CommentRepository
+	ReviewRepo          repository.ReviewRepository
+	NotificationRepo    repository.NotificationRepository
+	FollowRepo          repository.FollowRepository
+	UsageRepo           repository.UsageRepository
+	PresetRepo          repository.PresetRepository
+	ExecutionRepo       repository.ExecutionRepository
+	EvaluationRepo      repository.EvaluationRepository
+	UserRepo            repository.UserRepository
+	Audit               *Auditor
+	Viewers             SeenMarker
+	Provider            llm.Provider
+	DefaultModel        string
+	// PageTokenSecret signs the page tokens of the list RPCs.
+	PageTokenSecret string
+}
+
+func NewPromptService(deps PromptServiceDeps) *PromptService {
 	return &PromptService{
-		PromptRepo:          promptRepo,
-		TemplateRepo:        templateRepo,
-		TemplateVersionRepo: templateVersionRepo,
+		PromptRepo:          deps.PromptRepo,
+		TemplateRepo:        deps.TemplateRepo,
+		TemplateVersionRepo: deps.TemplateVersionRepo,
+		CollectionRepo:      deps.CollectionRepo,
+		ShareRepo:           deps.ShareRepo,
+		OrgRepo:             deps.OrgRepo,
+		ModerationRepo:      deps.ModerationRepo,
+		CommentRepo:         deps.CommentRepo,
+		ReviewRepo:          deps.ReviewRepo,
+		NotificationRepo:    deps.NotificationRepo,
+		FollowRepo:          deps.FollowRepo,
+		UsageRepo:           deps.UsageRepo,
+		PresetRepo:          deps.PresetRepo,
+		ExecutionRepo:       deps.ExecutionRepo,
+		EvaluationRepo:      deps.EvaluationRepo,
+		UserRepo:            deps.UserRepo,
+		Audit:               deps.Audit,
+		Viewers:             deps.Viewers,
+		Provider:            deps.Provider,
+		DefaultModel:        deps.DefaultModel,
+		PageTokens:          NewPageTokenCodec(deps.PageTokenSecret),
+		ReportHideThreshold: defaultReportHideThreshold,
 	}
 }
 
@@ -52,8 +125,23 @@ func (s *PromptService) CreateTemplate(ctx context.Context, req *pb.CreateTempla
 
 	// Map Visibility
 	visibility := "private"
-	if req.Visibility == pb.Visibility_VISIBILITY_PUBLIC {
+	switch req.Visibility {
+	case pb.Visibility_VISIBILITY_PUBLIC:
 		visibility = "public"
+	case pb.Visibility_VISIBILITY_ORG:
+		if req.OrgId == "" {
+			return nil, status.Error(codes.InvalidArgument, "org visibility requires org_id")
+		}
+		visibility = "org"
+	}
+	if req.OrgId != "" {
+		if _, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Create); err != nil {
+			return nil, err
+		}
+	}
+	overridable, err := overridableVariables(req.OverridableVariables)
+	if err != nil {
+		return nil, err
 	}
 
 	// Map Type
@@ -76,9 +164,14 @@ func (s *PromptService) CreateTemplate(ctx context.Context, req *pb.CreateTempla
 		Tags:        pq.StringArray(req.Tags),
 		Category:    sql.NullString{String: req.Category, Valid: req.Category != ""},
 		Language:    language,
+		OrgID:       sql.NullString{String: req.OrgId, Valid: req.OrgId != ""},
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
+	// Only admins can publish system templates.
+	if err := authorize(policy.Template(principal(ctx), policy.Create, template, policy.TemplateRelation{}), "template"); err != nil {
+		return nil, err
+	}
 
 	if err := s.TemplateRepo.Create(ctx, template); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create template: %v", err)
@@ -86,19 +179,21 @@ func (s *PromptService) CreateTemplate(ctx context.Context, req *pb.CreateTempla
 
 	// Create Version 1
 	version := &models.TemplateVersion{
-		TemplateID: template.ID,
-		Version:    1,
-		Content:    req.Content,
-		CreatedAt:  time.Now(),
+		TemplateID:           template.ID,
+		Version:              1,
+		Content:              req.Content,
+		OverridableVariables: overridable,
+		CreatedAt:            time.Now(),
 	}
 
 	if err := s.TemplateVersionRepo.Create(ctx, version); err != nil {
 		// Cleanup template? For now, just fail.
 		return nil, status.Errorf(codes.Internal, "failed to create template version: %v", err)
 	}
+	s.Audit.Record(ctx, "template.create", template.ID, template.OwnerID, nil, templateAuditSummary(template))
 
 	return &pb.CreateTemplateResponse{
-		Template: s.templateModelToProto(template),
+		Template: templateModelToProto(template),
 		Version:  s.versionModelToProto(version),
 	}, nil
 }
@@ -111,9 +206,9 @@ func (s *PromptService) ForkTemplate(ctx context.Context, templateID string) (*p
 	}
 
 	// 1. Get Source Template
-	sourceTpl, err := s.TemplateRepo.Get(ctx, templateID, "")
+	sourceTpl, err := s.getTemplateFor(ctx, templateID, policy.Fork)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "source template not found")
+		return nil, err
 	}
 
 	// 2. Get Source Latest Version
@@ -133,6 +228,8 @@ func (s *PromptService) ForkTemplate(ctx context.Context, templateID string) (*p
 		Type:        "user",
 		Tags:        sourceTpl.Tags,
 		Category:    sourceTpl.Category,
+		Language:    sourceTpl.Language,
+		ForkedFrom:  sql.NullString{String: sourceTpl.ID, Valid: true},
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -143,34 +240,53 @@ func (s *PromptService) ForkTemplate(ctx context.Context, templateID string) (*p
 
 	// 4. Create New Version (copy content)
 	newVer := &models.TemplateVersion{
-		TemplateID: newTpl.ID,
-		Version:    1,
-		Content:    sourceVer.Content,
-		CreatedAt:  time.Now(),
+		TemplateID:           newTpl.ID,
+		Version:              1,
+		Content:              sourceVer.Content,
+		OverridableVariables: sourceVer.OverridableVariables,
+		CreatedAt:            time.Now(),
 	}
 
 	if err := s.TemplateVersionRepo.Create(ctx, newVer); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create forked version: %v", err)
 	}
+	s.notify(ctx, sourceTpl.OwnerID, "fork", userID, sourceTpl.ID, "")
+	s.Audit.Record(ctx, "template.fork", newTpl.ID, newTpl.OwnerID, nil, templateAuditSummary(newTpl))
 
 	return &pb.CreateTemplateResponse{
-		Template: s.templateModelToProto(newTpl),
+		Template: templateModelToProto(newTpl),
 		Version:  s.versionModelToProto(newVer),
 	}, nil
 }
 
 // UpdateTemplate updates an existing template.
+// The owner and users granted the editor role can update it; only the owner can
+// change its visibility.
 func (s *PromptService) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.UpdateTemplateResponse, error) {
 	zap.S().Infof("PromptService.UpdateTemplate: template_id=%s", req.TemplateId)
 	// Get existing template
-	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Update)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "template not found")
+		return nil, err
 	}
-
-	// AuthZ
-	if req.OwnerId != "" && template.OwnerID != req.OwnerId {
-		return nil, status.Errorf(codes.PermissionDenied, "not authorized")
+	before := templateAuditSummary(template)
+	if req.Visibility != pb.Visibility_VISIBILITY_UNSPECIFIED && req.Visibility != templateModelToProto(template).Visibility {
+		// Changing who can see the template is a sharing decision.
+		if err := s.authorizeTemplate(ctx, principal(ctx), policy.Share, template, ""); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				return nil, status.Errorf(codes.PermissionDenied, "only the owner can change the visibility")
+			}
+			return nil, err
+		}
+	}
+	if req.Visibility == pb.Visibility_VISIBILITY_ORG && !template.OrgID.Valid {
+		return nil, status.Error(codes.InvalidArgument, "only organization templates can have org visibility")
+	}
+	var overridable []string
+	if req.OverridableVariables != nil {
+		if overridable, err = overridableVariables(req.OverridableVariables.Names); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update fields
@@ -193,6 +309,8 @@ func (s *PromptService) UpdateTemplate(ctx context.Context, req *pb.UpdateTempla
 			template.Visibility = "public"
 		case pb.Visibility_VISIBILITY_PRIVATE:
 			template.Visibility = "private"
+		case pb.Visibility_VISIBILITY_ORG:
+			template.Visibility = "org"
 		}
 	}
 
@@ -215,12 +333,22 @@ func (s *PromptService) UpdateTemplate(ctx context.Context, req *pb.UpdateTempla
 
 	// Create new version
 	latest, err := s.TemplateVersionRepo.GetLatest(ctx, template.ID)
+	if req.OverridableVariables == nil {
+		// Without a new list, the overridable variables carry over.
+		overridable = []string{}
+		if err == nil && latest != nil {
+			overridable = latest.OverridableVariables
+		}
+	}
 
 	// Check if content has changed
-	if err == nil && latest != nil && latest.Content == req.Content {
+	if err == nil && latest != nil && latest.Content == req.Content && slices.Equal(latest.OverridableVariables, overridable) {
 		// Content hasn't changed, so don't create a new version
+		after := templateAuditSummary(template)
+		after["version"] = latest.Version
+		s.Audit.Record(ctx, "template.update", template.ID, template.OwnerID, before, after)
 		return &pb.UpdateTemplateResponse{
-			Template:   s.templateModelToProto(template),
+			Template:   templateModelToProto(template),
 			NewVersion: s.versionModelToProto(latest), // Return latest
 		}, nil
 	}
@@ -234,20 +362,28 @@ func (s *PromptService) UpdateTemplate(ctx context.Context, req *pb.UpdateTempla
 		TemplateID: template.ID,
 		Version:    int32(newVersionNum),
 		Content:    req.Content,
+		Major:      req.Major,
 		CreatedAt:  time.Now(),
+
+		OverridableVariables: overridable,
 	}
 
 	if err := s.TemplateVersionRepo.Create(ctx, newVersion); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create new version: %v", err)
 	}
+	after := templateAuditSummary(template)
+	after["version"] = newVersion.Version
+	s.Audit.Record(ctx, "template.update", template.ID, template.OwnerID, before, after)
 
 	return &pb.UpdateTemplateResponse{
-		Template:   s.templateModelToProto(template),
+		Template:   templateModelToProto(template),
 		NewVersion: s.versionModelToProto(newVersion),
 	}, nil
 }
 
 // GetTemplate retrieves a template by ID.
+// Private templates are only returned to their owner, to users they are shared
+// with, and to holders of an active share link token.
 func (s *PromptService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateResponse, error) {
 	zap.S().Infof("PromptService.GetTemplate: id=%s", req.Id)
 	userID, _ := GetUserIDFromContext(ctx)
@@ -255,11 +391,19 @@ func (s *PromptService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequ
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "template not found")
 	}
+	if err := s.authorizeTemplate(ctx, principal(ctx), policy.Read, template, req.ShareToken); err != nil {
+		return nil, err
+	}
 
 	latest, _ := s.TemplateVersionRepo.GetLatest(ctx, template.ID)
+	var latestNumber int32
+	if latest != nil {
+		latestNumber = latest.Version
+	}
+	s.recordView(ctx, template.ID, latestNumber)
 
 	return &pb.GetTemplateResponse{
-		Template:      s.templateModelToProto(template),
+		Template:      templateModelToProto(template),
 		LatestVersion: s.versionModelToProto(latest),
 	}, nil
 }
@@ -268,43 +412,164 @@ func (s *PromptService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequ
 func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
 	userID, _ := GetUserIDFromContext(ctx)
 	zap.S().Infof("PromptService.ListTemplates: page_size=%d page_token=%s owner_id=%s visibility=%s user_id=%s", req.PageSize, req.PageToken, req.OwnerId, req.Visibility, userID)
+	if req.MinRating < 0 || req.MinRating > 5 {
+		return nil, status.Error(codes.InvalidArgument, "min_rating must be between 1 and 5")
+	}
 
 	limit := int(req.PageSize)
 	if limit <= 0 {
 		limit = 10
 	}
 
-	// Helper to fetch templates and versions
-	fetch := func(limit, offset int, filters map[string]interface{}) ([]*pb.Template, string, error) {
+	// Helper to fetch one page of templates and their latest versions.
+	// It returns the page, the token of the next page (empty on the last page)
+	// and, when requested, the total number of matching templates.
+	fetch := func(pageToken string, filters map[string]interface{}) ([]*pb.Template, string, int32, error) {
 		if userID != "" {
 			filters["current_user_id"] = userID
 		}
-		templates, err := s.TemplateRepo.List(ctx, limit, offset, filters)
+		switch req.Sort {
+		case pb.TemplateSort_TEMPLATE_SORT_TRENDING:
+			filters["sort"] = "trending"
+		case pb.TemplateSort_TEMPLATE_SORT_RATING:
+			filters["sort"] = "rating"
+		}
+		if req.MinRating > 0 {
+			filters["min_rating"] = req.MinRating
+		}
+		after, err := s.PageTokens.Decode(pageToken, filters)
 		if err != nil {
-			return nil, "", err
-		}
-		var pbTemplates []*pb.Template
-		for _, t := range templates {
-			pbT := s.templateModelToProto(t)
-			latest, err := s.TemplateVersionRepo.GetLatest(ctx, t.ID)
-			if err == nil {
-				pbT.LatestVersion = s.versionModelToProto(latest)
-			}
-			pbTemplates = append(pbTemplates, pbT)
+			return nil, "", 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// Fetch one extra row to find out whether there is a next page.
+		templates, err := s.TemplateRepo.List(ctx, limit+1, after, filters)
+		if err != nil {
+			return nil, "", 0, status.Errorf(codes.Internal, "failed to list templates: %v", err)
 		}
 		nextToken := ""
-		if len(templates) == limit {
-			nextToken = strconv.Itoa(offset + limit)
+		if len(templates) > limit {
+			templates = templates[:limit]
+			nextToken = s.PageTokens.Encode(repository.TemplateCursor(templates[limit-1], filters), filters)
+		}
+		pbTemplates, err := s.templatesWithLatestVersion(ctx, templates)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		var total int32
+		if req.IncludeTotalCount {
+			count, err := s.TemplateRepo.Count(ctx, filters)
+			if err != nil {
+				return nil, "", 0, status.Errorf(codes.Internal, "failed to count templates: %v", err)
+			}
+			total = int32(count)
+		}
+		return pbTemplates, nextToken, total, nil
+	}
+
+	// SPECIAL HANDLING: Collection (single stream in the collection's order)
+	// Only the templates the caller can see are listed, whoever owns the collection.
+	if req.CollectionId != "" {
+		if _, err := s.getCollectionFor(ctx, req.CollectionId, policy.Read); err != nil {
+			return nil, err
+		}
+		filters := map[string]interface{}{
+			"collection_id": req.CollectionId,
+			"sort":          "position",
+			"visible_to":    userID,
+		}
+		if req.Category != "" {
+			filters["category"] = req.Category
+		}
+		if req.Language != "" {
+			filters["language"] = req.Language
+		}
+		if len(req.Tags) > 0 {
+			filters["tags"] = req.Tags
+		}
+
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
+	}
+
+	// SPECIAL HANDLING: Shared with me (single stream)
+	if req.SharedWithMe {
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+		filters := map[string]interface{}{"shared_with": userID}
+		if req.Category != "" {
+			filters["category"] = req.Category
+		}
+		if req.Language != "" {
+			filters["language"] = req.Language
+		}
+		if len(req.Tags) > 0 {
+			filters["tags"] = req.Tags
+		}
+
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
+	}
+
+	// SPECIAL HANDLING: Featured (single stream, most recently featured first)
+	if req.FeaturedOnly {
+		filters := map[string]interface{}{
+			"visibility": "public",
+			"featured":   true,
+			"sort":       "featured",
+		}
+		if req.Category != "" {
+			filters["category"] = req.Category
+		}
+		if req.Language != "" {
+			filters["language"] = req.Language
+		}
+		if len(req.Tags) > 0 {
+			filters["tags"] = req.Tags
+		}
+
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
+	}
+
+	// SPECIAL HANDLING: Organization library (single stream)
+	// Members see the templates of the organization that are not private to someone else.
+	if req.OrgId != "" {
+		if _, err := orgMembershipFor(ctx, s.OrgRepo, req.OrgId, policy.Read); err != nil {
+			return nil, err
+		}
+		filters := map[string]interface{}{
+			"org_id":     req.OrgId,
+			"visible_to": userID,
+		}
+		if req.Category != "" {
+			filters["category"] = req.Category
+		}
+		if req.Language != "" {
+			filters["language"] = req.Language
+		}
+		if len(req.Tags) > 0 {
+			filters["tags"] = req.Tags
+		}
+
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
+		if err != nil {
+			return nil, err
 		}
-		return pbTemplates, nextToken, nil
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
 	}
 
 	// SPECIAL HANDLING: My Likes / My Favorites (Treat as single stream)
 	if userID != "" && (req.MyLikes || req.MyFavorites) {
-		offset := 0
-		if req.PageToken != "" {
-			offset, _ = strconv.Atoi(req.PageToken)
-		}
 		filters := make(map[string]interface{})
 		if req.MyLikes {
 			filters["my_likes"] = true
@@ -322,19 +587,15 @@ func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplates
 			filters["tags"] = req.Tags
 		}
 
-		templates, nextToken, err := fetch(limit, offset, filters)
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+			return nil, err
 		}
-		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken}, nil
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
 	}
 
 	// 1. No Token: Return Public Only
 	if userID == "" {
-		offset := 0
-		if req.PageToken != "" {
-			offset, _ = strconv.Atoi(req.PageToken)
-		}
 		filters := make(map[string]interface{})
 		filters["visibility"] = "public"
 		if req.Category != "" {
@@ -350,23 +611,23 @@ func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplates
 			filters["owner_id"] = req.OwnerId
 		}
 
-		templates, nextToken, err := fetch(limit, offset, filters)
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+			return nil, err
 		}
-		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken}, nil
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
 	}
 
 	// 2. Token Present
 	// If specific visibility requested, return single list
 	if req.Visibility != pb.Visibility_VISIBILITY_UNSPECIFIED {
-		offset := 0
-		if req.PageToken != "" {
-			offset, _ = strconv.Atoi(req.PageToken)
-		}
 		filters := make(map[string]interface{})
 		if req.Visibility == pb.Visibility_VISIBILITY_PUBLIC {
 			filters["visibility"] = "public"
+		} else if req.Visibility == pb.Visibility_VISIBILITY_ORG {
+			// The libraries of all my organizations
+			filters["visibility"] = "org"
+			filters["member_of"] = userID
 		} else {
 			filters["visibility"] = "private"
 			if req.OwnerId == "" {
@@ -389,35 +650,27 @@ func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplates
 			filters["tags"] = req.Tags
 		}
 
-		templates, nextToken, err := fetch(limit, offset, filters)
+		templates, nextToken, total, err := fetch(req.PageToken, filters)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+			return nil, err
 		}
-		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken}, nil
+		return &pb.ListTemplatesResponse{Templates: templates, NextPageToken: nextToken, TotalCount: total}, nil
 	}
 
 	// 3. Token Present AND Visibility Unspecified -> Mixed View
 	// When a user is logged in (Token present) and no specific visibility filter is applied,
 	// we return a mixed view containing both Public templates and the user's Private templates.
-	// Each list is paginated independently, so we return two separate lists and two separate next page tokens (if applicable).
-	// To support a single 'next_page_token' in the request, we encode both offsets.
-	// Parse tokens. Format: "public_offset:private_offset"
-	publicOffset := 0
-	privateOffset := 0
+	// Each list is paginated independently, so we return two separate lists and two separate next page tokens.
+	// Clients send both back in a single page_token as "public_token:private_token".
+	// An empty side (or a token without a separator, which only carries the public side)
+	// means that list is exhausted and is not fetched again.
+	publicToken, privateToken := req.PageToken, ""
+	fetchPublic, fetchPrivate := true, true
 	if req.PageToken != "" {
-		parts := strings.Split(req.PageToken, ":")
-		if len(parts) == 2 {
-			// Format is "public:private"
-			publicOffset, _ = strconv.Atoi(parts[0])
-			privateOffset, _ = strconv.Atoi(parts[1])
-		} else {
-			// Fallback if parsing fails or old format (single integer),
-			// assume 0 for both or try parsing as single offset for backward compatibility.
-			if val, err := strconv.Atoi(req.PageToken); err == nil {
-				publicOffset = val
-				privateOffset = val // Ambiguous, but safe to start both at same offset if previously simple
-			}
-		}
+		var hasPrivate bool
+		publicToken, privateToken, hasPrivate = strings.Cut(req.PageToken, ":")
+		fetchPublic = publicToken != ""
+		fetchPrivate = hasPrivate && privateToken != ""
 	}
 
 	// Fetch Public
@@ -436,9 +689,15 @@ func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplates
 		publicFilters["owner_id"] = req.OwnerId
 	}
 
-	publicTemplates, nextPublicToken, err := fetch(limit, publicOffset, publicFilters)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list public templates: %v", err)
+	var publicTemplates []*pb.Template
+	var nextPublicToken string
+	var publicTotal int32
+	if fetchPublic {
+		var err error
+		publicTemplates, nextPublicToken, publicTotal, err = fetch(publicToken, publicFilters)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Fetch Private
@@ -458,50 +717,78 @@ func (s *PromptService) ListTemplates(ctx context.Context, req *pb.ListTemplates
 	// So if OwnerId filter is present and != userID, we skip private fetch.
 	var privateTemplates []*pb.Template
 	nextPrivateToken := ""
+	var privateTotal int32
 
-	shouldFetchPrivate := req.OwnerId == "" || req.OwnerId == userID
+	shouldFetchPrivate := fetchPrivate && (req.OwnerId == "" || req.OwnerId == userID)
 
 	if shouldFetchPrivate {
 		var err error
-		privateTemplates, nextPrivateToken, err = fetch(limit, privateOffset, privateFilters)
+		privateTemplates, nextPrivateToken, privateTotal, err = fetch(privateToken, privateFilters)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list private templates: %v", err)
+			return nil, err
 		}
 	}
 
-	// Construct Response
-	// We put Public in "Templates" for backward compatibility/simplicity?
-	// Or we use the split fields.
-	// Proto: templates (1), private_templates (3).
-	// Let's populate: public -> templates, private -> private_templates.
-
-	// Tokens:
-	// We need to construct a combined token if both have more pages?
-	// Or we return separate tokens?
-	// Proto: next_page_token (2), private_next_page_token (4).
-
+	// Public templates go to "templates" and private ones to "private_templates",
+	// each with its own next page token and total count.
 	return &pb.ListTemplatesResponse{
 		Templates:            publicTemplates,
 		NextPageToken:        nextPublicToken,
 		PrivateTemplates:     privateTemplates,
 		PrivateNextPageToken: nextPrivateToken,
+		TotalCount:           publicTotal,
+		PrivateTotalCount:    privateTotal,
 	}, nil
 }
 
-func (s *PromptService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
-	zap.S().Infof("PromptService.DeleteTemplate: id=%s owner_id=%s", req.Id, req.OwnerId)
-	template, err := s.TemplateRepo.Get(ctx, req.Id, "")
+// ListTrendingTemplates lists public templates ordered by their trending score.
+// Templates without any recent activity are left out.
+func (s *PromptService) ListTrendingTemplates(ctx context.Context, req *pb.ListTrendingTemplatesRequest) (*pb.ListTrendingTemplatesResponse, error) {
+	zap.S().Infof("PromptService.ListTrendingTemplates: category=%s language=%s", req.Category, req.Language)
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := map[string]interface{}{
+		"visibility":    "public",
+		"sort":          "trending",
+		"trending_only": true,
+	}
+	if userID, err := GetUserIDFromContext(ctx); err == nil {
+		filters["current_user_id"] = userID
+	}
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+	if req.Language != "" {
+		filters["language"] = req.Language
+	}
+
+	templates, err := s.TemplateRepo.List(ctx, limit, nil, filters)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "template not found")
+		return nil, status.Errorf(codes.Internal, "failed to list trending templates: %v", err)
 	}
 
-	if req.OwnerId != "" && template.OwnerID != req.OwnerId {
-		return nil, status.Errorf(codes.PermissionDenied, "not authorized")
+	pbTemplates, err := s.templatesWithLatestVersion(ctx, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListTrendingTemplatesResponse{Templates: pbTemplates}, nil
+}
+
+func (s *PromptService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	zap.S().Infof("PromptService.DeleteTemplate: id=%s", req.Id)
+	template, err := s.getTemplateFor(ctx, req.Id, policy.Delete)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.TemplateRepo.Delete(ctx, req.Id); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete template: %v", err)
 	}
+	s.Audit.Record(ctx, "template.delete", template.ID, template.OwnerID, templateAuditSummary(template), nil)
 
 	return &pb.DeleteTemplateResponse{Success: true}, nil
 }
@@ -513,11 +800,21 @@ func (s *PromptService) ToggleLikeTemplate(ctx context.Context, req *pb.ToggleLi
 		return nil, err
 	}
 	zap.S().Infof("PromptService.ToggleLikeTemplate: user_id=%s template_id=%s", userID, req.TemplateId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
 
 	isLiked, count, err := s.TemplateRepo.ToggleLike(ctx, userID, req.TemplateId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to toggle like: %v", err)
 	}
+	if isLiked {
+		s.notify(ctx, template.OwnerID, "like", userID, template.ID, "")
+		s.Audit.Record(ctx, "template.like", template.ID, template.OwnerID, nil, map[string]interface{}{"like_count": count})
+	} else {
+		s.Audit.Record(ctx, "template.unlike", template.ID, template.OwnerID, nil, map[string]interface{}{"like_count": count})
+	}
 
 	return &pb.ToggleLikeResponse{
 		IsLiked:   isLiked,
@@ -532,11 +829,21 @@ func (s *PromptService) ToggleFavoriteTemplate(ctx context.Context, req *pb.Togg
 		return nil, err
 	}
 	zap.S().Infof("PromptService.ToggleFavoriteTemplate: user_id=%s template_id=%s", userID, req.TemplateId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Read)
+	if err != nil {
+		return nil, err
+	}
 
 	isFavorited, count, err := s.TemplateRepo.ToggleFavorite(ctx, userID, req.TemplateId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to toggle favorite: %v", err)
 	}
+	if isFavorited {
+		s.notify(ctx, template.OwnerID, "favorite", userID, template.ID, "")
+		s.Audit.Record(ctx, "template.favorite", template.ID, template.OwnerID, nil, map[string]interface{}{"favorite_count": count})
+	} else {
+		s.Audit.Record(ctx, "template.unfavorite", template.ID, template.OwnerID, nil, map[string]interface{}{"favorite_count": count})
+	}
 
 	return &pb.ToggleFavoriteResponse{
 		IsFavorited:   isFavorited,
@@ -545,18 +852,28 @@ func (s *PromptService) ToggleFavoriteTemplate(ctx context.Context, req *pb.Togg
 }
 
 func (s *PromptService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
-	zap.S().Infof("PromptService.ListCategories: owner_id=%s", req.OwnerId)
+	zap.S().Infof("PromptService.ListCategories: owner_id=%s org_id=%s", req.OwnerId, req.OrgId)
 	filters := make(map[string]interface{})
-	if req.OwnerId != "" {
+	if req.OrgId != "" {
+		orgFilters, err := s.orgLibraryFilters(ctx, req.OrgId)
+		if err != nil {
+			return nil, err
+		}
+		filters = orgFilters
+	} else if req.OwnerId != "" {
 		filters["owner_id"] = req.OwnerId
 		filters["visibility"] = "private" // Assuming implicit private if owner specified for "My Prompts"
+		if authorizeSelf(ctx, policy.Read, req.OwnerId) != nil {
+			// Other users' libraries only count their public templates
+			filters["visibility"] = "public"
+		}
 	}
 	// If no owner, maybe public? Or sidebar expects all?
 	// Sidebar "All Public" -> calls API.
 	// Sidebar "My Prompts" -> calls API.
 	// The sidebar logic implies it wants filtered stats.
 	// Let's assume if owner_id is NOT provided, we return PUBLIC categories.
-	if req.OwnerId == "" {
+	if req.OwnerId == "" && req.OrgId == "" {
 		filters["visibility"] = "public"
 	}
 	if req.Language != "" {
@@ -580,11 +897,18 @@ func (s *PromptService) ListCategories(ctx context.Context, req *pb.ListCategori
 }
 
 func (s *PromptService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
-	zap.S().Info("PromptService.ListTags")
-	// Tags currently global in sidebar, so public only?
+	zap.S().Infof("PromptService.ListTags: org_id=%s", req.OrgId)
+	// Tags currently global in sidebar, so public only, unless an organization's library is browsed
 	filters := map[string]interface{}{
 		"visibility": "public",
 	}
+	if req.OrgId != "" {
+		orgFilters, err := s.orgLibraryFilters(ctx, req.OrgId)
+		if err != nil {
+			return nil, err
+		}
+		filters = orgFilters
+	}
 	if req.Language != "" {
 		filters["language"] = req.Language
 	}
@@ -608,77 +932,162 @@ func (s *PromptService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (
 
 func (s *PromptService) CreatePrompt(ctx context.Context, req *pb.CreatePromptRequest) (*pb.CreatePromptResponse, error) {
 	zap.S().Infof("PromptService.CreatePrompt: template_id=%s owner_id=%s", req.TemplateId, req.OwnerId)
-	if req.OwnerId == "" {
-		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
+	if err := authorizeSelf(ctx, policy.Update, req.OwnerId); err != nil {
+		return nil, err
+	}
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Instantiate)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.TemplateVersionRepo.Get(ctx, req.TemplateId, req.VersionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "version not found")
+	}
+
+	named, report, err := s.namedValues(ctx, template, version, req.PresetId, req.NamedVariables)
+	if err != nil {
+		return nil, err
 	}
 
 	variablesJSON, err := json.Marshal(req.Variables)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid variables: %v", err)
 	}
+	namedJSON, err := json.Marshal(named)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid named variables: %v", err)
+	}
 
 	prompt := &models.Prompt{
-		TemplateID: req.TemplateId,
-		VersionID:  req.VersionId,
-		OwnerID:    req.OwnerId,
-		Variables:  variablesJSON,
+		TemplateID:     req.TemplateId,
+		VersionID:      req.VersionId,
+		OwnerID:        principal(ctx).UserID,
+		Variables:      variablesJSON,
+		NamedVariables: namedJSON,
+		Title:          req.Title,
+		Notes:          req.Notes,
+		Tags:           pq.StringArray(req.Tags),
+		Pinned:         req.Pinned,
+		RenderedText:   renderContent(version.Content, req.Variables, named),
+		TemplateTitle:  template.Title,
+		Version:        version.Version,
 	}
 
 	if err := s.PromptRepo.Create(ctx, prompt); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create prompt: %v", err)
 	}
+	s.recordUsage(ctx, &models.UsageEvent{TemplateID: prompt.TemplateID, VersionID: prompt.VersionID, Event: "instantiate"})
+	s.Audit.Record(ctx, "prompt.create", prompt.ID, prompt.OwnerID, nil, promptAuditSummary(prompt))
 
 	return &pb.CreatePromptResponse{
 		Prompt: s.promptModelToProto(prompt),
+		Report: report,
+	}, nil
+}
+
+// RenderPrompt renders a version of a template, the latest by default, without saving a prompt.
+func (s *PromptService) RenderPrompt(ctx context.Context, req *pb.RenderPromptRequest) (*pb.RenderPromptResponse, error) {
+	zap.S().Infof("PromptService.RenderPrompt: template_id=%s version_id=%d preset_id=%s", req.TemplateId, req.VersionId, req.PresetId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Instantiate)
+	if err != nil {
+		return nil, err
+	}
+	var version *models.TemplateVersion
+	if req.VersionId != 0 {
+		version, err = s.TemplateVersionRepo.Get(ctx, req.TemplateId, req.VersionId)
+	} else {
+		version, err = s.TemplateVersionRepo.GetLatest(ctx, req.TemplateId)
+	}
+	if err != nil || version == nil {
+		return nil, status.Errorf(codes.NotFound, "version not found")
+	}
+
+	named, report, err := s.namedValues(ctx, template, version, req.PresetId, req.NamedVariables)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenderPromptResponse{
+		RenderedText: renderContent(version.Content, req.Variables, named),
+		VersionId:    version.ID,
+		Version:      version.Version,
+		Report:       report,
 	}, nil
 }
 
 func (s *PromptService) GetPrompt(ctx context.Context, req *pb.GetPromptRequest) (*pb.GetPromptResponse, error) {
 	zap.S().Infof("PromptService.GetPrompt: id=%s", req.Id)
-	prompt, err := s.PromptRepo.Get(ctx, req.Id)
+	prompt, err := s.getPromptFor(ctx, req.Id, policy.Read)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "prompt not found")
+		return nil, err
 	}
 	return &pb.GetPromptResponse{Prompt: s.promptModelToProto(prompt)}, nil
 }
 
 func (s *PromptService) ListPrompts(ctx context.Context, req *pb.ListPromptsRequest) (*pb.ListPromptsResponse, error) {
-	zap.S().Infof("PromptService.ListPrompts: owner_id=%s template_id=%s page_size=%d", req.OwnerId, req.TemplateId, req.PageSize)
+	zap.S().Infof("PromptService.ListPrompts: owner_id=%s template_id=%s query=%s page_size=%d", req.OwnerId, req.TemplateId, req.Query, req.PageSize)
 	limit := int(req.PageSize)
 	if limit <= 0 {
 		limit = 10
 	}
-	offset := 0
-	if req.PageToken != "" {
-		if v, err := strconv.Atoi(req.PageToken); err == nil {
-			offset = v
-		}
-	}
 
-	filters := make(map[string]interface{})
-	if req.OwnerId != "" {
-		filters["owner_id"] = req.OwnerId
+	// Prompts are private, so only the caller's own are listed.
+	if err := authorizeSelf(ctx, policy.Read, req.OwnerId); err != nil {
+		return nil, err
 	}
+	filters := map[string]interface{}{"owner_id": principal(ctx).UserID}
 	if req.TemplateId != "" {
 		filters["template_id"] = req.TemplateId
 	}
+	if req.StartTime != nil {
+		filters["start_time"] = req.StartTime.AsTime().Format(time.RFC3339Nano)
+	}
+	if req.EndTime != nil {
+		filters["end_time"] = req.EndTime.AsTime().Format(time.RFC3339Nano)
+	}
+	if req.Query != "" {
+		filters["query"] = req.Query
+	}
+	if req.PinnedOnly {
+		filters["pinned"] = true
+	}
+	if req.Tag != "" {
+		filters["tag"] = req.Tag
+	}
+	if req.Sort == pb.PromptSort_PROMPT_SORT_LAST_USED {
+		filters["sort"] = "last_used"
+	}
 
-	prompts, err := s.PromptRepo.List(ctx, limit, offset, filters)
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prompts, err := s.PromptRepo.List(ctx, limit+1, after, filters)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list prompts: %v", err)
 	}
 
+	nextPageToken := ""
+	if len(prompts) > limit {
+		prompts = prompts[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.PromptCursor(prompts[limit-1], filters), filters)
+	}
+
 	var pbPrompts []*pb.Prompt
 	for _, p := range prompts {
 		pbPrompts = append(pbPrompts, s.promptModelToProto(p))
 	}
 
-	nextPageToken := ""
-	if len(prompts) == limit {
-		nextPageToken = strconv.Itoa(offset + limit)
+	resp := &pb.ListPromptsResponse{Prompts: pbPrompts, NextPageToken: nextPageToken}
+	if req.IncludeTotalCount {
+		count, err := s.PromptRepo.Count(ctx, filters)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count prompts: %v", err)
+		}
+		resp.TotalCount = int32(count)
 	}
 
-	return &pb.ListPromptsResponse{Prompts: pbPrompts, NextPageToken: nextPageToken}, nil
+	return resp, nil
 }
 
 // ListTemplateVersions lists all versions of a template.
@@ -687,80 +1096,229 @@ func (s *PromptService) ListTemplateVersions(ctx context.Context, req *pb.ListTe
 	if req.TemplateId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "template_id is required")
 	}
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Read); err != nil {
+		return nil, err
+	}
 
 	limit := int(req.PageSize)
 	if limit <= 0 {
 		limit = 10
 	}
-	offset := 0
-	if req.PageToken != "" {
-		if v, err := strconv.Atoi(req.PageToken); err == nil {
-			offset = v
-		}
+
+	filters := map[string]interface{}{"template_id": req.TemplateId}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	versions, err := s.TemplateVersionRepo.List(ctx, limit, offset, req.TemplateId)
+	versions, err := s.TemplateVersionRepo.List(ctx, limit+1, after, req.TemplateId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list template versions: %v", err)
 	}
 
+	nextPageToken := ""
+	if len(versions) > limit {
+		versions = versions[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.TemplateVersionCursor(versions[limit-1]), filters)
+	}
+
 	var pbVersions []*pb.TemplateVersion
 	for _, v := range versions {
 		pbVersions = append(pbVersions, s.versionModelToProto(v))
 	}
 
-	nextPageToken := ""
-	if len(versions) == limit {
-		nextPageToken = strconv.Itoa(offset + limit)
+	resp := &pb.ListTemplateVersionsResponse{Versions: pbVersions, NextPageToken: nextPageToken}
+	if req.IncludeTotalCount {
+		count, err := s.TemplateVersionRepo.Count(ctx, req.TemplateId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count template versions: %v", err)
+		}
+		resp.TotalCount = int32(count)
 	}
 
-	return &pb.ListTemplateVersionsResponse{Versions: pbVersions, NextPageToken: nextPageToken}, nil
+	return resp, nil
 }
 
-func (s *PromptService) DeletePrompt(ctx context.Context, req *pb.DeletePromptRequest) (*pb.DeletePromptResponse, error) {
-	zap.S().Infof("PromptService.DeletePrompt: id=%s owner_id=%s", req.Id, req.OwnerId)
-	prompt, err := s.PromptRepo.Get(ctx, req.Id)
+// UpdatePrompt updates the title, notes, tags and pinned state of one of the caller's prompts.
+func (s *PromptService) UpdatePrompt(ctx context.Context, req *pb.UpdatePromptRequest) (*pb.UpdatePromptResponse, error) {
+	zap.S().Infof("PromptService.UpdatePrompt: id=%s", req.Id)
+	prompt, err := s.getPromptFor(ctx, req.Id, policy.Update)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "prompt not found")
+		return nil, err
+	}
+	before := promptAuditSummary(prompt)
+
+	if req.Title != "" {
+		prompt.Title = req.Title
+	}
+	prompt.Notes = req.Notes
+	prompt.Tags = pq.StringArray(req.Tags)
+	prompt.Pinned = req.Pinned
+	if req.MarkUsed {
+		prompt.LastUsedAt = time.Now()
 	}
 
-	if req.OwnerId != "" && prompt.OwnerID != req.OwnerId {
-		return nil, status.Errorf(codes.PermissionDenied, "not authorized")
+	if err := s.PromptRepo.Update(ctx, prompt); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update prompt: %v", err)
+	}
+	s.Audit.Record(ctx, "prompt.update", prompt.ID, prompt.OwnerID, before, promptAuditSummary(prompt))
+	return &pb.UpdatePromptResponse{Prompt: s.promptModelToProto(prompt)}, nil
+}
+
+// DuplicatePrompt saves a copy of one of the caller's prompts instantiated
+// against the latest version of its template. The variables are fitted to the
+// placeholders of the latest version, and the drift from the version of the
+// original prompt is reported.
+func (s *PromptService) DuplicatePrompt(ctx context.Context, req *pb.DuplicatePromptRequest) (*pb.DuplicatePromptResponse, error) {
+	zap.S().Infof("PromptService.DuplicatePrompt: id=%s", req.Id)
+	source, err := s.getPromptFor(ctx, req.Id, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	// The template may have been unshared since the prompt was saved.
+	template, err := s.getTemplateFor(ctx, source.TemplateID, policy.Instantiate)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.TemplateVersionRepo.Get(ctx, source.TemplateID, source.VersionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get version: %v", err)
+	}
+	latest, err := s.TemplateVersionRepo.GetLatest(ctx, source.TemplateID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest version: %v", err)
+	}
+
+	var variables []string
+	_ = json.Unmarshal(source.Variables, &variables)
+	drift, variables := placeholderDrift(previous.Content, latest.Content, variables)
+	drift.PreviousVersion = previous.Version
+	drift.LatestVersion = latest.Version
+	var named map[string]string
+	_ = json.Unmarshal(source.NamedVariables, &named)
+	// The reserved variables are filled again, unless the prompt set them.
+	named, report := resolveNamedValues(latest.Content, s.systemValues(ctx, template, latest), nil, settableValues(named, latest.OverridableVariables))
+
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode variables: %v", err)
+	}
+	namedJSON, err := json.Marshal(named)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode named variables: %v", err)
+	}
+
+	prompt := &models.Prompt{
+		TemplateID:     source.TemplateID,
+		VersionID:      latest.ID,
+		OwnerID:        source.OwnerID,
+		Variables:      variablesJSON,
+		NamedVariables: namedJSON,
+		Title:          source.Title,
+		Notes:          source.Notes,
+		Tags:           source.Tags,
+		RenderedText:   renderContent(latest.Content, variables, named),
+		TemplateTitle:  template.Title,
+		Version:        latest.Version,
+	}
+	if err := s.PromptRepo.Create(ctx, prompt); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create prompt: %v", err)
+	}
+	s.recordUsage(ctx, &models.UsageEvent{TemplateID: prompt.TemplateID, VersionID: prompt.VersionID, Event: "instantiate"})
+	s.Audit.Record(ctx, "prompt.create", prompt.ID, prompt.OwnerID, nil, promptAuditSummary(prompt))
+
+	source.LastUsedAt = time.Now()
+	if err := s.PromptRepo.Update(ctx, source); err != nil {
+		zap.S().Warnf("PromptService.DuplicatePrompt: failed to mark prompt %s as used: %v", source.ID, err)
+	}
+
+	return &pb.DuplicatePromptResponse{Prompt: s.promptModelToProto(prompt), Drift: drift, Report: report}, nil
+}
+
+func (s *PromptService) DeletePrompt(ctx context.Context, req *pb.DeletePromptRequest) (*pb.DeletePromptResponse, error) {
+	zap.S().Infof("PromptService.DeletePrompt: id=%s", req.Id)
+	prompt, err := s.getPromptFor(ctx, req.Id, policy.Delete)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.PromptRepo.Delete(ctx, req.Id); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete prompt: %v", err)
 	}
+	s.Audit.Record(ctx, "prompt.delete", prompt.ID, prompt.OwnerID, promptAuditSummary(prompt), nil)
 
 	return &pb.DeletePromptResponse{Success: true}, nil
 }
 
 // --- Helpers ---
 
-func (s *PromptService) templateModelToProto(m *models.Template) *pb.Template {
+// templatesWithLatestVersion converts templates to their proto form with the
+// latest version attached, loading all versions in a single batch.
+func (s *PromptService) templatesWithLatestVersion(ctx context.Context, templates []*models.Template) ([]*pb.Template, error) {
+	ids := make([]string, 0, len(templates))
+	for _, t := range templates {
+		ids = append(ids, t.ID)
+	}
+	latest, err := s.TemplateVersionRepo.GetLatestForTemplates(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest versions: %v", err)
+	}
+
+	var pbTemplates []*pb.Template
+	for _, t := range templates {
+		pbT := templateModelToProto(t)
+		pbT.LatestVersion = s.versionModelToProto(latest[t.ID])
+		pbTemplates = append(pbTemplates, pbT)
+	}
+	return pbTemplates, nil
+}
+
+// orgLibraryFilters returns the filters matching the library of an organization
+// as seen by the current user, who must be a member.
+func (s *PromptService) orgLibraryFilters(ctx context.Context, orgID string) (map[string]interface{}, error) {
+	member, err := orgMembershipFor(ctx, s.OrgRepo, orgID, policy.Read)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"org_id": orgID, "visible_to": member.UserID}, nil
+}
+
+func templateModelToProto(m *models.Template) *pb.Template {
 	if m == nil {
 		return nil
 	}
 	vis := pb.Visibility_VISIBILITY_PRIVATE
-	if m.Visibility == "public" {
+	switch m.Visibility {
+	case "public":
 		vis = pb.Visibility_VISIBILITY_PUBLIC
+	case "org":
+		vis = pb.Visibility_VISIBILITY_ORG
 	}
 
 	return &pb.Template{
-		Id:            m.ID,
-		OwnerId:       m.OwnerID,
-		Title:         m.Title,
-		Description:   m.Description.String,
-		Visibility:    vis,
-		Tags:          m.Tags,
-		Category:      m.Category.String,
-		CreatedAt:     timestamppb.New(m.CreatedAt),
-		UpdatedAt:     timestamppb.New(m.UpdatedAt),
-		LikeCount:     m.LikeCount,
-		FavoriteCount: m.FavoriteCount,
-		IsLiked:       m.IsLiked,
-		IsFavorited:   m.IsFavorited,
-		Language:      m.Language,
+		Id:              m.ID,
+		OwnerId:         m.OwnerID,
+		Title:           m.Title,
+		Description:     m.Description.String,
+		Visibility:      vis,
+		Tags:            m.Tags,
+		Category:        m.Category.String,
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+		UpdatedAt:       timestamppb.New(m.UpdatedAt),
+		LikeCount:       m.LikeCount,
+		FavoriteCount:   m.FavoriteCount,
+		IsLiked:         m.IsLiked,
+		IsFavorited:     m.IsFavorited,
+		Language:        m.Language,
+		TrendingScore:   m.TrendingScore,
+		ForkedFrom:      m.ForkedFrom.String,
+		OrgId:           m.OrgID.String,
+		Featured:        m.FeaturedAt.Valid,
+		ModerationState: moderationStateToProto(m.ModerationState),
+		CommentCount:    m.CommentCount,
+		RatingCount:     m.RatingCount,
+		RatingAverage:   m.RatingAverage,
+		RatingScore:     m.RatingScore,
 	}
 }
 
@@ -774,6 +1332,9 @@ func (s *PromptService) versionModelToProto(m *models.TemplateVersion) *pb.Templ
 		Version:    m.Version,
 		Content:    m.Content,
 		CreatedAt:  timestamppb.New(m.CreatedAt),
+		Major:      m.Major,
+
+		OverridableVariables: m.OverridableVariables,
 	}
 }
 
@@ -783,13 +1344,24 @@ func (s *PromptService) promptModelToProto(m *models.Prompt) *pb.Prompt {
 	}
 	var variables []string
 	_ = json.Unmarshal(m.Variables, &variables)
+	var named map[string]string
+	_ = json.Unmarshal(m.NamedVariables, &named)
 
 	return &pb.Prompt{
-		Id:         m.ID,
-		TemplateId: m.TemplateID,
-		VersionId:  m.VersionID,
-		OwnerId:    m.OwnerID,
-		Variables:  variables,
-		CreatedAt:  timestamppb.New(m.CreatedAt),
+		Id:             m.ID,
+		TemplateId:     m.TemplateID,
+		VersionId:      m.VersionID,
+		OwnerId:        m.OwnerID,
+		Variables:      variables,
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+		TemplateTitle:  m.TemplateTitle,
+		Version:        m.Version,
+		Title:          m.Title,
+		Notes:          m.Notes,
+		Tags:           m.Tags,
+		Pinned:         m.Pinned,
+		RenderedText:   m.RenderedText,
+		LastUsedAt:     timestamppb.New(m.LastUsedAt),
+		NamedVariables: named,
 	}
 }
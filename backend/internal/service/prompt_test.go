@@ -3,14 +3,20 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	pb "awsome-prompt/backend/api/proto/v1"
 	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Mocks
@@ -31,14 +37,24 @@ func (m *MockPromptRepository) Get(ctx context.Context, id string) (*models.Prom
 	return args.Get(0).(*models.Prompt), args.Error(1)
 }
 
-func (m *MockPromptRepository) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*models.Prompt, error) {
-	args := m.Called(ctx, limit, offset, filters)
+func (m *MockPromptRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.Prompt, error) {
+	args := m.Called(ctx, limit, after, filters)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*models.Prompt), args.Error(1)
 }
 
+func (m *MockPromptRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPromptRepository) Update(ctx context.Context, prompt *models.Prompt) error {
+	args := m.Called(ctx, prompt)
+	return args.Error(0)
+}
+
 func (m *MockPromptRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -51,10 +67,22 @@ type MockTemplateRepository struct {
 
 func (m *MockTemplateRepository) Create(ctx context.Context, t *models.Template) error { return nil }
 func (m *MockTemplateRepository) Get(ctx context.Context, id string, currentUserID string) (*models.Template, error) {
-	return nil, nil
+	args := m.Called(ctx, id, currentUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Template), args.Error(1)
 }
-func (m *MockTemplateRepository) List(ctx context.Context, l, o int, f map[string]interface{}) ([]*models.Template, error) {
-	return nil, nil
+func (m *MockTemplateRepository) List(ctx context.Context, l int, after *repository.Cursor, f map[string]interface{}) ([]*models.Template, error) {
+	args := m.Called(ctx, l, after, f)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Template), args.Error(1)
+}
+func (m *MockTemplateRepository) Count(ctx context.Context, f map[string]interface{}) (int64, error) {
+	args := m.Called(ctx, f)
+	return args.Get(0).(int64), args.Error(1)
 }
 func (m *MockTemplateRepository) Update(ctx context.Context, t *models.Template) error { return nil }
 func (m *MockTemplateRepository) Delete(ctx context.Context, id string) error          { return nil }
@@ -64,6 +92,10 @@ func (m *MockTemplateRepository) ToggleLike(ctx context.Context, userID, templat
 func (m *MockTemplateRepository) ToggleFavorite(ctx context.Context, userID, templateID string) (bool, int32, error) {
 	return false, 0, nil
 }
+func (m *MockTemplateRepository) RefreshTrendingScores(ctx context.Context, halfLife, window time.Duration) (int64, error) {
+	args := m.Called(ctx, halfLife, window)
+	return args.Get(0).(int64), args.Error(1)
+}
 func (m *MockTemplateRepository) ListCategories(ctx context.Context, filters map[string]interface{}) ([]*models.CategoryStat, error) {
 	args := m.Called(ctx, filters)
 	if args.Get(0) == nil {
@@ -78,6 +110,18 @@ func (m *MockTemplateRepository) ListTags(ctx context.Context, filters map[strin
 	}
 	return args.Get(0).([]*models.TagStat), args.Error(1)
 }
+func (m *MockTemplateRepository) TransferOwnership(ctx context.Context, id, newOwnerID string) error {
+	args := m.Called(ctx, id, newOwnerID)
+	return args.Error(0)
+}
+func (m *MockTemplateRepository) SetFeatured(ctx context.Context, id string, featured bool) error {
+	args := m.Called(ctx, id, featured)
+	return args.Error(0)
+}
+func (m *MockTemplateRepository) SetModerationState(ctx context.Context, id, state string) error {
+	args := m.Called(ctx, id, state)
+	return args.Error(0)
+}
 
 // MockTemplateVersionRepository
 type MockTemplateVersionRepository struct {
@@ -87,41 +131,96 @@ type MockTemplateVersionRepository struct {
 func (m *MockTemplateVersionRepository) Create(ctx context.Context, v *models.TemplateVersion) error {
 	return nil
 }
+func (m *MockTemplateVersionRepository) Get(ctx context.Context, tid string, id int32) (*models.TemplateVersion, error) {
+	args := m.Called(ctx, tid, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TemplateVersion), args.Error(1)
+}
 func (m *MockTemplateVersionRepository) GetLatest(ctx context.Context, tid string) (*models.TemplateVersion, error) {
 	return nil, nil
 }
-func (m *MockTemplateVersionRepository) List(ctx context.Context, limit, offset int, templateID string) ([]*models.TemplateVersion, error) {
-	args := m.Called(ctx, limit, offset, templateID)
+func (m *MockTemplateVersionRepository) GetLatestForTemplates(ctx context.Context, ids []string) (map[string]*models.TemplateVersion, error) {
+	return map[string]*models.TemplateVersion{}, nil
+}
+func (m *MockTemplateVersionRepository) List(ctx context.Context, limit int, after *repository.Cursor, templateID string) ([]*models.TemplateVersion, error) {
+	args := m.Called(ctx, limit, after, templateID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*models.TemplateVersion), args.Error(1)
 }
+func (m *MockTemplateVersionRepository) Count(ctx context.Context, templateID string) (int64, error) {
+	args := m.Called(ctx, templateID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// newTestService builds a PromptService on deps for the tests. The prompt,
+// template and version repositories default to mocks without expectations.
+func newTestService(deps PromptServiceDeps) *PromptService {
+	if deps.PromptRepo == nil {
+		deps.PromptRepo = new(MockPromptRepository)
+	}
+	if deps.TemplateRepo == nil {
+		deps.TemplateRepo = new(MockTemplateRepository)
+	}
+	if deps.TemplateVersionRepo == nil {
+		deps.TemplateVersionRepo = new(MockTemplateVersionRepository)
+	}
+	deps.PageTokenSecret = "secret"
+	return NewPromptService(deps)
+}
 
 func TestCreatePrompt(t *testing.T) {
 	mockPromptRepo := new(MockPromptRepository)
 	mockTemplateRepo := new(MockTemplateRepository)
 	mockVersionRepo := new(MockTemplateVersionRepository)
 
-	svc := NewPromptService(mockPromptRepo, mockTemplateRepo, mockVersionRepo)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
+
+	ctx := ContextWithUserID(context.Background(), "user_1")
+	mockTemplateRepo.On("Get", ctx, "tpl_1", "").Return(&models.Template{ID: "tpl_1", OwnerID: "user_2", Visibility: "public", Title: "Greeting"}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(1)).Return(&models.TemplateVersion{ID: 1, TemplateID: "tpl_1", Version: 1, Content: "Hello $$, meet $$."}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(9)).Return(nil, errors.New("not found"))
 
 	t.Run("Success", func(t *testing.T) {
 		req := &pb.CreatePromptRequest{
 			TemplateId: "tpl_1",
 			VersionId:  1,
-			OwnerId:    "user_1",
 			Variables:  []string{"var1"},
+			Title:      "Q3 report",
+			Tags:       []string{"work"},
 		}
 
 		mockPromptRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Prompt")).Return(nil)
 
-		resp, err := svc.CreatePrompt(context.Background(), req)
+		resp, err := svc.CreatePrompt(ctx, req)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
-		assert.Equal(t, req.OwnerId, resp.Prompt.OwnerId)
+		assert.Equal(t, "user_1", resp.Prompt.OwnerId)
+		assert.Equal(t, "Q3 report", resp.Prompt.Title)
+		assert.Equal(t, []string{"work"}, resp.Prompt.Tags)
+		assert.Equal(t, "Hello var1, meet .", resp.Prompt.RenderedText)
+		assert.Equal(t, "Greeting", resp.Prompt.TemplateTitle)
 
 		mockPromptRepo.AssertExpectations(t)
 	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		_, err := svc.CreatePrompt(ctx, &pb.CreatePromptRequest{TemplateId: "tpl_1", VersionId: 9})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OwnerIdOfAnotherUser", func(t *testing.T) {
+		_, err := svc.CreatePrompt(ctx, &pb.CreatePromptRequest{TemplateId: "tpl_1", VersionId: 1, OwnerId: "user_2"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := svc.CreatePrompt(context.Background(), &pb.CreatePromptRequest{TemplateId: "tpl_1", VersionId: 1})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
 }
 
 func TestGetPrompt(t *testing.T) {
@@ -129,7 +228,7 @@ func TestGetPrompt(t *testing.T) {
 	mockTemplateRepo := new(MockTemplateRepository)
 	mockVersionRepo := new(MockTemplateVersionRepository)
 
-	svc := NewPromptService(mockPromptRepo, mockTemplateRepo, mockVersionRepo)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
 
 	t.Run("Success", func(t *testing.T) {
 		vars, _ := json.Marshal([]string{"v1"})
@@ -142,10 +241,127 @@ func TestGetPrompt(t *testing.T) {
 
 		mockPromptRepo.On("Get", mock.Anything, "p_1").Return(prompt, nil)
 
-		resp, err := svc.GetPrompt(context.Background(), &pb.GetPromptRequest{Id: "p_1"})
+		resp, err := svc.GetPrompt(ContextWithUserID(context.Background(), "user_1"), &pb.GetPromptRequest{Id: "p_1"})
 		assert.NoError(t, err)
 		assert.Equal(t, "p_1", resp.Prompt.Id)
 	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		_, err := svc.GetPrompt(ContextWithUserID(context.Background(), "user_2"), &pb.GetPromptRequest{Id: "p_1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListPrompts(t *testing.T) {
+	mockPromptRepo := new(MockPromptRepository)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo})
+	ctx := ContextWithUserID(context.Background(), "user_1")
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Filters", func(t *testing.T) {
+		vars, _ := json.Marshal([]string{"World"})
+		mockPromptRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{
+			"owner_id":    "user_1",
+			"template_id": "tpl_1",
+			"start_time":  "2026-03-01T00:00:00Z",
+			"end_time":    "2026-04-01T00:00:00Z",
+			"query":       "world",
+		}).Return([]*models.Prompt{
+			{ID: "p_1", TemplateID: "tpl_1", VersionID: 7, OwnerID: "user_1", Variables: vars, CreatedAt: start, TemplateTitle: "Greeting", Version: 2},
+		}, nil)
+
+		resp, err := svc.ListPrompts(ctx, &pb.ListPromptsRequest{
+			TemplateId: "tpl_1",
+			StartTime:  timestamppb.New(start),
+			EndTime:    timestamppb.New(end),
+			Query:      "world",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Prompts, 1)
+		assert.Equal(t, "Greeting", resp.Prompts[0].TemplateTitle)
+		assert.Equal(t, int32(2), resp.Prompts[0].Version)
+		assert.Equal(t, []string{"World"}, resp.Prompts[0].Variables)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("OtherUsersPrompts", func(t *testing.T) {
+		_, err := svc.ListPrompts(ctx, &pb.ListPromptsRequest{OwnerId: "user_2"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := svc.ListPrompts(context.Background(), &pb.ListPromptsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestUpdatePrompt(t *testing.T) {
+	mockPromptRepo := new(MockPromptRepository)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo})
+	ctx := ContextWithUserID(context.Background(), "user_1")
+	lastUsed := time.Now().Add(-time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		prompt := &models.Prompt{ID: "p_1", OwnerID: "user_1", Title: "Old", Notes: "old notes", LastUsedAt: lastUsed}
+		mockPromptRepo.On("Get", ctx, "p_1").Return(prompt, nil).Once()
+		mockPromptRepo.On("Update", ctx, mock.MatchedBy(func(p *models.Prompt) bool {
+			return p.ID == "p_1" && p.Title == "Old" && p.Notes == "for the Q3 report" && p.Pinned && p.LastUsedAt.After(lastUsed)
+		})).Return(nil).Once()
+
+		resp, err := svc.UpdatePrompt(ctx, &pb.UpdatePromptRequest{Id: "p_1", Notes: "for the Q3 report", Tags: []string{"q3"}, Pinned: true, MarkUsed: true})
+		assert.NoError(t, err)
+		assert.True(t, resp.Prompt.Pinned)
+		assert.Equal(t, []string{"q3"}, resp.Prompt.Tags)
+		mockPromptRepo.AssertExpectations(t)
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		mockPromptRepo.On("Get", mock.Anything, "p_2").Return(&models.Prompt{ID: "p_2", OwnerID: "user_2"}, nil)
+		_, err := svc.UpdatePrompt(ctx, &pb.UpdatePromptRequest{Id: "p_2", Pinned: true})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestDuplicatePrompt(t *testing.T) {
+	mockPromptRepo := new(MockPromptRepository)
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockVersionRepo := new(driftVersionRepository)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
+	ctx := ContextWithUserID(context.Background(), "user_1")
+
+	vars, _ := json.Marshal([]string{"Ada", "Alan", "Grace"})
+	source := &models.Prompt{ID: "p_1", TemplateID: "tpl_1", VersionID: 1, OwnerID: "user_1", Variables: vars, Title: "Intro", Tags: []string{"team"}}
+	mockPromptRepo.On("Get", ctx, "p_1").Return(source, nil)
+	mockTemplateRepo.On("Get", ctx, "tpl_1", "").Return(&models.Template{ID: "tpl_1", OwnerID: "user_2", Visibility: "public", Title: "Greeting"}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(1)).Return(&models.TemplateVersion{ID: 1, TemplateID: "tpl_1", Version: 1, Content: "$$, $$ and $$"}, nil)
+	mockPromptRepo.On("Create", ctx, mock.MatchedBy(func(p *models.Prompt) bool {
+		return p.VersionID == 4 && string(p.Variables) == `["Ada","Alan"]` && p.Title == "Intro" && !p.Pinned
+	})).Return(nil)
+	mockPromptRepo.On("Update", ctx, source).Return(nil)
+
+	resp, err := svc.DuplicatePrompt(ctx, &pb.DuplicatePromptRequest{Id: "p_1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi Ada and Alan!", resp.Prompt.RenderedText)
+	assert.Equal(t, int32(2), resp.Prompt.Version)
+	assert.Equal(t, &pb.PlaceholderDrift{
+		PreviousVersion:      1,
+		LatestVersion:        2,
+		PreviousPlaceholders: 3,
+		LatestPlaceholders:   2,
+		DroppedVariables:     []string{"Grace"},
+	}, resp.Drift)
+	assert.False(t, source.LastUsedAt.IsZero())
+	mockPromptRepo.AssertExpectations(t)
+}
+
+// driftVersionRepository reports a latest version with fewer placeholders.
+type driftVersionRepository struct {
+	MockTemplateVersionRepository
+}
+
+func (r *driftVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
+	return &models.TemplateVersion{ID: 4, TemplateID: templateID, Version: 2, Content: "Hi $$ and $$!"}, nil
 }
 
 func TestDeletePrompt(t *testing.T) {
@@ -153,14 +369,14 @@ func TestDeletePrompt(t *testing.T) {
 	mockTemplateRepo := new(MockTemplateRepository)
 	mockVersionRepo := new(MockTemplateVersionRepository)
 
-	svc := NewPromptService(mockPromptRepo, mockTemplateRepo, mockVersionRepo)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
 
 	t.Run("Success", func(t *testing.T) {
 		prompt := &models.Prompt{ID: "p_1", OwnerID: "user_1"}
 		mockPromptRepo.On("Get", mock.Anything, "p_1").Return(prompt, nil)
 		mockPromptRepo.On("Delete", mock.Anything, "p_1").Return(nil)
 
-		resp, err := svc.DeletePrompt(context.Background(), &pb.DeletePromptRequest{Id: "p_1", OwnerId: "user_1"})
+		resp, err := svc.DeletePrompt(ContextWithUserID(context.Background(), "user_1"), &pb.DeletePromptRequest{Id: "p_1"})
 		assert.NoError(t, err)
 		assert.True(t, resp.Success)
 	})
@@ -169,8 +385,9 @@ func TestDeletePrompt(t *testing.T) {
 		prompt := &models.Prompt{ID: "p_1", OwnerID: "user_1"}
 		mockPromptRepo.On("Get", mock.Anything, "p_1").Return(prompt, nil)
 
-		_, err := svc.DeletePrompt(context.Background(), &pb.DeletePromptRequest{Id: "p_1", OwnerId: "user_2"})
-		assert.Error(t, err)
+		// A client-supplied owner_id is not trusted.
+		_, err := svc.DeletePrompt(ContextWithUserID(context.Background(), "user_2"), &pb.DeletePromptRequest{Id: "p_1", OwnerId: "user_1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
 	})
 }
 
@@ -179,7 +396,7 @@ func TestListCategories(t *testing.T) {
 	mockTemplateRepo := new(MockTemplateRepository)
 	mockVersionRepo := new(MockTemplateVersionRepository)
 
-	svc := NewPromptService(mockPromptRepo, mockTemplateRepo, mockVersionRepo)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
 
 	t.Run("Success", func(t *testing.T) {
 		stats := []*models.CategoryStat{
@@ -201,7 +418,7 @@ func TestListTags(t *testing.T) {
 	mockTemplateRepo := new(MockTemplateRepository)
 	mockVersionRepo := new(MockTemplateVersionRepository)
 
-	svc := NewPromptService(mockPromptRepo, mockTemplateRepo, mockVersionRepo)
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
 
 	t.Run("Success", func(t *testing.T) {
 		stats := []*models.TagStat{
@@ -217,3 +434,212 @@ func TestListTags(t *testing.T) {
 		assert.Equal(t, int32(20), resp.Tags[0].Count)
 	})
 }
+
+func TestListTrendingTemplates(t *testing.T) {
+	mockPromptRepo := new(MockPromptRepository)
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockVersionRepo := new(MockTemplateVersionRepository)
+
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
+
+	t.Run("Success", func(t *testing.T) {
+		templates := []*models.Template{
+			{ID: "tpl_1", Title: "Hot", Visibility: "public", TrendingScore: 12.5},
+			{ID: "tpl_2", Title: "Warm", Visibility: "public", TrendingScore: 3},
+		}
+		mockTemplateRepo.On("List", mock.Anything, 5, (*repository.Cursor)(nil), map[string]interface{}{
+			"visibility":    "public",
+			"sort":          "trending",
+			"trending_only": true,
+			"category":      "coding",
+		}).Return(templates, nil)
+
+		resp, err := svc.ListTrendingTemplates(context.Background(), &pb.ListTrendingTemplatesRequest{Category: "coding", PageSize: 5})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 2)
+		assert.Equal(t, "tpl_1", resp.Templates[0].Id)
+		assert.Equal(t, 12.5, resp.Templates[0].TrendingScore)
+		mockTemplateRepo.AssertExpectations(t)
+	})
+}
+
+func TestListTemplatesTrendingSort(t *testing.T) {
+	mockPromptRepo := new(MockPromptRepository)
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockVersionRepo := new(MockTemplateVersionRepository)
+
+	svc := newTestService(PromptServiceDeps{PromptRepo: mockPromptRepo, TemplateRepo: mockTemplateRepo, TemplateVersionRepo: mockVersionRepo})
+
+	mockTemplateRepo.On("List", mock.Anything, 11, (*repository.Cursor)(nil), mock.MatchedBy(func(f map[string]interface{}) bool {
+		return f["sort"] == "trending" && f["visibility"] == "public"
+	})).Return([]*models.Template{{ID: "tpl_1"}}, nil)
+
+	resp, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{Sort: pb.TemplateSort_TEMPLATE_SORT_TRENDING})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Templates, 1)
+	mockTemplateRepo.AssertExpectations(t)
+}
+
+func TestTrendingWorkerRunOnce(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	worker := NewTrendingWorker(mockTemplateRepo, time.Minute, time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTemplateRepo.On("RefreshTrendingScores", mock.Anything, time.Hour, 10*time.Hour).Return(int64(3), nil).Once()
+		assert.NoError(t, worker.RunOnce(context.Background()))
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		mockTemplateRepo.On("RefreshTrendingScores", mock.Anything, time.Hour, 10*time.Hour).Return(int64(0), errors.New("db down")).Once()
+		assert.Error(t, worker.RunOnce(context.Background()))
+	})
+
+	t.Run("RefreshInProgress", func(t *testing.T) {
+		mockTemplateRepo.On("RefreshTrendingScores", mock.Anything, time.Hour, 10*time.Hour).Return(int64(0), repository.ErrTrendingRefreshInProgress).Once()
+		assert.NoError(t, worker.RunOnce(context.Background()))
+	})
+}
+
+func TestListTemplatesKeysetPagination(t *testing.T) {
+	newService := func() (*PromptService, *MockTemplateRepository) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		return newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo}), mockTemplateRepo
+	}
+	now := time.Now().UTC()
+	page := []*models.Template{
+		{ID: "tpl_3", CreatedAt: now},
+		{ID: "tpl_2", CreatedAt: now.Add(-time.Minute)},
+		{ID: "tpl_1", CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	t.Run("NextPageToken", func(t *testing.T) {
+		svc, mockTemplateRepo := newService()
+		mockTemplateRepo.On("List", mock.Anything, 3, (*repository.Cursor)(nil), mock.Anything).Return(page, nil).Once()
+		mockTemplateRepo.On("Count", mock.Anything, mock.Anything).Return(int64(3), nil).Once()
+
+		resp, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{PageSize: 2, IncludeTotalCount: true})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 2)
+		assert.NotEmpty(t, resp.NextPageToken)
+		assert.Equal(t, int32(3), resp.TotalCount)
+
+		want := &repository.Cursor{Key: page[1].CreatedAt.Format(time.RFC3339Nano), ID: "tpl_2"}
+		mockTemplateRepo.On("List", mock.Anything, 3, want, mock.Anything).Return(page[2:], nil).Once()
+
+		resp, err = svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{PageSize: 2, PageToken: resp.NextPageToken})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 1)
+		assert.Empty(t, resp.NextPageToken)
+		mockTemplateRepo.AssertExpectations(t)
+	})
+
+	t.Run("TokenReusedWithOtherFilters", func(t *testing.T) {
+		svc, mockTemplateRepo := newService()
+		mockTemplateRepo.On("List", mock.Anything, 3, (*repository.Cursor)(nil), mock.Anything).Return(page, nil).Once()
+
+		resp, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{PageSize: 2})
+		assert.NoError(t, err)
+
+		_, err = svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{PageSize: 2, PageToken: resp.NextPageToken, Category: "coding"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("RawOffsetRejected", func(t *testing.T) {
+		svc, _ := newService()
+		_, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{PageToken: "20"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("MixedViewSkipsExhaustedList", func(t *testing.T) {
+		svc, mockTemplateRepo := newService()
+		ctx := ContextWithUserID(context.Background(), "user_1")
+		publicFilters := map[string]interface{}{"visibility": "public", "current_user_id": "user_1"}
+		token := svc.PageTokens.Encode(&repository.Cursor{Key: now.Format(time.RFC3339Nano), ID: "tpl_9"}, publicFilters)
+
+		mockTemplateRepo.On("List", mock.Anything, 3, mock.AnythingOfType("*repository.Cursor"), publicFilters).Return(page[:1], nil).Once()
+
+		resp, err := svc.ListTemplates(ctx, &pb.ListTemplatesRequest{PageSize: 2, PageToken: token + ":"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Templates, 1)
+		assert.Empty(t, resp.PrivateTemplates)
+		mockTemplateRepo.AssertExpectations(t)
+	})
+}
+
+// countingTemplateRepository serves pages of synthetic templates and counts repository queries.
+type countingTemplateRepository struct {
+	MockTemplateRepository
+	queries *int
+}
+
+func (r *countingTemplateRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.Template, error) {
+	*r.queries++
+	templates := make([]*models.Template, limit)
+	for i := range templates {
+		templates[i] = &models.Template{ID: fmt.Sprintf("tpl_%d", i), Visibility: "public", CreatedAt: time.Now()}
+	}
+	return templates, nil
+}
+
+// countingVersionRepository returns one version per template and counts repository queries.
+type countingVersionRepository struct {
+	MockTemplateVersionRepository
+	queries *int
+}
+
+func (r *countingVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
+	*r.queries++
+	return &models.TemplateVersion{TemplateID: templateID, Version: 1}, nil
+}
+
+func (r *countingVersionRepository) GetLatestForTemplates(ctx context.Context, ids []string) (map[string]*models.TemplateVersion, error) {
+	*r.queries++
+	versions := make(map[string]*models.TemplateVersion, len(ids))
+	for _, id := range ids {
+		versions[id] = &models.TemplateVersion{TemplateID: id, Version: 1}
+	}
+	return versions, nil
+}
+
+func TestListTemplatesQueryCount(t *testing.T) {
+	for _, mixed := range []bool{false, true} {
+		ctx := context.Background()
+		if mixed {
+			ctx = ContextWithUserID(ctx, "user_1")
+		}
+		var counts []int
+		for _, pageSize := range []int32{1, 10, 50, 100} {
+			queries := 0
+			svc := newTestService(PromptServiceDeps{TemplateRepo: &countingTemplateRepository{queries: &queries}, TemplateVersionRepo: &countingVersionRepository{queries: &queries}})
+			resp, err := svc.ListTemplates(ctx, &pb.ListTemplatesRequest{PageSize: pageSize})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Templates, int(pageSize))
+			for _, tpl := range resp.Templates {
+				assert.NotNil(t, tpl.LatestVersion)
+			}
+			counts = append(counts, queries)
+		}
+		for _, c := range counts {
+			assert.Equal(t, counts[0], c, "query count must not grow with page size (mixed=%v)", mixed)
+		}
+	}
+}
+
+func BenchmarkListTemplates(b *testing.B) {
+	for _, pageSize := range []int32{10, 50, 100} {
+		b.Run(fmt.Sprintf("page_size=%d", pageSize), func(b *testing.B) {
+			queries := 0
+			svc := newTestService(PromptServiceDeps{TemplateRepo: &countingTemplateRepository{queries: &queries}, TemplateVersionRepo: &countingVersionRepository{queries: &queries}})
+			ctx := ContextWithUserID(context.Background(), "user_1")
+			req := &pb.ListTemplatesRequest{PageSize: pageSize}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := svc.ListTemplates(ctx, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(queries)/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+)
+
+// placeholder marks where a variable is substituted in the content of a
+// template version. Variables fill the placeholders in order.
+const placeholder = "$$"
+
+// namedPlaceholder matches a named placeholder such as {{product}}, filled by
+// the value of that name wherever it appears.
+var namedPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.]*)\s*\}\}`)
+
+// placeholderName matches the valid names of named placeholders.
+var placeholderName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// countPlaceholders returns the number of placeholders of content.
+func countPlaceholders(content string) int {
+	return strings.Count(content, placeholder)
+}
+
+// placeholderNames returns the names of the named placeholders of content,
+// each once, in order of first appearance.
+func placeholderNames(content string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range namedPlaceholder.FindAllStringSubmatch(content, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// renderContent substitutes the variables for the placeholders of content, in
+// order, and the named values for its named placeholders. Placeholders without
+// a variable are left empty and extra variables are ignored, as in the template
+// editor; named placeholders without a value are kept as written, since
+// templates may contain such text for another purpose. Substituted values are
+// not rendered again.
+func renderContent(content string, variables []string, named map[string]string) string {
+	parts := strings.Split(content, placeholder)
+	var b strings.Builder
+	for i, part := range parts {
+		b.WriteString(namedPlaceholder.ReplaceAllStringFunc(part, func(m string) string {
+			if v, ok := named[namedPlaceholder.FindStringSubmatch(m)[1]]; ok {
+				return v
+			}
+			return m
+		}))
+		if i < len(parts)-1 && i < len(variables) {
+			b.WriteString(variables[i])
+		}
+	}
+	return b.String()
+}
+
+// resolveNamedValues picks the value of each named placeholder of content from
+// the explicit values, then from the preset, then from the values filled by the
+// system, and reports which placeholders the preset and the system filled and
+// which are missing.
+func resolveNamedValues(content string, system, preset, explicit map[string]string) (map[string]string, *pb.PlaceholderReport) {
+	values := make(map[string]string)
+	report := &pb.PlaceholderReport{}
+	for _, name := range placeholderNames(content) {
+		if v, ok := explicit[name]; ok {
+			values[name] = v
+		} else if v, ok := preset[name]; ok {
+			values[name] = v
+			report.FilledFromPreset = append(report.FilledFromPreset, name)
+		} else if v, ok := system[name]; ok {
+			values[name] = v
+			report.FilledBySystem = append(report.FilledBySystem, name)
+		} else {
+			report.Missing = append(report.Missing, name)
+		}
+	}
+	return values, report
+}
+
+// placeholderDrift compares the placeholders of the previous and latest
+// version of a template for the given variables, and returns the variables
+// fitted to the placeholders of the latest version.
+func placeholderDrift(previous, latest string, variables []string) (*pb.PlaceholderDrift, []string) {
+	drift := &pb.PlaceholderDrift{
+		PreviousPlaceholders: int32(countPlaceholders(previous)),
+		LatestPlaceholders:   int32(countPlaceholders(latest)),
+	}
+	n := int(drift.LatestPlaceholders)
+	fitted := make([]string, n)
+	copy(fitted, variables)
+	if len(variables) > n {
+		drift.DroppedVariables = variables[n:]
+	} else {
+		drift.UnfilledPlaceholders = int32(n - len(variables))
+	}
+	return drift, fitted
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderContent(t *testing.T) {
+	assert.Equal(t, "Hello World", renderContent("Hello $$", []string{"World"}, nil))
+	assert.Equal(t, "a-b-", renderContent("$$-$$-$$", []string{"a", "b"}, nil))
+	assert.Equal(t, "no placeholders", renderContent("no placeholders", []string{"extra"}, nil))
+	assert.Equal(t, "Acme for CTOs, Acme: {{b}} and $$ and {{missing}}",
+		renderContent("{{product}} for $$, {{ product }}: $$ and {{missing}}", []string{"CTOs", "{{b}} and $$"}, map[string]string{"product": "Acme"}))
+
+	drift, fitted := placeholderDrift("$$", "$$ $$ $$", []string{"x"})
+	assert.Equal(t, int32(2), drift.UnfilledPlaceholders)
+	assert.Empty(t, drift.DroppedVariables)
+	assert.Equal(t, []string{"x", "", ""}, fitted)
+}
+
+func TestResolveNamedValues(t *testing.T) {
+	content := "Pitch {{product}} to {{audience}} in {{tone}}, {{product}}!"
+	preset := map[string]string{"product": "Acme CRM", "audience": "enterprise CTOs", "unused": "x"}
+	values, report := resolveNamedValues(content, nil, preset, map[string]string{"audience": "startups"})
+	assert.Equal(t, map[string]string{"product": "Acme CRM", "audience": "startups"}, values)
+	assert.Equal(t, []string{"product"}, report.FilledFromPreset)
+	assert.Equal(t, []string{"tone"}, report.Missing)
+
+	// The system fills what is neither given nor in the preset.
+	system := map[string]string{"tone": "formal", "product": "ignored"}
+	values, report = resolveNamedValues(content, system, preset, nil)
+	assert.Equal(t, "formal", values["tone"])
+	assert.Equal(t, "Acme CRM", values["product"])
+	assert.Equal(t, []string{"tone"}, report.FilledBySystem)
+	assert.Empty(t, report.Missing)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	clientIPKey  contextKey = "client_ip"
+	requestIDKey contextKey = "request_id"
+	languageKey  contextKey = "language"
+	timeZoneKey  contextKey = "time_zone"
+)
+
+// maxRequestIDLength caps the length of request IDs supplied by clients.
+const maxRequestIDLength = 128
+
+// ContextWithClientIP adds the address of the client a request comes from to the context.
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// clientIP returns the address of the client a request comes from: the one set
+// by the REST gateway, else the gRPC peer. It is empty when neither is known.
+func clientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey).(string); ok && ip != "" {
+		return ip
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// ContextWithRequestID adds the ID of the request being served to the context.
+// The ID supplied by the client is kept when there is one of a sensible length;
+// otherwise a new one is generated.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" || len(id) > maxRequestIDLength {
+		id = newRequestID()
+	}
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the ID of the request being served: the one added to the
+// context, else the x-request-id gRPC metadata. It is empty when neither is set.
+func RequestID(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-request-id"); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// ContextWithLanguage adds the preferred language of the client, as sent in
+// an Accept-Language header, to the context.
+func ContextWithLanguage(ctx context.Context, acceptLanguage string) context.Context {
+	return context.WithValue(ctx, languageKey, acceptLanguage)
+}
+
+// requestLanguage returns the preferred language of the client: the first tag
+// of the Accept-Language added to the context, else of the accept-language
+// gRPC metadata. It is empty when neither is set.
+func requestLanguage(ctx context.Context) string {
+	header, ok := ctx.Value(languageKey).(string)
+	if !ok {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("accept-language"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+	}
+	tag := strings.Split(strings.Split(header, ",")[0], ";")[0]
+	tag = strings.TrimSpace(tag)
+	if tag == "*" {
+		return ""
+	}
+	return tag
+}
+
+// ContextWithTimeZone adds the IANA time zone of the client, such as
+// Europe/Paris, to the context.
+func ContextWithTimeZone(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, timeZoneKey, name)
+}
+
+// requestLocation returns the time zone of the client: the one added to the
+// context, else the x-timezone gRPC metadata. It is UTC when neither is set or
+// the zone is unknown.
+func requestLocation(ctx context.Context) *time.Location {
+	name, ok := ctx.Value(timeZoneKey).(string)
+	if !ok {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-timezone"); len(values) > 0 {
+				name = values[0]
+			}
+		}
+	}
+	if name == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// newRequestID returns a random request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
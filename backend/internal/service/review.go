@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// maxReviewLength caps the written part of a review, in characters.
+const maxReviewLength = 5000
+
+// RateTemplate rates a template the caller can read, replacing their previous
+// review. The review records the latest version of the template, so that it can
+// be flagged as stale once a major version comes out.
+func (s *PromptService) RateTemplate(ctx context.Context, req *pb.RateTemplateRequest) (*pb.RateTemplateResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.RateTemplate: template_id=%s rating=%d user_id=%s", req.TemplateId, req.Rating, userID)
+
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, status.Error(codes.InvalidArgument, "rating must be between 1 and 5")
+	}
+	body := strings.TrimSpace(req.Body)
+	if utf8.RuneCountInString(body) > maxReviewLength {
+		return nil, status.Errorf(codes.InvalidArgument, "body must be at most %d characters", maxReviewLength)
+	}
+
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Review)
+	if err != nil {
+		return nil, err
+	}
+	if template.OwnerID == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot review your own template")
+	}
+	latest, err := s.TemplateVersionRepo.GetLatest(ctx, template.ID)
+	if err != nil || latest == nil {
+		return nil, status.Error(codes.FailedPrecondition, "template has no version to review")
+	}
+
+	review := &models.Review{
+		TemplateID: template.ID,
+		UserID:     userID,
+		Rating:     req.Rating,
+		Body:       body,
+		Version:    latest.Version,
+	}
+	if err := s.ReviewRepo.Upsert(ctx, review); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save review: %v", err)
+	}
+	s.Audit.Record(ctx, "review.upsert", template.ID, userID, nil, map[string]interface{}{"rating": review.Rating, "body": review.Body, "version": review.Version})
+	s.notify(ctx, template.OwnerID, "review", userID, template.ID, "")
+	return &pb.RateTemplateResponse{Review: reviewModelToProto(review)}, nil
+}
+
+// DeleteReview deletes the caller's review of a template.
+func (s *PromptService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Infof("PromptService.DeleteReview: template_id=%s user_id=%s", req.TemplateId, userID)
+
+	if err := s.ReviewRepo.Delete(ctx, req.TemplateId, userID); err != nil {
+		if errors.Is(err, repository.ErrReviewNotFound) {
+			return nil, status.Error(codes.NotFound, "review not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete review: %v", err)
+	}
+	s.Audit.Record(ctx, "review.delete", req.TemplateId, userID, nil, nil)
+	return &pb.DeleteReviewResponse{Success: true}, nil
+}
+
+// ListReviews lists the reviews of a template the caller can read, most recently updated first.
+func (s *PromptService) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	zap.S().Infof("PromptService.ListReviews: template_id=%s page_size=%d exclude_stale=%t", req.TemplateId, req.PageSize, req.ExcludeStale)
+	if req.TemplateId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template_id is required")
+	}
+	template, err := s.TemplateRepo.Get(ctx, req.TemplateId, "")
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "template not found")
+	}
+	if err := s.authorizeTemplate(ctx, principal(ctx), policy.Read, template, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := map[string]interface{}{"template_id": req.TemplateId, "exclude_stale": req.ExcludeStale}
+	after, err := s.PageTokens.Decode(req.PageToken, filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reviews, err := s.ReviewRepo.List(ctx, template.ID, req.ExcludeStale, limit+1, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reviews: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		nextPageToken = s.PageTokens.Encode(repository.ReviewCursor(reviews[limit-1]), filters)
+	}
+
+	var pbReviews []*pb.Review
+	for _, r := range reviews {
+		pbReviews = append(pbReviews, reviewModelToProto(r))
+	}
+	return &pb.ListReviewsResponse{Reviews: pbReviews, NextPageToken: nextPageToken}, nil
+}
+
+func reviewModelToProto(m *models.Review) *pb.Review {
+	return &pb.Review{
+		Id:              m.ID,
+		TemplateId:      m.TemplateID,
+		UserId:          m.UserID,
+		UserDisplayName: m.UserName,
+		Rating:          m.Rating,
+		Body:            m.Body,
+		Version:         m.Version,
+		Stale:           m.Stale,
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+		UpdatedAt:       timestamppb.New(m.UpdatedAt),
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockReviewRepository is a mock implementation of repository.ReviewRepository
+type MockReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewRepository) Upsert(ctx context.Context, r *models.Review) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+func (m *MockReviewRepository) Delete(ctx context.Context, templateID, userID string) error {
+	args := m.Called(ctx, templateID, userID)
+	return args.Error(0)
+}
+func (m *MockReviewRepository) List(ctx context.Context, templateID string, excludeStale bool, limit int, after *repository.Cursor) ([]*models.Review, error) {
+	args := m.Called(ctx, templateID, excludeStale, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Review), args.Error(1)
+}
+
+func TestRateTemplate(t *testing.T) {
+	template := &models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}
+
+	t.Run("RecordsLatestVersion", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockReviewRepo := new(MockReviewRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+		mockReviewRepo.On("Upsert", ctx, mock.MatchedBy(func(r *models.Review) bool {
+			return r.UserID == "bob" && r.Rating == 4 && r.Body == "Works well" && r.Version == 4
+		})).Return(nil)
+
+		resp, err := svc.RateTemplate(ctx, &pb.RateTemplateRequest{TemplateId: "t1", Rating: 4, Body: " Works well "})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), resp.Review.Version)
+		assert.False(t, resp.Review.Stale)
+	})
+
+	for _, rating := range []int32{0, 6} {
+		t.Run("InvalidRating", func(t *testing.T) {
+			mockReviewRepo := new(MockReviewRepository)
+			svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo})
+
+			_, err := svc.RateTemplate(ContextWithUserID(context.Background(), "bob"), &pb.RateTemplateRequest{TemplateId: "t1", Rating: rating})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			mockReviewRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("OwnTemplate", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		mockReviewRepo := new(MockReviewRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(template, nil)
+
+		_, err := svc.RateTemplate(ctx, &pb.RateTemplateRequest{TemplateId: "t1", Rating: 5})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockReviewRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("PrivateTemplate", func(t *testing.T) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ShareRepo: new(MockShareRepository), ReviewRepo: new(MockReviewRepository)})
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t2", "").Return(&models.Template{ID: "t2", OwnerID: "alice", Visibility: "private"}, nil)
+		svc.ShareRepo.(*MockShareRepository).On("GetGrant", ctx, "t2", "bob").Return(nil, nil)
+
+		_, err := svc.RateTemplate(ctx, &pb.RateTemplateRequest{TemplateId: "t2", Rating: 5})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestDeleteReview(t *testing.T) {
+	mockReviewRepo := new(MockReviewRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: new(MockTemplateRepository), TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo})
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockReviewRepo.On("Delete", ctx, "t1", "bob").Return(repository.ErrReviewNotFound)
+
+	_, err := svc.DeleteReview(ctx, &pb.DeleteReviewRequest{TemplateId: "t1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListReviews(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockReviewRepo := new(MockReviewRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: &latestVersionRepository{latest: 4}, ReviewRepo: mockReviewRepo})
+	ctx := context.Background()
+	mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "public"}, nil)
+
+	now := time.Now()
+	reviews := []*models.Review{
+		{ID: "r1", TemplateID: "t1", UserID: "bob", Rating: 5, Version: 4, UpdatedAt: now},
+		{ID: "r2", TemplateID: "t1", UserID: "carol", Rating: 2, Version: 1, Stale: true, UpdatedAt: now.Add(-time.Hour)},
+	}
+	mockReviewRepo.On("List", ctx, "t1", false, 11, (*repository.Cursor)(nil)).Return(reviews, nil)
+
+	resp, err := svc.ListReviews(ctx, &pb.ListReviewsRequest{TemplateId: "t1"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Reviews, 2)
+	assert.False(t, resp.Reviews[0].Stale)
+	assert.True(t, resp.Reviews[1].Stale)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestListTemplatesRatingSort(t *testing.T) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo})
+
+	mockTemplateRepo.On("List", mock.Anything, 11, (*repository.Cursor)(nil), mock.MatchedBy(func(f map[string]interface{}) bool {
+		return f["sort"] == "rating" && f["min_rating"] == 4.5 && f["visibility"] == "public"
+	})).Return([]*models.Template{{ID: "tpl_1", RatingCount: 12, RatingAverage: 4.75, RatingScore: 4.26}}, nil)
+
+	resp, err := svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{Sort: pb.TemplateSort_TEMPLATE_SORT_RATING, MinRating: 4.5})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Templates, 1)
+	assert.Equal(t, 4.75, resp.Templates[0].RatingAverage)
+	mockTemplateRepo.AssertExpectations(t)
+
+	_, err = svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{MinRating: 6})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
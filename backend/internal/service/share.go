@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
+	"awsome-prompt/backend/internal/repository"
+)
+
+// newSecretToken returns a random URL-safe token, used for share links and invitations.
+func newSecretToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecretToken returns the stored form of a secret token.
+// Tokens carry 256 bits of entropy, so an unsalted hash is enough.
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// --- Sharing RPCs ---
+
+// GrantTemplateAccess shares a template with a user. Only the owner can share.
+func (s *PromptService) GrantTemplateAccess(ctx context.Context, req *pb.GrantTemplateAccessRequest) (*pb.GrantTemplateAccessResponse, error) {
+	zap.S().Infof("PromptService.GrantTemplateAccess: template_id=%s user_id=%s role=%s", req.TemplateId, req.UserId, req.Role)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share)
+	if err != nil {
+		return nil, err
+	}
+
+	var role string
+	switch req.Role {
+	case pb.ShareRole_SHARE_ROLE_VIEWER:
+		role = "viewer"
+	case pb.ShareRole_SHARE_ROLE_EDITOR:
+		role = "editor"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	if req.UserId == "" || req.UserId == template.OwnerID {
+		return nil, status.Error(codes.InvalidArgument, "user_id must be another user")
+	}
+
+	grant := &models.TemplateGrant{
+		TemplateID: template.ID,
+		UserID:     req.UserId,
+		Role:       role,
+		GrantedBy:  principal(ctx).UserID,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.ShareRepo.UpsertGrant(ctx, grant); err != nil {
+		if errors.Is(err, repository.ErrUnknownUser) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to grant access: %v", err)
+	}
+	s.Audit.Record(ctx, "template.grant_access", template.ID, template.OwnerID, nil, map[string]interface{}{"user_id": grant.UserID, "role": grant.Role})
+	return &pb.GrantTemplateAccessResponse{Grant: grantModelToProto(grant)}, nil
+}
+
+// RevokeTemplateAccess removes a user's grant on a template.
+func (s *PromptService) RevokeTemplateAccess(ctx context.Context, req *pb.RevokeTemplateAccessRequest) (*pb.RevokeTemplateAccessResponse, error) {
+	zap.S().Infof("PromptService.RevokeTemplateAccess: template_id=%s user_id=%s", req.TemplateId, req.UserId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ShareRepo.DeleteGrant(ctx, req.TemplateId, req.UserId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access: %v", err)
+	}
+	s.Audit.Record(ctx, "template.revoke_access", template.ID, template.OwnerID, map[string]interface{}{"user_id": req.UserId}, nil)
+	return &pb.RevokeTemplateAccessResponse{Success: true}, nil
+}
+
+// ListTemplateGrants lists the users a template is shared with.
+func (s *PromptService) ListTemplateGrants(ctx context.Context, req *pb.ListTemplateGrantsRequest) (*pb.ListTemplateGrantsResponse, error) {
+	zap.S().Infof("PromptService.ListTemplateGrants: template_id=%s", req.TemplateId)
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share); err != nil {
+		return nil, err
+	}
+	grants, err := s.ShareRepo.ListGrants(ctx, req.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grants: %v", err)
+	}
+
+	var pbGrants []*pb.TemplateGrant
+	for _, g := range grants {
+		pbGrants = append(pbGrants, grantModelToProto(g))
+	}
+	return &pb.ListTemplateGrantsResponse{Grants: pbGrants}, nil
+}
+
+// CreateShareLink creates a secret link giving read access to a template.
+// The token is returned once; only its hash is stored.
+func (s *PromptService) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.CreateShareLinkResponse, error) {
+	zap.S().Infof("PromptService.CreateShareLink: template_id=%s", req.TemplateId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share)
+	if err != nil {
+		return nil, err
+	}
+
+	link := &models.ShareLink{
+		TemplateID: template.ID,
+		CreatedBy:  principal(ctx).UserID,
+		CreatedAt:  time.Now(),
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if !expiresAt.After(link.CreatedAt) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		link.ExpiresAt = sql.NullTime{Time: expiresAt, Valid: true}
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	link.TokenHash = hashSecretToken(token)
+
+	if err := s.ShareRepo.CreateLink(ctx, link); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create share link: %v", err)
+	}
+	after := map[string]interface{}{"link_id": link.ID}
+	if link.ExpiresAt.Valid {
+		after["expires_at"] = link.ExpiresAt.Time
+	}
+	s.Audit.Record(ctx, "template.create_share_link", template.ID, template.OwnerID, nil, after)
+
+	pbLink := shareLinkModelToProto(link)
+	pbLink.Token = token
+	return &pb.CreateShareLinkResponse{Link: pbLink}, nil
+}
+
+// ListShareLinks lists the share links of a template.
+func (s *PromptService) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	zap.S().Infof("PromptService.ListShareLinks: template_id=%s", req.TemplateId)
+	if _, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share); err != nil {
+		return nil, err
+	}
+	links, err := s.ShareRepo.ListLinks(ctx, req.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list share links: %v", err)
+	}
+
+	var pbLinks []*pb.ShareLink
+	for _, l := range links {
+		pbLinks = append(pbLinks, shareLinkModelToProto(l))
+	}
+	return &pb.ListShareLinksResponse{Links: pbLinks}, nil
+}
+
+// RevokeShareLink revokes a share link of a template.
+func (s *PromptService) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkResponse, error) {
+	zap.S().Infof("PromptService.RevokeShareLink: template_id=%s link_id=%s", req.TemplateId, req.LinkId)
+	template, err := s.getTemplateFor(ctx, req.TemplateId, policy.Share)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ShareRepo.RevokeLink(ctx, req.TemplateId, req.LinkId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	s.Audit.Record(ctx, "template.revoke_share_link", template.ID, template.OwnerID, map[string]interface{}{"link_id": req.LinkId}, nil)
+	return &pb.RevokeShareLinkResponse{Success: true}, nil
+}
+
+// --- Sharing Helpers ---
+
+func grantModelToProto(m *models.TemplateGrant) *pb.TemplateGrant {
+	role := pb.ShareRole_SHARE_ROLE_VIEWER
+	if m.Role == "editor" {
+		role = pb.ShareRole_SHARE_ROLE_EDITOR
+	}
+	return &pb.TemplateGrant{
+		TemplateId: m.TemplateID,
+		UserId:     m.UserID,
+		Role:       role,
+		GrantedBy:  m.GrantedBy,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+}
+
+func shareLinkModelToProto(m *models.ShareLink) *pb.ShareLink {
+	l := &pb.ShareLink{
+		Id:         m.ID,
+		TemplateId: m.TemplateID,
+		Revoked:    m.RevokedAt.Valid,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+	if m.ExpiresAt.Valid {
+		l.ExpiresAt = timestamppb.New(m.ExpiresAt.Time)
+	}
+	return l
+}
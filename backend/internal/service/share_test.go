@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockShareRepository is a mock implementation of repository.ShareRepository
+type MockShareRepository struct {
+	mock.Mock
+}
+
+func (m *MockShareRepository) UpsertGrant(ctx context.Context, g *models.TemplateGrant) error {
+	args := m.Called(ctx, g)
+	return args.Error(0)
+}
+func (m *MockShareRepository) DeleteGrant(ctx context.Context, templateID, userID string) error {
+	args := m.Called(ctx, templateID, userID)
+	return args.Error(0)
+}
+func (m *MockShareRepository) GetGrant(ctx context.Context, templateID, userID string) (*models.TemplateGrant, error) {
+	args := m.Called(ctx, templateID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TemplateGrant), args.Error(1)
+}
+func (m *MockShareRepository) ListGrants(ctx context.Context, templateID string) ([]*models.TemplateGrant, error) {
+	args := m.Called(ctx, templateID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TemplateGrant), args.Error(1)
+}
+func (m *MockShareRepository) CreateLink(ctx context.Context, l *models.ShareLink) error {
+	args := m.Called(ctx, l)
+	return args.Error(0)
+}
+func (m *MockShareRepository) GetLinkByTokenHash(ctx context.Context, tokenHash string) (*models.ShareLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ShareLink), args.Error(1)
+}
+func (m *MockShareRepository) ListLinks(ctx context.Context, templateID string) ([]*models.ShareLink, error) {
+	args := m.Called(ctx, templateID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ShareLink), args.Error(1)
+}
+func (m *MockShareRepository) RevokeLink(ctx context.Context, templateID, linkID string) error {
+	args := m.Called(ctx, templateID, linkID)
+	return args.Error(0)
+}
+
+func newShareTestService() (*PromptService, *MockTemplateRepository, *MockShareRepository) {
+	mockTemplateRepo := new(MockTemplateRepository)
+	mockShareRepo := new(MockShareRepository)
+	svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, ShareRepo: mockShareRepo})
+	return svc, mockTemplateRepo, mockShareRepo
+}
+
+func privateDraft() *models.Template {
+	return &models.Template{ID: "t1", OwnerID: "alice", Title: "Draft", Visibility: "private"}
+}
+
+func TestGetTemplateSharing(t *testing.T) {
+	t.Run("PrivateHiddenFromAnonymous", func(t *testing.T) {
+		svc, mockTemplateRepo, _ := newShareTestService()
+		mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(privateDraft(), nil)
+
+		_, err := svc.GetTemplate(context.Background(), &pb.GetTemplateRequest{Id: "t1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Grantee", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "bob").Return(privateDraft(), nil)
+		mockShareRepo.On("GetGrant", ctx, "t1", "bob").Return(&models.TemplateGrant{TemplateID: "t1", UserID: "bob", Role: "viewer"}, nil)
+
+		resp, err := svc.GetTemplate(ctx, &pb.GetTemplateRequest{Id: "t1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Draft", resp.Template.Title)
+	})
+
+	t.Run("ShareLink", func(t *testing.T) {
+		future := sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}
+		past := sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+		tests := []struct {
+			name   string
+			link   *models.ShareLink
+			wantOK bool
+		}{
+			{"Active", &models.ShareLink{TemplateID: "t1", ExpiresAt: future}, true},
+			{"NoExpiry", &models.ShareLink{TemplateID: "t1"}, true},
+			{"Expired", &models.ShareLink{TemplateID: "t1", ExpiresAt: past}, false},
+			{"Revoked", &models.ShareLink{TemplateID: "t1", RevokedAt: past}, false},
+			{"OtherTemplate", &models.ShareLink{TemplateID: "t2"}, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+				mockTemplateRepo.On("Get", mock.Anything, "t1", "").Return(privateDraft(), nil)
+				mockShareRepo.On("GetLinkByTokenHash", mock.Anything, hashSecretToken("tok")).Return(tt.link, nil)
+
+				_, err := svc.GetTemplate(context.Background(), &pb.GetTemplateRequest{Id: "t1", ShareToken: "tok"})
+				if tt.wantOK {
+					assert.NoError(t, err)
+				} else {
+					assert.Equal(t, codes.NotFound, status.Code(err))
+				}
+			})
+		}
+	})
+}
+
+func TestUpdateTemplateSharing(t *testing.T) {
+	setup := func(role string) (*PromptService, context.Context) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		var grant *models.TemplateGrant
+		if role != "" {
+			grant = &models.TemplateGrant{TemplateID: "t1", UserID: "bob", Role: role}
+		}
+		mockShareRepo.On("GetGrant", ctx, "t1", "bob").Return(grant, nil)
+		return svc, ctx
+	}
+
+	t.Run("Editor", func(t *testing.T) {
+		svc, ctx := setup("editor")
+		resp, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Edited", Content: "new"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Edited", resp.Template.Title)
+	})
+
+	t.Run("EditorCannotChangeVisibility", func(t *testing.T) {
+		svc, ctx := setup("editor")
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Visibility: pb.Visibility_VISIBILITY_PUBLIC})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Viewer", func(t *testing.T) {
+		svc, ctx := setup("viewer")
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Edited"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Stranger", func(t *testing.T) {
+		svc, ctx := setup("")
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Edited"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGrantTemplateAccess(t *testing.T) {
+	t.Run("OwnerGrants", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		mockShareRepo.On("UpsertGrant", ctx, mock.MatchedBy(func(g *models.TemplateGrant) bool {
+			return g.UserID == "bob" && g.Role == "editor" && g.GrantedBy == "alice"
+		})).Return(nil)
+
+		resp, err := svc.GrantTemplateAccess(ctx, &pb.GrantTemplateAccessRequest{TemplateId: "t1", UserId: "bob", Role: pb.ShareRole_SHARE_ROLE_EDITOR})
+		assert.NoError(t, err)
+		assert.Equal(t, pb.ShareRole_SHARE_ROLE_EDITOR, resp.Grant.Role)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		mockShareRepo.On("UpsertGrant", ctx, mock.Anything).Return(repository.ErrUnknownUser)
+
+		_, err := svc.GrantTemplateAccess(ctx, &pb.GrantTemplateAccessRequest{TemplateId: "t1", UserId: "nobody", Role: pb.ShareRole_SHARE_ROLE_VIEWER})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("EditorCannotShare", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "bob")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		mockShareRepo.On("GetGrant", ctx, "t1", "bob").Return(&models.TemplateGrant{Role: "editor"}, nil)
+
+		_, err := svc.GrantTemplateAccess(ctx, &pb.GrantTemplateAccessRequest{TemplateId: "t1", UserId: "carol", Role: pb.ShareRole_SHARE_ROLE_VIEWER})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestCreateShareLink(t *testing.T) {
+	t.Run("StoresOnlyTheHash", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		var stored *models.ShareLink
+		mockShareRepo.On("CreateLink", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*models.ShareLink)
+		}).Return(nil)
+
+		resp, err := svc.CreateShareLink(ctx, &pb.CreateShareLinkRequest{TemplateId: "t1", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Link.Token, 43)
+		assert.Equal(t, hashSecretToken(resp.Link.Token), stored.TokenHash)
+		assert.True(t, stored.ExpiresAt.Valid)
+	})
+
+	t.Run("ExpiryInThePast", func(t *testing.T) {
+		svc, mockTemplateRepo, _ := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+
+		_, err := svc.CreateShareLink(ctx, &pb.CreateShareLinkRequest{TemplateId: "t1", ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("RevokeUnknown", func(t *testing.T) {
+		svc, mockTemplateRepo, mockShareRepo := newShareTestService()
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(privateDraft(), nil)
+		mockShareRepo.On("RevokeLink", ctx, "t1", "l9").Return(errors.New("share link not found"))
+
+		_, err := svc.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{TemplateId: "t1", LinkId: "l9"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListTemplatesSharedWithMe(t *testing.T) {
+	svc, mockTemplateRepo, _ := newShareTestService()
+	ctx := ContextWithUserID(context.Background(), "bob")
+	mockTemplateRepo.On("List", ctx, 11, (*repository.Cursor)(nil), map[string]interface{}{
+		"shared_with":     "bob",
+		"current_user_id": "bob",
+	}).Return([]*models.Template{privateDraft()}, nil)
+
+	resp, err := svc.ListTemplates(ctx, &pb.ListTemplatesRequest{SharedWithMe: true})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Templates, 1)
+
+	_, err = svc.ListTemplates(context.Background(), &pb.ListTemplatesRequest{SharedWithMe: true})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"awsome-prompt/backend/internal/models"
+)
+
+// Reserved variables are the named placeholders under the sys. prefix. The
+// server fills them when rendering, and prompts cannot set them unless the
+// version lists them as overridable.
+const (
+	reservedPrefix = "sys."
+
+	sysDate            = "sys.date"
+	sysTime            = "sys.time"
+	sysDateTime        = "sys.datetime"
+	sysTimeZone        = "sys.timezone"
+	sysLanguage        = "sys.language"
+	sysUserDisplayName = "sys.user.display_name"
+	sysTemplateTitle   = "sys.template.title"
+)
+
+// reservedVariables are the reserved variables the server knows how to fill.
+var reservedVariables = []string{sysDate, sysTime, sysDateTime, sysTimeZone, sysLanguage, sysUserDisplayName, sysTemplateTitle}
+
+// isReserved reports whether name is in the reserved sys. namespace.
+func isReserved(name string) bool {
+	return strings.HasPrefix(name, reservedPrefix)
+}
+
+// renderContext is what the server knows of a request when it renders a
+// version of a template.
+type renderContext struct {
+	// Now is the time of the request in the time zone of the client.
+	Now           time.Time
+	Language      string
+	DisplayName   string
+	TemplateTitle string
+}
+
+// reservedValues returns the values of the reserved variables for rc. Dates
+// and times are those of the time zone of rc.Now.
+func reservedValues(rc renderContext) map[string]string {
+	return map[string]string{
+		sysDate:            rc.Now.Format(time.DateOnly),
+		sysTime:            rc.Now.Format("15:04"),
+		sysDateTime:        rc.Now.Format(time.RFC3339),
+		sysTimeZone:        rc.Now.Location().String(),
+		sysLanguage:        rc.Language,
+		sysUserDisplayName: rc.DisplayName,
+		sysTemplateTitle:   rc.TemplateTitle,
+	}
+}
+
+// overridableVariables validates the reserved variables an author lets
+// prompts set, and returns them sorted and without duplicates.
+func overridableVariables(names []string) ([]string, error) {
+	overridable := []string{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(reservedVariables, name) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown reserved variable: %q", name)
+		}
+		if !slices.Contains(overridable, name) {
+			overridable = append(overridable, name)
+		}
+	}
+	sort.Strings(overridable)
+	return overridable, nil
+}
+
+// checkSettable returns an InvalidArgument error when values set a reserved
+// variable that is not overridable.
+func checkSettable(values map[string]string, overridable []string) error {
+	var reserved []string
+	for name := range values {
+		if isReserved(name) && !slices.Contains(overridable, name) {
+			reserved = append(reserved, name)
+		}
+	}
+	if len(reserved) > 0 {
+		sort.Strings(reserved)
+		return status.Errorf(codes.InvalidArgument, "reserved variables cannot be set: %s", strings.Join(reserved, ", "))
+	}
+	return nil
+}
+
+// settableValues returns values without the reserved variables that are not
+// overridable.
+func settableValues(values map[string]string, overridable []string) map[string]string {
+	settable := make(map[string]string, len(values))
+	for name, v := range values {
+		if !isReserved(name) || slices.Contains(overridable, name) {
+			settable[name] = v
+		}
+	}
+	return settable
+}
+
+// systemValues returns the values of the reserved variables for rendering
+// version of template in the request of ctx. The language defaults to the one
+// of the template. The user is only looked up when the version shows their name.
+func (s *PromptService) systemValues(ctx context.Context, template *models.Template, version *models.TemplateVersion) map[string]string {
+	rc := renderContext{
+		Now:           time.Now().In(requestLocation(ctx)),
+		Language:      requestLanguage(ctx),
+		TemplateTitle: template.Title,
+	}
+	if rc.Language == "" {
+		rc.Language = template.Language
+	}
+	if s.UserRepo != nil && slices.Contains(placeholderNames(version.Content), sysUserDisplayName) {
+		if userID, err := GetUserIDFromContext(ctx); err == nil {
+			user, err := s.UserRepo.GetByID(ctx, userID)
+			if err != nil {
+				zap.S().Warnf("PromptService.systemValues: failed to get user %s: %v", userID, err)
+			} else {
+				rc.DisplayName = user.DisplayName
+			}
+		}
+	}
+	return reservedValues(rc)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "awsome-prompt/backend/api/proto/v1"
+	"awsome-prompt/backend/internal/models"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestReservedValues(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+	// 23:30 UTC on Dec 31 is already New Year's Day in Tokyo.
+	now := time.Date(2025, 12, 31, 23, 30, 0, 0, time.UTC).In(tokyo)
+
+	values := reservedValues(renderContext{Now: now, Language: "ja", DisplayName: "Alice", TemplateTitle: "Pitch"})
+	assert.Equal(t, "2026-01-01", values[sysDate])
+	assert.Equal(t, "08:30", values[sysTime])
+	assert.Equal(t, "2026-01-01T08:30:00+09:00", values[sysDateTime])
+	assert.Equal(t, "Asia/Tokyo", values[sysTimeZone])
+	assert.Equal(t, "ja", values[sysLanguage])
+	assert.Equal(t, "Alice", values[sysUserDisplayName])
+	assert.Equal(t, "Pitch", values[sysTemplateTitle])
+}
+
+func TestRequestLocale(t *testing.T) {
+	ctx := ContextWithLanguage(context.Background(), "fr-CH, fr;q=0.9, en;q=0.8")
+	ctx = ContextWithTimeZone(ctx, "Europe/Zurich")
+	assert.Equal(t, "fr-CH", requestLanguage(ctx))
+	assert.Equal(t, "Europe/Zurich", requestLocation(ctx).String())
+
+	md := metadata.Pairs("accept-language", "de", "x-timezone", "Not/AZone")
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	assert.Equal(t, "de", requestLanguage(ctx))
+	assert.Equal(t, time.UTC, requestLocation(ctx))
+
+	assert.Empty(t, requestLanguage(context.Background()))
+	assert.Equal(t, time.UTC, requestLocation(context.Background()))
+}
+
+func TestOverridableVariables(t *testing.T) {
+	names, err := overridableVariables([]string{"sys.language", " sys.date ", "sys.language"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sys.date", "sys.language"}, names)
+
+	_, err = overridableVariables([]string{"product"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = overridableVariables([]string{"sys.unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRenderPromptReservedVariables(t *testing.T) {
+	mockPresetRepo := new(MockPresetRepository)
+	mockUserRepo := new(MockUserRepository)
+	svc := newTestService(PromptServiceDeps{OrgRepo: new(MockOrganizationRepository), PresetRepo: mockPresetRepo, UserRepo: mockUserRepo})
+	mockTemplateRepo := svc.TemplateRepo.(*MockTemplateRepository)
+	mockVersionRepo := svc.TemplateVersionRepo.(*MockTemplateVersionRepository)
+	ctx := ContextWithUserID(context.Background(), "alice")
+	ctx = ContextWithLanguage(ctx, "fr")
+	ctx = ContextWithTimeZone(ctx, "Pacific/Kiritimati")
+
+	content := "{{sys.template.title}} by {{sys.user.display_name}} on {{sys.date}} in {{sys.language}}"
+	mockTemplateRepo.On("Get", ctx, "tpl_1", "").Return(&models.Template{ID: "tpl_1", OwnerID: "bob", Title: "Memo", Language: "en", Visibility: "public"}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(1)).Return(&models.TemplateVersion{ID: 1, TemplateID: "tpl_1", Version: 1, Content: content}, nil)
+	mockVersionRepo.On("Get", ctx, "tpl_1", int32(2)).Return(&models.TemplateVersion{ID: 2, TemplateID: "tpl_1", Version: 2, Content: content,
+		OverridableVariables: pq.StringArray{"sys.language"}}, nil)
+	mockUserRepo.On("GetByID", ctx, "alice").Return(&models.User{ID: "alice", DisplayName: "Alice"}, nil)
+
+	resp, err := svc.RenderPrompt(ctx, &pb.RenderPromptRequest{TemplateId: "tpl_1", VersionId: 1})
+	assert.NoError(t, err)
+	today := time.Now().In(requestLocation(ctx)).Format(time.DateOnly)
+	assert.Equal(t, "Memo by Alice on "+today+" in fr", resp.RenderedText)
+	assert.Equal(t, []string{"sys.template.title", "sys.user.display_name", "sys.date", "sys.language"}, resp.Report.FilledBySystem)
+
+	// Reserved variables cannot be set, unless the version lets them be.
+	_, err = svc.RenderPrompt(ctx, &pb.RenderPromptRequest{TemplateId: "tpl_1", VersionId: 1, NamedVariables: map[string]string{"sys.language": "de"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err = svc.RenderPrompt(ctx, &pb.RenderPromptRequest{TemplateId: "tpl_1", VersionId: 2, NamedVariables: map[string]string{"sys.language": "de"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Memo by Alice on "+today+" in de", resp.RenderedText)
+	assert.NotContains(t, resp.Report.FilledBySystem, "sys.language")
+
+	// Presets do not set reserved variables that are not overridable.
+	mockPresetRepo.On("Get", ctx, "v1").Return(&models.VariablePreset{ID: "v1", OwnerID: "alice", Values: []byte(`{"sys.date":"someday"}`)}, nil)
+	resp, err = svc.RenderPrompt(ctx, &pb.RenderPromptRequest{TemplateId: "tpl_1", VersionId: 1, PresetId: "v1"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Report.FilledFromPreset)
+	assert.NotContains(t, resp.RenderedText, "someday")
+	mockUserRepo.AssertNumberOfCalls(t, "GetByID", 3)
+}
+
+// overridableVersionRepository reports a latest version with an overridable
+// variable and keeps the versions created.
+type overridableVersionRepository struct {
+	MockTemplateVersionRepository
+	created []*models.TemplateVersion
+}
+
+func (r *overridableVersionRepository) GetLatest(ctx context.Context, templateID string) (*models.TemplateVersion, error) {
+	return &models.TemplateVersion{ID: 2, TemplateID: templateID, Version: 2, Content: "Hi {{sys.language}}",
+		OverridableVariables: pq.StringArray{"sys.language"}}, nil
+}
+
+func (r *overridableVersionRepository) Create(ctx context.Context, v *models.TemplateVersion) error {
+	r.created = append(r.created, v)
+	return nil
+}
+
+func TestUpdateTemplateOverridableVariables(t *testing.T) {
+	setup := func() (*PromptService, *overridableVersionRepository, context.Context) {
+		mockTemplateRepo := new(MockTemplateRepository)
+		versionRepo := &overridableVersionRepository{}
+		svc := newTestService(PromptServiceDeps{TemplateRepo: mockTemplateRepo, TemplateVersionRepo: versionRepo})
+		ctx := ContextWithUserID(context.Background(), "alice")
+		mockTemplateRepo.On("Get", ctx, "t1", "").Return(&models.Template{ID: "t1", OwnerID: "alice", Visibility: "private"}, nil)
+		return svc, versionRepo, ctx
+	}
+
+	t.Run("Omitted", func(t *testing.T) {
+		svc, versionRepo, ctx := setup()
+		resp, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Title: "Memo", Content: "Hi {{sys.language}}"})
+		assert.NoError(t, err)
+		assert.Empty(t, versionRepo.created)
+		assert.Equal(t, []string{"sys.language"}, resp.NewVersion.OverridableVariables)
+
+		// A new content keeps the overridable variables.
+		resp, err = svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Content: "Hello {{sys.language}}"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), resp.NewVersion.Version)
+		assert.Equal(t, []string{"sys.language"}, resp.NewVersion.OverridableVariables)
+	})
+
+	t.Run("Cleared", func(t *testing.T) {
+		svc, versionRepo, ctx := setup()
+		resp, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", Content: "Hi {{sys.language}}", OverridableVariables: &pb.VariableNames{}})
+		assert.NoError(t, err)
+		assert.Len(t, versionRepo.created, 1)
+		assert.Empty(t, resp.NewVersion.OverridableVariables)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		svc, _, ctx := setup()
+		_, err := svc.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{TemplateId: "t1", OverridableVariables: &pb.VariableNames{Names: []string{"sys.unknown"}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
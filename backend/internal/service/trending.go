@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"awsome-prompt/backend/internal/repository"
+)
+
+// TrendingWorker periodically recomputes the trending score of templates.
+type TrendingWorker struct {
+	Repo     repository.TemplateRepository
+	Interval time.Duration
+	// HalfLife is the age at which an event counts for half its weight.
+	HalfLife time.Duration
+	// Window bounds how far back events are considered.
+	Window time.Duration
+}
+
+// NewTrendingWorker creates a new TrendingWorker.
+// Events older than ten half-lives contribute less than 0.1% of their weight,
+// so they are ignored altogether.
+func NewTrendingWorker(repo repository.TemplateRepository, interval, halfLife time.Duration) *TrendingWorker {
+	return &TrendingWorker{
+		Repo:     repo,
+		Interval: interval,
+		HalfLife: halfLife,
+		Window:   10 * halfLife,
+	}
+}
+
+// Run refreshes the scores immediately and then on every interval until ctx is cancelled.
+func (w *TrendingWorker) Run(ctx context.Context) {
+	zap.S().Infof("TrendingWorker: interval=%s half_life=%s", w.Interval, w.HalfLife)
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil {
+			zap.S().Errorf("TrendingWorker: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single recomputation of the trending scores, unless
+// another instance is already recomputing them.
+func (w *TrendingWorker) RunOnce(ctx context.Context) error {
+	start := time.Now()
+	n, err := w.Repo.RefreshTrendingScores(ctx, w.HalfLife, w.Window)
+	if errors.Is(err, repository.ErrTrendingRefreshInProgress) {
+		zap.S().Infof("TrendingWorker: skipped, another instance is refreshing the scores")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	zap.S().Infof("TrendingWorker: updated %d templates in %s", n, time.Since(start))
+	return nil
+}
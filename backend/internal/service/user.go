@@ -9,11 +9,13 @@ import (
 	"math/big"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"go.uber.org/zap"
 
 	pb "awsome-prompt/backend/api/proto/v1"
 	"awsome-prompt/backend/internal/models"
+	"awsome-prompt/backend/internal/policy"
 	"awsome-prompt/backend/internal/repository"
 
 	"regexp"
@@ -26,6 +28,9 @@ import (
 
 var idRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
 
+// maxBioLength caps the profile bio, in characters.
+const maxBioLength = 500
+
 type RedisStore interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
@@ -34,18 +39,23 @@ type RedisStore interface {
 
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	Repo      repository.UserRepository
-	Redis     RedisStore
-	EmailSvc  EmailService
-	JWTSecret []byte
+	Repo         repository.UserRepository
+	FollowRepo   repository.FollowRepository
+	TemplateRepo repository.TemplateRepository
+	Redis        RedisStore
+	EmailSvc     EmailService
+	JWTSecret    []byte
+	Audit        *Auditor
 }
 
-func NewUserService(repo repository.UserRepository, redisClient RedisStore, emailSvc EmailService, jwtSecret string) *UserService {
+func NewUserService(repo repository.UserRepository, followRepo repository.FollowRepository, templateRepo repository.TemplateRepository, redisClient RedisStore, emailSvc EmailService, jwtSecret string) *UserService {
 	return &UserService{
-		Repo:      repo,
-		Redis:     redisClient,
-		EmailSvc:  emailSvc,
-		JWTSecret: []byte(jwtSecret),
+		Repo:         repo,
+		FollowRepo:   followRepo,
+		TemplateRepo: templateRepo,
+		Redis:        redisClient,
+		EmailSvc:     emailSvc,
+		JWTSecret:    []byte(jwtSecret),
 	}
 }
 
@@ -145,6 +155,7 @@ func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		Email:        req.Email,
 		PasswordHash: string(hash),
 		DisplayName:  req.DisplayName,
+		Role:         policy.RoleUser,
 	}
 	if req.Mobile != "" {
 		user.Mobile = sql.NullString{String: req.Mobile, Valid: true}
@@ -153,9 +164,10 @@ func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 	if err := s.Repo.Insert(ctx, user); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	s.Audit.Record(asUser(ctx, user), "user.register", user.ID, user.ID, nil, userAuditSummary(user))
 
 	// Generate token
-	token, err := s.generateToken(user.ID)
+	token, err := s.generateToken(user)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
@@ -191,33 +203,44 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 
 	// Check password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		// Failed attempts are recorded against the account; the actor stays anonymous.
+		s.Audit.Record(ctx, "user.login_failed", user.ID, user.ID, nil, nil)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
+	if user.Suspended() {
+		return nil, status.Error(codes.PermissionDenied, "account suspended")
+	}
 
 	// Generate token
-	token, err := s.generateToken(user.ID)
+	token, err := s.generateToken(user)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
+	s.Audit.Record(asUser(ctx, user), "user.login", user.ID, user.ID, nil, nil)
 
 	return &pb.LoginResponse{
 		Id:          user.ID,
 		Token:       token,
 		DisplayName: user.DisplayName,
 		Avatar:      user.Avatar,
+		Role:        userRoleToProto(user.Role),
 	}, nil
 }
 
 func (s *UserService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
 	zap.S().Infof("UserService.UpdateProfile: id=%s", req.Id)
+	if err := authorizeSelf(ctx, policy.Update, req.Id); err != nil {
+		return nil, err
+	}
 
-	user, err := s.Repo.GetByID(ctx, req.Id)
+	user, err := s.Repo.GetByID(ctx, principal(ctx).UserID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
+	before := userAuditSummary(user)
 
 	if req.DisplayName != "" {
 		user.DisplayName = req.DisplayName
@@ -225,6 +248,12 @@ func (s *UserService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRe
 	if req.Avatar != "" {
 		user.Avatar = req.Avatar
 	}
+	if bio := strings.TrimSpace(req.Bio); bio != "" {
+		if utf8.RuneCountInString(bio) > maxBioLength {
+			return nil, status.Errorf(codes.InvalidArgument, "bio must be at most %d characters", maxBioLength)
+		}
+		user.Bio = bio
+	}
 	if strings.TrimSpace(req.Password) != "" {
 		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 		if err != nil {
@@ -236,18 +265,25 @@ func (s *UserService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRe
 	if err := s.Repo.Update(ctx, user); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	after := userAuditSummary(user)
+	after["password_changed"] = strings.TrimSpace(req.Password) != ""
+	s.Audit.Record(ctx, "user.update_profile", user.ID, user.ID, before, after)
 
 	return &pb.UpdateProfileResponse{
 		Id:          user.ID,
 		DisplayName: user.DisplayName,
 		Avatar:      user.Avatar,
+		Bio:         user.Bio,
 	}, nil
 }
 
 func (s *UserService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
 	zap.S().Infof("UserService.GetProfile: id=%s", req.Id)
+	if err := authorizeSelf(ctx, policy.Read, req.Id); err != nil {
+		return nil, err
+	}
 
-	user, err := s.Repo.GetByID(ctx, req.Id)
+	user, err := s.Repo.GetByID(ctx, principal(ctx).UserID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
@@ -256,20 +292,34 @@ func (s *UserService) GetProfile(ctx context.Context, req *pb.GetProfileRequest)
 	}
 
 	return &pb.GetProfileResponse{
-		Id:          user.ID,
-		Email:       user.Email,
-		DisplayName: user.DisplayName,
-		Avatar:      user.Avatar,
+		Id:             user.ID,
+		Email:          user.Email,
+		DisplayName:    user.DisplayName,
+		Avatar:         user.Avatar,
+		Role:           userRoleToProto(user.Role),
+		FollowerCount:  user.FollowerCount,
+		FollowingCount: user.FollowingCount,
+		Bio:            user.Bio,
 	}, nil
 }
 
-func (s *UserService) generateToken(userID string) (string, error) {
+// generateToken issues a token for the user. The role in the claims is
+// informative: the interceptor authorizes requests with the current role.
+func (s *UserService) generateToken(user *models.User) (string, error) {
 	claims := jwt.MapClaims{
-		"sub": userID,
-		"exp": time.Now().Add(24 * time.Hour).Unix(),
-		"iss": "awsome-prompt",
+		"sub":  user.ID,
+		"role": user.Role,
+		"exp":  time.Now().Add(24 * time.Hour).Unix(),
+		"iss":  "awsome-prompt",
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(s.JWTSecret)
 }
+
+func userRoleToProto(role string) pb.UserRole {
+	if role == policy.RoleAdmin {
+		return pb.UserRole_USER_ROLE_ADMIN
+	}
+	return pb.UserRole_USER_ROLE_USER
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 "context"
+"database/sql"
 "testing"
 "time"
 
@@ -12,6 +13,8 @@ pb "awsome-prompt/backend/api/proto/v1"
 "github.com/stretchr/testify/assert"
 "github.com/stretchr/testify/mock"
 "golang.org/x/crypto/bcrypt"
+"google.golang.org/grpc/codes"
+"google.golang.org/grpc/status"
 )
 
 // MockUserRepository is a mock implementation of repository.UserRepository
@@ -45,6 +48,30 @@ args := m.Called(ctx, user)
 return args.Error(0)
 }
 
+func (m *MockUserRepository) List(ctx context.Context, limit int, after *repository.Cursor, filters map[string]interface{}) ([]*models.User, error) {
+args := m.Called(ctx, limit, after, filters)
+if args.Get(0) == nil {
+return nil, args.Error(1)
+}
+return args.Get(0).([]*models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) SetSuspended(ctx context.Context, id string, suspended bool) (*models.User, error) {
+args := m.Called(ctx, id, suspended)
+if args.Get(0) == nil {
+return nil, args.Error(1)
+}
+return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetAuthorStats(ctx context.Context, id string) (*models.AuthorStats, error) {
+args := m.Called(ctx, id)
+if args.Get(0) == nil {
+return nil, args.Error(1)
+}
+return args.Get(0).(*models.AuthorStats), args.Error(1)
+}
+
 // MockRedisStore is a mock implementation of RedisStore
 type MockRedisStore struct {
 mock.Mock
@@ -75,12 +102,22 @@ args := m.Called(to, code, lang)
 return args.Error(0)
 }
 
+func (m *MockEmailService) SendOrganizationInvitation(to, orgName, inviterName, token, lang string) error {
+args := m.Called(to, orgName, inviterName, token, lang)
+return args.Error(0)
+}
+
+func (m *MockEmailService) SendModerationWarning(to, templateTitle, note, lang string) error {
+args := m.Called(to, templateTitle, note, lang)
+return args.Error(0)
+}
+
 func TestRegister(t *testing.T) {
 t.Run("Success", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.RegisterRequest{
 Id:               "user_123",
 Email:            "test@example.com",
@@ -112,7 +149,7 @@ t.Run("DuplicateID", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.RegisterRequest{
 Id:               "user_123",
 Email:            "test@example.com",
@@ -136,7 +173,7 @@ t.Run("InvalidID", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.RegisterRequest{
 Id:               "user-123", // Invalid character '-'
 Email:            "test@example.com",
@@ -158,7 +195,7 @@ t.Run("InvalidVerificationCode", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.RegisterRequest{
 Id:               "user_123",
 Email:            "test@example.com",
@@ -191,7 +228,7 @@ t.Run("SuccessByEmail", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.LoginRequest{
 Email:    "test@example.com",
 Password: password,
@@ -207,11 +244,22 @@ assert.Equal(t, user.ID, resp.Id)
 assert.NotEmpty(t, resp.Token)
 })
 
+t.Run("Suspended", func(t *testing.T) {
+mockRepo := new(MockUserRepository)
+svc := NewUserService(mockRepo, nil, nil, new(MockRedisStore), new(MockEmailService), "secret")
+suspended := *user
+suspended.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+mockRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(&suspended, nil)
+
+_, err := svc.Login(context.Background(), &pb.LoginRequest{Email: "test@example.com", Password: password})
+assert.Equal(t, codes.PermissionDenied, status.Code(err))
+})
+
 t.Run("SuccessByID", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.LoginRequest{
 Email:    "user_123", // Using ID in Email field as identifier
 Password: password,
@@ -231,7 +279,7 @@ t.Run("InvalidPassword", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.LoginRequest{
 Email:    "test@example.com",
 Password: "wrongpassword",
@@ -250,7 +298,7 @@ t.Run("UserNotFound", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 req := &pb.LoginRequest{
 Email:    "unknown@example.com",
 Password: "password",
@@ -272,7 +320,7 @@ t.Run("Success", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 
 userID := "user_123"
 existingUser := &models.User{
@@ -292,13 +340,25 @@ mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(u *models.User) bool {
 return u.ID == userID && u.DisplayName == "New Name" && u.Avatar == "avatar_data"
 })).Return(nil)
 
-resp, err := svc.UpdateProfile(context.Background(), req)
+resp, err := svc.UpdateProfile(ContextWithUserID(context.Background(), userID), req)
 
 assert.NoError(t, err)
 assert.Equal(t, "New Name", resp.DisplayName)
 assert.Equal(t, "avatar_data", resp.Avatar)
 mockRepo.AssertExpectations(t)
 })
+
+t.Run("OtherUser", func(t *testing.T) {
+mockRepo := new(MockUserRepository)
+svc := NewUserService(mockRepo, nil, nil, new(MockRedisStore), new(MockEmailService), "secret")
+
+req := &pb.UpdateProfileRequest{Id: "user_123", DisplayName: "Hijacked"}
+resp, err := svc.UpdateProfile(ContextWithUserID(context.Background(), "user_456"), req)
+
+assert.Nil(t, resp)
+assert.Equal(t, codes.PermissionDenied, status.Code(err))
+mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+})
 }
 
 // Added test for SendVerificationCode
@@ -307,7 +367,7 @@ t.Run("Success_En", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 
 req := &pb.SendVerificationCodeRequest{
 Email:    "real_user@domain.com",
@@ -331,7 +391,7 @@ t.Run("Success_TestUser", func(t *testing.T) {
 mockRepo := new(MockUserRepository)
 mockRedis := new(MockRedisStore)
 mockEmail := new(MockEmailService)
-svc := NewUserService(mockRepo, mockRedis, mockEmail, "secret")
+svc := NewUserService(mockRepo, nil, nil, mockRedis, mockEmail, "secret")
 
 req := &pb.SendVerificationCodeRequest{
 Email:    "test@example.com",
@@ -11,6 +11,8 @@ CREATE TABLE IF NOT EXISTS users (
     mobile TEXT UNIQUE,
     password_hash TEXT NOT NULL, -- For local auth
     display_name TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin')),
+    suspended_at TIMESTAMPTZ,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
@@ -28,8 +30,30 @@ BEGIN
         ALTER TABLE users ADD COLUMN avatar TEXT;
         COMMENT ON COLUMN users.avatar IS 'User avatar URL or base64 string';
     END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='users' AND column_name='role') THEN
+        ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin'));
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='users' AND column_name='suspended_at') THEN
+        ALTER TABLE users ADD COLUMN suspended_at TIMESTAMPTZ;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='users' AND column_name='follower_count') THEN
+        ALTER TABLE users ADD COLUMN follower_count INT NOT NULL DEFAULT 0;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='users' AND column_name='following_count') THEN
+        ALTER TABLE users ADD COLUMN following_count INT NOT NULL DEFAULT 0;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='users' AND column_name='bio') THEN
+        ALTER TABLE users ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+    END IF;
 END $$;
 
+-- Administrators are promoted by hand, e.g. UPDATE users SET role = 'admin' WHERE id = '...';
+COMMENT ON COLUMN users.role IS 'Account role: user or admin';
+COMMENT ON COLUMN users.suspended_at IS 'When the account was suspended; suspended users cannot sign in';
+COMMENT ON COLUMN users.follower_count IS 'Number of users following this user';
+COMMENT ON COLUMN users.following_count IS 'Number of users this user follows';
+COMMENT ON COLUMN users.bio IS 'Short public description shown on the profile page';
+
 -- -----------------------------------------------------------------------------
 -- Table: user_identities
 -- Description: Stores OAuth identities for users (SSO).
@@ -57,13 +81,21 @@ CREATE TABLE IF NOT EXISTS templates (
     owner_id TEXT NOT NULL,
     title TEXT NOT NULL,
     description TEXT,
-    visibility TEXT NOT NULL CHECK (visibility IN ('public', 'private')),
+    visibility TEXT NOT NULL CHECK (visibility IN ('public', 'private', 'org')),
     type TEXT NOT NULL CHECK (type IN ('system', 'user')),
     tags TEXT[], -- Array of strings for tags
     category TEXT,
     language TEXT NOT NULL DEFAULT 'en',
     like_count INT NOT NULL DEFAULT 0,
     favorite_count INT NOT NULL DEFAULT 0,
+    trending_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    forked_from UUID REFERENCES templates(id) ON DELETE SET NULL,
+    featured_at TIMESTAMPTZ,
+    moderation_state TEXT NOT NULL DEFAULT 'visible' CHECK (moderation_state IN ('visible', 'hidden', 'removed')),
+    comment_count INT NOT NULL DEFAULT 0,
+    rating_count INT NOT NULL DEFAULT 0,
+    rating_average DOUBLE PRECISION NOT NULL DEFAULT 0,
+    rating_score DOUBLE PRECISION NOT NULL DEFAULT 0,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
@@ -73,12 +105,14 @@ COMMENT ON TABLE templates IS 'Stores metadata for prompt templates';
 COMMENT ON COLUMN templates.id IS 'Unique identifier for the template';
 COMMENT ON COLUMN templates.owner_id IS 'ID of the user who owns the template';
 COMMENT ON COLUMN templates.title IS 'Title of the template';
-COMMENT ON COLUMN templates.visibility IS 'Visibility status: public or private';
+COMMENT ON COLUMN templates.visibility IS 'Visibility status: public, private, or org (members of the owning organization)';
 COMMENT ON COLUMN templates.type IS 'Type of template: system or user';
 COMMENT ON COLUMN templates.tags IS 'List of tags associated with the template';
 COMMENT ON COLUMN templates.language IS 'Language of the template';
 COMMENT ON COLUMN templates.like_count IS 'Number of likes';
 COMMENT ON COLUMN templates.favorite_count IS 'Number of favorites';
+COMMENT ON COLUMN templates.trending_score IS 'Time-decayed popularity score, recomputed by a background job';
+COMMENT ON COLUMN templates.forked_from IS 'Template this one was forked from';
 
 -- Indexes for templates
 CREATE INDEX IF NOT EXISTS idx_templates_owner_id ON templates(owner_id);
@@ -94,8 +128,45 @@ BEGIN
         ALTER TABLE templates ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
         CREATE INDEX IF NOT EXISTS idx_templates_language ON templates(language);
     END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='trending_score') THEN
+        ALTER TABLE templates ADD COLUMN trending_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+        COMMENT ON COLUMN templates.trending_score IS 'Time-decayed popularity score, recomputed by a background job';
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='forked_from') THEN
+        ALTER TABLE templates ADD COLUMN forked_from UUID REFERENCES templates(id) ON DELETE SET NULL;
+        COMMENT ON COLUMN templates.forked_from IS 'Template this one was forked from';
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='featured_at') THEN
+        ALTER TABLE templates ADD COLUMN featured_at TIMESTAMPTZ;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='moderation_state') THEN
+        ALTER TABLE templates ADD COLUMN moderation_state TEXT NOT NULL DEFAULT 'visible' CHECK (moderation_state IN ('visible', 'hidden', 'removed'));
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='comment_count') THEN
+        ALTER TABLE templates ADD COLUMN comment_count INT NOT NULL DEFAULT 0;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='rating_count') THEN
+        ALTER TABLE templates ADD COLUMN rating_count INT NOT NULL DEFAULT 0;
+        ALTER TABLE templates ADD COLUMN rating_average DOUBLE PRECISION NOT NULL DEFAULT 0;
+        ALTER TABLE templates ADD COLUMN rating_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+    END IF;
 END $$;
 
+COMMENT ON COLUMN templates.featured_at IS 'When an administrator featured the template, NULL if not featured';
+COMMENT ON COLUMN templates.comment_count IS 'Number of comments that are not deleted';
+COMMENT ON COLUMN templates.rating_count IS 'Number of reviews';
+COMMENT ON COLUMN templates.rating_average IS 'Average rating of the reviews, 0 without reviews';
+COMMENT ON COLUMN templates.rating_score IS 'Bayesian average rating, pulled towards a prior while there are few reviews';
+CREATE INDEX IF NOT EXISTS idx_templates_rating_score ON templates(rating_score DESC, id DESC);
+COMMENT ON COLUMN templates.moderation_state IS 'visible, hidden (only the owner and administrators see it) or removed (only administrators see it)';
+CREATE INDEX IF NOT EXISTS idx_templates_featured ON templates(featured_at DESC, id DESC) WHERE featured_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_templates_trending ON templates(category, trending_score DESC);
+-- Keyset pagination indexes: (sort key, id)
+CREATE INDEX IF NOT EXISTS idx_templates_created_at_id ON templates(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_templates_trending_score_id ON templates(trending_score DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_templates_forked_from ON templates(forked_from, created_at);
+
 -- -----------------------------------------------------------------------------
 -- Table: template_likes
 -- Description: Stores user likes for templates.
@@ -107,6 +178,8 @@ CREATE TABLE IF NOT EXISTS template_likes (
     PRIMARY KEY (user_id, template_id)
 );
 
+CREATE INDEX IF NOT EXISTS idx_template_likes_created_at ON template_likes(created_at);
+
 -- -----------------------------------------------------------------------------
 -- Table: template_favorites
 -- Description: Stores user favorites for templates.
@@ -118,6 +191,8 @@ CREATE TABLE IF NOT EXISTS template_favorites (
     PRIMARY KEY (user_id, template_id)
 );
 
+CREATE INDEX IF NOT EXISTS idx_template_favorites_created_at ON template_favorites(created_at);
+
 -- -----------------------------------------------------------------------------
 -- Table: template_versions
 -- Description: Stores the actual content and version history of templates.
@@ -127,6 +202,7 @@ CREATE TABLE IF NOT EXISTS template_versions (
     template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
     version INT NOT NULL, -- Logical version number (1, 2, 3...)
     content TEXT NOT NULL, -- The prompt content with $$ placeholders
+    major BOOLEAN NOT NULL DEFAULT false,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     UNIQUE (template_id, version)
 );
@@ -137,6 +213,19 @@ COMMENT ON COLUMN template_versions.template_id IS 'Reference to the parent temp
 COMMENT ON COLUMN template_versions.version IS 'Logical version number of the template';
 COMMENT ON COLUMN template_versions.content IS 'The actual prompt text containing placeholders';
 
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='template_versions' AND column_name='major') THEN
+        ALTER TABLE template_versions ADD COLUMN major BOOLEAN NOT NULL DEFAULT false;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='template_versions' AND column_name='overridable_variables') THEN
+        ALTER TABLE template_versions ADD COLUMN overridable_variables TEXT[] NOT NULL DEFAULT '{}';
+    END IF;
+END $$;
+
+COMMENT ON COLUMN template_versions.major IS 'Whether the version is a major update, making reviews of earlier versions stale';
+COMMENT ON COLUMN template_versions.overridable_variables IS 'Reserved sys.* variables that prompts may set instead of the server';
+
 -- Indexes for template_versions
 CREATE INDEX IF NOT EXISTS idx_template_versions_template_id ON template_versions(template_id);
 
@@ -160,6 +249,477 @@ COMMENT ON COLUMN prompts.version_id IS 'Reference to the specific version of th
 COMMENT ON COLUMN prompts.variables IS 'JSON array of strings replacing the placeholders';
 COMMENT ON COLUMN prompts.owner_id IS 'ID of the user who saved this prompt';
 
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='prompts' AND column_name='title') THEN
+        ALTER TABLE prompts ADD COLUMN title TEXT NOT NULL DEFAULT '';
+        ALTER TABLE prompts ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+        ALTER TABLE prompts ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+        ALTER TABLE prompts ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT false;
+        ALTER TABLE prompts ADD COLUMN rendered_text TEXT NOT NULL DEFAULT '';
+        ALTER TABLE prompts ADD COLUMN last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+        UPDATE prompts SET last_used_at = created_at;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='prompts' AND column_name='named_variables') THEN
+        ALTER TABLE prompts ADD COLUMN named_variables JSONB NOT NULL DEFAULT '{}';
+    END IF;
+END $$;
+
+COMMENT ON COLUMN prompts.title IS 'Name given by the owner, empty if unnamed';
+COMMENT ON COLUMN prompts.notes IS 'Free-form notes of the owner';
+COMMENT ON COLUMN prompts.pinned IS 'Whether the owner pinned the prompt';
+COMMENT ON COLUMN prompts.named_variables IS 'JSON object of the values replacing the named {{placeholders}}, by name';
+COMMENT ON COLUMN prompts.rendered_text IS 'Snapshot of the version content with the variables substituted';
+COMMENT ON COLUMN prompts.last_used_at IS 'When the prompt was last created, copied or re-instantiated';
+
 -- Indexes for prompts
 CREATE INDEX IF NOT EXISTS idx_prompts_owner_id ON prompts(owner_id);
 CREATE INDEX IF NOT EXISTS idx_prompts_template_id ON prompts(template_id);
+CREATE INDEX IF NOT EXISTS idx_prompts_created_at ON prompts(created_at);
+CREATE INDEX IF NOT EXISTS idx_prompts_owner_created_at_id ON prompts(owner_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_prompts_owner_last_used_at_id ON prompts(owner_id, last_used_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: collections
+-- Description: Stores user-owned collections (folders) of templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS collections (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    visibility TEXT NOT NULL CHECK (visibility IN ('public', 'private')),
+    follower_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE collections IS 'Stores user-owned collections of templates';
+COMMENT ON COLUMN collections.owner_id IS 'ID of the user who owns the collection';
+COMMENT ON COLUMN collections.visibility IS 'Visibility status: public collections can be browsed and followed';
+COMMENT ON COLUMN collections.follower_count IS 'Number of followers';
+
+CREATE INDEX IF NOT EXISTS idx_collections_owner_id ON collections(owner_id, updated_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_collections_visibility ON collections(visibility, updated_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: collection_items
+-- Description: Stores the templates of each collection and their order.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS collection_items (
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, template_id)
+);
+
+COMMENT ON COLUMN collection_items.position IS 'Position of the template within the collection, ascending';
+
+CREATE INDEX IF NOT EXISTS idx_collection_items_position ON collection_items(collection_id, position, template_id);
+CREATE INDEX IF NOT EXISTS idx_collection_items_template_id ON collection_items(template_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: collection_follows
+-- Description: Stores the users following public collections.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS collection_follows (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, collection_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_collection_follows_collection_id ON collection_follows(collection_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: template_grants
+-- Description: Stores the users a template is shared with.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_grants (
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor')),
+    granted_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (template_id, user_id)
+);
+
+COMMENT ON TABLE template_grants IS 'Stores per-user access to templates';
+COMMENT ON COLUMN template_grants.role IS 'viewer can read, editor can also update';
+
+CREATE INDEX IF NOT EXISTS idx_template_grants_user_id ON template_grants(user_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: template_share_links
+-- Description: Stores secret links giving read access to templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_share_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    token_hash TEXT UNIQUE NOT NULL,
+    created_by TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE template_share_links IS 'Stores revocable, expiring share links';
+COMMENT ON COLUMN template_share_links.token_hash IS 'SHA-256 hash of the link token; the token itself is never stored';
+COMMENT ON COLUMN template_share_links.expires_at IS 'NULL if the link never expires';
+
+CREATE INDEX IF NOT EXISTS idx_template_share_links_template_id ON template_share_links(template_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: organizations
+-- Description: Stores teams sharing a library of templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE organizations IS 'Stores teams sharing a library of templates';
+
+-- -----------------------------------------------------------------------------
+-- Table: organization_members
+-- Description: Stores the members of organizations and their roles.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS organization_members (
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (org_id, user_id)
+);
+
+COMMENT ON COLUMN organization_members.role IS 'member reads and adds templates, admin also edits them and manages members, owner also changes roles';
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members(user_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: organization_invitations
+-- Description: Stores pending invitations to join organizations.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'member')),
+    token_hash TEXT UNIQUE NOT NULL,
+    invited_by TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN organization_invitations.token_hash IS 'SHA-256 hash of the emailed token; the token itself is never stored';
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_org_id ON organization_invitations(org_id);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name='templates' AND column_name='org_id') THEN
+        ALTER TABLE templates ADD COLUMN org_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+        COMMENT ON COLUMN templates.org_id IS 'Organization owning the template, if any';
+        ALTER TABLE templates DROP CONSTRAINT IF EXISTS templates_visibility_check;
+        ALTER TABLE templates ADD CONSTRAINT templates_visibility_check CHECK (visibility IN ('public', 'private', 'org'));
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_templates_org_id ON templates(org_id, created_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: template_reports
+-- Description: Stores reports of abusive or spammy templates for moderation.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    reporter_id TEXT NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('spam', 'abuse', 'inappropriate', 'copyright', 'other')),
+    details TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'dismissed', 'actioned')),
+    resolved_by TEXT,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (template_id, reporter_id)
+);
+
+COMMENT ON TABLE template_reports IS 'Stores user reports of templates; a user can report a template once';
+COMMENT ON COLUMN template_reports.status IS 'open until an administrator dismisses the report or acts on the template';
+
+CREATE INDEX IF NOT EXISTS idx_template_reports_open ON template_reports(template_id, created_at) WHERE status = 'open';
+
+-- -----------------------------------------------------------------------------
+-- Table: template_comments
+-- Description: Stores threaded comments on templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_comments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES template_comments(id) ON DELETE CASCADE,
+    version INT,
+    author_id TEXT NOT NULL,
+    body TEXT NOT NULL,
+    body_html TEXT NOT NULL,
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE template_comments IS 'Stores threaded comments on templates';
+COMMENT ON COLUMN template_comments.parent_id IS 'Comment this one replies to, NULL for top-level comments';
+COMMENT ON COLUMN template_comments.version IS 'Template version the comment is about, NULL if not anchored to a version';
+COMMENT ON COLUMN template_comments.body IS 'Markdown source, emptied when the comment is deleted';
+COMMENT ON COLUMN template_comments.body_html IS 'Sanitized HTML rendering of the body';
+COMMENT ON COLUMN template_comments.deleted_at IS 'Deleted comments are kept as placeholders while they have replies';
+
+CREATE INDEX IF NOT EXISTS idx_template_comments_thread ON template_comments(template_id, parent_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_template_comments_parent_id ON template_comments(parent_id);
+
+-- -----------------------------------------------------------------------------
+-- Table: template_reviews
+-- Description: Stores star ratings and written reviews of templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    body TEXT NOT NULL DEFAULT '',
+    version INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (template_id, user_id)
+);
+
+COMMENT ON TABLE template_reviews IS 'Stores star ratings and written reviews of templates; a user reviews a template once';
+COMMENT ON COLUMN template_reviews.rating IS 'Star rating from 1 to 5';
+COMMENT ON COLUMN template_reviews.body IS 'Optional written review';
+COMMENT ON COLUMN template_reviews.version IS 'Template version the review was written against';
+
+CREATE INDEX IF NOT EXISTS idx_template_reviews_template ON template_reviews(template_id, updated_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: notifications
+-- Description: Stores the in-app notifications of users about activity on their templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('like', 'favorite', 'fork', 'comment', 'reply', 'review')),
+    actor_id TEXT NOT NULL,
+    actor_ids TEXT[] NOT NULL,
+    event_count INT NOT NULL DEFAULT 1,
+    template_id UUID REFERENCES templates(id) ON DELETE CASCADE,
+    comment_id UUID REFERENCES template_comments(id) ON DELETE CASCADE,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE notifications IS 'Stores in-app notifications; repeated unread events on a template are collapsed into one';
+COMMENT ON COLUMN notifications.user_id IS 'User the notification is for';
+COMMENT ON COLUMN notifications.actor_id IS 'User who caused the latest collapsed event';
+COMMENT ON COLUMN notifications.actor_ids IS 'Distinct users who caused the collapsed events';
+COMMENT ON COLUMN notifications.event_count IS 'Number of events collapsed into the notification';
+COMMENT ON COLUMN notifications.comment_id IS 'Latest comment, for comment and reply notifications';
+COMMENT ON COLUMN notifications.updated_at IS 'Time of the latest collapsed event';
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user_id, updated_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications(user_id, type, template_id) WHERE read_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- Table: user_follows
+-- Description: Stores which users follow which authors.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS user_follows (
+    follower_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+COMMENT ON TABLE user_follows IS 'Stores follow relationships between users; feeds are assembled from it on read';
+COMMENT ON COLUMN user_follows.follower_id IS 'User who follows';
+COMMENT ON COLUMN user_follows.followee_id IS 'User being followed';
+
+CREATE INDEX IF NOT EXISTS idx_user_follows_followee_id ON user_follows(followee_id);
+-- The feed reads the latest templates and versions of each followed author.
+CREATE INDEX IF NOT EXISTS idx_templates_owner_created_at ON templates(owner_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_template_versions_template_created_at ON template_versions(template_id, created_at DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: template_usage_daily
+-- Description: Stores daily usage counters of templates, per version and event.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS template_usage_daily (
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    version INT NOT NULL DEFAULT 0,
+    day DATE NOT NULL,
+    event TEXT NOT NULL CHECK (event IN ('view', 'render', 'copy', 'instantiate')),
+    count BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (template_id, day, version, event)
+);
+
+COMMENT ON TABLE template_usage_daily IS 'Stores how often templates are viewed, rendered, copied and instantiated, bucketed by UTC day';
+COMMENT ON COLUMN template_usage_daily.version IS 'Version number the events were about; 0 when unknown';
+COMMENT ON COLUMN template_usage_daily.event IS 'view (deduplicated per viewer and day), render, copy or instantiate (prompt created)';
+COMMENT ON COLUMN template_usage_daily.count IS 'Number of events in the bucket';
+
+-- -----------------------------------------------------------------------------
+-- Table: audit_events
+-- Description: Stores the append-only audit log of mutating operations.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id TEXT NOT NULL DEFAULT '',
+    actor_role TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    owner_id TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    client_ip TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE audit_events IS 'Stores who did what to which resource; rows are never updated and only deleted once past the retention period';
+COMMENT ON COLUMN audit_events.actor_id IS 'User who performed the operation, empty when anonymous; not a foreign key so that events outlive users';
+COMMENT ON COLUMN audit_events.action IS 'Operation performed, as <resource_type>.<verb>, e.g. template.update';
+COMMENT ON COLUMN audit_events.owner_id IS 'User owning the resource acted on, who can read the event';
+COMMENT ON COLUMN audit_events.before IS 'Summary of the resource before the operation, NULL on creation';
+COMMENT ON COLUMN audit_events.after IS 'Summary of the resource after the operation, NULL on deletion';
+COMMENT ON COLUMN audit_events.request_id IS 'ID of the request the operation was performed in, to correlate with logs';
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_owner_id ON audit_events(owner_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events(actor_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_resource ON audit_events(resource_type, resource_id, created_at DESC, id DESC);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_events_no_update ON audit_events;
+CREATE TRIGGER audit_events_no_update BEFORE UPDATE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+-- -----------------------------------------------------------------------------
+-- Table: variable_presets
+-- Description: Stores named sets of placeholder values reused across templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS variable_presets (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    "values" JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE variable_presets IS 'Stores named sets of placeholder values of a user or an organization';
+COMMENT ON COLUMN variable_presets.owner_id IS 'User who created the preset';
+COMMENT ON COLUMN variable_presets.org_id IS 'Organization sharing the preset with its members, NULL for personal presets';
+COMMENT ON COLUMN variable_presets."values" IS 'JSON object of placeholder values, by placeholder name';
+
+CREATE INDEX IF NOT EXISTS idx_variable_presets_owner_id ON variable_presets(owner_id, name) WHERE org_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_variable_presets_org_id ON variable_presets(org_id, name);
+
+-- -----------------------------------------------------------------------------
+-- Table: prompt_executions
+-- Description: Stores the runs of prompts on language models.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS prompt_executions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    prompt_id UUID REFERENCES prompts(id) ON DELETE SET NULL,
+    provider TEXT NOT NULL,
+    model TEXT NOT NULL,
+    request JSONB NOT NULL,
+    response_text TEXT NOT NULL DEFAULT '',
+    finish_reason TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL CHECK (status IN ('succeeded', 'failed', 'cancelled')),
+    error TEXT NOT NULL DEFAULT '',
+    latency_ms INT NOT NULL DEFAULT 0,
+    prompt_tokens INT NOT NULL DEFAULT 0,
+    completion_tokens INT NOT NULL DEFAULT 0,
+    total_tokens INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE prompt_executions IS 'Stores the runs of prompts on language models, with their usage';
+COMMENT ON COLUMN prompt_executions.prompt_id IS 'Saved prompt that was run, NULL when the text was given directly';
+COMMENT ON COLUMN prompt_executions.provider IS 'Provider that ran the prompt, such as openai';
+COMMENT ON COLUMN prompt_executions.request IS 'Request sent to the provider: model, messages and parameters';
+COMMENT ON COLUMN prompt_executions.response_text IS 'Text answered by the model, up to the cancellation of cancelled runs';
+COMMENT ON COLUMN prompt_executions.error IS 'Error of the provider when the run failed';
+COMMENT ON COLUMN prompt_executions.latency_ms IS 'Time taken by the provider to answer, in milliseconds';
+
+ALTER TABLE prompt_executions DROP CONSTRAINT IF EXISTS prompt_executions_status_check;
+ALTER TABLE prompt_executions ADD CONSTRAINT prompt_executions_status_check CHECK (status IN ('succeeded', 'failed', 'cancelled'));
+
+CREATE INDEX IF NOT EXISTS idx_prompt_executions_owner_id ON prompt_executions(owner_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_prompt_executions_prompt_id ON prompt_executions(prompt_id, created_at DESC, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- Table: evaluation_cases
+-- Description: Stores the test cases of templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS evaluation_cases (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    variables TEXT[] NOT NULL DEFAULT '{}',
+    named_variables JSONB NOT NULL DEFAULT '{}',
+    assertions JSONB NOT NULL DEFAULT '[]',
+    created_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE evaluation_cases IS 'Stores the test cases run against the versions of a template';
+COMMENT ON COLUMN evaluation_cases.created_by IS 'User who wrote the case, NULL once the account is deleted';
+COMMENT ON COLUMN evaluation_cases.variables IS 'Values of the $$ placeholders, in order';
+COMMENT ON COLUMN evaluation_cases.named_variables IS 'JSON object of the values of the named placeholders, by name';
+COMMENT ON COLUMN evaluation_cases.assertions IS 'JSON array of the checks of the output: contains, regex, json_schema, max_length or judge';
+
+CREATE INDEX IF NOT EXISTS idx_evaluation_cases_template_id ON evaluation_cases(template_id, created_at, id);
+
+-- -----------------------------------------------------------------------------
+-- Table: evaluation_runs
+-- Description: Stores the results of running the test cases of templates.
+-- -----------------------------------------------------------------------------
+CREATE TABLE IF NOT EXISTS evaluation_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES templates(id) ON DELETE CASCADE,
+    version_id INT NOT NULL REFERENCES template_versions(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    created_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    provider TEXT NOT NULL,
+    model TEXT NOT NULL,
+    passed INT NOT NULL DEFAULT 0,
+    failed INT NOT NULL DEFAULT 0,
+    results JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE evaluation_runs IS 'Stores the runs of the test cases of a template on one of its versions';
+COMMENT ON COLUMN evaluation_runs.created_by IS 'User who started the run, NULL once the account is deleted';
+COMMENT ON COLUMN evaluation_runs.version IS 'Version number of the version that was run';
+COMMENT ON COLUMN evaluation_runs.passed IS 'Number of cases whose assertions all passed';
+COMMENT ON COLUMN evaluation_runs.failed IS 'Number of cases with a failed assertion or an error';
+COMMENT ON COLUMN evaluation_runs.results IS 'JSON array of the results of the cases, with the output and the outcome of each assertion';
+
+CREATE INDEX IF NOT EXISTS idx_evaluation_runs_template_id ON evaluation_runs(template_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_evaluation_runs_version_id ON evaluation_runs(version_id, created_at DESC, id DESC);
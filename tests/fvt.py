@@ -21,7 +21,7 @@ def cleanup():
         try:
             token = get_auth_token(p['owner_id'])
             headers = {"Authorization": f"Bearer {token}"}
-            url = f"{PROMPT_URL}/{p['id']}?owner_id={p['owner_id']}"
+            url = f"{PROMPT_URL}/{p['id']}"
             resp = requests.delete(url, headers=headers)
             if resp.status_code in [200, 404]:
                 print(f"Cleaned prompt {p['id']}")
@@ -35,7 +35,7 @@ def cleanup():
         try:
             token = get_auth_token(t['owner_id'])
             headers = {"Authorization": f"Bearer {token}"}
-            url = f"{BASE_URL}/{t['id']}?owner_id={t['owner_id']}"
+            url = f"{BASE_URL}/{t['id']}"
             resp = requests.delete(url, headers=headers)
             if resp.status_code in [200, 404]:
                 print(f"Cleaned template {t['id']}")
@@ -374,7 +374,7 @@ def test_prompt_lifecycle():
     CREATED_PROMPTS.append({"id": prompt_id, "owner_id": user1_id})
 
     # 4. Get Prompt (User 1)
-    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}")
+    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}", headers=headers)
     if resp.status_code != 200:
         print(f"Get Prompt failed: {resp.status_code} - {resp.text}")
         return False
@@ -382,8 +382,27 @@ def test_prompt_lifecycle():
         print("Get Prompt returned wrong ID")
         return False
 
+    # Prompts are private to their owner
+    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}")
+    if resp.status_code != 401:
+        print(f"Anonymous Get Prompt should be 401, got {resp.status_code}")
+        return False
+    other_headers = {"Authorization": f"Bearer {get_auth_token(f'{user1_id}_other')}"}
+    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}", headers=other_headers)
+    if resp.status_code != 404:
+        print(f"Get Prompt by another user should be 404, got {resp.status_code}")
+        return False
+    resp = requests.get(f"http://localhost:8080/api/v1/prompts?owner_id={user1_id}", headers=other_headers)
+    if resp.status_code != 403:
+        print(f"Listing another user's prompts should be 403, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"http://localhost:8080/api/v1/prompts/{prompt_id}?owner_id={user1_id}", headers=other_headers)
+    if resp.status_code != 404:
+        print(f"Delete Prompt by another user should be 404, got {resp.status_code}")
+        return False
+
     # 5. List Prompts (User 1)
-    resp = requests.get(f"http://localhost:8080/api/v1/prompts?owner_id={user1_id}")
+    resp = requests.get("http://localhost:8080/api/v1/prompts", headers=headers)
     if resp.status_code != 200:
         print(f"List Prompts failed: {resp.status_code} - {resp.text}")
         return False
@@ -393,13 +412,13 @@ def test_prompt_lifecycle():
         return False
 
     # 6. Delete Prompt (User 1)
-    resp = requests.delete(f"http://localhost:8080/api/v1/prompts/{prompt_id}?owner_id={user1_id}", headers=headers)
+    resp = requests.delete(f"http://localhost:8080/api/v1/prompts/{prompt_id}", headers=headers)
     if resp.status_code != 200:
         print(f"Delete Prompt failed: {resp.status_code} - {resp.text}")
         return False
 
     # 7. Verify Deletion
-    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}")
+    resp = requests.get(f"http://localhost:8080/api/v1/prompts/{prompt_id}", headers=headers)
     if resp.status_code != 404:
         print(f"Prompt still exists after deletion or wrong status: {resp.status_code}")
         return False
@@ -479,7 +498,7 @@ def test_stats():
             return False
 
     # 2. Query Private (with owner_id)
-    resp = requests.get(f"http://localhost:8080/api/v1/categories?owner_id={owner_id}")
+    resp = requests.get(f"http://localhost:8080/api/v1/categories?owner_id={owner_id}", headers=headers)
     cats = resp.json().get("categories", [])
     found_private = False
     for c in cats:
@@ -544,10 +563,13 @@ def test_pagination():
     if len(data.get("templates", [])) != 2:
         print(f"Page 1 size incorrect: {len(data.get('templates', []))}")
         return False
+    next_token = data.get("next_page_token")
+    if not next_token:
+        print("Page 1 missing next_page_token")
+        return False
 
-    # Request page 2 (size 2, offset 2)
-    # Frontend sends page_token as stringified offset
-    params = {"page_size": 2, "owner_id": owner_id, "page_token": "2"}
+    # Request page 2 (size 2) with the opaque token from page 1
+    params = {"page_size": 2, "owner_id": owner_id, "page_token": next_token, "include_total_count": "true"}
     resp = requests.get(BASE_URL, params=params)
     if resp.status_code != 200:
         print(f"List Page 2 failed: {resp.status_code}")
@@ -556,6 +578,22 @@ def test_pagination():
     if len(data.get("templates", [])) != 1:
         print(f"Page 2 size incorrect: {len(data.get('templates', []))}")
         return False
+    if data.get("next_page_token"):
+        print("Page 2 should be the last page")
+        return False
+    if data.get("total_count") != 3:
+        print(f"Total count incorrect: {data.get('total_count')}")
+        return False
+
+    # Raw offsets and tokens replayed with other filters are rejected
+    resp = requests.get(BASE_URL, params={"page_size": 2, "owner_id": owner_id, "page_token": "2"})
+    if resp.status_code != 400:
+        print(f"Raw offset token should be rejected with 400, got {resp.status_code}")
+        return False
+    resp = requests.get(BASE_URL, params={"page_size": 2, "category": "other", "page_token": next_token})
+    if resp.status_code != 400:
+        print(f"Token with different filters should be rejected with 400, got {resp.status_code}")
+        return False
 
     print("--- Pagination Test Passed ---")
     return True
@@ -639,7 +677,7 @@ def test_version_and_prompt():
 
     # 5. List Prompts (Filter by Template)
     print("5. Listing Prompts for Template...")
-    resp = requests.get(f"{PROMPT_URL}?template_id={template_id}")
+    resp = requests.get(f"{PROMPT_URL}?template_id={template_id}", headers=headers)
     if resp.status_code != 200:
         print(f"List Prompts failed: {resp.status_code} - {resp.text}")
         return False
@@ -662,7 +700,7 @@ def test_version_and_prompt():
 
     # 6. Delete Prompt
     print("6. Deleting Prompt...")
-    resp = requests.delete(f"{PROMPT_URL}/{prompt_id}?owner_id={owner_id}")
+    resp = requests.delete(f"{PROMPT_URL}/{prompt_id}", headers=headers)
     if resp.status_code != 200:
         print(f"Delete Prompt failed: {resp.status_code} - {resp.text}")
         return False
@@ -919,6 +957,1208 @@ def test_profile_update():
     print("PASSED: Profile Update Test")
     return True
 
+def test_collections():
+    print("\n--- Starting Collections Test ---")
+    collections_url = "http://localhost:8080/api/v1/collections"
+
+    owner_id = f"curator_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    follower_id = f"follower_{int(time.time())}"
+    headers_follower = {"Authorization": f"Bearer {get_auth_token(follower_id)}"}
+
+    # 1. Owner creates two public templates and a private one
+    t_ids = []
+    for i, vis in enumerate(["VISIBILITY_PUBLIC", "VISIBILITY_PUBLIC", "VISIBILITY_PRIVATE"]):
+        resp = requests.post(BASE_URL, json={"title": f"Curated {i}", "content": "c", "visibility": vis}, headers=headers_owner)
+        if resp.status_code != 200:
+            print(f"Failed to create template: {resp.text}")
+            return False
+        t_id = resp.json()["template"]["id"]
+        CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+        t_ids.append(t_id)
+
+    # 2. Create a public collection and add the templates
+    resp = requests.post(collections_url, json={"name": "Favorites", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create collection: {resp.text}")
+        return False
+    c_id = resp.json()["collection"]["id"]
+
+    for t_id in t_ids:
+        resp = requests.post(f"{collections_url}/{c_id}/items", json={"template_id": t_id}, headers=headers_owner)
+        if resp.status_code != 200:
+            print(f"Failed to add template to collection: {resp.text}")
+            return False
+    if resp.json()["collection"]["item_count"] != 3:
+        print(f"Expected 3 items, got {resp.json()['collection']['item_count']}")
+        return False
+
+    # 3. Reorder and list in collection order
+    new_order = [t_ids[1], t_ids[2], t_ids[0]]
+    resp = requests.put(f"{collections_url}/{c_id}/order", json={"template_ids": new_order}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to reorder collection: {resp.text}")
+        return False
+    resp = requests.put(f"{collections_url}/{c_id}/order", json={"template_ids": t_ids[:1]}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Partial reorder should be rejected, got {resp.status_code}")
+        return False
+
+    resp = requests.get(f"{BASE_URL}?collection_id={c_id}", headers=headers_owner)
+    ids = [t["id"] for t in resp.json().get("templates", [])]
+    if ids != new_order:
+        print(f"Owner should see the collection in order {new_order}, got {ids}")
+        return False
+
+    # 4. Others only see the public templates of the collection
+    resp = requests.get(f"{BASE_URL}?collection_id={c_id}", headers=headers_follower)
+    ids = [t["id"] for t in resp.json().get("templates", [])]
+    if ids != [t_ids[1], t_ids[0]]:
+        print(f"Follower should only see the public templates, got {ids}")
+        return False
+
+    # 5. Follow and list followed collections
+    resp = requests.post(f"{collections_url}/{c_id}/follow", headers=headers_follower)
+    if resp.status_code != 200 or not resp.json()["is_following"]:
+        print(f"Failed to follow collection: {resp.text}")
+        return False
+    resp = requests.get(f"{collections_url}?followed=true", headers=headers_follower)
+    if c_id not in [c["id"] for c in resp.json().get("collections", [])]:
+        print("Followed collection not listed")
+        return False
+
+    # 6. Only the owner can delete
+    resp = requests.delete(f"{collections_url}/{c_id}", headers=headers_follower)
+    if resp.status_code != 403:
+        print(f"Follower should not delete the collection, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"{collections_url}/{c_id}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to delete collection: {resp.text}")
+        return False
+
+    print("--- Collections Test Passed ---")
+    return True
+
+def test_sharing():
+    print("\n--- Starting Sharing Test ---")
+
+    owner_id = f"sharer_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    editor_id = f"editor_{int(time.time())}"
+    headers_editor = {"Authorization": f"Bearer {get_auth_token(editor_id)}"}
+
+    # 1. Owner creates a private template
+    resp = requests.post(BASE_URL, json={"title": "Secret", "content": "c", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+
+    resp = requests.get(f"{BASE_URL}/{t_id}", headers=headers_editor)
+    if resp.status_code != 404:
+        print(f"Private template should be hidden before sharing, got {resp.status_code}")
+        return False
+
+    # 2. Grant editor access; the template shows up as shared and can be edited
+    resp = requests.post(f"{BASE_URL}/{t_id}/grants", json={"user_id": editor_id, "role": "SHARE_ROLE_EDITOR"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to grant access: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}?shared_with_me=true", headers=headers_editor)
+    if t_id not in [t["id"] for t in resp.json().get("templates", [])]:
+        print("Granted template not listed as shared")
+        return False
+    resp = requests.put(f"{BASE_URL}/{t_id}", json={"title": "Edited", "content": "c2"}, headers=headers_editor)
+    if resp.status_code != 200:
+        print(f"Editor failed to update template: {resp.text}")
+        return False
+    resp = requests.put(f"{BASE_URL}/{t_id}", json={"visibility": "VISIBILITY_PUBLIC"}, headers=headers_editor)
+    if resp.status_code != 403:
+        print(f"Editor should not change visibility, got {resp.status_code}")
+        return False
+
+    # 3. Revoke the grant
+    resp = requests.delete(f"{BASE_URL}/{t_id}/grants/{editor_id}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to revoke access: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}", headers=headers_editor)
+    if resp.status_code != 404:
+        print(f"Revoked grant should hide the template, got {resp.status_code}")
+        return False
+
+    # 4. Share links give anonymous read access until revoked
+    resp = requests.post(f"{BASE_URL}/{t_id}/links", json={}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create share link: {resp.text}")
+        return False
+    link = resp.json()["link"]
+    resp = requests.get(f"{BASE_URL}/{t_id}?share_token={link['token']}")
+    if resp.status_code != 200:
+        print(f"Share link should grant read access, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"{BASE_URL}/{t_id}/links/{link['id']}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to revoke share link: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}?share_token={link['token']}")
+    if resp.status_code != 404:
+        print(f"Revoked share link should not grant access, got {resp.status_code}")
+        return False
+
+    print("--- Sharing Test Passed ---")
+    return True
+
+def test_organizations():
+    print("\n--- Starting Organizations Test ---")
+    orgs_url = "http://localhost:8080/api/v1/organizations"
+
+    owner_id = f"orgowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    outsider_id = f"outsider_{int(time.time())}"
+    headers_outsider = {"Authorization": f"Bearer {get_auth_token(outsider_id)}"}
+
+    # 1. Create an organization and add a template to its library
+    resp = requests.post(orgs_url, json={"name": "Acme"}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json()["organization"]["role"] != "ORG_ROLE_OWNER":
+        print(f"Failed to create organization: {resp.text}")
+        return False
+    org_id = resp.json()["organization"]["id"]
+
+    resp = requests.post(BASE_URL, json={"title": "Team Prompt", "content": "c", "visibility": "VISIBILITY_ORG", "org_id": org_id, "tags": ["team"]}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create org template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+
+    # 2. Members see the library, scoped tags included
+    resp = requests.get(f"{BASE_URL}?org_id={org_id}", headers=headers_owner)
+    if t_id not in [t["id"] for t in resp.json().get("templates", [])]:
+        print("Org template not listed in the library")
+        return False
+    resp = requests.get(f"http://localhost:8080/api/v1/tags?org_id={org_id}", headers=headers_owner)
+    if "team" not in [t["name"] for t in resp.json().get("tags", [])]:
+        print(f"Org tags not listed: {resp.text}")
+        return False
+
+    # 3. Outsiders see neither the library nor the template
+    resp = requests.get(f"{BASE_URL}?org_id={org_id}", headers=headers_outsider)
+    if resp.status_code != 404:
+        print(f"Outsider should not list the library, got {resp.status_code}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}", headers=headers_outsider)
+    if resp.status_code != 404:
+        print(f"Outsider should not see the org template, got {resp.status_code}")
+        return False
+
+    # 4. Invite by email; outsiders cannot invite
+    resp = requests.post(f"{orgs_url}/{org_id}/invitations", json={"email": f"{outsider_id}@example.com"}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json()["invitation"]["role"] != "ORG_ROLE_MEMBER":
+        print(f"Failed to invite member: {resp.text}")
+        return False
+    resp = requests.post(f"{orgs_url}/{org_id}/invitations", json={"email": "x@example.com"}, headers=headers_outsider)
+    if resp.status_code != 404:
+        print(f"Outsider should not invite, got {resp.status_code}")
+        return False
+
+    # 5. The last owner cannot leave
+    resp = requests.delete(f"{orgs_url}/{org_id}/members/{owner_id}", headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Last owner should not leave, got {resp.status_code}")
+        return False
+
+    print("--- Organizations Test Passed ---")
+    return True
+
+def test_admin_role():
+    print("\n--- Starting Admin Role Test ---")
+    admin_url = "http://localhost:8080/api/v1/admin"
+
+    user_id = f"plainuser_{int(time.time())}"
+    headers_user = {"Authorization": f"Bearer {get_auth_token(user_id)}"}
+
+    # 1. New accounts are plain users
+    resp = requests.get("http://localhost:8080/api/v1/profile", headers=headers_user)
+    if resp.status_code != 200 or resp.json().get("role") != "USER_ROLE_USER":
+        print(f"Expected the user role: {resp.text}")
+        return False
+
+    # 2. Only admins create system templates
+    resp = requests.post(BASE_URL, json={"title": "Sys", "content": "c", "type": "TEMPLATE_TYPE_SYSTEM"}, headers=headers_user)
+    if resp.status_code != 403:
+        print(f"User should not create a system template, got {resp.status_code}")
+        return False
+
+    # 3. Administration endpoints are closed to users and anonymous callers
+    resp = requests.get(f"{admin_url}/users", headers=headers_user)
+    if resp.status_code != 403:
+        print(f"User should not list users, got {resp.status_code}")
+        return False
+    resp = requests.post(f"{admin_url}/users/{user_id}/suspend")
+    if resp.status_code != 401:
+        print(f"Anonymous caller should not suspend users, got {resp.status_code}")
+        return False
+
+    # 4. Featured listing is public
+    resp = requests.get(f"{BASE_URL}?featured_only=true")
+    if resp.status_code != 200:
+        print(f"Failed to list featured templates: {resp.text}")
+        return False
+
+    print("--- Admin Role Test Passed ---")
+    return True
+
+def test_moderation():
+    print("\n--- Starting Moderation Test ---")
+    owner_id = f"modowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    reporter_id = f"reporter_{int(time.time())}"
+    headers_reporter = {"Authorization": f"Bearer {get_auth_token(reporter_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Reported Prompt", "content": "c", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+    if resp.json()["template"].get("moderation_state") != "MODERATION_STATE_VISIBLE":
+        print(f"New template should be visible: {resp.text}")
+        return False
+
+    # 1. Report once; a second report and reporting your own template are rejected
+    resp = requests.post(f"{BASE_URL}/{t_id}/report", json={"reason": "REPORT_REASON_SPAM", "details": "ads"}, headers=headers_reporter)
+    if resp.status_code != 200 or resp.json()["report"]["status"] != "REPORT_STATUS_OPEN":
+        print(f"Failed to report template: {resp.text}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{t_id}/report", json={"reason": "REPORT_REASON_SPAM"}, headers=headers_reporter)
+    if resp.status_code != 409:
+        print(f"Duplicate report should conflict, got {resp.status_code}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{t_id}/report", json={"reason": "REPORT_REASON_SPAM"}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Owner should not report their template, got {resp.status_code}")
+        return False
+
+    # 2. The queue and moderation actions are reserved to admins
+    resp = requests.get("http://localhost:8080/api/v1/admin/moderation", headers=headers_reporter)
+    if resp.status_code != 403:
+        print(f"User should not see the moderation queue, got {resp.status_code}")
+        return False
+    resp = requests.post(f"http://localhost:8080/api/v1/admin/templates/{t_id}/moderate", json={"action": "MODERATION_ACTION_REMOVE"}, headers=headers_reporter)
+    if resp.status_code != 403:
+        print(f"User should not moderate templates, got {resp.status_code}")
+        return False
+
+    print("--- Moderation Test Passed ---")
+    return True
+
+def test_comments():
+    print("\n--- Starting Comments Test ---")
+    owner_id = f"cmtowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    author_id = f"commenter_{int(time.time())}"
+    headers_author = {"Authorization": f"Bearer {get_auth_token(author_id)}"}
+    other_id = f"bystander_{int(time.time())}"
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Discussed Prompt", "content": "Hello {{name}}", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+
+    # 1. Comment on version 1; markup is rendered and raw HTML escaped
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "**Great** <script>x</script>", "version": 1}, headers=headers_author)
+    if resp.status_code != 200:
+        print(f"Failed to create comment: {resp.text}")
+        return False
+    comment = resp.json()["comment"]
+    if "<strong>Great</strong>" not in comment["body_html"] or "<script>" in comment["body_html"]:
+        print(f"Unexpected rendering: {comment['body_html']}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "x", "version": 2}, headers=headers_author)
+    if resp.status_code != 400:
+        print(f"Comment on a missing version should fail, got {resp.status_code}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "x"})
+    if resp.status_code != 401:
+        print(f"Anonymous comment should fail, got {resp.status_code}")
+        return False
+
+    # 2. Reply, and list the thread anonymously
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "Thanks!", "parent_id": comment["id"]}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json()["comment"].get("version") != 1:
+        print(f"Failed to reply: {resp.text}")
+        return False
+    reply_id = resp.json()["comment"]["id"]
+    resp = requests.get(f"{BASE_URL}/{t_id}/comments")
+    if resp.status_code != 200 or len(resp.json().get("comments", [])) != 1 or resp.json()["comments"][0].get("reply_count") != 1:
+        print(f"Unexpected top-level comments: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}/comments", params={"parent_id": comment["id"]})
+    if resp.status_code != 200 or [c["id"] for c in resp.json().get("comments", [])] != [reply_id]:
+        print(f"Unexpected replies: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}")
+    if resp.json()["template"].get("comment_count") != 2:
+        print(f"Expected 2 comments on template: {resp.text}")
+        return False
+
+    # 3. Only the author edits; the template owner may delete
+    resp = requests.put(f"http://localhost:8080/api/v1/comments/{comment['id']}", json={"body": "*Edited*"}, headers=headers_owner)
+    if resp.status_code != 403:
+        print(f"Owner should not edit others' comments, got {resp.status_code}")
+        return False
+    resp = requests.put(f"http://localhost:8080/api/v1/comments/{comment['id']}", json={"body": "*Edited*"}, headers=headers_author)
+    if resp.status_code != 200 or not resp.json()["comment"].get("edited_at"):
+        print(f"Failed to edit comment: {resp.text}")
+        return False
+    resp = requests.delete(f"http://localhost:8080/api/v1/comments/{comment['id']}", headers=headers_other)
+    if resp.status_code != 403:
+        print(f"Bystander should not delete comments, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"http://localhost:8080/api/v1/comments/{comment['id']}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Owner failed to delete comment: {resp.text}")
+        return False
+
+    # 4. The deleted comment stays as a placeholder while it has replies
+    resp = requests.get(f"{BASE_URL}/{t_id}/comments")
+    comments = resp.json().get("comments", [])
+    if len(comments) != 1 or not comments[0].get("deleted") or comments[0].get("body"):
+        print(f"Expected a deleted placeholder: {resp.text}")
+        return False
+
+    print("--- Comments Test Passed ---")
+    return True
+
+def test_reviews():
+    print("\n--- Starting Reviews Test ---")
+    owner_id = f"revowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    alice_id = f"reviewer_a_{int(time.time())}"
+    headers_alice = {"Authorization": f"Bearer {get_auth_token(alice_id)}"}
+    bob_id = f"reviewer_b_{int(time.time())}"
+    headers_bob = {"Authorization": f"Bearer {get_auth_token(bob_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Rated Prompt", "content": "v1", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+
+    # 1. Rate; invalid ratings and self-reviews are rejected
+    resp = requests.put(f"{BASE_URL}/{t_id}/reviews", json={"rating": 6}, headers=headers_alice)
+    if resp.status_code != 400:
+        print(f"Rating out of range should fail, got {resp.status_code}")
+        return False
+    resp = requests.put(f"{BASE_URL}/{t_id}/reviews", json={"rating": 5}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Owner should not review their template, got {resp.status_code}")
+        return False
+    resp = requests.put(f"{BASE_URL}/{t_id}/reviews", json={"rating": 2, "body": "Meh"}, headers=headers_alice)
+    if resp.status_code != 200 or resp.json()["review"]["version"] != 1:
+        print(f"Failed to review template: {resp.text}")
+        return False
+    # Rating again replaces the review
+    resp = requests.put(f"{BASE_URL}/{t_id}/reviews", json={"rating": 4, "body": "Better than I thought"}, headers=headers_alice)
+    if resp.status_code != 200:
+        print(f"Failed to update review: {resp.text}")
+        return False
+
+    # 2. A major update makes earlier reviews stale
+    resp = requests.put(f"{BASE_URL}/{t_id}", json={"title": "Rated Prompt", "content": "v2", "major": True}, headers=headers_owner)
+    if resp.status_code != 200 or not resp.json()["new_version"].get("major"):
+        print(f"Failed to publish a major version: {resp.text}")
+        return False
+    resp = requests.put(f"{BASE_URL}/{t_id}/reviews", json={"rating": 5}, headers=headers_bob)
+    if resp.status_code != 200 or resp.json()["review"]["version"] != 2:
+        print(f"Failed to review the new version: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}/reviews")
+    reviews = {r["user_id"]: r for r in resp.json().get("reviews", [])}
+    if resp.status_code != 200 or len(reviews) != 2 or not reviews[alice_id].get("stale") or reviews[bob_id].get("stale"):
+        print(f"Unexpected reviews: {resp.text}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}/reviews", params={"exclude_stale": "true"})
+    if [r["user_id"] for r in resp.json().get("reviews", [])] != [bob_id]:
+        print(f"Stale reviews should be excluded: {resp.text}")
+        return False
+
+    # 3. The template carries its ratings, and can be filtered and sorted by them
+    resp = requests.get(f"{BASE_URL}/{t_id}", headers=headers_bob)
+    template = resp.json()["template"]
+    if template.get("rating_count") != 2 or abs(template.get("rating_average", 0) - 4.5) > 1e-9 or not 3 < template.get("rating_score", 0) < 4.5:
+        print(f"Unexpected ratings: {resp.text}")
+        return False
+    resp = requests.get(BASE_URL, params={"sort": "TEMPLATE_SORT_RATING", "min_rating": "4.5", "page_size": 100}, headers=headers_bob)
+    if resp.status_code != 200 or t_id not in [t["id"] for t in resp.json().get("templates", [])]:
+        print(f"Template should be listed by rating: {resp.text}")
+        return False
+
+    # 4. Deleting a review updates the ratings
+    resp = requests.delete(f"{BASE_URL}/{t_id}/reviews", headers=headers_alice)
+    if resp.status_code != 200:
+        print(f"Failed to delete review: {resp.text}")
+        return False
+    resp = requests.delete(f"{BASE_URL}/{t_id}/reviews", headers=headers_alice)
+    if resp.status_code != 404:
+        print(f"Deleting a missing review should fail, got {resp.status_code}")
+        return False
+    resp = requests.get(f"{BASE_URL}/{t_id}", headers=headers_bob)
+    if resp.json()["template"].get("rating_count") != 1:
+        print(f"Expected 1 rating after deletion: {resp.text}")
+        return False
+
+    print("--- Reviews Test Passed ---")
+    return True
+
+def test_notifications():
+    print("\n--- Starting Notifications Test ---")
+    owner_id = f"notifowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    alice_id = f"notif_a_{int(time.time())}"
+    headers_alice = {"Authorization": f"Bearer {get_auth_token(alice_id)}"}
+    bob_id = f"notif_b_{int(time.time())}"
+    headers_bob = {"Authorization": f"Bearer {get_auth_token(bob_id)}"}
+    notifications_url = BASE_URL.replace("/templates", "/notifications")
+
+    resp = requests.post(BASE_URL, json={"title": "Popular Prompt", "content": "Hello", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create template: {resp.text}")
+        return False
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': owner_id})
+
+    # 1. Likes from two users collapse into one notification; the owner's own like is ignored
+    for headers in (headers_alice, headers_bob, headers_owner):
+        resp = requests.post(f"{BASE_URL}/{t_id}/like", headers=headers)
+        if resp.status_code != 200:
+            print(f"Failed to like template: {resp.text}")
+            return False
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "Nice one"}, headers=headers_alice)
+    if resp.status_code != 200:
+        print(f"Failed to comment: {resp.text}")
+        return False
+    comment_id = resp.json()["comment"]["id"]
+
+    resp = requests.get(notifications_url, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to list notifications: {resp.text}")
+        return False
+    body = resp.json()
+    notifications = body.get("notifications", [])
+    if body.get("unread_count") != 2 or [n["type"] for n in notifications] != ["NOTIFICATION_TYPE_COMMENT", "NOTIFICATION_TYPE_LIKE"]:
+        print(f"Unexpected notifications: {resp.text}")
+        return False
+    comment_notification, like_notification = notifications
+    if like_notification.get("actor_count") != 2 or like_notification.get("event_count") != 2 or like_notification.get("actor_id") != bob_id:
+        print(f"Likes should be collapsed: {like_notification}")
+        return False
+    if comment_notification.get("comment_id") != comment_id or comment_notification.get("template_title") != "Popular Prompt":
+        print(f"Unexpected comment notification: {comment_notification}")
+        return False
+
+    # 2. Replies notify the parent author; inboxes are private
+    resp = requests.post(f"{BASE_URL}/{t_id}/comments", json={"body": "Thanks!", "parent_id": comment_id}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to reply: {resp.text}")
+        return False
+    resp = requests.get(notifications_url, headers=headers_alice)
+    if [n["type"] for n in resp.json().get("notifications", [])] != ["NOTIFICATION_TYPE_REPLY"]:
+        print(f"Expected a reply notification: {resp.text}")
+        return False
+    resp = requests.get(notifications_url)
+    if resp.status_code != 401:
+        print(f"Anonymous inbox should be rejected, got {resp.status_code}")
+        return False
+
+    # 3. Mark read, one by one then all at once
+    resp = requests.post(f"{notifications_url}/read", json={"ids": [comment_notification["id"]]}, headers=headers_bob)
+    if resp.status_code != 200 or resp.json().get("updated", 0) != 0:
+        print(f"Other users' notifications should not be marked read: {resp.text}")
+        return False
+    resp = requests.post(f"{notifications_url}/read", json={"ids": [comment_notification["id"]]}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json().get("updated") != 1:
+        print(f"Failed to mark notification read: {resp.text}")
+        return False
+    resp = requests.get(notifications_url, params={"unread_only": "true"}, headers=headers_owner)
+    if [n["id"] for n in resp.json().get("notifications", [])] != [like_notification["id"]]:
+        print(f"Expected only the like to be unread: {resp.text}")
+        return False
+    resp = requests.post(f"{notifications_url}/read-all", headers=headers_owner)
+    if resp.status_code != 200 or resp.json().get("updated") != 1:
+        print(f"Failed to mark all read: {resp.text}")
+        return False
+    resp = requests.get(notifications_url, headers=headers_owner)
+    if resp.json().get("unread_count", 0) != 0:
+        print(f"Expected no unread notifications: {resp.text}")
+        return False
+
+    print("--- Notifications Test Passed ---")
+    return True
+
+def test_follows():
+    print("\n--- Starting Follows Test ---")
+    author_id = f"author_{int(time.time())}"
+    headers_author = {"Authorization": f"Bearer {get_auth_token(author_id)}"}
+    other_id = f"otherauthor_{int(time.time())}"
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+    reader_id = f"reader_{int(time.time())}"
+    headers_reader = {"Authorization": f"Bearer {get_auth_token(reader_id)}"}
+    users_url = "http://localhost:8080/api/v1/users"
+    feed_url = "http://localhost:8080/api/v1/feed"
+    profile_url = "http://localhost:8080/api/v1/profile"
+
+    # 1. Follow; following yourself or unknown users fails
+    resp = requests.post(f"{users_url}/{author_id}/follow", headers=headers_reader)
+    if resp.status_code != 200 or not resp.json().get("is_following") or resp.json().get("follower_count") != 1:
+        print(f"Failed to follow: {resp.text}")
+        return False
+    resp = requests.post(f"{users_url}/{author_id}/follow", headers=headers_reader)
+    if resp.status_code != 200 or resp.json().get("follower_count") != 1:
+        print(f"Following twice should be a no-op: {resp.text}")
+        return False
+    resp = requests.post(f"{users_url}/{reader_id}/follow", headers=headers_reader)
+    if resp.status_code != 400:
+        print(f"Following yourself should fail, got {resp.status_code}")
+        return False
+    resp = requests.post(f"{users_url}/nobody_{int(time.time())}/follow", headers=headers_reader)
+    if resp.status_code != 404:
+        print(f"Following an unknown user should fail, got {resp.status_code}")
+        return False
+    resp = requests.get(profile_url, headers=headers_author)
+    if resp.json().get("follower_count") != 1:
+        print(f"Expected 1 follower: {resp.text}")
+        return False
+    resp = requests.get(profile_url, headers=headers_reader)
+    if resp.json().get("following_count") != 1:
+        print(f"Expected 1 followed user: {resp.text}")
+        return False
+
+    # 2. The author publishes a template, a new version, a private template and a public fork
+    resp = requests.post(BASE_URL, json={"title": "Feed Prompt", "content": "v1", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_author)
+    t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': t_id, 'owner_id': author_id})
+    requests.put(f"{BASE_URL}/{t_id}", json={"title": "Feed Prompt", "content": "v2"}, headers=headers_author)
+    resp = requests.post(BASE_URL, json={"title": "Secret Prompt", "content": "s", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_author)
+    CREATED_TEMPLATES.append({'id': resp.json()["template"]["id"], 'owner_id': author_id})
+    resp = requests.post(BASE_URL, json={"title": "Other Prompt", "content": "o", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_other)
+    other_t_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': other_t_id, 'owner_id': other_id})
+    resp = requests.post(f"{BASE_URL}/{other_t_id}/fork", headers=headers_author)
+    fork_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': fork_id, 'owner_id': author_id})
+    resp = requests.put(f"{BASE_URL}/{fork_id}", json={"title": "Other Prompt", "content": "o", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_author)
+    if resp.status_code != 200:
+        print(f"Failed to publish fork: {resp.text}")
+        return False
+
+    # 3. The feed lists them, latest first, across pages
+    resp = requests.get(feed_url, params={"page_size": 2}, headers=headers_reader)
+    if resp.status_code != 200:
+        print(f"Failed to get feed: {resp.text}")
+        return False
+    first = resp.json()
+    items = first.get("items", [])
+    if [(i["type"], i["template"]["id"]) for i in items] != [("FEED_ITEM_TYPE_FORK", fork_id), ("FEED_ITEM_TYPE_VERSION", t_id)]:
+        print(f"Unexpected first feed page: {resp.text}")
+        return False
+    if items[1].get("version") != 2 or items[0].get("actor_id") != author_id or not first.get("next_page_token"):
+        print(f"Unexpected feed items: {resp.text}")
+        return False
+    resp = requests.get(feed_url, params={"page_size": 2, "page_token": first["next_page_token"]}, headers=headers_reader)
+    items = resp.json().get("items", [])
+    if [(i["type"], i["template"]["id"]) for i in items] != [("FEED_ITEM_TYPE_TEMPLATE", t_id)]:
+        print(f"Unexpected second feed page: {resp.text}")
+        return False
+    resp = requests.get(feed_url)
+    if resp.status_code != 401:
+        print(f"Anonymous feed should be rejected, got {resp.status_code}")
+        return False
+
+    # 4. Unfollowing empties the feed
+    resp = requests.delete(f"{users_url}/{author_id}/follow", headers=headers_reader)
+    if resp.status_code != 200 or resp.json().get("is_following") or resp.json().get("follower_count", 0) != 0:
+        print(f"Failed to unfollow: {resp.text}")
+        return False
+    resp = requests.get(feed_url, headers=headers_reader)
+    if resp.json().get("items"):
+        print(f"Feed should be empty after unfollowing: {resp.text}")
+        return False
+
+    print("--- Follows Test Passed ---")
+    return True
+
+def test_public_profile():
+    print("\n--- Starting Public Profile Test ---")
+    author_id = f"pubauthor_{int(time.time())}"
+    headers_author = {"Authorization": f"Bearer {get_auth_token(author_id)}"}
+    fan_id = f"fan_{int(time.time())}"
+    headers_fan = {"Authorization": f"Bearer {get_auth_token(fan_id)}"}
+    profile_url = f"http://localhost:8080/api/v1/users/{author_id}"
+
+    resp = requests.put("http://localhost:8080/api/v1/profile", json={"bio": "Prompt tinkerer"}, headers=headers_author)
+    if resp.status_code != 200 or resp.json().get("bio") != "Prompt tinkerer":
+        print(f"Failed to set bio: {resp.text}")
+        return False
+
+    template_ids = []
+    for title in ("Liked Prompt", "Quiet Prompt"):
+        resp = requests.post(BASE_URL, json={"title": title, "content": "c", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_author)
+        template_ids.append(resp.json()["template"]["id"])
+        CREATED_TEMPLATES.append({'id': template_ids[-1], 'owner_id': author_id})
+    resp = requests.post(BASE_URL, json={"title": "Hidden Prompt", "content": "c", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_author)
+    CREATED_TEMPLATES.append({'id': resp.json()["template"]["id"], 'owner_id': author_id})
+    requests.post(f"{BASE_URL}/{template_ids[0]}/like", headers=headers_fan)
+    requests.post(f"{BASE_URL}/{template_ids[0]}/favorite", headers=headers_fan)
+    requests.post(f"http://localhost:8080/api/v1/users/{author_id}/follow", headers=headers_fan)
+
+    # 1. Anyone can read the profile; private fields are never exposed
+    resp = requests.get(profile_url)
+    if resp.status_code != 200:
+        print(f"Failed to get public profile: {resp.text}")
+        return False
+    profile = resp.json()
+    if "email" in resp.text or "mobile" in resp.text:
+        print(f"Public profile leaks private fields: {resp.text}")
+        return False
+    if profile.get("bio") != "Prompt tinkerer" or not profile.get("joined_at") or profile.get("public_template_count") != 2:
+        print(f"Unexpected profile: {resp.text}")
+        return False
+    if int(profile.get("total_likes", 0)) != 1 or int(profile.get("total_favorites", 0)) != 1 or profile.get("follower_count") != 1:
+        print(f"Unexpected profile statistics: {resp.text}")
+        return False
+    if [t["id"] for t in profile.get("top_templates", [])] != template_ids or profile.get("is_following"):
+        print(f"Unexpected top templates: {resp.text}")
+        return False
+
+    # 2. Followers see that they follow the author
+    resp = requests.get(profile_url, headers=headers_fan)
+    if not resp.json().get("is_following"):
+        print(f"Expected is_following: {resp.text}")
+        return False
+
+    resp = requests.get(f"http://localhost:8080/api/v1/users/nobody_{int(time.time())}")
+    if resp.status_code != 404:
+        print(f"Unknown users should have no profile, got {resp.status_code}")
+        return False
+
+    print("--- Public Profile Test Passed ---")
+    return True
+
+def test_usage_stats():
+    print("\n--- Starting Usage Stats Test ---")
+    owner_id = f"statsowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    viewer_id = f"statsviewer_{int(time.time())}"
+    headers_viewer = {"Authorization": f"Bearer {get_auth_token(viewer_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Counted Prompt", "content": "Hello {{name}}", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    version_id = data.get("version", {}).get("id")
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+    stats_url = f"{BASE_URL}/{template_id}/stats"
+
+    # 1. Views are counted once a day per viewer, renders and copies once an hour; instantiations every time
+    for _ in range(2):
+        requests.get(f"{BASE_URL}/{template_id}", headers=headers_viewer)
+    for event in ("TEMPLATE_EVENT_RENDER", "TEMPLATE_EVENT_COPY", "TEMPLATE_EVENT_COPY"):
+        resp = requests.post(f"{BASE_URL}/{template_id}/events", json={"event": event, "version": 1})
+        if resp.status_code != 200:
+            print(f"Failed to record {event}: {resp.text}")
+            return False
+    requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "owner_id": viewer_id, "variables": ["World"]}, headers=headers_viewer)
+
+    resp = requests.post(f"{BASE_URL}/{template_id}/events", json={"event": "TEMPLATE_EVENT_VIEW"})
+    if resp.status_code != 400:
+        print(f"Clients should not record views, got {resp.status_code}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{template_id}/events", json={"event": "TEMPLATE_EVENT_COPY", "version": 99})
+    if resp.status_code != 400:
+        print(f"Events of unknown versions should be rejected, got {resp.status_code}")
+        return False
+
+    # 2. The owner reads the daily statistics
+    resp = requests.get(stats_url, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to get stats: {resp.text}")
+        return False
+    stats = resp.json()
+    totals = stats.get("totals", {})
+    if int(totals.get("views", 0)) != 1 or int(totals.get("renders", 0)) != 1 or int(totals.get("copies", 0)) != 1 or int(totals.get("instantiations", 0)) != 1:
+        print(f"Unexpected totals: {resp.text}")
+        return False
+    if len(stats.get("days", [])) != 30:
+        print(f"Expected 30 days by default, got {len(stats.get('days', []))}")
+        return False
+
+    # 3. CSV export
+    resp = requests.get(stats_url, params={"format": "csv"}, headers=headers_owner)
+    if resp.status_code != 200 or not resp.headers.get("Content-Type", "").startswith("text/csv"):
+        print(f"Failed to export stats: {resp.status_code} {resp.text}")
+        return False
+    lines = resp.text.strip().splitlines()
+    if lines[0] != "date,views,renders,copies,instantiations" or len(lines) != 31:
+        print(f"Unexpected CSV: {resp.text}")
+        return False
+
+    # 4. Only the owner can read the statistics
+    resp = requests.get(stats_url, headers=headers_viewer)
+    if resp.status_code != 403:
+        print(f"Expected 403 for non-owner, got {resp.status_code}")
+        return False
+
+    print("--- Usage Stats Test Passed ---")
+    return True
+
+def test_audit_log():
+    print("\n--- Starting Audit Log Test ---")
+    owner_id = f"auditowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}", "X-Request-Id": f"fvt-{owner_id}"}
+    other_id = f"auditother_{int(time.time())}"
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+    audit_url = "http://localhost:8080/api/v1/audit-events"
+
+    resp = requests.post(BASE_URL, json={"title": "Audited Prompt", "content": "Hello {{name}}", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    template_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+    resp = requests.put(f"{BASE_URL}/{template_id}", json={"title": "Audited Prompt v2", "content": "Hi {{name}}"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to update template: {resp.text}")
+        return False
+
+    # 1. The owner sees who changed what, latest first
+    resp = requests.get(audit_url, params={"resource_id": template_id}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to list audit events: {resp.text}")
+        return False
+    events = resp.json().get("events", [])
+    if [e.get("action") for e in events] != ["template.update", "template.create"]:
+        print(f"Unexpected audit events: {resp.text}")
+        return False
+    update = events[0]
+    if update.get("actor_id") != owner_id or update.get("request_id") != f"fvt-{owner_id}":
+        print(f"Unexpected actor or request ID: {update}")
+        return False
+    if update.get("before", {}).get("title") != "Audited Prompt" or update.get("after", {}).get("title") != "Audited Prompt v2":
+        print(f"Unexpected before/after: {update}")
+        return False
+
+    # 2. Other users do not see the owner's events
+    resp = requests.get(audit_url, params={"resource_id": template_id}, headers=headers_other)
+    if resp.status_code != 200 or resp.json().get("events"):
+        print(f"Other users should not see the events: {resp.status_code} {resp.text}")
+        return False
+
+    # 3. The audit log requires authentication
+    resp = requests.get(audit_url)
+    if resp.status_code != 401:
+        print(f"Expected 401 for anonymous caller, got {resp.status_code}")
+        return False
+
+    print("--- Audit Log Test Passed ---")
+    return True
+
+def test_prompt_history():
+    print("\n--- Starting Prompt History Test ---")
+    owner_id = f"historyowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    other_id = f"historyother_{int(time.time())}"
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "History Prompt", "content": "Hello {{name}}", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    version_id = data.get("version", {}).get("id")
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+    for name in ("Ada Lovelace", "Alan Turing"):
+        resp = requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "variables": [name]}, headers=headers_owner)
+        if resp.status_code != 200:
+            print(f"Failed to create prompt: {resp.text}")
+            return False
+    requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "variables": ["Grace Hopper"]}, headers=headers_other)
+
+    # 1. Prompts are joined with their template title and version number
+    resp = requests.get(PROMPT_URL, params={"template_id": template_id, "include_total_count": "true"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to list prompts: {resp.text}")
+        return False
+    data = resp.json()
+    prompts = data.get("prompts", [])
+    if data.get("total_count") != 2 or [p["variables"][0] for p in prompts] != ["Alan Turing", "Ada Lovelace"]:
+        print(f"Unexpected prompts: {resp.text}")
+        return False
+    if prompts[0].get("template_title") != "History Prompt" or prompts[0].get("version") != 1:
+        print(f"Prompt not joined with its template: {prompts[0]}")
+        return False
+
+    # 2. Search the variable values
+    resp = requests.get(PROMPT_URL, params={"template_id": template_id, "query": "lovelace"}, headers=headers_owner)
+    prompts = resp.json().get("prompts", [])
+    if [p["variables"][0] for p in prompts] != ["Ada Lovelace"]:
+        print(f"Unexpected search results: {resp.text}")
+        return False
+
+    # 3. Filter by date range
+    resp = requests.get(PROMPT_URL, params={"template_id": template_id, "start_time": "2999-01-01T00:00:00Z"}, headers=headers_owner)
+    if resp.json().get("prompts"):
+        print(f"Expected no prompts in the future: {resp.text}")
+        return False
+
+    # 4. Other users' prompts are never listed
+    resp = requests.get(PROMPT_URL, params={"owner_id": other_id}, headers=headers_owner)
+    if resp.status_code != 403:
+        print(f"Expected 403 listing another user's prompts, got {resp.status_code}")
+        return False
+
+    print("--- Prompt History Test Passed ---")
+    return True
+
+def test_saved_prompts():
+    print("\n--- Starting Saved Prompts Test ---")
+    owner_id = f"savedowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Report Prompt", "content": "Write about $$ for $$ and $$", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    version_id = data.get("version", {}).get("id")
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+
+    # 1. Prompts are saved with a title, tags and the rendered text
+    resp = requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "variables": ["sales", "Q3", "Q4"], "title": "Q3 report", "tags": ["work"]}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create prompt: {resp.text}")
+        return False
+    prompt = resp.json()["prompt"]
+    if prompt.get("rendered_text") != "Write about sales for Q3 and Q4" or prompt.get("title") != "Q3 report":
+        print(f"Unexpected prompt: {prompt}")
+        return False
+    requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "variables": ["costs"]}, headers=headers_owner)
+
+    # 2. Pin it and find it again
+    resp = requests.put(f"{PROMPT_URL}/{prompt['id']}", json={"notes": "Sent to finance", "tags": ["work"], "pinned": True, "mark_used": True}, headers=headers_owner)
+    if resp.status_code != 200 or not resp.json()["prompt"].get("pinned"):
+        print(f"Failed to update prompt: {resp.status_code} {resp.text}")
+        return False
+    resp = requests.get(PROMPT_URL, params={"template_id": template_id, "pinned_only": "true"}, headers=headers_owner)
+    if [p["id"] for p in resp.json().get("prompts", [])] != [prompt["id"]]:
+        print(f"Unexpected pinned prompts: {resp.text}")
+        return False
+    resp = requests.get(PROMPT_URL, params={"template_id": template_id, "sort": "PROMPT_SORT_LAST_USED"}, headers=headers_owner)
+    prompts = resp.json().get("prompts", [])
+    if len(prompts) != 2 or prompts[0]["id"] != prompt["id"]:
+        print(f"Expected the used prompt first: {resp.text}")
+        return False
+    resp = requests.get(PROMPT_URL, params={"query": "finance"}, headers=headers_owner)
+    if [p["id"] for p in resp.json().get("prompts", [])] != [prompt["id"]]:
+        print(f"Notes not searchable: {resp.text}")
+        return False
+
+    # 3. Duplicate against a new version with fewer placeholders
+    resp = requests.put(f"{BASE_URL}/{template_id}", json={"title": "Report Prompt", "content": "Summarize $$ for $$"}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to update template: {resp.text}")
+        return False
+    resp = requests.post(f"{PROMPT_URL}/{prompt['id']}/duplicate", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to duplicate prompt: {resp.text}")
+        return False
+    data = resp.json()
+    drift = data.get("drift", {})
+    if data["prompt"].get("rendered_text") != "Summarize sales for Q3" or data["prompt"].get("version") != 2:
+        print(f"Unexpected duplicate: {data}")
+        return False
+    if drift.get("dropped_variables") != ["Q4"] or drift.get("previous_placeholders") != 3 or drift.get("latest_placeholders") != 2:
+        print(f"Unexpected drift: {drift}")
+        return False
+
+    print("--- Saved Prompts Test Passed ---")
+    return True
+
+def test_variable_presets():
+    print("\n--- Starting Variable Presets Test ---")
+    owner_id = f"presetowner_{int(time.time())}"
+    other_id = f"presetother_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+    preset_url = BASE_URL.replace("/templates", "/presets")
+
+    resp = requests.post(BASE_URL, json={"title": "Pitch Prompt", "content": "Pitch {{product}} to {{audience}}, {{tone}}", "visibility": "VISIBILITY_PUBLIC"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    version_id = data.get("version", {}).get("id")
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+
+    # 1. Save a preset
+    resp = requests.post(preset_url, json={"name": "Acme", "values": {"product": "Acme CRM", "audience": "CTOs"}}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create preset: {resp.text}")
+        return False
+    preset_id = resp.json()["preset"]["id"]
+    resp = requests.get(preset_url, headers=headers_owner)
+    if [p["id"] for p in resp.json().get("presets", [])] != [preset_id]:
+        print(f"Unexpected presets: {resp.text}")
+        return False
+
+    # 2. Apply it with an explicit override
+    resp = requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": version_id, "preset_id": preset_id, "named_variables": {"audience": "startups"}}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create prompt: {resp.text}")
+        return False
+    data = resp.json()
+    report = data.get("report", {})
+    if data["prompt"].get("rendered_text") != "Pitch Acme CRM to startups, {{tone}}":
+        print(f"Unexpected rendered text: {data}")
+        return False
+    if report.get("filled_from_preset") != ["product"] or report.get("missing") != ["tone"]:
+        print(f"Unexpected report: {report}")
+        return False
+
+    # 3. Render without saving
+    resp = requests.post(f"{BASE_URL}/{template_id}/render", json={"preset_id": preset_id, "named_variables": {"tone": "briefly"}}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json().get("rendered_text") != "Pitch Acme CRM to CTOs, briefly":
+        print(f"Unexpected render: {resp.status_code} {resp.text}")
+        return False
+
+    # 4. Another user can neither use nor change the preset
+    resp = requests.post(f"{BASE_URL}/{template_id}/render", json={"preset_id": preset_id}, headers=headers_other)
+    if resp.status_code != 404:
+        print(f"Expected 404 for another user's preset, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"{preset_url}/{preset_id}", headers=headers_other)
+    if resp.status_code != 404:
+        print(f"Expected 404 deleting another user's preset, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"{preset_url}/{preset_id}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to delete preset: {resp.text}")
+        return False
+
+    print("--- Variable Presets Test Passed ---")
+    return True
+
+def test_reserved_variables():
+    print("\n--- Starting Reserved Variables Test ---")
+    owner_id = f"sysowner_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}", "Accept-Language": "fr-CH, fr;q=0.9", "X-Timezone": "Asia/Tokyo"}
+
+    resp = requests.post(BASE_URL, json={"title": "Memo Prompt", "content": "{{sys.template.title}} in {{sys.language}} ({{sys.timezone}})", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    template_id = resp.json()["template"]["id"]
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+
+    # 1. Reserved variables are filled from the request
+    resp = requests.post(f"{BASE_URL}/{template_id}/render", json={}, headers=headers_owner)
+    data = resp.json()
+    if resp.status_code != 200 or data.get("rendered_text") != "Memo Prompt in fr-CH (Asia/Tokyo)":
+        print(f"Unexpected render: {resp.status_code} {resp.text}")
+        return False
+    if data.get("report", {}).get("filled_by_system") != ["sys.template.title", "sys.language", "sys.timezone"]:
+        print(f"Unexpected report: {data}")
+        return False
+
+    # 2. They cannot be set by prompts...
+    resp = requests.post(f"{BASE_URL}/{template_id}/render", json={"named_variables": {"sys.language": "de"}}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Expected 400 overriding a reserved variable, got {resp.status_code}")
+        return False
+
+    # 3. ...unless the author marks them as overridable
+    resp = requests.put(f"{BASE_URL}/{template_id}", json={"title": "Memo Prompt", "content": "{{sys.template.title}} in {{sys.language}} ({{sys.timezone}})", "overridable_variables": {"names": ["sys.language"]}}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json().get("new_version", {}).get("overridable_variables") != ["sys.language"]:
+        print(f"Failed to mark variable overridable: {resp.status_code} {resp.text}")
+        return False
+    resp = requests.post(f"{BASE_URL}/{template_id}/render", json={"named_variables": {"sys.language": "de"}}, headers=headers_owner)
+    if resp.status_code != 200 or resp.json().get("rendered_text") != "Memo Prompt in de (Asia/Tokyo)":
+        print(f"Unexpected override: {resp.status_code} {resp.text}")
+        return False
+
+    print("--- Reserved Variables Test Passed ---")
+    return True
+
+def test_prompt_execution():
+    print("\n--- Starting Prompt Execution Test ---")
+    owner_id = f"execowner_{int(time.time())}"
+    other_id = f"execother_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+    execution_url = PROMPT_URL.replace("/prompts", "/executions")
+
+    resp = requests.post(BASE_URL, json={"title": "Memo Prompt", "content": "Write a memo about $$", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+    resp = requests.post(PROMPT_URL, json={"template_id": template_id, "version_id": data["version"]["id"], "variables": ["budgets"]}, headers=headers_owner)
+    prompt_id = resp.json()["prompt"]["id"]
+
+    # 1. Run a saved prompt (the server runs the fake provider)
+    resp = requests.post(execution_url, json={"prompt_id": prompt_id, "parameters": {"model": "fvt-model", "temperature": 0, "max_tokens": 10}}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to execute prompt: {resp.status_code} {resp.text}")
+        return False
+    execution = resp.json()["execution"]
+    if execution.get("output") != "[fvt-model] Write a memo about budgets" or execution.get("status") != "EXECUTION_STATUS_SUCCEEDED":
+        print(f"Unexpected execution: {execution}")
+        return False
+    if execution.get("input") != "Write a memo about budgets" or execution.get("total_tokens") != 11:
+        print(f"Unexpected usage: {execution}")
+        return False
+
+    # 2. Run a rendered text and list the runs
+    resp = requests.post(execution_url, json={"rendered_text": "Hello there", "parameters": {"model": "fvt-model"}}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to execute text: {resp.status_code} {resp.text}")
+        return False
+    resp = requests.get(execution_url, params={"prompt_id": prompt_id}, headers=headers_owner)
+    if [e["id"] for e in resp.json().get("executions", [])] != [execution["id"]]:
+        print(f"Unexpected executions: {resp.text}")
+        return False
+
+    # 3. Invalid parameters and other users' prompts are rejected
+    resp = requests.post(execution_url, json={"rendered_text": "Hi", "parameters": {"temperature": 5}}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Expected 400 for an invalid temperature, got {resp.status_code}")
+        return False
+    resp = requests.post(execution_url, json={"prompt_id": prompt_id}, headers=headers_other)
+    if resp.status_code != 404:
+        print(f"Expected 404 running another user's prompt, got {resp.status_code}")
+        return False
+
+    print("--- Prompt Execution Test Passed ---")
+    return True
+
+def test_streaming_execution():
+    print("\n--- Starting Streaming Execution Test ---")
+    user_id = f"streamer_{int(time.time())}"
+    headers = {"Authorization": f"Bearer {get_auth_token(user_id)}"}
+    stream_url = PROMPT_URL.replace("/prompts", "/executions/stream")
+
+    # 1. The text arrives as delta events, then the stored execution
+    resp = requests.post(stream_url, json={"rendered_text": "Stream this text", "parameters": {"model": "fvt-model"}}, headers=headers, stream=True)
+    if resp.status_code != 200 or not resp.headers.get("Content-Type", "").startswith("text/event-stream"):
+        print(f"Failed to stream execution: {resp.status_code} {resp.text}")
+        return False
+    events = []
+    event = None
+    for line in resp.iter_lines(decode_unicode=True):
+        if line.startswith("event: "):
+            event = line[len("event: "):]
+        elif line.startswith("data: "):
+            events.append((event, json.loads(line[len("data: "):])))
+    deltas = "".join(data.get("delta", "") for name, data in events if name == "delta")
+    if deltas != "[fvt-model] Stream this text" or not events or events[-1][0] != "execution":
+        print(f"Unexpected events: {events}")
+        return False
+    execution = events[-1][1]
+    if execution.get("output") != deltas or execution.get("status") != "EXECUTION_STATUS_SUCCEEDED":
+        print(f"Unexpected execution: {execution}")
+        return False
+
+    # 2. The streamed run is listed like the others
+    resp = requests.get(PROMPT_URL.replace("/prompts", "/executions"), headers=headers)
+    if [e["id"] for e in resp.json().get("executions", [])] != [execution["id"]]:
+        print(f"Unexpected executions: {resp.text}")
+        return False
+
+    # 3. Errors before the stream starts are plain HTTP errors
+    resp = requests.post(stream_url, json={}, headers=headers)
+    if resp.status_code != 400:
+        print(f"Expected 400 streaming nothing, got {resp.status_code}")
+        return False
+
+    print("--- Streaming Execution Test Passed ---")
+    return True
+
+def test_evaluation_suites():
+    print("\n--- Starting Evaluation Suites Test ---")
+    owner_id = f"evalowner_{int(time.time())}"
+    other_id = f"evalother_{int(time.time())}"
+    headers_owner = {"Authorization": f"Bearer {get_auth_token(owner_id)}"}
+    headers_other = {"Authorization": f"Bearer {get_auth_token(other_id)}"}
+
+    resp = requests.post(BASE_URL, json={"title": "Eval Prompt", "content": "Write a {{tone}} memo about $$", "visibility": "VISIBILITY_PRIVATE"}, headers=headers_owner)
+    data = resp.json()
+    template_id = data["template"]["id"]
+    CREATED_TEMPLATES.append({'id': template_id, 'owner_id': owner_id})
+    v1 = data["version"]["id"]
+    cases_url = f"{BASE_URL}/{template_id}/evaluation-cases"
+    runs_url = f"{BASE_URL}/{template_id}/evaluations"
+
+    # 1. Attach test cases with assertions
+    resp = requests.post(cases_url, json={"name": "Budgets", "variables": ["budgets"], "named_variables": {"tone": "formal"}, "assertions": [
+        {"type": "ASSERTION_TYPE_CONTAINS", "value": "memo about budgets"},
+        {"type": "ASSERTION_TYPE_REGEX", "value": "^\\[fvt-model\\]"},
+        {"type": "ASSERTION_TYPE_MAX_LENGTH", "max_length": 200},
+    ]}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create case: {resp.status_code} {resp.text}")
+        return False
+    budgets_case = resp.json()["case"]
+    resp = requests.post(cases_url, json={"name": "JSON", "assertions": [{"type": "ASSERTION_TYPE_JSON_SCHEMA", "value": '{"type": "object"}'}]}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to create JSON case: {resp.status_code} {resp.text}")
+        return False
+    resp = requests.post(cases_url, json={"name": "Bad", "assertions": [{"type": "ASSERTION_TYPE_REGEX", "value": "("}]}, headers=headers_owner)
+    if resp.status_code != 400:
+        print(f"Expected 400 for an invalid regex, got {resp.status_code}")
+        return False
+    resp = requests.get(cases_url, headers=headers_owner)
+    if [c["name"] for c in resp.json().get("cases", [])] != ["Budgets", "JSON"]:
+        print(f"Unexpected cases: {resp.text}")
+        return False
+
+    # 2. A new version drops the topic
+    resp = requests.put(f"{BASE_URL}/{template_id}", json={"title": "Eval Prompt", "content": "Write a {{tone}} note"}, headers=headers_owner)
+    v2 = resp.json()["new_version"]["id"]
+
+    # 3. Run every version
+    resp = requests.post(runs_url, json={"all_versions": True, "parameters": {"model": "fvt-model"}}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to run evaluation: {resp.status_code} {resp.text}")
+        return False
+    runs = resp.json()["runs"]
+    if [(r["version_id"], r["passed"], r["failed"]) for r in runs] != [(v1, 1, 1), (v2, 0, 2)]:
+        print(f"Unexpected runs: {runs}")
+        return False
+    json_result = runs[0]["results"][1]
+    if json_result["passed"] or not json_result["assertions"][0]["message"].startswith("output is not JSON"):
+        print(f"Unexpected JSON result: {json_result}")
+        return False
+
+    # 4. Compare the versions side by side
+    resp = requests.get(f"{runs_url}/compare", params={"base_version_id": v1, "head_version_id": v2}, headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to compare runs: {resp.status_code} {resp.text}")
+        return False
+    changes = {c["case_id"]: c["change"] for c in resp.json()["cases"]}
+    if changes.get(budgets_case["id"]) != "EVALUATION_CHANGE_REGRESSED" or list(changes.values()).count("EVALUATION_CHANGE_UNCHANGED") != 1:
+        print(f"Unexpected comparison: {resp.text}")
+        return False
+    resp = requests.get(runs_url, params={"version_id": v2}, headers=headers_owner)
+    if [r["id"] for r in resp.json().get("runs", [])] != [runs[1]["id"]]:
+        print(f"Unexpected run list: {resp.text}")
+        return False
+
+    # 5. Only editors of the template see its cases
+    resp = requests.get(cases_url, headers=headers_other)
+    if resp.status_code not in (403, 404):
+        print(f"Expected another user to be rejected, got {resp.status_code}")
+        return False
+    resp = requests.delete(f"{PROMPT_URL.replace('/prompts', '/evaluation-cases')}/{budgets_case['id']}", headers=headers_owner)
+    if resp.status_code != 200:
+        print(f"Failed to delete case: {resp.status_code} {resp.text}")
+        return False
+
+    print("--- Evaluation Suites Test Passed ---")
+    return True
+
 def main():
     # Start containers
     print("Starting containers...")
@@ -945,6 +2185,25 @@ def main():
     if success: success = test_pagination()
     if success: success = test_likes_and_favorites()
     if success: success = test_profile_update()
+    if success: success = test_collections()
+    if success: success = test_sharing()
+    if success: success = test_organizations()
+    if success: success = test_admin_role()
+    if success: success = test_moderation()
+    if success: success = test_comments()
+    if success: success = test_reviews()
+    if success: success = test_notifications()
+    if success: success = test_follows()
+    if success: success = test_public_profile()
+    if success: success = test_usage_stats()
+    if success: success = test_audit_log()
+    if success: success = test_prompt_history()
+    if success: success = test_saved_prompts()
+    if success: success = test_variable_presets()
+    if success: success = test_reserved_variables()
+    if success: success = test_prompt_execution()
+    if success: success = test_streaming_execution()
+    if success: success = test_evaluation_suites()
 
     # Cleanup is handled by atexit
 